@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/builder"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	metricsservice "github.com/radius-project/radius/pkg/metrics/service"
+	"github.com/radius-project/radius/pkg/portableresources/backend/drift"
 	profilerservice "github.com/radius-project/radius/pkg/profiler/service"
 	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
 	"github.com/radius-project/radius/pkg/server"
@@ -97,17 +98,23 @@ func main() {
 		hostingSvc = append(hostingSvc, data.NewEmbeddedETCDService(data.EmbeddedETCDServiceOptions{ClientConfigSink: client}))
 	}
 
-	builders, err := builders(options)
+	config, err := controllerconfig.New(options)
 	if err != nil {
 		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
+	builders := builders(config)
+
 	hostingSvc = append(
 		hostingSvc,
 		server.NewAPIService(options, builders),
 		server.NewAsyncWorker(options, builders),
 	)
 
+	if options.Config.RecipeDrift.Enabled {
+		hostingSvc = append(hostingSvc, drift.NewService(options, config.Engine, config.ConfigLoader))
+	}
+
 	tracerOpts := options.Config.TracerProvider
 	tracerOpts.ServiceName = serviceName
 	hostingSvc = append(hostingSvc, &trace.Service{Options: tracerOpts})
@@ -129,17 +136,12 @@ func main() {
 	}
 }
 
-func builders(options hostoptions.HostOptions) ([]builder.Builder, error) {
-	config, err := controllerconfig.New(options)
-	if err != nil {
-		return nil, err
-	}
-
+func builders(config *controllerconfig.RecipeControllerConfig) []builder.Builder {
 	return []builder.Builder{
 		corerp_setup.SetupNamespace(config).GenerateBuilder(),
 		daprrp_setup.SetupNamespace(config).GenerateBuilder(),
 		msgrp_setup.SetupNamespace(config).GenerateBuilder(),
 		dsrp_setup.SetupNamespace(config).GenerateBuilder(),
 		// Add resource provider builders...
-	}, nil
+	}
 }
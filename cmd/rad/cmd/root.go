@@ -37,6 +37,7 @@ import (
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
@@ -50,9 +51,11 @@ import (
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_plan "github.com/radius-project/radius/pkg/cli/cmd/recipe/plan"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	recipe_upgrade "github.com/radius-project/radius/pkg/cli/cmd/recipe/upgrade"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
@@ -223,6 +226,9 @@ func initSubCommands() {
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
+	planRecipeCmd, _ := recipe_plan.NewCommand(framework)
+	recipeCmd.AddCommand(planRecipeCmd)
+
 	registerRecipeCmd, _ := recipe_register.NewCommand(framework)
 	recipeCmd.AddCommand(registerRecipeCmd)
 
@@ -232,12 +238,18 @@ func initSubCommands() {
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
+	upgradeRecipeCmd, _ := recipe_upgrade.NewCommand(framework)
+	recipeCmd.AddCommand(upgradeRecipeCmd)
+
 	providerCmd := credential.NewCommand(framework)
 	RootCmd.AddCommand(providerCmd)
 
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	deadLetterCmd := deadletter.NewCommand(framework)
+	RootCmd.AddCommand(deadLetterCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
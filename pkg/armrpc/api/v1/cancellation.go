@@ -0,0 +1,33 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrOperationCanceled is the cause of the cancellation of the context passed to an async operation controller when
+// the operation is canceled by the user.
+var ErrOperationCanceled = errors.New("the async operation was canceled by the user")
+
+// IsOperationCanceled returns true if ctx was canceled because the user canceled the async operation. Controllers and
+// recipe drivers use it to tell a cancellation apart from a timeout or a shutdown, and to roll back the changes made
+// so far where possible.
+func IsOperationCanceled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrOperationCanceled)
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+)
+
+// DeadLetterOperation represents an async operation that was moved to the dead-letter queue because it could
+// not be processed.
+type DeadLetterOperation struct {
+	// ID is the ID of the dead-lettered queue message.
+	ID string `json:"id"`
+
+	// OperationID is the ID of the async operation.
+	OperationID string `json:"operationId,omitempty"`
+
+	// OperationType is the type of the async operation, for example 'APPLICATIONS.CORE/CONTAINERS|PUT'.
+	OperationType string `json:"operationType,omitempty"`
+
+	// ResourceID is the ID of the resource the async operation was operating on.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Reason is the reason the operation was dead-lettered.
+	Reason string `json:"reason"`
+
+	// LastError is the last error observed while processing the operation.
+	LastError string `json:"lastError,omitempty"`
+
+	// DequeueCount is the number of times the operation was dequeued.
+	DequeueCount int `json:"dequeueCount"`
+
+	// EnqueuedAt is the time when the operation was queued.
+	EnqueuedAt time.Time `json:"enqueuedAt"`
+
+	// DeadLetteredAt is the time when the operation was dead-lettered.
+	DeadLetteredAt time.Time `json:"deadLetteredAt"`
+}
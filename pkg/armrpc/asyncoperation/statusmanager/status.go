@@ -43,4 +43,12 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime,omitempty"`
+
+	// CancelRequestedTime is the time when the user requested the cancellation of the async operation.
+	CancelRequestedTime *time.Time `json:"cancelRequestedTime,omitempty"`
+}
+
+// CancelRequested returns true if the user requested the cancellation of the async operation.
+func (s *Status) CancelRequested() bool {
+	return s.CancelRequestedTime != nil
 }
@@ -48,6 +48,22 @@ type QueueOperationOptions struct {
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Priority is the priority class of the queued operation. The zero value is queue.PriorityNormal.
+	Priority queue.Priority
+	// Resource is the optional resource that is saved together with the operation status.
+	Resource *ResourceUpdate
+}
+
+// ResourceUpdate is the resource that is saved together with the status of a new async operation. The resource and
+// the operation status are committed in a single batch when their storage clients share the same store, otherwise
+// the resource is saved before the operation status.
+type ResourceUpdate struct {
+	// StorageClient is the storage client of the resource.
+	StorageClient store.StorageClient
+	// Object is the resource to save. The ETag of Object is set once the resource is saved.
+	Object *store.Object
+	// ETag is the optional ETag precondition used to save the resource.
+	ETag string
 }
 
 //go:generate mockgen -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -62,8 +78,24 @@ type StatusManager interface {
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// Reset resets an async operation status to Accepted so that the operation can be processed again.
+	Reset(ctx context.Context, id resources.ID, operationID uuid.UUID) error
+	// RequestCancel records the cancellation request of an async operation. The worker processing the operation
+	// cancels it once it observes the request. It returns ErrOperationCompleted if the operation has already completed.
+	RequestCancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
 
+var (
+	// ErrOperationCompleted represents the error when an async operation can't be changed because it has already completed.
+	ErrOperationCompleted = errors.New("the async operation has already completed")
+)
+
+const (
+	// requestCancelRetryCount is the number of attempts to record a cancellation request when the status is
+	// updated concurrently by the worker.
+	requestCancelRetryCount = 3
+)
+
 // New creates statusManager instance.
 func New(dataProvider dataprovider.DataStorageProvider, q queue.Client, location string) StatusManager {
 	return &statusManager{
@@ -83,7 +115,8 @@ func (aom *statusManager) getClient(ctx context.Context, id resources.ID) (store
 }
 
 // QueueAsyncOperation creates and saves a new status resource with the given parameters in datastore, and queues
-// a request message. If an error occurs, the status is deleted using the storeClient.
+// a request message. If options.Resource is set the resource is saved alongside the status. If queueing fails, the
+// status is deleted using the storeClient.
 func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error {
 	ctx, span := trace.StartProducerSpan(ctx, "statusmanager.QueueAsyncOperation publish", trace.FrontendTracerName)
 	defer span.End()
@@ -116,16 +149,16 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	err = storeClient.Save(ctx, &store.Object{
+	err = saveStatus(ctx, storeClient, &store.Object{
 		Metadata: store.Metadata{ID: opID},
 		Data:     aos,
-	})
+	}, options.Resource)
 
 	if err != nil {
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout, options.Priority); err != nil {
 		delErr := storeClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -138,6 +171,27 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 	return nil
 }
 
+// saveStatus saves the operation status and the optional resource. When both storage clients share the same store
+// they are committed in a single batch so that neither is saved without the other.
+func saveStatus(ctx context.Context, storeClient store.StorageClient, status *store.Object, resource *ResourceUpdate) error {
+	if resource == nil {
+		return storeClient.Save(ctx, status)
+	}
+
+	if tx, ok := storeClient.(store.Transactional); ok && tx.SharesStore(resource.StorageClient) {
+		batch := (&store.Batch{}).
+			Save(resource.Object, store.WithETag(resource.ETag)).
+			Save(status)
+		return tx.CommitBatch(ctx, batch)
+	}
+
+	if err := resource.StorageClient.Save(ctx, resource.Object, store.WithETag(resource.ETag)); err != nil {
+		return err
+	}
+
+	return storeClient.Save(ctx, status)
+}
+
 // Get gets a status object from the datastore or an error if the retrieval fails.
 func (aom *statusManager) Get(ctx context.Context, id resources.ID, operationID uuid.UUID) (*Status, error) {
 	storeClient, err := aom.getClient(ctx, id)
@@ -203,8 +257,80 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 	return storeClient.Delete(ctx, aom.operationStatusResourceID(id, operationID))
 }
 
+// Reset resets the status of the operation to Accepted and clears its error and end time. It is used when a
+// dead-lettered operation is requeued so that the worker doesn't treat the operation as already completed.
+func (aom *statusManager) Reset(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	obj, err := storeClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	s.Status = v1.ProvisioningStateAccepted
+	s.EndTime = nil
+	s.Error = nil
+	s.CancelRequestedTime = nil
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+}
+
+// RequestCancel records the cancellation request in the operation status. It retries when the status is updated
+// concurrently, and returns ErrOperationCompleted if the operation is already in a terminal state.
+func (aom *statusManager) RequestCancel(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	storeClient, err := aom.getClient(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		obj, err := storeClient.Get(ctx, opID)
+		if err != nil {
+			return err
+		}
+
+		s := &Status{}
+		if err := obj.As(s); err != nil {
+			return err
+		}
+
+		if s.Status.IsTerminal() {
+			return ErrOperationCompleted
+		}
+
+		// The cancellation was already requested.
+		if s.CancelRequested() {
+			return nil
+		}
+
+		now := time.Now().UTC()
+		s.CancelRequestedTime = &now
+		s.LastUpdatedTime = now
+		obj.Data = s
+
+		err = storeClient.Save(ctx, obj, store.WithETag(obj.ETag))
+		if errors.Is(err, &store.ErrConcurrency{}) && attempt < requestCancelRetryCount {
+			continue
+		}
+		return err
+	}
+}
+
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration, priority queue.Priority) error {
 	msg := &ctrl.Request{
 		APIVersion:       sCtx.APIVersion,
 		OperationID:      sCtx.OperationID,
@@ -218,5 +344,10 @@ func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMR
 		OperationTimeout: &operationTimeout,
 	}
 
-	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
+	qmsg := queue.NewMessage(msg)
+	qmsg.Priority = priority
+	// Operations take turns with the operations of the other resource groups, so that a large deployment doesn't
+	// starve the other resource groups.
+	qmsg.FairnessKey = strings.ToLower(sCtx.ResourceID.RootScope())
+	return aom.queue.Enqueue(ctx, qmsg)
 }
@@ -19,6 +19,7 @@ package statusmanager
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -30,7 +31,9 @@ import (
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
 )
 
 type asyncOperationsManagerTest struct {
@@ -191,6 +194,110 @@ func TestCreateAsyncOperationStatus(t *testing.T) {
 	}
 }
 
+func TestCreateAsyncOperationStatus_Priority(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+		Priority:         queue.PriorityHigh,
+	}
+
+	aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, msg *queue.Message, options ...queue.EnqueueOptions) error {
+			require.Equal(t, queue.PriorityHigh, msg.Priority)
+			require.Equal(t, "/planes/radius/local/resourcegroups/radius-test-rg", msg.FairnessKey)
+			return nil
+		})
+
+	err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+	require.NoError(t, err)
+}
+
+func TestCreateAsyncOperationStatus_WithResource(t *testing.T) {
+	options := QueueOperationOptions{
+		OperationTimeout: operationTimeoutDuration,
+		RetryAfter:       opererationRetryAfterDuration,
+	}
+
+	t.Run("separate stores save the resource first", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: reqCtx.ResourceID.String()}}
+		options.Resource = &ResourceUpdate{StorageClient: resourceClient, Object: resource, ETag: "resource-etag"}
+
+		gomock.InOrder(
+			resourceClient.EXPECT().Save(gomock.Any(), resource, gomock.Any()).Return(nil),
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil),
+			aomTest.queue.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.NoError(t, err)
+	})
+
+	t.Run("separate stores do not save the status if the resource fails", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		resourceClient := store.NewMockStorageClient(mctrl)
+		resource := &store.Object{Metadata: store.Metadata{ID: reqCtx.ResourceID.String()}}
+		options.Resource = &ResourceUpdate{StorageClient: resourceClient, Object: resource, ETag: "resource-etag"}
+
+		resourceClient.EXPECT().Save(gomock.Any(), resource, gomock.Any()).Return(&store.ErrConcurrency{})
+
+		err := aomTest.manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+	})
+
+	t.Run("shared store commits a batch", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		defer mctrl.Finish()
+
+		db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0o600, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		statusClient, err := boltstore.NewBoltClient(db)
+		require.NoError(t, err)
+		resourceClient, err := boltstore.NewBoltClient(db)
+		require.NoError(t, err)
+
+		dp := dataprovider.NewMockDataStorageProvider(mctrl)
+		dp.EXPECT().GetStorageClient(gomock.Any(), "Applications.Core/operationstatuses").Return(statusClient, nil).AnyTimes()
+		enq := queue.NewMockClient(mctrl)
+		manager := New(dp, enq, "test-location")
+
+		// A precondition failure on the resource must not leave the status behind.
+		resource := &store.Object{Metadata: store.Metadata{ID: reqCtx.ResourceID.String()}, Data: map[string]any{}}
+		options.Resource = &ResourceUpdate{StorageClient: resourceClient, Object: resource, ETag: "not-a-match"}
+		err = manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+		require.Empty(t, resource.ETag)
+
+		_, err = manager.Get(context.TODO(), reqCtx.ResourceID, reqCtx.OperationID)
+		require.ErrorIs(t, err, &store.ErrNotFound{})
+
+		enq.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		options.Resource.ETag = ""
+		err = manager.QueueAsyncOperation(context.TODO(), reqCtx, options)
+		require.NoError(t, err)
+		require.NotEmpty(t, resource.ETag)
+
+		status, err := manager.Get(context.TODO(), reqCtx.ResourceID, reqCtx.OperationID)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateAccepted, status.Status)
+
+		saved, err := resourceClient.Get(context.TODO(), reqCtx.ResourceID.String())
+		require.NoError(t, err)
+		require.Equal(t, resource.ETag, saved.ETag)
+	})
+}
+
 func TestDeleteAsyncOperationStatus(t *testing.T) {
 	deleteCases := []struct {
 		Desc      string
@@ -328,3 +435,107 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestResetAsyncOperationStatus(t *testing.T) {
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	endTime := time.Now().UTC()
+	failed := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:      opID.String(),
+			Name:    opID.String(),
+			Status:  v1.ProvisioningStateFailed,
+			EndTime: &endTime,
+			Error:   &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"},
+		},
+	}
+
+	aomTest.storeClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&store.Object{Metadata: store.Metadata{ID: opID.String(), ETag: "etag"}, Data: failed}, nil)
+
+	aomTest.storeClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+			require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+
+			s := obj.Data.(*Status)
+			require.Equal(t, v1.ProvisioningStateAccepted, s.Status)
+			require.Nil(t, s.EndTime)
+			require.Nil(t, s.Error)
+			return nil
+		})
+
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+	err = aomTest.manager.Reset(context.TODO(), rid, opID)
+	require.NoError(t, err)
+}
+
+func TestRequestCancelAsyncOperation(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	newStatus := func(state v1.ProvisioningState) *store.Object {
+		return &store.Object{
+			Metadata: store.Metadata{ID: opID.String(), ETag: "etag"},
+			Data: &Status{
+				AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Name: opID.String(), Status: state},
+			},
+		}
+	}
+
+	t.Run("records the cancellation", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(v1.ProvisioningStateUpdating), nil)
+		aomTest.storeClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+				require.Equal(t, "etag", store.NewSaveConfig(options...).ETag)
+
+				s := obj.Data.(*Status)
+				require.Equal(t, v1.ProvisioningStateUpdating, s.Status)
+				require.True(t, s.CancelRequested())
+				return nil
+			})
+
+		err := aomTest.manager.RequestCancel(context.TODO(), rid, opID)
+		require.NoError(t, err)
+	})
+
+	t.Run("retries on concurrency error", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return newStatus(v1.ProvisioningStateAccepted), nil
+			}).
+			Times(2)
+		gomock.InOrder(
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&store.ErrConcurrency{}),
+			aomTest.storeClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		err := aomTest.manager.RequestCancel(context.TODO(), rid, opID)
+		require.NoError(t, err)
+	})
+
+	t.Run("completed operation", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.storeClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(newStatus(v1.ProvisioningStateSucceeded), nil)
+
+		err := aomTest.manager.RequestCancel(context.TODO(), rid, opID)
+		require.ErrorIs(t, err, ErrOperationCompleted)
+	})
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// scheduledMessage is a dequeued message waiting to be processed by the worker.
+type scheduledMessage struct {
+	msg *queue.Message
+
+	// group is the resource group (or the root scope) of the resource, used for fairness between tenants.
+	group string
+	// limitKey is the key of the operation concurrency limit which applies to the message, or empty if none applies.
+	limitKey string
+}
+
+// lane is the list of pending messages of a single resource group in a priority class.
+type lane struct {
+	group    string
+	messages []*scheduledMessage
+}
+
+// priorityClass holds the pending messages of a priority class, one lane per resource group.
+type priorityClass struct {
+	priority queue.Priority
+	weight   int
+	credit   int
+
+	lanes []*lane
+	// next is the index of the lane that is served next.
+	next int
+}
+
+// scheduler decides the order in which dequeued messages are processed. Priority classes are served in weighted
+// round-robin order so that lower classes are never starved, and the resource groups in a class are served in
+// round-robin order so that a single large deployment cannot starve other resource groups. The scheduler only sees
+// the pending messages, so the queue also dequeues the resource groups in turns using the FairnessKey of the messages.
+// Messages whose operation type has reached its concurrency limit are skipped until a running operation of that type
+// is done.
+//
+// scheduler is not thread-safe, it is only used by the message loop of the worker.
+type scheduler struct {
+	classes []*priorityClass
+	limits  map[string]int
+	running map[string]int
+	pending int
+}
+
+func newScheduler(options Options) *scheduler {
+	s := &scheduler{
+		limits:  map[string]int{},
+		running: map[string]int{},
+	}
+
+	for _, p := range []queue.Priority{queue.PriorityHigh, queue.PriorityNormal, queue.PriorityLow} {
+		weight := defaultPriorityWeights[p]
+		for k, v := range options.PriorityWeights {
+			if strings.EqualFold(k, p.String()) && v > 0 {
+				weight = v
+			}
+		}
+		s.classes = append(s.classes, &priorityClass{priority: p, weight: weight})
+	}
+
+	for k, v := range options.OperationConcurrencyLimits {
+		if v > 0 {
+			s.limits[strings.ToUpper(k)] = v
+		}
+	}
+
+	return s
+}
+
+// len returns the number of pending messages.
+func (s *scheduler) len() int {
+	return s.pending
+}
+
+// push adds a dequeued message to the scheduler.
+func (s *scheduler) push(msg *queue.Message) {
+	item := &scheduledMessage{msg: msg}
+
+	// Messages that can't be decoded are still scheduled so that the worker can dead-letter them.
+	op := &ctrl.Request{}
+	if err := json.Unmarshal(msg.Data, op); err == nil {
+		if id, err := resources.ParseResource(op.ResourceID); err == nil {
+			item.group = strings.ToLower(id.RootScope())
+		}
+		item.limitKey = s.limitKey(op.OperationType)
+	}
+
+	class := s.class(msg.Priority)
+	for _, l := range class.lanes {
+		if l.group == item.group {
+			l.messages = append(l.messages, item)
+			s.pending++
+			return
+		}
+	}
+
+	class.lanes = append(class.lanes, &lane{group: item.group, messages: []*scheduledMessage{item}})
+	s.pending++
+}
+
+// next removes and returns the next message to process, or nil if no pending message can be processed now.
+func (s *scheduler) next() *scheduledMessage {
+	// Smooth weighted round-robin: every class that has a message to process earns its weight, and the class with the
+	// most credit is served and pays the total weight of the candidates.
+	var selected *priorityClass
+	total := 0
+	for _, c := range s.classes {
+		if !s.ready(c) {
+			c.credit = 0
+			continue
+		}
+		c.credit += c.weight
+		total += c.weight
+		if selected == nil || c.credit > selected.credit {
+			selected = c
+		}
+	}
+
+	if selected == nil {
+		return nil
+	}
+	selected.credit -= total
+
+	return s.take(selected)
+}
+
+// done releases the concurrency limit held by the message once it is processed.
+func (s *scheduler) done(item *scheduledMessage) {
+	if item.limitKey != "" && s.running[item.limitKey] > 0 {
+		s.running[item.limitKey]--
+	}
+}
+
+// remove removes a pending message from the scheduler without processing it.
+func (s *scheduler) remove(item *scheduledMessage) {
+	for _, c := range s.classes {
+		for i, l := range c.lanes {
+			for j, m := range l.messages {
+				if m == item {
+					l.messages = append(l.messages[:j], l.messages[j+1:]...)
+					s.pending--
+					if len(l.messages) == 0 {
+						c.removeLane(i)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// all returns all pending messages.
+func (s *scheduler) all() []*scheduledMessage {
+	items := []*scheduledMessage{}
+	for _, c := range s.classes {
+		for _, l := range c.lanes {
+			items = append(items, l.messages...)
+		}
+	}
+	return items
+}
+
+func (s *scheduler) class(p queue.Priority) *priorityClass {
+	for _, c := range s.classes {
+		if c.priority == p.Class() {
+			return c
+		}
+	}
+	return nil
+}
+
+// limitKey returns the key of the concurrency limit for the operation type. A limit can be set for an operation type,
+// such as 'Applications.Core/containers|PUT', or for all the operations of a resource type, such as
+// 'Applications.Core/containers'.
+func (s *scheduler) limitKey(operationType string) string {
+	if len(s.limits) == 0 {
+		return ""
+	}
+
+	ot, ok := v1.ParseOperationType(operationType)
+	if !ok {
+		return ""
+	}
+
+	if _, ok := s.limits[ot.String()]; ok {
+		return ot.String()
+	}
+	if _, ok := s.limits[ot.Type]; ok {
+		return ot.Type
+	}
+	return ""
+}
+
+func (s *scheduler) allowed(item *scheduledMessage) bool {
+	return item.limitKey == "" || s.running[item.limitKey] < s.limits[item.limitKey]
+}
+
+// ready returns true if the class has a message that can be processed now.
+func (s *scheduler) ready(c *priorityClass) bool {
+	for _, l := range c.lanes {
+		for _, m := range l.messages {
+			if s.allowed(m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// take removes the first message that can be processed from the next lane of the class that has one.
+func (s *scheduler) take(c *priorityClass) *scheduledMessage {
+	for n := 0; n < len(c.lanes); n++ {
+		i := (c.next + n) % len(c.lanes)
+		l := c.lanes[i]
+		for j, m := range l.messages {
+			if !s.allowed(m) {
+				continue
+			}
+
+			l.messages = append(l.messages[:j], l.messages[j+1:]...)
+			s.pending--
+			if m.limitKey != "" {
+				s.running[m.limitKey]++
+			}
+
+			// Serve the following lane next time.
+			if len(l.messages) == 0 {
+				c.removeLane(i)
+				c.next = i
+			} else {
+				c.next = i + 1
+			}
+			if len(c.lanes) > 0 {
+				c.next %= len(c.lanes)
+			} else {
+				c.next = 0
+			}
+			return m
+		}
+	}
+	return nil
+}
+
+func (c *priorityClass) removeLane(i int) {
+	c.lanes = append(c.lanes[:i], c.lanes[i+1:]...)
+	if c.next > i {
+		c.next--
+	}
+	if c.next >= len(c.lanes) {
+		c.next = 0
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"testing"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduledTestMessage(id string, resourceID string, operationType string, priority queue.Priority) *queue.Message {
+	msg := queue.NewMessage(&ctrl.Request{ResourceID: resourceID, OperationType: operationType})
+	msg.ID = id
+	msg.Priority = priority
+	return msg
+}
+
+func nextIDs(s *scheduler) []string {
+	ids := []string{}
+	for item := s.next(); item != nil; item = s.next() {
+		ids = append(ids, item.msg.ID)
+	}
+	return ids
+}
+
+const (
+	testContainerA = "/planes/radius/local/resourceGroups/a/providers/Applications.Core/containers/c"
+	testContainerB = "/planes/radius/local/resourceGroups/b/providers/Applications.Core/containers/c"
+	testPutType    = "APPLICATIONS.CORE/CONTAINERS|PUT"
+	testDeleteType = "APPLICATIONS.CORE/CONTAINERS|DELETE"
+)
+
+func TestScheduler_RoundRobinResourceGroups(t *testing.T) {
+	s := newScheduler(Options{})
+	s.push(newScheduledTestMessage("a1", testContainerA, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("a2", testContainerA, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("a3", testContainerA, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("b1", testContainerB, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("b2", testContainerB, testPutType, queue.PriorityNormal))
+	require.Equal(t, 5, s.len())
+
+	require.Equal(t, []string{"a1", "b1", "a2", "b2", "a3"}, nextIDs(s))
+	require.Equal(t, 0, s.len())
+}
+
+func TestScheduler_PriorityWeights(t *testing.T) {
+	tests := []struct {
+		name     string
+		weights  map[string]int
+		expected map[queue.Priority]int
+	}{
+		{
+			name:     "default weights",
+			expected: map[queue.Priority]int{queue.PriorityHigh: 4, queue.PriorityNormal: 2, queue.PriorityLow: 1},
+		},
+		{
+			name:     "custom weights",
+			weights:  map[string]int{"high": 1, "NORMAL": 1, "Low": 1, "unknown": 10},
+			expected: map[queue.Priority]int{queue.PriorityHigh: 1, queue.PriorityNormal: 1, queue.PriorityLow: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newScheduler(Options{PriorityWeights: tt.weights})
+			for _, p := range []queue.Priority{queue.PriorityHigh, queue.PriorityNormal, queue.PriorityLow} {
+				for i := 0; i < 10; i++ {
+					s.push(newScheduledTestMessage(p.String(), testContainerA, testPutType, p))
+				}
+			}
+
+			// Every class gets its share of a full round.
+			rounds := 0
+			for _, w := range tt.expected {
+				rounds += w
+			}
+
+			actual := map[queue.Priority]int{}
+			for i := 0; i < rounds; i++ {
+				item := s.next()
+				require.NotNil(t, item)
+				actual[item.msg.Priority]++
+			}
+			require.Equal(t, tt.expected, actual)
+
+			// Lower classes are processed once the higher classes are empty.
+			require.Equal(t, 30, rounds+len(nextIDs(s)))
+		})
+	}
+}
+
+func TestScheduler_OperationConcurrencyLimits(t *testing.T) {
+	t.Run("operation type", func(t *testing.T) {
+		s := newScheduler(Options{OperationConcurrencyLimits: map[string]int{"Applications.Core/containers|PUT": 1}})
+		s.push(newScheduledTestMessage("put1", testContainerA, testPutType, queue.PriorityNormal))
+		s.push(newScheduledTestMessage("put2", testContainerB, testPutType, queue.PriorityNormal))
+		s.push(newScheduledTestMessage("delete", testContainerA, testDeleteType, queue.PriorityNormal))
+
+		put1 := s.next()
+		require.Equal(t, "put1", put1.msg.ID)
+
+		// put2 waits for put1 while the delete is not limited.
+		require.Equal(t, []string{"delete"}, nextIDs(s))
+
+		s.done(put1)
+		require.Equal(t, []string{"put2"}, nextIDs(s))
+	})
+
+	t.Run("resource type", func(t *testing.T) {
+		s := newScheduler(Options{OperationConcurrencyLimits: map[string]int{"applications.core/containers": 2}})
+		s.push(newScheduledTestMessage("put1", testContainerA, testPutType, queue.PriorityNormal))
+		s.push(newScheduledTestMessage("put2", testContainerB, testPutType, queue.PriorityNormal))
+		s.push(newScheduledTestMessage("delete", testContainerA, testDeleteType, queue.PriorityNormal))
+
+		require.Equal(t, []string{"put1", "put2"}, nextIDs(s))
+		require.Equal(t, 1, s.len())
+	})
+}
+
+func TestScheduler_InvalidMessage(t *testing.T) {
+	s := newScheduler(Options{OperationConcurrencyLimits: map[string]int{"Applications.Core/containers": 1}})
+	msg := queue.NewMessage("invalid")
+	msg.ID = "invalid"
+	s.push(msg)
+
+	require.Equal(t, []string{"invalid"}, nextIDs(s))
+}
+
+func TestScheduler_Remove(t *testing.T) {
+	s := newScheduler(Options{})
+	s.push(newScheduledTestMessage("a1", testContainerA, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("b1", testContainerB, testPutType, queue.PriorityNormal))
+	s.push(newScheduledTestMessage("a2", testContainerA, testPutType, queue.PriorityHigh))
+
+	all := s.all()
+	require.Len(t, all, 3)
+
+	for _, item := range all {
+		if item.msg.ID == "b1" {
+			s.remove(item)
+		}
+	}
+
+	require.Equal(t, 2, s.len())
+	require.Equal(t, []string{"a2", "a1"}, nextIDs(s))
+}
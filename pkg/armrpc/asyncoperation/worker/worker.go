@@ -35,9 +35,6 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
-
-	"github.com/google/uuid"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
@@ -58,8 +55,25 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultMaxPendingOperationsFactor is the default number of pending messages per concurrent operation.
+	defaultMaxPendingOperationsFactor = 5
+
+	// defaultCancellationPollInterval is the default interval to check whether the cancellation of a running operation was requested.
+	defaultCancellationPollInterval = time.Duration(5) * time.Second
+
+	// defaultCancellationGracePeriod is the default duration given to a canceled operation to stop and roll back.
+	defaultCancellationGracePeriod = time.Duration(2) * time.Minute
 )
 
+// defaultPriorityWeights is the default share of the processing slots given to each priority class when messages
+// of several classes are pending.
+var defaultPriorityWeights = map[queue.Priority]int{
+	queue.PriorityHigh:   4,
+	queue.PriorityNormal: 2,
+	queue.PriorityLow:    1,
+}
+
 // Options configures AsyncRequestProcessorWorker
 type Options struct {
 	// MaxOperationConcurrency is the maximum concurrency to process async request operation.
@@ -79,6 +93,26 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// MaxPendingOperations is the maximum number of dequeued messages waiting to be scheduled. A larger value lets the
+	// worker see the messages of more resource groups, at the cost of holding more message locks.
+	MaxPendingOperations int
+
+	// PriorityWeights is the share of the processing slots given to each priority class ('High', 'Normal' or 'Low')
+	// when messages of several classes are pending. The default is 4:2:1.
+	PriorityWeights map[string]int
+
+	// OperationConcurrencyLimits is the maximum number of operations of an operation type that can be processed
+	// concurrently. The key is either an operation type such as 'Applications.Core/containers|PUT', or a resource
+	// type such as 'Applications.Core/containers' to limit all its operations. Keys are case-insensitive.
+	OperationConcurrencyLimits map[string]int
+
+	// CancellationPollInterval is the interval to check whether the user requested the cancellation of a running operation.
+	CancellationPollInterval time.Duration
+
+	// CancellationGracePeriod is the duration given to a canceled operation to stop and roll back its changes before
+	// it is completed as Canceled.
+	CancellationGracePeriod time.Duration
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -87,8 +121,6 @@ type AsyncRequestProcessWorker struct {
 	sm           manager.StatusManager
 	registry     *ControllerRegistry
 	requestQueue queue.Client
-
-	sem *semaphore.Weighted
 }
 
 // New creates AsyncRequestProcessWorker server instance.
@@ -115,18 +147,27 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.MaxPendingOperations == 0 {
+		options.MaxPendingOperations = options.MaxOperationConcurrency * defaultMaxPendingOperationsFactor
+	}
+	if options.CancellationPollInterval == time.Duration(0) {
+		options.CancellationPollInterval = defaultCancellationPollInterval
+	}
+	if options.CancellationGracePeriod == time.Duration(0) {
+		options.CancellationGracePeriod = defaultCancellationGracePeriod
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
 		sm:           sm,
 		registry:     ctrlRegistry,
 		requestQueue: qu,
-		sem:          semaphore.NewWeighted(int64(options.MaxOperationConcurrency)),
 	}
 }
 
-// Start starts worker's message loop - it starts a loop to process messages from a queue concurrently, and handles deduplication, updating
-// resource and operation status, and running the operation. It returns an error if it fails to start the dequeuer.
+// Start starts worker's message loop - it starts a loop to schedule and process messages from a queue concurrently, and handles
+// deduplication, updating resource and operation status, and running the operation. It returns an error if it fails to start
+// the dequeuer.
 func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	msgCh, err := queue.StartDequeuer(ctx, w.requestQueue, queue.WithDequeueInterval(w.options.DequeueIntervalDuration))
@@ -134,84 +175,162 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 		return err
 	}
 
+	sched := newScheduler(w.options)
+	running := 0
+
+	// done is buffered so that the processing goroutines never block after the message loop has stopped.
+	done := make(chan *scheduledMessage, w.options.MaxOperationConcurrency)
+
+	extendTicker := time.NewTicker(w.options.MinMessageLockDuration)
+	defer extendTicker.Stop()
+
 	// this loop will run until msgCh is closed (or when ctx is canceled)
-	for msg := range msgCh {
-		// This semaphore will maintain the number of go routines to process the messages concurrently.
-		if err := w.sem.Acquire(ctx, 1); err != nil {
-			break
+	for {
+		// Start processing as many pending messages as the concurrency limits allow.
+		for running < w.options.MaxOperationConcurrency {
+			item := sched.next()
+			if item == nil {
+				break
+			}
+
+			running++
+			go func(item *scheduledMessage) {
+				defer func() { done <- item }()
+				w.processMessage(ctx, item.msg)
+			}(item)
 		}
 
-		go func(msgreq *queue.Message) {
-			defer w.sem.Release(1)
+		// Stop dequeuing while the scheduler is full.
+		in := msgCh
+		if sched.len() >= w.options.MaxPendingOperations {
+			in = nil
+		}
 
-			op := &ctrl.Request{}
-			if err := json.Unmarshal(msgreq.Data, op); err != nil {
-				logger.Error(err, "failed to unmarshal queue message.")
-				return
+		select {
+		case msg, ok := <-in:
+			if !ok {
+				logger.Info("Message loop stopped...")
+				return nil
 			}
+			sched.push(msg)
+
+		case item := <-done:
+			running--
+			sched.done(item)
+
+		case <-extendTicker.C:
+			w.extendPendingMessages(ctx, sched)
+
+		case <-ctx.Done():
+			logger.Info("Message loop stopped...")
+			return nil
+		}
+	}
+}
 
-			reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
+// extendPendingMessages extends the lock of the pending messages which are about to be requeued. Messages whose lock
+// can't be extended are dropped because they will be delivered again.
+func (w *AsyncRequestProcessWorker) extendPendingMessages(ctx context.Context, sched *scheduler) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	for _, item := range sched.all() {
+		if time.Until(item.msg.NextVisibleAt) > w.options.MessageExtendMargin {
+			continue
+		}
 
-			// Populate the default attributes in the current context so all logs will have these fields.
-			reqCtx = ucplog.WrapLogContext(reqCtx,
-				logging.LogFieldResourceID, op.ResourceID,
-				logging.LogFieldOperationID, op.OperationID,
-				logging.LogFieldOperationType, op.OperationType,
-				logging.LogFieldDequeueCount, msgreq.DequeueCount)
+		if err := w.requestQueue.ExtendMessage(ctx, item.msg); err != nil {
+			logger.Error(err, "failed to extend the lock of a pending message", "messageID", item.msg.ID)
+			sched.remove(item)
+		}
+	}
+}
 
-			opLogger := ucplog.FromContextOrDiscard(reqCtx)
+// processMessage processes a single message from the queue.
+func (w *AsyncRequestProcessWorker) processMessage(ctx context.Context, msgreq *queue.Message) {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
-			armReqCtx, err := op.ARMRequestContext()
-			if err != nil {
-				opLogger.Error(err, "failed to get ARM request context.")
-				return
-			}
-			reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
-
-			asyncCtrl := w.registry.Get(armReqCtx.OperationType)
-			if asyncCtrl == nil {
-				opLogger.Error(nil, "cannot process unknown operation: "+armReqCtx.OperationType.String())
-				if err := w.requestQueue.FinishMessage(reqCtx, msgreq); err != nil {
-					opLogger.Error(err, "failed to finish the message")
-				}
-				return
-			}
+	op := &ctrl.Request{}
+	if err := json.Unmarshal(msgreq.Data, op); err != nil {
+		logger.Error(err, "failed to unmarshal queue message.")
+		w.deadLetter(ctx, msgreq, queue.DeadLetterReasonInvalidMessage, err.Error())
+		return
+	}
 
-			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
-				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
-				opLogger.Error(nil, errMsg)
-				failed := ctrl.NewFailedResult(v1.ErrorDetails{
-					Code:    v1.CodeInternal,
-					Message: errMsg,
-				})
-				w.completeOperation(reqCtx, msgreq, failed, asyncCtrl.StorageClient())
-				return
-			}
+	reqCtx := trace.WithTraceparent(ctx, op.TraceparentID)
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+	// Populate the default attributes in the current context so all logs will have these fields.
+	reqCtx = ucplog.WrapLogContext(reqCtx,
+		logging.LogFieldResourceID, op.ResourceID,
+		logging.LogFieldOperationID, op.OperationID,
+		logging.LogFieldOperationType, op.OperationType,
+		logging.LogFieldDequeueCount, msgreq.DequeueCount)
 
-			dup, err := w.isDuplicated(reqCtx, asyncCtrl.StorageClient(), op.ResourceID, op.OperationID)
-			if err != nil {
-				opLogger.Error(err, "failed to check potential deduplication.")
-				return
-			}
-			if dup {
-				opLogger.Info("duplicated message detected")
-				return
-			}
+	opLogger := ucplog.FromContextOrDiscard(reqCtx)
 
-			if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
-				return
-			}
+	armReqCtx, err := op.ARMRequestContext()
+	if err != nil {
+		opLogger.Error(err, "failed to get ARM request context.")
+		w.deadLetter(reqCtx, msgreq, queue.DeadLetterReasonInvalidMessage, err.Error())
+		return
+	}
+	reqCtx = v1.WithARMRequestContext(reqCtx, armReqCtx)
 
-			w.runOperation(reqCtx, msgreq, asyncCtrl)
-		}(msg)
+	asyncCtrl := w.registry.Get(armReqCtx.OperationType)
+	if asyncCtrl == nil {
+		errMsg := "cannot process unknown operation: " + armReqCtx.OperationType.String()
+		opLogger.Error(nil, errMsg)
+		w.deadLetter(reqCtx, msgreq, queue.DeadLetterReasonUnknownOperation, errMsg)
+		return
 	}
 
-	logger.Info("Message loop stopped...")
-	return nil
+	if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
+		errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
+		opLogger.Error(nil, errMsg)
+
+		// Keep the last error reported by the previous attempts before the status is overwritten.
+		lastError := errMsg
+		if status, err := w.getStatus(reqCtx, op); err == nil && status.Error != nil {
+			lastError = status.Error.Message
+		}
+
+		failed := ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: errMsg,
+		})
+		if err := w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, failed.ProvisioningState(), failed.Error); err != nil {
+			return
+		}
+
+		w.deadLetter(reqCtx, msgreq, queue.DeadLetterReasonMaxRetryCountExceeded, lastError)
+		metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(reqCtx, op, &failed)
+		return
+	}
+
+	// TODO: Handle the edge cases:
+	// 1. The same message is delivered twice in multiple instances.
+	// 2. provisioningState is not matched between resource and operationStatuses
+
+	status, err := w.getStatus(reqCtx, op)
+	if err != nil {
+		opLogger.Error(err, "failed to check potential deduplication.")
+		return
+	}
+	if w.isDuplicated(status) {
+		opLogger.Info("duplicated message detected")
+		return
+	}
+
+	// The operation was canceled before it started, so there is nothing to stop.
+	if status.CancelRequested() {
+		opLogger.Info("Operation was canceled before it started.")
+		w.completeOperation(reqCtx, msgreq, newCanceledResult(op), asyncCtrl.StorageClient())
+		return
+	}
+
+	if err = w.updateResourceAndOperationStatus(reqCtx, asyncCtrl.StorageClient(), op, v1.ProvisioningStateUpdating, nil); err != nil {
+		return
+	}
+
+	w.runOperation(reqCtx, msgreq, asyncCtrl)
 }
 
 func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
@@ -224,11 +343,11 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		logger.Error(err, "failed to unmarshal queue message.")
 		return
 	}
-	asyncReqCtx, opCancel := context.WithCancel(ctx)
+	asyncReqCtx, opCancel := context.WithCancelCause(ctx)
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
-	defer opCancel()
+	defer opCancel(nil)
 
 	opDone := make(chan struct{}, 1)
 	opStartAt := time.Now()
@@ -259,9 +378,10 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 
 		logger.Info("Operation returned", "success", result.Error == nil, "code", code, "provisioningState", result.ProvisioningState(), "err", err)
 
-		// There are two cases when asyncReqCtx is canceled.
-		// 1. When the operation is timed out, w.completeOperation will be called in L186
-		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
+		// There are three cases when asyncReqCtx is canceled.
+		// 1. When the operation is timed out, w.completeOperation will be called by the loop below.
+		// 2. When the user cancels the operation, w.completeOperation will be called by the loop below once the controller returns.
+		// 3. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
 			if err != nil {
@@ -276,23 +396,56 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}()
 
 	operationTimeoutAfter := time.After(asyncReq.Timeout())
-	messageExtendAfter := w.getMessageExtendDuration(message.NextVisibleAt)
+	// The timer must outlive the loop iterations since the cancellation poll wakes up the loop more often than the lock is extended.
+	messageExtendTimer := time.NewTimer(w.getMessageExtendDuration(message.NextVisibleAt))
+	defer messageExtendTimer.Stop()
+
+	cancelPoll := time.NewTicker(w.options.CancellationPollInterval)
+	defer cancelPoll.Stop()
+
+	// canceledAfter is set once the user cancels the operation, to wait for the controller to stop and roll back.
+	var canceledAfter <-chan time.Time
 
 	for {
 		select {
-		case <-time.After(messageExtendAfter):
+		case <-cancelPoll.C:
+			status, err := w.getStatus(ctx, asyncReq)
+			if err != nil {
+				logger.Error(err, "failed to check the cancellation of the operation")
+				continue
+			}
+			if !status.CancelRequested() {
+				continue
+			}
+
+			logger.Info("Cancelling async operation as requested by the user.")
+			cancelPoll.Stop()
+			opCancel(v1.ErrOperationCanceled)
+			canceledAfter = time.After(w.options.CancellationGracePeriod)
+
+		case <-canceledAfter:
+			logger.Info("Canceled async operation did not stop within the grace period.", "gracePeriod", w.options.CancellationGracePeriod.String())
+			w.completeOperation(ctx, message, newCanceledResult(asyncReq), asyncCtrl.StorageClient())
+			return
+
+		case <-messageExtendTimer.C:
 			if err := w.requestQueue.ExtendMessage(ctx, message); err != nil {
 				logger.Error(err, "fails to extend message lock")
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
 			}
-			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
+			messageExtendTimer.Reset(w.getMessageExtendDuration(message.NextVisibleAt))
 
 		case <-operationTimeoutAfter:
+			// The timeout does not apply once the operation has been canceled, the grace period does.
+			if canceledAfter != nil {
+				continue
+			}
+
 			logger.Info("Cancelling async operation.")
 
-			opCancel()
+			opCancel(nil)
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
@@ -304,6 +457,10 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			return
 
 		case <-opDone:
+			if canceledAfter != nil {
+				w.completeOperation(ctx, message, newCanceledResult(asyncReq), asyncCtrl.StorageClient())
+			}
+
 			// FIXME: Would this give me all the operations? No matter if it is successful or cancelled or failed?
 			metrics.DefaultAsyncOperationMetrics.RecordAsyncOperationDuration(ctx, asyncReq, opStartAt)
 
@@ -346,6 +503,28 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+// deadLetter moves the message to the dead-letter queue so that it is no longer processed, but can still be
+// inspected and requeued.
+func (w *AsyncRequestProcessWorker) deadLetter(ctx context.Context, message *queue.Message, reason string, lastError string) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	err := queue.DeadLetter(ctx, w.requestQueue, message, queue.DeadLetterInfo{Reason: reason, LastError: lastError})
+	if err != nil {
+		logger.Error(err, "failed to dead-letter the message")
+		return
+	}
+
+	logger.Info("Moved the message to the dead-letter queue.", "reason", reason)
+}
+
+func (w *AsyncRequestProcessWorker) getStatus(ctx context.Context, req *ctrl.Request) (*manager.Status, error) {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.sm.Get(ctx, rID, req.OperationID)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc store.StorageClient, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -374,26 +553,19 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) isDuplicated(ctx context.Context, sc store.StorageClient, resourceID string, operationID uuid.UUID) (bool, error) {
-	rID, err := resources.ParseResource(resourceID)
-	if err != nil {
-		return false, err
-	}
-
-	status, err := w.sm.Get(ctx, rID, operationID)
-	if err != nil {
-		return false, err
-	}
-
+func (w *AsyncRequestProcessWorker) isDuplicated(status *manager.Status) bool {
 	// 1. If the operation is in updating state and the last updated time is within the deduplication duration, we consider it as a duplicated operation.
 	// 2. If the operation is in terminal state, we consider it as a duplicated operation.
-	if (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
+	return (status.Status == v1.ProvisioningStateUpdating && status.LastUpdatedTime.IsZero() &&
 		status.LastUpdatedTime.Add(w.options.DeduplicationDuration).After(time.Now().UTC())) ||
-		status.Status.IsTerminal() {
-		return true, nil
-	}
+		status.Status.IsTerminal()
+}
 
-	return false, nil
+// newCanceledResult returns the result of an operation canceled by the user.
+func newCanceledResult(req *ctrl.Request) ctrl.Result {
+	result := ctrl.NewCanceledResult(fmt.Sprintf("Operation (%s) has been canceled by the user.", req.OperationType))
+	result.Error.Target = req.ResourceID
+	return result
 }
 
 func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time) time.Duration {
@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -30,10 +31,12 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
@@ -202,6 +205,11 @@ func TestStart_UnknownOperation(t *testing.T) {
 
 	require.Equal(t, 1, testMessage.DequeueCount)
 	require.False(t, called)
+
+	deadLetters := tCtx.internalQ.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, queue.DeadLetterReasonUnknownOperation, deadLetters[0].Reason)
+	require.Equal(t, testMessage.Data, deadLetters[0].Data)
 }
 
 func TestStart_MaxDequeueCount(t *testing.T) {
@@ -214,6 +222,12 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(&manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status: v1.ProvisioningStateUpdating,
+			Error:  &v1.ErrorDetails{Code: v1.CodeInternal, Message: "last attempt failed"},
+		},
+	}, nil).Times(1)
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).Times(1)
 
@@ -265,6 +279,12 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 	<-done
 
 	require.Equal(t, expectedDequeueCount+2, testMessage.DequeueCount)
+
+	deadLetters := tCtx.internalQ.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, queue.DeadLetterReasonMaxRetryCountExceeded, deadLetters[0].Reason)
+	require.Equal(t, "last attempt failed", deadLetters[0].LastError)
+	require.Equal(t, testMessage.Data, deadLetters[0].Data)
 }
 
 func TestStart_MaxConcurrency(t *testing.T) {
@@ -346,6 +366,99 @@ func TestStart_MaxConcurrency(t *testing.T) {
 	require.Equal(t, int32(defaultMaxOperationConcurrency), maxConcurrency.Load())
 }
 
+func TestStart_FairnessAcrossResourceGroups(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSP.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Return(store.StorageClient(tCtx.mockSC), nil).AnyTimes()
+
+	// The pending window of the worker is much smaller than the backlog of the busy resource group.
+	registry := NewControllerRegistry(tCtx.mockSP)
+	worker := New(Options{
+		MaxOperationConcurrency: 1,
+		MaxPendingOperations:    2,
+		DequeueIntervalDuration: defaultTestDequeueInterval,
+	}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	processed := make(chan string, 100)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			processed <- v1.ARMRequestContextFromContext(ctx).ResourceID.FindScope(resources_radius.ScopeResourceGroups)
+			time.Sleep(10 * time.Millisecond)
+			return ctrl.Result{}, nil
+		},
+	}
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+	err := registry.Register(
+		ctx,
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	enqueue := func(group string) {
+		testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+		op := &ctrl.Request{}
+		require.NoError(t, json.Unmarshal(testMessage.Data, op))
+		op.ResourceID = fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Applications.Core/environments/%s", group, uuid.NewString())
+		testMessage.Data, err = json.Marshal(op)
+		require.NoError(t, err)
+		testMessage.FairnessKey = "/planes/radius/local/resourcegroups/" + group
+
+		err = tCtx.testQueue.Enqueue(ctx, testMessage)
+		require.NoError(t, err)
+	}
+
+	busyCnt := 20
+	for i := 0; i < busyCnt; i++ {
+		enqueue("busy")
+	}
+	enqueue("quiet")
+
+	done := make(chan struct{}, 1)
+	go func() {
+		err = worker.Start(ctx)
+		require.NoError(t, err)
+		close(done)
+	}()
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loop.
+	cancel()
+	<-done
+	close(processed)
+
+	order := []string{}
+	for group := range processed {
+		order = append(order, group)
+	}
+	require.Len(t, order, busyCnt+1)
+
+	// The operation of the quiet resource group is dequeued in the first round, before the backlog of the busy resource
+	// group is processed.
+	require.Contains(t, order[:2], "quiet")
+}
+
 func TestStart_RunOperation(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -468,6 +581,7 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 
 	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
 	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
@@ -595,6 +709,66 @@ func TestRunOperation_Timeout(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_CancelRequested(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	canceledStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:     uuid.NewString(),
+			Name:   "operation-status",
+			Status: v1.ProvisioningStateUpdating,
+		},
+		CancelRequestedTime: to.Ptr(time.Now().UTC()),
+	}
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(canceledStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ resources.ID, _ uuid.UUID, state v1.ProvisioningState, _ *time.Time, opError *v1.ErrorDetails) error {
+			if state == v1.ProvisioningStateCanceled && strings.HasPrefix(opError.Message, "Operation (APPLICATIONS.CORE/ENVIRONMENTS|PUT) has been canceled") &&
+				strings.HasPrefix(opError.Target, "/subscriptions/00000000-0000-0000-0000-000000000000") {
+				return nil
+			}
+			return errors.New("!!! failed to update status !!!")
+		}).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{CancellationPollInterval: 10 * time.Millisecond}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		StorageClient: tCtx.mockSC,
+		DataProvider:  tCtx.mockSP,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	canceled := atomic.NewBool(false)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			<-ctx.Done()
+			canceled.Store(v1.IsOperationCanceled(ctx))
+			return ctrl.Result{}, ctx.Err()
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	require.True(t, canceled.Load(), "controller context is canceled by the user")
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -35,6 +35,7 @@ func TestDefaultOptions(t *testing.T) {
 	require.Equal(t, defaultMessageExtendMargin, worker.options.MessageExtendMargin)
 	require.Equal(t, defaultMinMessageLockDuration, worker.options.MinMessageLockDuration)
 	require.Equal(t, defaultMaxOperationConcurrency, worker.options.MaxOperationConcurrency)
+	require.Equal(t, defaultMaxOperationConcurrency*defaultMaxPendingOperationsFactor, worker.options.MaxPendingOperations)
 }
 
 func TestUpdateResourceState(t *testing.T) {
@@ -75,6 +75,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/%s", rootScopePath, namespace, defaultoperation.CancelOperationAction),
+		ResourceType:      statusType,
+		Method:            v1.OperationPost,
+		ControllerFactory: defaultoperation.NewCancelOperation,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -83,6 +91,41 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationResult,
 	})
 
+	// Admin operations to inspect, requeue or purge the async operations in the dead-letter queue.
+	deadLetterType := namespace + "/deadletteroperations"
+	deadLetterPath := fmt.Sprintf("%s/providers/%s/deadletteroperations", rootScopePath, namespace)
+	deadLetterOperationPath := fmt.Sprintf("%s/{%s}", deadLetterPath, defaultoperation.DeadLetterOperationIDParameter)
+	handlers = append(handlers,
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationList,
+			ControllerFactory: defaultoperation.NewListDeadLetterOperations,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterOperationPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationGet,
+			ControllerFactory: defaultoperation.NewGetDeadLetterOperation,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterOperationPath + "/requeue",
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationPost,
+			ControllerFactory: defaultoperation.NewRequeueDeadLetterOperation,
+		},
+		server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              deadLetterOperationPath,
+			ResourceType:      deadLetterType,
+			Method:            v1.OperationDelete,
+			ControllerFactory: defaultoperation.NewDeleteDeadLetterOperation,
+		},
+	)
+
 	return handlers
 }
 
@@ -0,0 +1,346 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// FilterQueryParameter is the query parameter used to filter the results of a list operation.
+	FilterQueryParameter = "$filter"
+
+	// OrderByQueryParameter is the query parameter used to sort the results of a list operation.
+	OrderByQueryParameter = "$orderby"
+)
+
+// filterRoots is the set of top-level properties of a resource that can be used in $filter and $orderby.
+var filterRoots = map[string]bool{
+	"name":       true,
+	"type":       true,
+	"location":   true,
+	"tags":       true,
+	"properties": true,
+	"systemData": true,
+}
+
+// ParseFilter parses the value of the $filter query parameter into store conditions.
+//
+// A subset of the OData filter syntax is supported. Conditions can be combined with 'and':
+//
+//	properties/application eq '/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app'
+//	properties/provisioningState in ('Failed', 'Canceled')
+//	startswith(name, 'frontend')
+//	tags/team ne null
+//	systemData/createdAt gt '2023-10-01T00:00:00Z'
+func ParseFilter(filter string) ([]store.FilterCondition, error) {
+	p, err := newFilterParser(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.done() {
+		return nil, nil
+	}
+
+	conditions := []store.FilterCondition{}
+	for {
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if p.done() {
+			return conditions, nil
+		}
+		if err := p.expectKeyword("and"); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ParseOrderBy parses the value of the $orderby query parameter into store sort fields. The value is a comma
+// separated list of property paths, each optionally followed by 'asc' or 'desc'.
+//
+//	systemData/createdAt desc, name
+func ParseOrderBy(orderBy string) ([]store.SortField, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	fields := []store.SortField{}
+	for _, part := range strings.Split(orderBy, ",") {
+		tokens := strings.Fields(part)
+		if len(tokens) == 0 || len(tokens) > 2 {
+			return nil, fmt.Errorf("invalid $orderby %q", orderBy)
+		}
+
+		field, err := filterField(tokens[0])
+		if err != nil {
+			return nil, err
+		}
+
+		sortField := store.SortField{Field: field}
+		if len(tokens) == 2 {
+			switch strings.ToLower(tokens[1]) {
+			case "asc":
+			case "desc":
+				sortField.Descending = true
+			default:
+				return nil, fmt.Errorf("invalid $orderby direction %q, expected 'asc' or 'desc'", tokens[1])
+			}
+		}
+
+		fields = append(fields, sortField)
+	}
+
+	return fields, nil
+}
+
+// filterField converts a '/' separated property path of the versioned resource to the field of the datamodel.
+func filterField(path string) (string, error) {
+	segments := strings.Split(path, "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return "", fmt.Errorf("invalid property path %q", path)
+		}
+	}
+
+	if !filterRoots[segments[0]] {
+		return "", fmt.Errorf("property path %q is not supported, paths must start with one of 'name', 'type', 'location', 'tags', 'properties' or 'systemData'", path)
+	}
+
+	// The provisioning state is stored outside of the properties in the datamodel.
+	if len(segments) == 2 && segments[0] == "properties" && segments[1] == "provisioningState" {
+		return "provisioningState", nil
+	}
+
+	return strings.Join(segments, "."), nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenWord filterTokenKind = iota
+	filterTokenString
+	filterTokenOpenParen
+	filterTokenCloseParen
+	filterTokenComma
+)
+
+type filterToken struct {
+	kind  filterTokenKind
+	value string
+}
+
+type filterParser struct {
+	filter string
+	tokens []filterToken
+	next   int
+}
+
+func newFilterParser(filter string) (*filterParser, error) {
+	p := &filterParser{filter: filter}
+
+	runes := []rune(filter)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenOpenParen})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenCloseParen})
+			i++
+		case r == ',':
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenComma})
+			i++
+		case r == '\'':
+			// String literals use '' to escape a single quote.
+			value := strings.Builder{}
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("invalid $filter %q: unterminated string", filter)
+				}
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						value.WriteRune('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenString, value: value.String()})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("(),'", runes[i]) {
+				i++
+			}
+			p.tokens = append(p.tokens, filterToken{kind: filterTokenWord, value: string(runes[start:i])})
+		}
+	}
+
+	return p, nil
+}
+
+func (p *filterParser) done() bool {
+	return p.next >= len(p.tokens)
+}
+
+func (p *filterParser) take() (filterToken, error) {
+	if p.done() {
+		return filterToken{}, fmt.Errorf("invalid $filter %q: unexpected end of expression", p.filter)
+	}
+
+	token := p.tokens[p.next]
+	p.next++
+	return token, nil
+}
+
+func (p *filterParser) expect(kind filterTokenKind, description string) (filterToken, error) {
+	token, err := p.take()
+	if err != nil {
+		return filterToken{}, err
+	}
+	if token.kind != kind {
+		return filterToken{}, fmt.Errorf("invalid $filter %q: expected %s", p.filter, description)
+	}
+
+	return token, nil
+}
+
+func (p *filterParser) expectKeyword(keyword string) error {
+	token, err := p.expect(filterTokenWord, "'"+keyword+"'")
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(token.value, keyword) {
+		return fmt.Errorf("invalid $filter %q: expected '%s' but got %q", p.filter, keyword, token.value)
+	}
+
+	return nil
+}
+
+func (p *filterParser) parseCondition() (store.FilterCondition, error) {
+	token, err := p.expect(filterTokenWord, "a property path or function")
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	if strings.EqualFold(token.value, "startswith") {
+		return p.parseStartsWith()
+	}
+
+	field, err := filterField(token.value)
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	operator, err := p.expect(filterTokenWord, "an operator")
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	switch strings.ToLower(operator.value) {
+	case "eq", "ne", "gt", "lt":
+		value, err := p.take()
+		if err != nil {
+			return store.FilterCondition{}, err
+		}
+
+		if value.kind == filterTokenWord && strings.EqualFold(value.value, "null") {
+			if !strings.EqualFold(operator.value, "ne") {
+				return store.FilterCondition{}, fmt.Errorf("invalid $filter %q: null can only be used with 'ne'", p.filter)
+			}
+			return store.FilterCondition{Field: field, Operator: store.FilterOperatorExists}, nil
+		} else if value.kind != filterTokenString {
+			return store.FilterCondition{}, fmt.Errorf("invalid $filter %q: values must be quoted strings", p.filter)
+		}
+
+		return store.FilterCondition{Field: field, Operator: store.FilterOperator(strings.ToLower(operator.value)), Values: []string{value.value}}, nil
+	case "in":
+		if _, err := p.expect(filterTokenOpenParen, "'('"); err != nil {
+			return store.FilterCondition{}, err
+		}
+
+		values := []string{}
+		for {
+			value, err := p.expect(filterTokenString, "a quoted string")
+			if err != nil {
+				return store.FilterCondition{}, err
+			}
+			values = append(values, value.value)
+
+			separator, err := p.take()
+			if err != nil {
+				return store.FilterCondition{}, err
+			}
+			if separator.kind == filterTokenCloseParen {
+				break
+			} else if separator.kind != filterTokenComma {
+				return store.FilterCondition{}, fmt.Errorf("invalid $filter %q: expected ',' or ')'", p.filter)
+			}
+		}
+
+		return store.FilterCondition{Field: field, Operator: store.FilterOperatorIn, Values: values}, nil
+	default:
+		return store.FilterCondition{}, fmt.Errorf("invalid $filter %q: unsupported operator %q", p.filter, operator.value)
+	}
+}
+
+func (p *filterParser) parseStartsWith() (store.FilterCondition, error) {
+	if _, err := p.expect(filterTokenOpenParen, "'('"); err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	path, err := p.expect(filterTokenWord, "a property path")
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	field, err := filterField(path.value)
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	if _, err := p.expect(filterTokenComma, "','"); err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	value, err := p.expect(filterTokenString, "a quoted string")
+	if err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	if _, err := p.expect(filterTokenCloseParen, "')'"); err != nil {
+		return store.FilterCondition{}, err
+	}
+
+	return store.FilterCondition{Field: field, Operator: store.FilterOperatorPrefix, Values: []string{value.value}}, nil
+}
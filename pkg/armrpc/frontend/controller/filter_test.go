@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		desc       string
+		filter     string
+		conditions []store.FilterCondition
+		err        string
+	}{
+		{
+			desc:   "empty",
+			filter: " ",
+		},
+		{
+			desc:   "eq",
+			filter: "properties/application eq '/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app'",
+			conditions: []store.FilterCondition{
+				{Field: "properties.application", Operator: store.FilterOperatorEqual, Values: []string{"/planes/radius/local/resourceGroups/rg/providers/Applications.Core/applications/app"}},
+			},
+		},
+		{
+			desc:   "provisioning state",
+			filter: "properties/provisioningState in ('Failed','Canceled')",
+			conditions: []store.FilterCondition{
+				{Field: "provisioningState", Operator: store.FilterOperatorIn, Values: []string{"Failed", "Canceled"}},
+			},
+		},
+		{
+			desc:   "multiple conditions",
+			filter: "startswith(name, 'it''s') AND tags/team ne null and systemData/createdAt gt '2023-10-01T00:00:00Z' and location ne 'global'",
+			conditions: []store.FilterCondition{
+				{Field: "name", Operator: store.FilterOperatorPrefix, Values: []string{"it's"}},
+				{Field: "tags.team", Operator: store.FilterOperatorExists},
+				{Field: "systemData.createdAt", Operator: store.FilterOperatorGreaterThan, Values: []string{"2023-10-01T00:00:00Z"}},
+				{Field: "location", Operator: store.FilterOperatorNotEqual, Values: []string{"global"}},
+			},
+		},
+		{
+			desc:   "unsupported root",
+			filter: "tenantId eq 'a'",
+			err:    `property path "tenantId" is not supported, paths must start with one of 'name', 'type', 'location', 'tags', 'properties' or 'systemData'`,
+		},
+		{
+			desc:   "unsupported operator",
+			filter: "name ge 'a'",
+			err:    `invalid $filter "name ge 'a'": unsupported operator "ge"`,
+		},
+		{
+			desc:   "eq null",
+			filter: "name eq null",
+			err:    `invalid $filter "name eq null": null can only be used with 'ne'`,
+		},
+		{
+			desc:   "unquoted value",
+			filter: "name eq a",
+			err:    `invalid $filter "name eq a": values must be quoted strings`,
+		},
+		{
+			desc:   "unterminated string",
+			filter: "name eq 'a",
+			err:    `invalid $filter "name eq 'a": unterminated string`,
+		},
+		{
+			desc:   "or",
+			filter: "name eq 'a' or name eq 'b'",
+			err:    `invalid $filter "name eq 'a' or name eq 'b'": expected 'and' but got "or"`,
+		},
+		{
+			desc:   "incomplete",
+			filter: "name eq 'a' and",
+			err:    `invalid $filter "name eq 'a' and": unexpected end of expression`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			conditions, err := ParseFilter(tt.filter)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.conditions, conditions)
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	fields, err := ParseOrderBy("systemData/createdAt desc, name ASC,properties/provisioningState")
+	require.NoError(t, err)
+	require.Equal(t, []store.SortField{
+		{Field: "systemData.createdAt", Descending: true},
+		{Field: "name"},
+		{Field: "provisioningState"},
+	}, fields)
+
+	fields, err = ParseOrderBy("")
+	require.NoError(t, err)
+	require.Nil(t, fields)
+
+	_, err = ParseOrderBy("name sideways")
+	require.EqualError(t, err, `invalid $orderby direction "sideways", expected 'asc' or 'desc'`)
+
+	_, err = ParseOrderBy("name,")
+	require.EqualError(t, err, `invalid $orderby "name,"`)
+}
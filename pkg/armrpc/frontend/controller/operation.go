@@ -27,6 +27,7 @@ import (
 	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 )
@@ -163,27 +164,45 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
-// PrepareAsyncOperation saves the initial state and queue the async operation.
+// PrepareAsyncOperation saves the initial state and queue the async operation. The resource is saved together
+// with the async operation status so that a failure does not leave one without the other.
 func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
 	P(newResource).SetProvisioningState(initialState)
 
-	var err error
-	*etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
-	if err != nil {
-		return nil, err
+	nr := &store.Object{
+		Metadata: store.Metadata{
+			ID: serviceCtx.ResourceID.String(),
+		},
+		Data: newResource,
 	}
 
 	options := sm.QueueOperationOptions{
 		OperationTimeout: asyncTimeout,
 		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Resource: &sm.ResourceUpdate{
+			StorageClient: c.StorageClient(),
+			Object:        nr,
+			ETag:          *etag,
+		},
 	}
 	if c.resourceOptions.AsyncOperationRetryAfter != 0 {
 		options.RetryAfter = c.resourceOptions.AsyncOperationRetryAfter
 	}
 
+	// Deletes are usually short and free up resources, so they should not wait behind large deployments.
+	if serviceCtx.OperationType.Method == v1.OperationDelete {
+		options.Priority = queue.PriorityHigh
+	}
+
 	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		// The resource was not saved, so there is nothing to roll back.
+		if nr.ETag == "" {
+			return nil, err
+		}
+
+		*etag = nr.ETag
 		P(newResource).SetProvisioningState(v1.ProvisioningStateFailed)
 		_, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, *etag)
 		if rbErr != nil {
@@ -192,6 +211,7 @@ func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource
 		return nil, err
 	}
 
+	*etag = nr.ETag
 	return nil, nil
 }
 
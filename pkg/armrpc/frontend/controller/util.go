@@ -144,5 +144,12 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 	qps.Add("skipToken", paginationToken)
 	qps.Add("top", strconv.Itoa(serviceCtx.Top))
 
+	// The next page must be queried with the same filter and sort order.
+	for _, key := range []string{FilterQueryParameter, OrderByQueryParameter} {
+		if value := req.URL.Query().Get(key); value != "" {
+			qps.Add(key, value)
+		}
+	}
+
 	return GetURLFromReqWithQueryParameters(req, qps).String()
 }
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CancelOperationAction is the name of the action to cancel an async operation.
+	CancelOperationAction = "cancel"
+)
+
+var _ ctrl.Controller = (*CancelOperation)(nil)
+
+// CancelOperation is the controller implementation to cancel an async operation.
+type CancelOperation struct {
+	ctrl.BaseController
+}
+
+// NewCancelOperation creates a new CancelOperation.
+func NewCancelOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &CancelOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run records the cancellation request of an async operation and returns 202 Accepted with the current status of the
+// operation. The operation becomes Canceled once the worker processing it has stopped. It returns NotFound if the
+// operation does not exist and Conflict if the operation has already completed.
+func (e *CancelOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	os := &manager.Status{}
+	_, err := e.GetResource(ctx, serviceCtx.ResourceID.String(), os)
+	if errors.Is(&store.ErrNotFound{ID: serviceCtx.ResourceID.String()}, err) {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if os.Status.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf("The operation %s has already completed with status %s.", os.Name, os.Status)), nil
+	}
+
+	linkedID, err := resources.ParseResource(os.LinkedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	operationID, err := uuid.Parse(serviceCtx.ResourceID.Name())
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The operation id %q is invalid.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	err = e.StatusManager().RequestCancel(ctx, linkedID, operationID)
+	if errors.Is(err, manager.ErrOperationCompleted) {
+		return rest.NewConflictResponse(fmt.Sprintf("The operation %s has already completed.", os.Name)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The status is polled at the operation status URL.
+	location := strings.TrimSuffix(req.URL.Path, "/"+CancelOperationAction)
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	return rest.NewAcceptedAsyncResponse(os.AsyncOperationStatus, location, scheme), nil
+}
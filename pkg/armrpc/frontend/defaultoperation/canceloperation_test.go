@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationStatusCancelTestHeaderFile = "operationstatus_cancel_requestheaders.json"
+	cancelTestLinkedResourceID          = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/containers/container0"
+)
+
+func TestCancelOperationRun(t *testing.T) {
+	newStatus := func(state v1.ProvisioningState) *manager.Status {
+		return &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Applications.Core/locations/westus/operationStatuses/00000000-0000-0000-0000-000000000000",
+				Name:   "00000000-0000-0000-0000-000000000000",
+				Status: state,
+			},
+			LinkedResourceID: cancelTestLinkedResourceID,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		status     *manager.Status
+		cancelErr  error
+		cancelCall bool
+		statusCode int
+	}{
+		{
+			name:       "non-existing operation",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "completed operation",
+			status:     newStatus(v1.ProvisioningStateSucceeded),
+			statusCode: http.StatusConflict,
+		},
+		{
+			name:       "running operation",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			cancelCall: true,
+			statusCode: http.StatusAccepted,
+		},
+		{
+			name:       "operation completed before the cancellation was recorded",
+			status:     newStatus(v1.ProvisioningStateUpdating),
+			cancelErr:  manager.ErrOperationCompleted,
+			cancelCall: true,
+			statusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			defer mctrl.Finish()
+
+			mStorageClient := store.NewMockStorageClient(mctrl)
+			mStatusManager := manager.NewMockStatusManager(mctrl)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, operationStatusCancelTestHeaderFile, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mStorageClient.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+					require.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Applications.Core/locations/westus/operationStatuses/00000000-0000-0000-0000-000000000000", id)
+					if tt.status == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return &store.Object{Metadata: store.Metadata{ID: id}, Data: tt.status}, nil
+				})
+
+			if tt.cancelCall {
+				mStatusManager.
+					EXPECT().
+					RequestCancel(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
+						require.Equal(t, cancelTestLinkedResourceID, id.String())
+						require.Equal(t, "00000000-0000-0000-0000-000000000000", operationID.String())
+						return tt.cancelErr
+					})
+			}
+
+			ctl, err := NewCancelOperation(ctrl.Options{
+				StorageClient: mStorageClient,
+				StatusManager: mStatusManager,
+			})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.statusCode, w.Result().StatusCode)
+
+			if tt.statusCode == http.StatusAccepted {
+				require.Equal(t, "https://radapp.io/subscriptions/00000000-0000-0000-0000-000000000000/providers/Applications.Core/locations/westus/operationStatuses/00000000-0000-0000-0000-000000000000", w.Header().Get("Location"))
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// DeadLetterOperationIDParameter is the name of the URL parameter for the ID of a dead-lettered operation.
+	DeadLetterOperationIDParameter = "deadLetterOperationId"
+)
+
+var (
+	_ ctrl.Controller = (*ListDeadLetterOperations)(nil)
+	_ ctrl.Controller = (*GetDeadLetterOperation)(nil)
+	_ ctrl.Controller = (*RequeueDeadLetterOperation)(nil)
+	_ ctrl.Controller = (*DeleteDeadLetterOperation)(nil)
+)
+
+// ListDeadLetterOperations is the controller implementation to list the dead-lettered async operations of a resource provider.
+type ListDeadLetterOperations struct {
+	ctrl.BaseController
+}
+
+// NewListDeadLetterOperations creates a new ListDeadLetterOperations.
+func NewListDeadLetterOperations(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListDeadLetterOperations{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run lists the dead-lettered operations of the resource provider namespace. Messages that cannot be decoded are
+// always included since they cannot be attributed to a namespace.
+func (e *ListDeadLetterOperations) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	dlq, ok := e.QueueClient().(queue.DeadLetterQueue)
+	if !ok {
+		return deadLetterUnsupportedResponse(), nil
+	}
+
+	messages, err := dlq.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := deadLetterNamespace(e.ResourceType())
+	result := &v1.PaginatedList{Value: []any{}}
+	for _, msg := range messages {
+		if op, _, ok := toDeadLetterOperation(msg, namespace); ok {
+			result.Value = append(result.Value, op)
+		}
+	}
+
+	return rest.NewOKResponse(result), nil
+}
+
+// GetDeadLetterOperation is the controller implementation to get a dead-lettered async operation.
+type GetDeadLetterOperation struct {
+	ctrl.BaseController
+}
+
+// NewGetDeadLetterOperation creates a new GetDeadLetterOperation.
+func NewGetDeadLetterOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetDeadLetterOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the dead-lettered operation, or a NotFound error if the operation is not in the dead-letter queue.
+func (e *GetDeadLetterOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	dlq, ok := e.QueueClient().(queue.DeadLetterQueue)
+	if !ok {
+		return deadLetterUnsupportedResponse(), nil
+	}
+
+	id := chi.URLParam(req, DeadLetterOperationIDParameter)
+	op, _, err := getDeadLetterOperation(ctx, dlq, id, deadLetterNamespace(e.ResourceType()))
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return deadLetterNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(op), nil
+}
+
+// RequeueDeadLetterOperation is the controller implementation to requeue a dead-lettered async operation.
+type RequeueDeadLetterOperation struct {
+	ctrl.BaseController
+}
+
+// NewRequeueDeadLetterOperation creates a new RequeueDeadLetterOperation.
+func NewRequeueDeadLetterOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &RequeueDeadLetterOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run resets the status of the dead-lettered operation and moves it back to the queue so that it is processed again.
+func (e *RequeueDeadLetterOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	dlq, ok := e.QueueClient().(queue.DeadLetterQueue)
+	if !ok {
+		return deadLetterUnsupportedResponse(), nil
+	}
+
+	id := chi.URLParam(req, DeadLetterOperationIDParameter)
+	_, asyncReq, err := getDeadLetterOperation(ctx, dlq, id, deadLetterNamespace(e.ResourceType()))
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return deadLetterNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	// The operation status is terminal once the operation is dead-lettered. It must be reset before requeueing,
+	// otherwise the worker would treat the message as a duplicate.
+	if asyncReq != nil {
+		if rID, err := resources.ParseResource(asyncReq.ResourceID); err == nil {
+			err = e.StatusManager().Reset(ctx, rID, asyncReq.OperationID)
+			if err != nil && !errors.Is(err, &store.ErrNotFound{}) {
+				return nil, err
+			}
+		}
+	}
+
+	err = dlq.RequeueDeadLetter(ctx, id)
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return deadLetterNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
+
+// DeleteDeadLetterOperation is the controller implementation to delete a dead-lettered async operation.
+type DeleteDeadLetterOperation struct {
+	ctrl.BaseController
+}
+
+// NewDeleteDeadLetterOperation creates a new DeleteDeadLetterOperation.
+func NewDeleteDeadLetterOperation(opts ctrl.Options) (ctrl.Controller, error) {
+	return &DeleteDeadLetterOperation{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run deletes the dead-lettered operation. The operation status and the resource are left untouched.
+func (e *DeleteDeadLetterOperation) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	dlq, ok := e.QueueClient().(queue.DeadLetterQueue)
+	if !ok {
+		return deadLetterUnsupportedResponse(), nil
+	}
+
+	id := chi.URLParam(req, DeadLetterOperationIDParameter)
+	_, _, err := getDeadLetterOperation(ctx, dlq, id, deadLetterNamespace(e.ResourceType()))
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return rest.NewNoContentResponse(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = dlq.DeleteDeadLetter(ctx, id)
+	if err != nil && !errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(nil), nil
+}
+
+// getDeadLetterOperation gets the dead-lettered message with the given ID. It returns ErrDeadLetterNotFound if the
+// message belongs to another namespace. The returned request is nil if the message cannot be decoded.
+func getDeadLetterOperation(ctx context.Context, dlq queue.DeadLetterQueue, id string, namespace string) (*v1.DeadLetterOperation, *asyncctrl.Request, error) {
+	if id == "" {
+		return nil, nil, queue.ErrDeadLetterNotFound
+	}
+
+	msg, err := dlq.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	op, asyncReq, ok := toDeadLetterOperation(msg, namespace)
+	if !ok {
+		return nil, nil, queue.ErrDeadLetterNotFound
+	}
+
+	return op, asyncReq, nil
+}
+
+// toDeadLetterOperation converts the dead-lettered message to the API model and decodes the async operation request,
+// which is nil if the message cannot be decoded. It returns false if the operation belongs to a different resource
+// provider namespace.
+func toDeadLetterOperation(msg *queue.DeadLetterMessage, namespace string) (*v1.DeadLetterOperation, *asyncctrl.Request, bool) {
+	op := &v1.DeadLetterOperation{
+		ID:             msg.ID,
+		Reason:         msg.Reason,
+		LastError:      msg.LastError,
+		DequeueCount:   msg.DequeueCount,
+		EnqueuedAt:     msg.EnqueueAt,
+		DeadLetteredAt: msg.DeadLetteredAt,
+	}
+
+	asyncReq := &asyncctrl.Request{}
+	if err := json.Unmarshal(msg.Data, asyncReq); err != nil {
+		return op, nil, true
+	}
+
+	op.OperationID = asyncReq.OperationID.String()
+	op.OperationType = asyncReq.OperationType
+	op.ResourceID = asyncReq.ResourceID
+
+	rID, err := resources.ParseResource(asyncReq.ResourceID)
+	if err != nil {
+		return op, asyncReq, true
+	}
+
+	return op, asyncReq, strings.EqualFold(rID.ProviderNamespace(), namespace)
+}
+
+// deadLetterNamespace returns the resource provider namespace of the dead-letter operations resource type.
+func deadLetterNamespace(resourceType string) string {
+	namespace, _, _ := strings.Cut(resourceType, "/")
+	return namespace
+}
+
+func deadLetterNotFoundResponse(id string) rest.Response {
+	return rest.NewNotFoundMessageResponse(fmt.Sprintf("the dead-lettered operation '%s' was not found", id))
+}
+
+func deadLetterUnsupportedResponse() rest.Response {
+	return rest.NewBadRequestResponse("the request queue of this resource provider does not support dead-lettering")
+}
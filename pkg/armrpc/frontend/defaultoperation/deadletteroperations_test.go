@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeadLetterResourceType = "applications.core/deadletteroperations"
+	testDeadLetterResourceID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+)
+
+// setupDeadLetterQueue returns an in-memory queue with a dead-lettered message for Applications.Core, a dead-lettered
+// message for another namespace, and a dead-lettered message that cannot be decoded.
+func setupDeadLetterQueue(t *testing.T) (*inmemory.Client, *asyncctrl.Request, string) {
+	ctx := context.Background()
+	cli := inmemory.New(inmemory.NewInMemQueue(time.Minute))
+
+	deadLetter := func(data any) string {
+		err := cli.Enqueue(ctx, queue.NewMessage(data))
+		require.NoError(t, err)
+		msg, err := cli.Dequeue(ctx, queue.QueueClientConfig{})
+		require.NoError(t, err)
+		err = cli.DeadLetter(ctx, msg, queue.DeadLetterInfo{Reason: queue.DeadLetterReasonMaxRetryCountExceeded, LastError: "failed"})
+		require.NoError(t, err)
+		return msg.ID
+	}
+
+	req := &asyncctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.CORE/CONTAINERS|PUT",
+		ResourceID:    testDeadLetterResourceID,
+	}
+	id := deadLetter(req)
+
+	deadLetter(&asyncctrl.Request{
+		OperationID:   uuid.New(),
+		OperationType: "APPLICATIONS.DAPR/STATESTORES|PUT",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Dapr/stateStores/test-store",
+	})
+	deadLetter("not a request")
+
+	return cli, req, id
+}
+
+func newDeadLetterRequest(t *testing.T, method string, id string) *http.Request {
+	req, err := http.NewRequest(method, "http://localhost/planes/radius/local/providers/applications.core/deadletteroperations/"+id, nil)
+	require.NoError(t, err)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(DeadLetterOperationIDParameter, id)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestListDeadLetterOperations(t *testing.T) {
+	cli, req, id := setupDeadLetterQueue(t)
+
+	ctl, err := NewListDeadLetterOperations(ctrl.Options{QueueClient: cli, ResourceType: testDeadLetterResourceType})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	httpReq := newDeadLetterRequest(t, http.MethodGet, "")
+	resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	result := struct {
+		Value []v1.DeadLetterOperation `json:"value"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+
+	// The operation of Applications.Dapr is excluded, the message that cannot be decoded is included.
+	require.Len(t, result.Value, 2)
+	require.Equal(t, id, result.Value[0].ID)
+	require.Equal(t, req.OperationID.String(), result.Value[0].OperationID)
+	require.Equal(t, req.OperationType, result.Value[0].OperationType)
+	require.Equal(t, testDeadLetterResourceID, result.Value[0].ResourceID)
+	require.Equal(t, queue.DeadLetterReasonMaxRetryCountExceeded, result.Value[0].Reason)
+	require.Equal(t, "failed", result.Value[0].LastError)
+	require.Equal(t, 1, result.Value[0].DequeueCount)
+	require.Empty(t, result.Value[1].ResourceID)
+}
+
+func TestListDeadLetterOperations_Unsupported(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	ctl, err := NewListDeadLetterOperations(ctrl.Options{QueueClient: queue.NewMockClient(mctrl), ResourceType: testDeadLetterResourceType})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	httpReq := newDeadLetterRequest(t, http.MethodGet, "")
+	resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestGetDeadLetterOperation(t *testing.T) {
+	cli, _, id := setupDeadLetterQueue(t)
+
+	ctl, err := NewGetDeadLetterOperation(ctrl.Options{QueueClient: cli, ResourceType: testDeadLetterResourceType})
+	require.NoError(t, err)
+
+	t.Run("existing operation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		httpReq := newDeadLetterRequest(t, http.MethodGet, id)
+		resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		op := &v1.DeadLetterOperation{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), op))
+		require.Equal(t, id, op.ID)
+		require.Equal(t, testDeadLetterResourceID, op.ResourceID)
+	})
+
+	t.Run("non-existing operation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		httpReq := newDeadLetterRequest(t, http.MethodGet, "does-not-exist")
+		resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+}
+
+func TestRequeueDeadLetterOperation(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	cli, req, id := setupDeadLetterQueue(t)
+
+	sm := manager.NewMockStatusManager(mctrl)
+	sm.EXPECT().
+		Reset(gomock.Any(), resources.MustParse(testDeadLetterResourceID), req.OperationID).
+		Return(nil).
+		Times(1)
+
+	ctl, err := NewRequeueDeadLetterOperation(ctrl.Options{QueueClient: cli, StatusManager: sm, ResourceType: testDeadLetterResourceType})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	httpReq := newDeadLetterRequest(t, http.MethodPost, id)
+	resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+	require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+
+	msg, err := cli.Dequeue(context.Background(), queue.QueueClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, id, msg.ID)
+	require.Equal(t, 1, msg.DequeueCount)
+
+	_, err = cli.GetDeadLetter(context.Background(), id)
+	require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+}
+
+func TestDeleteDeadLetterOperation(t *testing.T) {
+	cli, _, id := setupDeadLetterQueue(t)
+
+	ctl, err := NewDeleteDeadLetterOperation(ctrl.Options{QueueClient: cli, ResourceType: testDeadLetterResourceType})
+	require.NoError(t, err)
+
+	for _, expected := range []int{http.StatusOK, http.StatusNoContent} {
+		w := httptest.NewRecorder()
+		httpReq := newDeadLetterRequest(t, http.MethodDelete, id)
+		resp, err := ctl.Run(httpReq.Context(), w, httpReq)
+		require.NoError(t, err)
+		require.NoError(t, resp.Apply(httpReq.Context(), w, httpReq))
+		require.Equal(t, expected, w.Result().StatusCode)
+	}
+
+	_, err = cli.GetDeadLetter(context.Background(), id)
+	require.ErrorIs(t, err, queue.ErrDeadLetterNotFound)
+}
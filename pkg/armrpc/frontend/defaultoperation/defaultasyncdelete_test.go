@@ -82,16 +82,12 @@ func TestDefaultAsyncDelete(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.rejectedByFilter && appDataModel.InternalMetadata.AsyncProvisioningState.IsTerminal() {
-				expectedOptions := statusmanager.QueueOperationOptions{
+				expectedOptions := &statusmanager.QueueOperationOptions{
 					OperationTimeout: asyncOperationTimeout,
 					RetryAfter:       asyncOperationRetryAfter,
 				}
-				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-					Return(tt.qErr).
-					Times(1)
-
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(fakeQueueAsyncOperation(t, expectedOptions, tt.saveErr, tt.qErr)).
 					Times(1)
 			}
 
@@ -99,24 +99,18 @@ func TestDefaultAsyncPut_Create(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil || errors.Is(&store.ErrNotFound{}, tt.getErr) {
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+				expectedOptions := &statusmanager.QueueOperationOptions{
+					OperationTimeout: asyncOperationTimeout,
+					RetryAfter:       asyncOperationRetryAfter,
+				}
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(fakeQueueAsyncOperation(t, expectedOptions, tt.saveErr, tt.qErr)).
 					Times(1)
 
-				if tt.saveErr == nil {
-					expectedOptions := statusmanager.QueueOperationOptions{
-						OperationTimeout: asyncOperationTimeout,
-						RetryAfter:       asyncOperationRetryAfter,
-					}
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
-						Return(tt.qErr).
+				if tt.saveErr == nil && tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(tt.rbErr).
 						Times(1)
-
-					if tt.qErr != nil {
-						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-							Return(tt.rbErr).
-							Times(1)
-					}
 				}
 			}
 
@@ -275,20 +269,14 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 				Times(1)
 
 			if tt.getErr == nil && !tt.skipSave {
-				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(tt.saveErr).
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(fakeQueueAsyncOperation(t, nil, tt.saveErr, tt.qErr)).
 					Times(1)
 
-				if tt.saveErr == nil {
-					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
-						Return(tt.qErr).
+				if tt.saveErr == nil && tt.qErr != nil {
+					mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(tt.rbErr).
 						Times(1)
-
-					if tt.qErr != nil {
-						mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
-							Return(tt.rbErr).
-							Times(1)
-					}
 				}
 			}
 
@@ -43,15 +43,28 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. The results
+// can be filtered and sorted with the $filter and $orderby query parameters, a bad request response is returned if
+// either is invalid. An internal error is returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
+	conditions, err := ctrl.ParseFilter(req.URL.Query().Get(ctrl.FilterQueryParameter))
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	orderBy, err := ctrl.ParseOrderBy(req.URL.Query().Get(ctrl.OrderByQueryParameter))
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	query := store.Query{
 		RootScope:      serviceCtx.ResourceID.RootScope(),
 		ResourceType:   serviceCtx.ResourceID.Type(),
 		ScopeRecursive: e.listRecursiveQuery,
+		Conditions:     conditions,
+		OrderBy:        orderBy,
 	}
 
 	result, err := e.StorageClient().Query(ctx, query, store.WithPaginationToken(serviceCtx.SkipToken), store.WithMaxQueryItemCount(serviceCtx.Top))
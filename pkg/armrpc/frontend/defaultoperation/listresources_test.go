@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -183,4 +184,75 @@ func TestListResourcesRun(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("list with filter and orderby", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("$filter", "properties/provisioningState in ('Failed', 'Canceled') and tags/team ne null")
+		q.Add("$orderby", "systemData/createdAt desc")
+		q.Add("top", "5")
+		req.URL.RawQuery = q.Encode()
+
+		ctx := rpctest.NewARMRequestContext(req)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		expectedQuery := store.Query{
+			RootScope:    serviceCtx.ResourceID.RootScope(),
+			ResourceType: serviceCtx.ResourceID.Type(),
+			Conditions: []store.FilterCondition{
+				{Field: "provisioningState", Operator: store.FilterOperatorIn, Values: []string{"Failed", "Canceled"}},
+				{Field: "tags.team", Operator: store.FilterOperatorExists},
+			},
+			OrderBy: []store.SortField{{Field: "systemData.createdAt", Descending: true}},
+		}
+
+		mStorageClient.
+			EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any()).
+			Return(&store.ObjectQueryResult{
+				Items:           []store.Object{{Metadata: store.Metadata{ID: uuid.New().String()}, Data: testResourceDataModel}},
+				PaginationToken: "nextLink",
+			}, nil)
+
+		ctl, err := NewListResources(ctrl.Options{StorageClient: mStorageClient}, ctrl.ResourceOptions[testDataModel]{ResponseConverter: resourceToVersioned})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &testResourceList{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, 1, len(actualOutput.Value))
+
+		// The next page uses the same filter and sort order.
+		require.NotNil(t, actualOutput.NextLink)
+		nextLink, err := url.Parse(*actualOutput.NextLink)
+		require.NoError(t, err)
+		require.Equal(t, q.Get("$filter"), nextLink.Query().Get("$filter"))
+		require.Equal(t, q.Get("$orderby"), nextLink.Query().Get("$orderby"))
+	})
+
+	t.Run("list with invalid filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("$filter", "internal/secret eq 'value'")
+		req.URL.RawQuery = q.Encode()
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListResources(ctrl.Options{StorageClient: mStorageClient}, ctrl.ResourceOptions[testDataModel]{ResponseConverter: resourceToVersioned})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/test/testutil"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -217,6 +218,26 @@ func setupTest(tb testing.TB) (func(testing.TB), *store.MockStorageClient, *stat
 	}, mds, msm
 }
 
+// fakeQueueAsyncOperation returns a QueueAsyncOperation implementation that simulates saving the resource passed in
+// the options. saveErr is returned if the resource can't be saved, otherwise qErr is returned.
+func fakeQueueAsyncOperation(tb testing.TB, expected *statusmanager.QueueOperationOptions, saveErr error, qErr error) func(context.Context, *v1.ARMRequestContext, statusmanager.QueueOperationOptions) error {
+	return func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+		if expected != nil {
+			require.Equal(tb, expected.OperationTimeout, options.OperationTimeout)
+			require.Equal(tb, expected.RetryAfter, options.RetryAfter)
+		}
+
+		require.NotNil(tb, options.Resource)
+		require.Equal(tb, sCtx.ResourceID.String(), options.Resource.Object.ID)
+		if saveErr != nil {
+			return saveErr
+		}
+
+		options.Resource.Object.ETag = "new-etag"
+		return qErr
+	}
+}
+
 // TODO: Use Referer header instead of X-Forwarded-Proto by following ARM RPC spec - https://github.com/radius-project/radius/issues/3068
 func getAsyncLocationPath(sCtx *v1.ARMRequestContext, location string, resourceType string, req *http.Request) string {
 	dest := url.URL{
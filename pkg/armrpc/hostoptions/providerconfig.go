@@ -43,6 +43,7 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Bicep            BicepOptions                             `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                         `yaml:"terraform,omitempty"`
+	RecipeDrift      RecipeDriftOptions                       `yaml:"recipeDrift,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -69,6 +70,12 @@ type WorkerServerOptions struct {
 	MaxOperationConcurrency *int `yaml:"maxOperationConcurrency,omitempty"`
 	// MaxOperationRetryCount is the maximum retry count to process async request operation.
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
+	// MaxPendingOperations is the maximum number of dequeued messages waiting to be scheduled.
+	MaxPendingOperations *int `yaml:"maxPendingOperations,omitempty"`
+	// PriorityWeights is the share of the processing slots given to each priority class ('High', 'Normal' or 'Low').
+	PriorityWeights map[string]int `yaml:"priorityWeights,omitempty"`
+	// OperationConcurrencyLimits is the maximum concurrency of an operation type or of all the operations of a resource type.
+	OperationConcurrencyLimits map[string]int `yaml:"operationConcurrencyLimits,omitempty"`
 }
 
 // BicepOptions includes options required for bicep execution.
@@ -83,4 +90,24 @@ type BicepOptions struct {
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string `yaml:"path,omitempty"`
+	// Version is the default version of Terraform used to execute recipes, which can be overridden per environment.
+	// The latest version is installed when it is empty.
+	Version string `yaml:"version,omitempty"`
+	// ExecPath is the path to a pre-provisioned Terraform binary used instead of installing Terraform.
+	ExecPath string `yaml:"execPath,omitempty"`
+	// ExecSHA256 is the hex-encoded SHA256 checksum of the binary at ExecPath. The binary is not verified when it is empty.
+	ExecSHA256 string `yaml:"execSHA256,omitempty"`
+	// MirrorDir is a local directory mirroring https://releases.hashicorp.com/terraform, from which Terraform is installed
+	// in air-gapped environments.
+	MirrorDir string `yaml:"mirrorDir,omitempty"`
+	// CacheDir is the directory where the installed versions of Terraform are reused across executions.
+	CacheDir string `yaml:"cacheDir,omitempty"`
+}
+
+// RecipeDriftOptions includes the options of the periodic drift detection of the resources deployed by recipes.
+type RecipeDriftOptions struct {
+	// Enabled enables the periodic drift detection.
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Interval is the interval between two drift checks, for example '30m'. Defaults to one hour.
+	Interval string `yaml:"interval,omitempty"`
 }
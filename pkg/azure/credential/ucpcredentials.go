@@ -26,6 +26,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"go.uber.org/atomic"
@@ -42,6 +43,8 @@ var _ azcore.TokenCredential = (*UCPCredential)(nil)
 type UCPCredentialOptions struct {
 	// Provider is an UCP credential provider.
 	Provider sdk_cred.CredentialProvider[sdk_cred.AzureCredential]
+	// Name is the name of the UCP credential. The default credential is used if it is empty.
+	Name string
 	// Duration is the duration to refresh token client.
 	Duration time.Duration
 
@@ -49,7 +52,9 @@ type UCPCredentialOptions struct {
 	ClientOptions *azcore.ClientOptions
 }
 
-// UCPCredential authenticates service principal using UCP credential APIs.
+// UCPCredential authenticates service principal or workload identity using UCP credential APIs. Requests whose context
+// selects another credential of the Azure plane with sdk_cred.WithPlaneCredentialName are authenticated with the
+// selected credential.
 type UCPCredential struct {
 	options    UCPCredentialOptions
 	credential *sdk_cred.AzureCredential
@@ -61,6 +66,11 @@ type UCPCredential struct {
 	// nextExpiry represents the time when the current UCP credential expires
 	// or when it checks if credential is updated.
 	nextExpiry atomic.Int64
+
+	// named caches the credentials selected by the context of the requests by name.
+	named map[string]*UCPCredential
+	// namedMu is the mutex to protect named.
+	namedMu sync.Mutex
 }
 
 // NewUCPCredential creates a new UCPCredential with the given options and returns it, or returns an error if the
@@ -75,9 +85,31 @@ func NewUCPCredential(options UCPCredentialOptions) (*UCPCredential, error) {
 
 	return &UCPCredential{
 		options: options,
+		named:   map[string]*UCPCredential{},
 	}, nil
 }
 
+// name returns the name of the UCP credential.
+func (c *UCPCredential) name() string {
+	return sdk_cred.CredentialNameOrDefault(c.options.Name)
+}
+
+// forName returns the UCPCredential of the named UCP credential, which shares the options of c.
+func (c *UCPCredential) forName(name string) *UCPCredential {
+	c.namedMu.Lock()
+	defer c.namedMu.Unlock()
+
+	if named, ok := c.named[name]; ok {
+		return named
+	}
+
+	options := c.options
+	options.Name = name
+	named := &UCPCredential{options: options, named: map[string]*UCPCredential{}}
+	c.named[name] = named
+	return named
+}
+
 func (c *UCPCredential) isExpired() bool {
 	return c.nextExpiry.Load() < time.Now().Unix()
 }
@@ -97,33 +129,25 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 		return nil
 	}
 
-	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AzureCloud, "default")
+	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AzureCloud, c.name())
 	if err != nil {
 		return err
 	}
 
-	if s.ClientID == "" || s.ClientSecret == "" || s.TenantID == "" {
-		return errors.New("invalid azure service principal credential info")
+	if s.ClientID == "" || s.TenantID == "" || (!s.IsWorkloadIdentity() && s.ClientSecret == "") {
+		return errors.New("invalid azure credential info")
 	}
 
-	// Do not instantiate new client unless the secret is rotated.
-	if c.credential != nil && c.credential.ClientSecret == s.ClientSecret &&
-		c.credential.ClientID == s.ClientID && c.credential.TenantID == s.TenantID {
+	// Do not instantiate new client unless the credential is rotated.
+	if c.credential != nil && *c.credential == *s {
 		c.refreshExpiry()
 		return nil
 	}
 
 	logger.Info("Retreived Azure Credential - ClientID: " + s.ClientID)
 
-	// Rotate credentials by creating new ClientSecretCredential.
-	var opt *azidentity.ClientSecretCredentialOptions
-	if c.options.ClientOptions != nil {
-		opt = &azidentity.ClientSecretCredentialOptions{
-			ClientOptions: *c.options.ClientOptions,
-		}
-	}
-
-	azCred, err := azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, opt)
+	// Rotate credentials by creating new WorkloadIdentityCredential or ClientSecretCredential.
+	azCred, err := newTokenCredential(s, c.options.ClientOptions)
 	if err != nil {
 		return err
 	}
@@ -135,15 +159,54 @@ func (c *UCPCredential) refreshCredentials(ctx context.Context) error {
 	return nil
 }
 
-// GetToken attempts to refresh the Azure service principal credential if it is expired and then returns an
+// newTokenCredential creates the workload identity credential or the client secret credential of the Azure credential.
+func newTokenCredential(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error) {
+	if s.IsWorkloadIdentity() {
+		return newWorkloadIdentityCredential(s, clientOptions)
+	}
+	return newClientSecretCredential(s, clientOptions)
+}
+
+// newClientSecretCredential creates the credential of an Azure service principal from its client secret.
+func newClientSecretCredential(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error) {
+	var opt *azidentity.ClientSecretCredentialOptions
+	if clientOptions != nil {
+		opt = &azidentity.ClientSecretCredentialOptions{
+			ClientOptions: *clientOptions,
+		}
+	}
+
+	return azidentity.NewClientSecretCredential(s.TenantID, s.ClientID, s.ClientSecret, opt)
+}
+
+// newWorkloadIdentityCredential creates the credential of an Azure workload identity. The federated token is read from
+// the service account token file projected into the pod by Azure workload identity, which is set in the
+// AZURE_FEDERATED_TOKEN_FILE environment variable.
+func newWorkloadIdentityCredential(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error) {
+	opt := &azidentity.WorkloadIdentityCredentialOptions{
+		ClientID: s.ClientID,
+		TenantID: s.TenantID,
+	}
+	if clientOptions != nil {
+		opt.ClientOptions = *clientOptions
+	}
+
+	return azidentity.NewWorkloadIdentityCredential(opt)
+}
+
+// GetToken attempts to refresh the Azure credential if it is expired and then returns an
 // access token if the credential is ready. This method is called automatically by Azure SDK clients.
 func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	if name := sdk_cred.PlaneCredentialName(ctx, resources_azure.PlaneTypeAzure); name != "" && name != c.name() {
+		return c.forName(name).GetToken(ctx, opts)
+	}
+
 	if c.isExpired() {
 		err := c.refreshCredentials(ctx)
 		if err != nil {
-			logger.Error(err, "failed to refresh Azure service principal credential.")
+			logger.Error(err, "failed to refresh Azure credential.")
 		}
 	}
 
@@ -152,7 +215,7 @@ func (c *UCPCredential) GetToken(ctx context.Context, opts policy.TokenRequestOp
 	c.tokenCredMu.RUnlock()
 
 	if credentialAuth == nil {
-		return azcore.AccessToken{}, errors.New("azure service principal credential is not ready")
+		return azcore.AccessToken{}, errors.New("azure credential is not ready")
 	}
 
 	return credentialAuth.GetToken(ctx, opts)
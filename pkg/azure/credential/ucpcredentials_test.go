@@ -19,19 +19,25 @@ package credential
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
 	fakeCredential *sdk_cred.AzureCredential
+	fetched        []string
 }
 
 // Fetch gets the Azure credentials from secret storage.
 func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.AzureCredential, error) {
+	p.fetched = append(p.fetched, name)
 	if p.fakeCredential == nil {
 		return nil, errors.New("failed to fetch credential")
 	}
@@ -79,6 +85,29 @@ func TestRefreshCredentials(t *testing.T) {
 		require.False(t, c.isExpired())
 	})
 
+	t.Run("workload identity credential", func(t *testing.T) {
+		t.Setenv("AZURE_FEDERATED_TOKEN_FILE", filepath.Join(t.TempDir(), "azure-identity-token"))
+		p := newMockProvider()
+		p.fakeCredential.Kind = ucp_dm.AzureWorkloadIdentityCredentialKind
+		p.fakeCredential.ClientSecret = ""
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.NoError(t, err)
+		require.IsType(t, &azidentity.WorkloadIdentityCredential{}, c.tokenCred)
+	})
+
+	t.Run("service principal credential without secret", func(t *testing.T) {
+		p := newMockProvider()
+		p.fakeCredential.ClientSecret = ""
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.Error(t, err)
+	})
+
 	t.Run("same credentials", func(t *testing.T) {
 		p := newMockProvider()
 		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
@@ -98,3 +127,42 @@ func TestRefreshCredentials(t *testing.T) {
 		require.Equal(t, old, c.tokenCred)
 	})
 }
+
+func TestNamedCredential(t *testing.T) {
+	t.Run("default credential", func(t *testing.T) {
+		p := newMockProvider()
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, []string{sdk_cred.DefaultCredentialName}, p.fetched)
+	})
+
+	t.Run("named credential", func(t *testing.T) {
+		p := newMockProvider()
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p, Name: "subscription2"})
+		require.NoError(t, err)
+
+		err = c.refreshCredentials(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, []string{"subscription2"}, p.fetched)
+	})
+
+	t.Run("credential selected by the context", func(t *testing.T) {
+		p := newMockProvider()
+		c, err := NewUCPCredential(UCPCredentialOptions{Provider: p})
+		require.NoError(t, err)
+
+		// Fail to fetch the credential so that no token is requested from Azure AD.
+		p.fakeCredential = nil
+		ctx := sdk_cred.WithPlaneCredentialName(context.TODO(), "azure", "subscription2")
+		_, err = c.GetToken(ctx, policy.TokenRequestOptions{})
+		require.Error(t, err)
+		require.Equal(t, []string{"subscription2"}, p.fetched)
+
+		// The selected credential is cached and the default credential is not refreshed.
+		require.Same(t, c.forName("subscription2"), c.forName("subscription2"))
+		require.True(t, c.isExpired())
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
+const (
+	// armScope is the scope of the token requested to validate that the credential can access Azure Resource Manager.
+	armScope = "https://management.azure.com/.default"
+)
+
+var _ sdk_cred.CredentialValidator[sdk_cred.AzureCredential] = (*UCPCredentialValidator)(nil)
+
+// UCPCredentialValidator validates Azure service principal or workload identity credentials by acquiring an Azure
+// Resource Manager token from Azure AD before the credentials are registered with UCP.
+type UCPCredentialValidator struct {
+	clientOptions *azcore.ClientOptions
+
+	// newCredential creates the token credential used to authenticate the Azure credential.
+	newCredential func(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error)
+}
+
+// NewUCPCredentialValidator creates a new UCPCredentialValidator with the given azure client options. Pass nil to accept
+// default options.
+func NewUCPCredentialValidator(clientOptions *azcore.ClientOptions) *UCPCredentialValidator {
+	return &UCPCredentialValidator{
+		clientOptions: clientOptions,
+		newCredential: newTokenCredential,
+	}
+}
+
+// Validate returns an error if the Azure credential is incomplete or if Azure AD does not issue a token for it.
+func (v *UCPCredentialValidator) Validate(ctx context.Context, s *sdk_cred.AzureCredential) error {
+	if s.ClientID == "" || s.TenantID == "" || (!s.IsWorkloadIdentity() && s.ClientSecret == "") {
+		return errors.New("invalid azure credential info")
+	}
+
+	cred, err := v.newCredential(s, v.clientOptions)
+	if err != nil {
+		return err
+	}
+
+	if _, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{armScope}}); err != nil {
+		return fmt.Errorf("failed to authenticate with Azure using the credential of client %q: %w", s.ClientID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// fakeTokenCredential is a local fake of Azure AD, which issues a token unless err is set.
+type fakeTokenCredential struct {
+	err    error
+	scopes []string
+}
+
+func (f *fakeTokenCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	f.scopes = opts.Scopes
+	if f.err != nil {
+		return azcore.AccessToken{}, f.err
+	}
+	return azcore.AccessToken{Token: "fakeToken"}, nil
+}
+
+func newTestValidator(fake *fakeTokenCredential) *UCPCredentialValidator {
+	v := NewUCPCredentialValidator(nil)
+	v.newCredential = func(s *sdk_cred.AzureCredential, clientOptions *azcore.ClientOptions) (azcore.TokenCredential, error) {
+		return fake, nil
+	}
+	return v
+}
+
+func TestUCPCredentialValidator_Validate(t *testing.T) {
+	t.Run("valid service principal", func(t *testing.T) {
+		fake := &fakeTokenCredential{}
+		err := newTestValidator(fake).Validate(context.Background(), &sdk_cred.AzureCredential{
+			ClientID:     "fakeid",
+			TenantID:     "fakeid",
+			ClientSecret: "fakeSecret",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{armScope}, fake.scopes)
+	})
+
+	t.Run("valid workload identity", func(t *testing.T) {
+		fake := &fakeTokenCredential{}
+		err := newTestValidator(fake).Validate(context.Background(), &sdk_cred.AzureCredential{
+			Kind:     ucp_dm.AzureWorkloadIdentityCredentialKind,
+			ClientID: "fakeid",
+			TenantID: "fakeid",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("incomplete credential", func(t *testing.T) {
+		fake := &fakeTokenCredential{}
+		err := newTestValidator(fake).Validate(context.Background(), &sdk_cred.AzureCredential{
+			ClientID: "fakeid",
+			TenantID: "fakeid",
+		})
+		require.EqualError(t, err, "invalid azure credential info")
+		require.Nil(t, fake.scopes)
+	})
+
+	t.Run("rejected credential", func(t *testing.T) {
+		fake := &fakeTokenCredential{err: errors.New("invalid client secret")}
+		err := newTestValidator(fake).Validate(context.Background(), &sdk_cred.AzureCredential{
+			ClientID:     "fakeid",
+			TenantID:     "fakeid",
+			ClientSecret: "fakeSecret",
+		})
+		require.ErrorContains(t, err, "invalid client secret")
+	})
+}
@@ -128,6 +128,14 @@ type LogsOptions struct {
 	Replica     string
 }
 
+// ListResourcesOptions are the options to filter and sort the resources returned by the server when listing resources.
+type ListResourcesOptions struct {
+	// Filter is the OData filter applied to the resources, eg: "properties/provisioningState eq 'Failed'".
+	Filter string
+	// OrderBy is the comma separated list of properties used to sort the resources, eg: "systemData/createdAt desc".
+	OrderBy string
+}
+
 type LogStream struct {
 	Name   string
 	Stream io.ReadCloser
@@ -137,8 +145,8 @@ type LogStream struct {
 
 // ApplicationsManagementClient is used to interface with management features like listing resources by app, show details of a resource.
 type ApplicationsManagementClient interface {
-	ListAllResourcesByType(ctx context.Context, resourceType string) ([]generated.GenericResource, error)
-	ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string) ([]generated.GenericResource, error)
+	ListAllResourcesByType(ctx context.Context, resourceType string, options *ListResourcesOptions) ([]generated.GenericResource, error)
+	ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string, options *ListResourcesOptions) ([]generated.GenericResource, error)
 	ListAllResourcesByApplication(ctx context.Context, applicationName string) ([]generated.GenericResource, error)
 	ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error)
 	ListAllResourcesByEnvironment(ctx context.Context, environmentName string) ([]generated.GenericResource, error)
@@ -171,6 +179,13 @@ type ApplicationsManagementClient interface {
 
 	// ShowRecipe shows recipe details including list of all parameters for a given recipe registered to an environment
 	ShowRecipe(ctx context.Context, environmentName string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error)
+
+	// PlanRecipe shows the changes the deployment of the recipe of a portable resource would make to its resources
+	PlanRecipe(ctx context.Context, environmentName string, plan corerp.RecipePlanRequest) (corerp.RecipePlanResponse, error)
+
+	// UpgradeRecipe upgrades a portable resource to the version of its recipe registered to the environment. Returns
+	// false if the resource was already deployed with that version of the recipe.
+	UpgradeRecipe(ctx context.Context, environmentName string, resourceID string) (bool, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
@@ -70,8 +70,9 @@ var (
 //
 
 // ListAllResourcesByType retrieves a list of all resources of a given type from the root
-// scope, and returns them in a slice of GenericResource objects, or an error if one occurs.
-func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
+// scope, filtered and sorted by the server according to the options when specified, and returns them
+// in a slice of GenericResource objects, or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.Context, resourceType string, options *ListResourcesOptions) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 
 	client, err := generated.NewGenericResourcesClient(amc.RootScope, resourceType, &aztoken.AnonymousCredential{}, amc.ClientOptions)
@@ -79,7 +80,15 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 		return results, err
 	}
 
-	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{})
+	listOptions := &generated.GenericResourcesClientListByRootScopeOptions{}
+	if options != nil && options.Filter != "" {
+		listOptions.Filter = &options.Filter
+	}
+	if options != nil && options.OrderBy != "" {
+		listOptions.Orderby = &options.OrderBy
+	}
+
+	pager := client.NewListByRootScopePager(listOptions)
 	for pager.More() {
 		nextPage, err := pager.NextPage(ctx)
 		if err != nil {
@@ -97,11 +106,11 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByType(ctx context.C
 // ListAllResourceOfTypeInApplication lists the resources of a particular type in an application
 //
 
-// ListAllResourcesOfTypeInApplication takes in a context, an application name and a
-// resource type and returns a slice of GenericResources and an error if one occurs.
-func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string) ([]generated.GenericResource, error) {
+// ListAllResourcesOfTypeInApplication takes in a context, an application name, a resource
+// type and optional list options and returns a slice of GenericResources and an error if one occurs.
+func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(ctx context.Context, applicationName string, resourceType string, options *ListResourcesOptions) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType, options)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +131,7 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInApplication(
 func (amc *UCPApplicationsManagementClient) ListAllResourcesByApplication(ctx context.Context, applicationName string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
 	for _, resourceType := range ResourceTypesList {
-		resourceList, err := amc.ListAllResourcesOfTypeInApplication(ctx, applicationName, resourceType)
+		resourceList, err := amc.ListAllResourcesOfTypeInApplication(ctx, applicationName, resourceType, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +166,7 @@ func (amc *UCPApplicationsManagementClient) ListAllResourcesByEnvironment(ctx co
 // resource type and returns a slice of GenericResources and an error if one occurs.
 func (amc *UCPApplicationsManagementClient) ListAllResourcesOfTypeInEnvironment(ctx context.Context, environmentName string, resourceType string) ([]generated.GenericResource, error) {
 	results := []generated.GenericResource{}
-	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType)
+	resourceList, err := amc.ListAllResourcesByType(ctx, resourceType, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -649,3 +658,44 @@ func (amc *UCPApplicationsManagementClient) ShowRecipe(ctx context.Context, envi
 
 	return corerpv20231001.RecipeGetMetadataResponse(resp.RecipeGetMetadataResponse), nil
 }
+
+// PlanRecipe creates a new EnvironmentsClient, plans the recipe of a portable resource in the environment, and returns
+// the changes the deployment of the recipe would make to its resources or an error if one occurs.
+func (amc *UCPApplicationsManagementClient) PlanRecipe(ctx context.Context, environmentName string, plan corerpv20231001.RecipePlanRequest) (corerpv20231001.RecipePlanResponse, error) {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	resp, err := client.PlanRecipe(ctx, environmentName, plan, &corerpv20231001.EnvironmentsClientPlanRecipeOptions{})
+	if err != nil {
+		return corerpv20231001.RecipePlanResponse{}, err
+	}
+
+	return resp.RecipePlanResponse, nil
+}
+
+// UpgradeRecipe creates a new EnvironmentsClient, upgrades the portable resource to the version of its recipe registered
+// to the environment and waits for the upgrade to complete. It returns false if the resource was already deployed with
+// that version of the recipe, and an error if the upgrade fails.
+func (amc *UCPApplicationsManagementClient) UpgradeRecipe(ctx context.Context, environmentName string, resourceID string) (bool, error) {
+	client, err := corerpv20231001.NewEnvironmentsClient(amc.RootScope, &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	var respFromCtx *http.Response
+	ctxWithResp := runtime.WithCaptureResponse(ctx, &respFromCtx)
+
+	poller, err := client.BeginUpgradeRecipe(ctxWithResp, environmentName, corerpv20231001.RecipeUpgradeRequest{ResourceID: &resourceID}, nil)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return respFromCtx.StatusCode != http.StatusNoContent, nil
+}
@@ -214,33 +214,33 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByEnviro
 }
 
 // ListAllResourcesByType mocks base method.
-func (m *MockApplicationsManagementClient) ListAllResourcesByType(arg0 context.Context, arg1 string) ([]generated.GenericResource, error) {
+func (m *MockApplicationsManagementClient) ListAllResourcesByType(arg0 context.Context, arg1 string, arg2 *ListResourcesOptions) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllResourcesByType", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListAllResourcesByType", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]generated.GenericResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAllResourcesByType indicates an expected call of ListAllResourcesByType.
-func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByType(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesByType(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesByType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesByType), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesByType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesByType), arg0, arg1, arg2)
 }
 
 // ListAllResourcesOfTypeInApplication mocks base method.
-func (m *MockApplicationsManagementClient) ListAllResourcesOfTypeInApplication(arg0 context.Context, arg1, arg2 string) ([]generated.GenericResource, error) {
+func (m *MockApplicationsManagementClient) ListAllResourcesOfTypeInApplication(arg0 context.Context, arg1, arg2 string, arg3 *ListResourcesOptions) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAllResourcesOfTypeInApplication", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListAllResourcesOfTypeInApplication", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]generated.GenericResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAllResourcesOfTypeInApplication indicates an expected call of ListAllResourcesOfTypeInApplication.
-func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesOfTypeInApplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockApplicationsManagementClientMockRecorder) ListAllResourcesOfTypeInApplication(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesOfTypeInApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesOfTypeInApplication), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllResourcesOfTypeInApplication", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAllResourcesOfTypeInApplication), arg0, arg1, arg2, arg3)
 }
 
 // ListAllResourcesOfTypeInEnvironment mocks base method.
@@ -318,6 +318,21 @@ func (mr *MockApplicationsManagementClientMockRecorder) ListUCPGroup(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListUCPGroup), arg0, arg1, arg2)
 }
 
+// PlanRecipe mocks base method.
+func (m *MockApplicationsManagementClient) PlanRecipe(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipePlanRequest) (v20231001preview.RecipePlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview.RecipePlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRecipe indicates an expected call of PlanRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanRecipe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanRecipe), arg0, arg1, arg2)
+}
+
 // ShowApplication mocks base method.
 func (m *MockApplicationsManagementClient) ShowApplication(arg0 context.Context, arg1 string) (v20231001preview.ApplicationResource, error) {
 	m.ctrl.T.Helper()
@@ -377,3 +392,18 @@ func (mr *MockApplicationsManagementClientMockRecorder) ShowUCPGroup(arg0, arg1,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowUCPGroup", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ShowUCPGroup), arg0, arg1, arg2, arg3)
 }
+
+// UpgradeRecipe mocks base method.
+func (m *MockApplicationsManagementClient) UpgradeRecipe(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeRecipe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeRecipe indicates an expected call of UpgradeRecipe.
+func (mr *MockApplicationsManagementClientMockRecorder) UpgradeRecipe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeRecipe", reflect.TypeOf((*MockApplicationsManagementClient)(nil).UpgradeRecipe), arg0, arg1, arg2)
+}
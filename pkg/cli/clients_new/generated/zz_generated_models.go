@@ -89,7 +89,10 @@ type GenericResourcesClientGetOptions struct {
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.ListByRootScope
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
-	// placeholder for future optional parameters
+	// The filter to apply to the results. For example: properties/provisioningState eq 'Failed'
+	Filter *string
+	// The properties used to sort the results. For example: systemData/createdAt desc
+	Orderby *string
 }
 
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
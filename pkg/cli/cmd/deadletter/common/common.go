@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/deadletter"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ProviderFlag is the name of the flag used to specify the resource provider namespace.
+	ProviderFlag = "provider"
+)
+
+// AddProviderFlag adds the flag used to specify the resource provider namespace of the dead-lettered operations.
+func AddProviderFlag(cmd *cobra.Command) {
+	cmd.Flags().String(ProviderFlag, deadletter.DefaultProviderNamespace, "The resource provider namespace of the dead-lettered operations")
+}
+
+// RequireProvider returns the resource provider namespace specified with the provider flag.
+func RequireProvider(cmd *cobra.Command) (string, error) {
+	provider, err := cmd.Flags().GetString(ProviderFlag)
+	if err != nil {
+		return "", err
+	}
+
+	if provider == "" {
+		return "", clierrors.Message("The resource provider namespace must not be empty.")
+	}
+
+	return provider, nil
+}
+
+// DeadLetterOperationFormat returns the format used to display dead-lettered operations.
+func DeadLetterOperationFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ID",
+				JSONPath: "{ .ID }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .OperationType }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .ResourceID }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "DEQUEUE COUNT",
+				JSONPath: "{ .DequeueCount }",
+			},
+			{
+				Heading:  "LAST ERROR",
+				JSONPath: "{ .LastError }",
+			},
+		},
+	}
+}
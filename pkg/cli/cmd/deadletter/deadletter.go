@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	deadletter_list "github.com/radius-project/radius/pkg/cli/cmd/deadletter/list"
+	deadletter_purge "github.com/radius-project/radius/pkg/cli/cmd/deadletter/purge"
+	deadletter_requeue "github.com/radius-project/radius/pkg/cli/cmd/deadletter/requeue"
+	deadletter_show "github.com/radius-project/radius/pkg/cli/cmd/deadletter/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command for the `rad deadletter` command, with subcommands for listing,
+// showing, requeueing and purging dead-lettered operations.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "deadletter",
+		Short: "Manage dead-lettered operations",
+		Long: `Manage dead-lettered operations
+
+Asynchronous operations, such as deploying a resource, are moved to the dead-letter queue of their resource provider
+when they cannot be processed, for example when they failed more times than the maximum retry count. Dead-lettered
+operations can be inspected, requeued or purged.
+`,
+		Example: `
+# List dead-lettered operations of Applications.Core
+rad deadletter list
+
+# Show a dead-lettered operation
+rad deadletter show 42
+
+# Requeue a dead-lettered operation
+rad deadletter requeue 42
+
+# Purge all dead-lettered operations of Applications.Dapr
+rad deadletter purge --all --provider Applications.Dapr
+`,
+	}
+
+	list, _ := deadletter_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := deadletter_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	requeue, _ := deadletter_requeue.NewCommand(factory)
+	cmd.AddCommand(requeue)
+
+	purge, _ := deadletter_purge.NewCommand(factory)
+	cmd.AddCommand(purge)
+
+	return cmd
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/deadletter/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	allFlag = "all"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad deadletter purge` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "purge [id]",
+		Short: "Purge dead-lettered operations",
+		Long: `Purge dead-lettered operations
+
+Purged operations are deleted permanently and can no longer be requeued. The status of the operations and the
+resources they were operating on are left untouched.`,
+		Example: `
+# Purge a dead-lettered operation of Applications.Core
+rad deadletter purge 42
+
+# Purge all dead-lettered operations of Applications.Core without prompting for confirmation
+rad deadletter purge --all --yes`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	common.AddProviderFlag(cmd)
+	cmd.Flags().Bool(allFlag, false, "Purge all dead-lettered operations of the resource provider")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad deadletter purge` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	Provider          string
+	ID                string
+	All               bool
+	Confirmation      bool
+}
+
+// NewRunner creates a new instance of the `rad deadletter purge` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad deadletter purge` command. Exactly one of the id argument and the all flag
+// must be specified.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	provider, err := common.RequireProvider(cmd)
+	if err != nil {
+		return err
+	}
+
+	all, err := cmd.Flags().GetBool(allFlag)
+	if err != nil {
+		return err
+	}
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	if all && len(args) > 0 {
+		return clierrors.Message("Specify either an operation ID or --all, not both.")
+	} else if !all && len(args) == 0 {
+		return clierrors.Message("Specify an operation ID or --all.")
+	}
+
+	r.Workspace = workspace
+	r.Provider = provider
+	r.All = all
+	r.Confirmation = yes
+	if len(args) > 0 {
+		r.ID = args[0]
+	}
+
+	return nil
+}
+
+// Run runs the `rad deadletter purge` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirmation {
+		message := fmt.Sprintf("Are you sure you want to purge the dead-lettered operation '%s'?", r.ID)
+		if r.All {
+			message = fmt.Sprintf("Are you sure you want to purge all dead-lettered operations of '%s'?", r.Provider)
+		}
+
+		confirmed, err := prompt.YesOrNoPrompt(message, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			r.Output.LogInfo("Dead-lettered operations NOT purged.")
+			return nil
+		}
+	}
+
+	client, err := r.ConnectionFactory.CreateDeadLetterClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if !r.All {
+		deleted, err := client.Delete(ctx, r.Provider, r.ID)
+		if err != nil {
+			return err
+		}
+
+		if deleted {
+			r.Output.LogInfo("Operation %q purged.", r.ID)
+		} else {
+			r.Output.LogInfo("Operation %q does not exist or has already been purged.", r.ID)
+		}
+		return nil
+	}
+
+	operations, err := client.List(ctx, r.Provider)
+	if err != nil {
+		return err
+	}
+
+	purged := 0
+	for _, operation := range operations {
+		deleted, err := client.Delete(ctx, r.Provider, operation.ID)
+		if err != nil {
+			return err
+		}
+		if deleted {
+			purged++
+		}
+	}
+
+	r.Output.LogInfo("Purged %d dead-lettered operations.", purged)
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package purge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deadletter"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Purge Command with id",
+			Input:         []string{"42"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "42", runner.(*Runner).ID)
+				require.False(t, runner.(*Runner).All)
+			},
+		},
+		{
+			Name:          "Purge Command with all",
+			Input:         []string{"--all", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).All)
+				require.True(t, runner.(*Runner).Confirmation)
+			},
+		},
+		{
+			Name:          "Purge Command with id and all",
+			Input:         []string{"42", "--all"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Purge Command without id or all",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Purge operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := deadletter.NewMockClient(ctrl)
+		client.EXPECT().
+			Delete(gomock.Any(), "Applications.Core", "42").
+			Return(true, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Workspace:         &workspaces.Workspace{},
+			Provider:          "Applications.Core",
+			ID:                "42",
+			Confirmation:      true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Operation %q purged.",
+				Params: []any{"42"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Purge all operations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := deadletter.NewMockClient(ctrl)
+		client.EXPECT().
+			List(gomock.Any(), "Applications.Core").
+			Return([]v1.DeadLetterOperation{{ID: "1"}, {ID: "2"}}, nil).
+			Times(1)
+		client.EXPECT().
+			Delete(gomock.Any(), "Applications.Core", "1").
+			Return(true, nil).
+			Times(1)
+		client.EXPECT().
+			Delete(gomock.Any(), "Applications.Core", "2").
+			Return(false, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: client},
+			Workspace:         &workspaces.Workspace{},
+			Provider:          "Applications.Core",
+			All:               true,
+			Confirmation:      true,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Purged %d dead-lettered operations.",
+				Params: []any{1},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Answer no on confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		prompter := prompt.NewMockInterface(ctrl)
+		prompter.EXPECT().
+			GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, "Are you sure you want to purge all dead-lettered operations of 'Applications.Core'?").
+			Return(prompt.ConfirmNo, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DeadLetterClient: deadletter.NewMockClient(ctrl)},
+			Workspace:         &workspaces.Workspace{},
+			Provider:          "Applications.Core",
+			All:               true,
+			InputPrompter:     prompter,
+			Output:            outputSink,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Dead-lettered operations NOT purged.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -33,6 +33,10 @@ func RecipeFormat() output.FormatterOptions {
 				Heading:  "TYPE",
 				JSONPath: "{ .ResourceType }",
 			},
+			{
+				Heading:  "RECIPE VERSION",
+				JSONPath: "{ .RecipeVersion }",
+			},
 			{
 				Heading:  "TEMPLATE KIND",
 				JSONPath: "{ .TemplateKind }",
@@ -77,3 +81,24 @@ func RecipeParametersFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// RecipePlanFormat returns a FormatterOptions struct containing the column headings and JSONPaths for the
+// recipe plan table.
+func RecipePlanFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+		},
+	}
+}
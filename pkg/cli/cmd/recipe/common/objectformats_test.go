@@ -32,13 +32,14 @@ func Test_RecipeFormat(t *testing.T) {
 		TemplateKind:    "test-kind",
 		TemplatePath:    "test-path",
 		TemplateVersion: "test-version",
+		RecipeVersion:   "1.0",
 	}
 
 	buffer := &bytes.Buffer{}
 	err := output.Write(output.FormatTable, obj, buffer, RecipeFormat())
 	require.NoError(t, err)
 
-	expected := "RECIPE    TYPE       TEMPLATE KIND  TEMPLATE VERSION  TEMPLATE\ntest      test-type  test-kind      test-version      test-path\n"
+	expected := "RECIPE    TYPE       RECIPE VERSION  TEMPLATE KIND  TEMPLATE VERSION  TEMPLATE\ntest      test-type  1.0             test-kind      test-version      test-path\n"
 	require.Equal(t, expected, buffer.String())
 }
 
@@ -58,3 +59,18 @@ func Test_RecipeParametersFormat(t *testing.T) {
 	expected := "PARAMETER  TYPE       DEFAULT VALUE  MIN       MAX\ntest       test-type  1              4         3\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_RecipePlanFormat(t *testing.T) {
+	obj := types.RecipeResourceChange{
+		Action:       "create",
+		ResourceType: "test-type",
+		Name:         "test",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, RecipePlanFormat())
+	require.NoError(t, err)
+
+	expected := "ACTION    TYPE       NAME\ncreate    test-type  test\n"
+	require.Equal(t, expected, buffer.String())
+}
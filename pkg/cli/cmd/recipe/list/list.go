@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -138,7 +139,24 @@ func (r *Runner) Run(ctx context.Context) error {
 					TemplateKind: *c.TemplateKind,
 					PlainHTTP:    *c.PlainHTTP,
 				}
+			case *corerp.HelmRecipeProperties:
+				recipe = types.EnvironmentRecipe{
+					Name:            recipeName,
+					ResourceType:    resourceType,
+					TemplatePath:    *c.TemplatePath,
+					TemplateKind:    *c.TemplateKind,
+					TemplateVersion: to.String(c.TemplateVersion),
+				}
+			case *corerp.KubernetesRecipeProperties:
+				recipe = types.EnvironmentRecipe{
+					Name:         recipeName,
+					ResourceType: resourceType,
+					TemplatePath: *c.TemplatePath,
+					TemplateKind: *c.TemplateKind,
+					PlainHTTP:    to.Bool(c.PlainHTTP),
+				}
 			}
+			recipe.RecipeVersion = to.String(recipeDetails.GetRecipeProperties().RecipeVersion)
 			envRecipes = append(envRecipes, recipe)
 		}
 	}
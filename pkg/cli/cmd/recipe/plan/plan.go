@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const recipeNameFlag = "recipe-name"
+
+// NewCommand creates a new cobra command that shows the changes the deployment of the recipe of a portable resource
+// would make to its resources, with the option to customize the output format.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "plan [resource-type] [resource-name]",
+		Short: "Show the changes a recipe would make",
+		Long: `Show the changes a recipe would make
+
+The recipe plan command outputs the resources the recipe of a portable resource would create, update, replace or delete when the portable resource is deployed, without deploying it. Terraform recipes are planned with 'terraform plan' and Bicep recipes with a what-if deployment.
+
+The recipe registered to the environment for the resource type with the name given by the recipe-name flag is planned, and the 'default' recipe if the flag is not set. If the portable resource is already deployed, the resources of its previous deployment are taken into account.
+
+You can specify parameters using the '--parameters' flag ('-p' for short). Parameters can be passed as:
+- A single key-value pair: --parameters key=value
+- A JSON file: --parameters @myfile.json
+
+By default, the command is scoped to the resource group and environment defined in your rad.yaml workspace file. You can optionally override these values through the environment and group flags.
+
+By default, the command outputs a human-readable table. You can customize the output format with the output flag.`,
+		Example: `
+# show the changes the default recipe of a redis cache would make
+rad recipe plan redisCaches redis
+
+# show the changes a named recipe of a redis cache would make, with parameters
+rad recipe plan redisCaches redis --recipe-name redis-prod --parameters size=large
+
+# show the changes a recipe would make, with a JSON output
+rad recipe plan redisCaches redis --output json`,
+		RunE: framework.RunCommand(runner),
+		Args: cobra.ExactArgs(2),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	cmd.Flags().String(recipeNameFlag, "", "The name of the recipe registered to the environment")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe plan` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	ApplicationName   string
+	RecipeName        string
+	ResourceType      string
+	ResourceName      string
+	Parameters        map[string]map[string]any
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad recipe plan` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate takes in a command and a slice of strings and validates the command line arguments, setting the workspace,
+// environment, application, portable resource type and name, recipe name, parameters and output format in the Runner
+// struct. It returns an error if any of the arguments are invalid.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	if !r.Workspace.IsNamedWorkspace() {
+		return workspaces.ErrNamedWorkspaceRequired
+	}
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	resourceType, resourceName, err := cli.RequireResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+
+	// The application is optional, a portable resource can be shared by the applications of an environment.
+	applicationName, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return err
+	}
+	r.ApplicationName = applicationName
+
+	recipeName, err := cmd.Flags().GetString(recipeNameFlag)
+	if err != nil {
+		return err
+	}
+	if recipeName == "" {
+		recipeName = portableresources.DefaultRecipeName
+	}
+	r.RecipeName = recipeName
+
+	parameterArgs, err := cmd.Flags().GetStringArray("parameters")
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: bicep.OSFileSystem{}}
+	r.Parameters, err = parser.Parse(parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format == "" {
+		format = "table"
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run plans the recipe of the portable resource through the Applications Management service and prints the changes
+// in the specified format. It returns an error if one occurs.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	planRequest := v20231001preview.RecipePlanRequest{
+		ResourceID: to.Ptr(r.Workspace.Scope + "/providers/" + r.ResourceType + "/" + r.ResourceName),
+		RecipeName: to.Ptr(r.RecipeName),
+		Parameters: bicep.ConvertToMapStringInterface(r.Parameters),
+	}
+	if r.ApplicationName != "" {
+		planRequest.Application = to.Ptr(r.Workspace.Scope + "/providers/Applications.Core/applications/" + r.ApplicationName)
+	}
+
+	plan, err := client.PlanRecipe(ctx, r.Workspace.Environment, planRequest)
+	if err != nil {
+		return err
+	}
+
+	changes := []types.RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		changes = append(changes, types.RecipeResourceChange{
+			Action:       to.String(change.Action),
+			ResourceType: to.String(change.ResourceType),
+			Name:         to.String(change.Name),
+		})
+	}
+
+	err = r.Output.WriteFormatted(r.Format, changes, common.RecipePlanFormat())
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		r.Output.LogInfo("No changes")
+	}
+
+	return nil
+}
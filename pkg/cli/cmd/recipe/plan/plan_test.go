@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	types "github.com/radius-project/radius/pkg/cli/cmd/recipe"
+	"github.com/radius-project/radius/pkg/cli/cmd/recipe/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Plan Command",
+			Input:         []string{"redisCaches", "redis"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.ResourceType)
+				require.Equal(t, "redis", r.ResourceName)
+				require.Equal(t, "default", r.RecipeName)
+				require.Equal(t, "table", r.Format)
+			},
+		},
+		{
+			Name:          "Valid Plan Command with recipe name and parameters",
+			Input:         []string{"redisCaches", "redis", "--recipe-name", "redis-prod", "--parameters", "size=large", "-a", "app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "redis-prod", r.RecipeName)
+				require.Equal(t, "app", r.ApplicationName)
+				require.Equal(t, map[string]map[string]any{"size": {"value": "large"}}, r.Parameters)
+			},
+		},
+		{
+			Name:          "Plan Command with invalid resource type",
+			Input:         []string{"invalidType", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command without resource name",
+			Input:         []string{"redisCaches"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Plan Command with incorrect fallback workspace",
+			Input:         []string{"-e", "my-env", "-g", "my-env", "redisCaches", "redis"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	scope := "/planes/radius/local/resourceGroups/test-group"
+
+	t.Run("Plan recipe - Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), "test-env", v20231001preview.RecipePlanRequest{
+				ResourceID:  to.Ptr(scope + "/providers/Applications.Datastores/redisCaches/redis"),
+				Application: to.Ptr(scope + "/providers/Applications.Core/applications/app"),
+				RecipeName:  to.Ptr("default"),
+				Parameters:  map[string]any{"size": "large"},
+			}).
+			Return(v20231001preview.RecipePlanResponse{
+				Changes: []*v20231001preview.RecipeResourceChange{
+					{Action: to.Ptr("create"), ResourceType: to.Ptr("kubernetes_deployment"), Name: to.Ptr("module.default.kubernetes_deployment.redis")},
+					{Action: to.Ptr("delete"), ResourceType: to.Ptr("kubernetes_service"), Name: to.Ptr("module.default.kubernetes_service.redis")},
+				},
+			}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: scope, Environment: "test-env"},
+			Format:            "table",
+			ApplicationName:   "app",
+			RecipeName:        "default",
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "redis",
+			Parameters:        map[string]map[string]any{"size": {"value": "large"}},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []types.RecipeResourceChange{
+					{Action: "create", ResourceType: "kubernetes_deployment", Name: "module.default.kubernetes_deployment.redis"},
+					{Action: "delete", ResourceType: "kubernetes_service", Name: "module.default.kubernetes_service.redis"},
+				},
+				Options: common.RecipePlanFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Plan recipe - No changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			PlanRecipe(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(v20231001preview.RecipePlanResponse{Changes: []*v20231001preview.RecipeResourceChange{}}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: scope, Environment: "test-env"},
+			Format:            "table",
+			RecipeName:        "default",
+			ResourceType:      "Applications.Datastores/redisCaches",
+			ResourceName:      "redis",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []types.RecipeResourceChange{},
+				Options: common.RecipePlanFormat(),
+			},
+			output.LogOutput{
+				Format: "No changes",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
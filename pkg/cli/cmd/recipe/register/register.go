@@ -59,6 +59,15 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 		
 # specify multiple parameters using a JSON parameter file
 rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --template-path template_path --resource-type Applications.Datastores/mongoDatabases --parameters @myfile.json
+
+# Add a recipe deploying a Helm chart from an OCI registry to an environment
+rad recipe register redis -e env_name -w workspace --template-kind helm --template-path oci://ghcr.io/myregistry/charts/redis --template-version 1.2.0 --resource-type Applications.Datastores/redisCaches
+
+# Add a recipe deploying Kubernetes manifests or a kustomization from an OCI registry to an environment
+rad recipe register redis -e env_name -w workspace --template-kind kubernetes --template-path ghcr.io/myregistry/manifests/redis:1.0 --resource-type Applications.Datastores/redisCaches
+
+# Add version 2.0 of a recipe to an environment, resources deployed with a previous version of the recipe keep using it until they are upgraded
+rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --template-path template_path:2.0 --resource-type Applications.Datastores/mongoDatabases --recipe-version 2.0
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -70,12 +79,13 @@ rad recipe register cosmosdb -e env_name -w workspace --template-kind bicep --te
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().String("template-kind", "", "specify the kind for the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-kind")
-	cmd.Flags().String("template-version", "", "specify the version for the terraform module.")
+	cmd.Flags().String("template-version", "", "specify the version for the terraform module or the helm chart.")
+	cmd.Flags().String("recipe-version", "", "specify the version of the recipe. Resources keep using the version of the recipe they were deployed with until they are upgraded with 'rad recipe upgrade'.")
 	cmd.Flags().String("template-path", "", "specify the path to the template provided by the recipe.")
 	_ = cmd.MarkFlagRequired("template-path")
 	cmd.Flags().String("resource-type", "", "specify the type of the portable resource this recipe can be consumed by")
 	_ = cmd.MarkFlagRequired("resource-type")
-	cmd.Flags().Bool("plain-http", false, "Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
+	cmd.Flags().Bool("plain-http", false, "Connect to the registry of a Bicep or Kubernetes recipe using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS, for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).")
 	commonflags.AddParameterFlag(cmd)
 
 	return cmd, runner
@@ -91,6 +101,7 @@ type Runner struct {
 	TemplatePath      string
 	PlainHTTP         bool
 	TemplateVersion   string
+	RecipeVersion     string
 	ResourceType      string
 	RecipeName        string
 	Parameters        map[string]map[string]any
@@ -161,6 +172,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.PlainHTTP = plainHTTP
 
+	recipeVersion, err := cmd.Flags().GetString("recipe-version")
+	if err != nil {
+		return err
+	}
+	r.RecipeVersion = recipeVersion
+
 	return nil
 }
 
@@ -191,14 +208,32 @@ func (r *Runner) Run(ctx context.Context) error {
 			TemplateKind:    &r.TemplateKind,
 			TemplatePath:    &r.TemplatePath,
 			TemplateVersion: &r.TemplateVersion,
+			RecipeVersion:   &r.RecipeVersion,
 			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
 		}
 	case recipes.TemplateKindBicep:
 		properties = &corerp.BicepRecipeProperties{
-			TemplateKind: &r.TemplateKind,
-			TemplatePath: &r.TemplatePath,
-			PlainHTTP:    &r.PlainHTTP,
-			Parameters:   bicep.ConvertToMapStringInterface(r.Parameters),
+			TemplateKind:  &r.TemplateKind,
+			TemplatePath:  &r.TemplatePath,
+			PlainHTTP:     &r.PlainHTTP,
+			RecipeVersion: &r.RecipeVersion,
+			Parameters:    bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	case recipes.TemplateKindHelm:
+		properties = &corerp.HelmRecipeProperties{
+			TemplateKind:    &r.TemplateKind,
+			TemplatePath:    &r.TemplatePath,
+			TemplateVersion: &r.TemplateVersion,
+			RecipeVersion:   &r.RecipeVersion,
+			Parameters:      bicep.ConvertToMapStringInterface(r.Parameters),
+		}
+	case recipes.TemplateKindKubernetes:
+		properties = &corerp.KubernetesRecipeProperties{
+			TemplateKind:  &r.TemplateKind,
+			TemplatePath:  &r.TemplatePath,
+			PlainHTTP:     &r.PlainHTTP,
+			RecipeVersion: &r.RecipeVersion,
+			Parameters:    bicep.ConvertToMapStringInterface(r.Parameters),
 		}
 	}
 	if val, ok := envRecipes[r.ResourceType]; ok {
@@ -63,6 +63,36 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid Register Command for helm recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindHelm, "--template-path", "oci://ghcr.io/test/charts/redis", "--resource-type", ds_ctrl.RedisCachesResourceType, "--template-version", "1.2.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Register Command for kubernetes recipe",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindKubernetes, "--template-path", "ghcr.io/test/manifests/redis:1.0", "--resource-type", ds_ctrl.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Register Command with recipe version",
+			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template:2.0", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--recipe-version", "2.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "2.0", runner.(*Runner).RecipeVersion)
+			},
+		},
 		{
 			Name:          "Valid Register Command with parameters passed as file",
 			Input:         []string{"test_recipe", "--template-kind", recipes.TemplateKindBicep, "--template-path", "test_template", "--resource-type", ds_ctrl.MongoDatabasesResourceType, "--parameters", "@testdata/recipeparam.json", "--plain-http"},
@@ -23,6 +23,7 @@ type EnvironmentRecipe struct {
 	TemplatePath    string `json:"templatePath"`
 	TemplateVersion string `json:"templateVersion"`
 	PlainHTTP       bool   `json:"plainHTTP"`
+	RecipeVersion   string `json:"recipeVersion"`
 }
 
 type RecipeParameter struct {
@@ -32,3 +33,9 @@ type RecipeParameter struct {
 	MaxValue     string      `json:"maxValue,omitempty"`
 	MinValue     string      `json:"minValue,omitempty"`
 }
+
+type RecipeResourceChange struct {
+	Action       string `json:"action"`
+	ResourceType string `json:"resourceType"`
+	Name         string `json:"name"`
+}
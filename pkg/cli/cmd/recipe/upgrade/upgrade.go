@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"sort"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command that upgrades the portable resources using a recipe to the version of the
+// recipe registered to the environment.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "upgrade [recipe-name]",
+		Short: "Upgrade the resources using a recipe to its registered version",
+		Long: `Upgrade the resources using a recipe to its registered version
+
+Portable resources keep using the version of the recipe they were deployed with when a new version of the recipe is registered to the environment. The recipe upgrade command redeploys the portable resources of the resource type using the recipe with the version of the recipe registered to the environment.
+
+The resources are upgraded one at a time. A resource which fails to upgrade is rolled back to the version of the recipe it was deployed with, and the remaining resources are not upgraded.`,
+		Example: `
+# upgrade the redis caches using the default recipe
+rad recipe upgrade default --resource-type Applications.Datastores/redisCaches`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddResourceTypeFlag(cmd)
+	_ = cmd.MarkFlagRequired(cli.ResourceTypeFlag)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe upgrade` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	RecipeName        string
+	ResourceType      string
+}
+
+// NewRunner creates a new instance of the `rad recipe upgrade` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate checks the command line arguments for a workspace, environment, recipe name and resource type, and returns
+// an error if any of these are not present.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	environment, err := cli.RequireEnvironmentName(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Environment = environment
+
+	recipeName, err := cli.RequireRecipeNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipeName = recipeName
+
+	resourceType, err := cli.GetResourceType(cmd)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = resourceType
+
+	return nil
+}
+
+// Run checks that the recipe is registered to the environment, and upgrades the portable resources of the environment
+// using the recipe one at a time. It stops at the first resource which fails to upgrade and returns an error.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = cmd.CheckIfRecipeExists(ctx, client, r.Workspace.Environment, r.RecipeName, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	resources, err := client.ListAllResourcesOfTypeInEnvironment(ctx, r.Workspace.Environment, r.ResourceType)
+	if err != nil {
+		return err
+	}
+
+	upgrades := []generated.GenericResource{}
+	for _, resource := range resources {
+		if usesRecipe(resource, r.RecipeName) {
+			upgrades = append(upgrades, resource)
+		}
+	}
+	if len(upgrades) == 0 {
+		r.Output.LogInfo("No resources of type %q use the recipe %q in the environment %q.", r.ResourceType, r.RecipeName, r.Workspace.Environment)
+		return nil
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		return to.String(upgrades[i].Name) < to.String(upgrades[j].Name)
+	})
+
+	for _, resource := range upgrades {
+		r.Output.LogInfo("Upgrading resource %q...", to.String(resource.Name))
+		upgraded, err := client.UpgradeRecipe(ctx, r.Workspace.Environment, to.String(resource.ID))
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to upgrade the resource %q. The remaining resources were not upgraded.", to.String(resource.Name))
+		}
+		if !upgraded {
+			r.Output.LogInfo("Resource %q already uses the registered version of the recipe.", to.String(resource.Name))
+		}
+	}
+
+	r.Output.LogInfo("Successfully upgraded the resources using recipe %q in environment %q.", r.RecipeName, r.Workspace.Environment)
+	return nil
+}
+
+// usesRecipe returns true if the portable resource is deployed with the recipe of the given name.
+func usesRecipe(resource generated.GenericResource, recipeName string) bool {
+	if provisioning, _ := resource.Properties["resourceProvisioning"].(string); provisioning == string(portableresources.ResourceProvisioningManual) {
+		return false
+	}
+
+	recipe, _ := resource.Properties["recipe"].(map[string]any)
+	name, _ := recipe["name"].(string)
+	if name == "" {
+		name = portableresources.DefaultRecipeName
+	}
+	return name == recipeName
+}
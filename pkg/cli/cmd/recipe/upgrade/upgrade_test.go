@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Upgrade Command",
+			Input:         []string{"test_recipe", "--resource-type", ds_ctrl.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Upgrade Command with fallback workspace",
+			Input:         []string{"-e", "my-env", "test_recipe", "--resource-type", ds_ctrl.RedisCachesResourceType},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Upgrade Command without name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Upgrade Command without resource type",
+			Input:         []string{"test_recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	envResource := v20231001preview.EnvironmentResource{
+		ID:       to.Ptr("/planes/radius/local/resourcegroups/kind-kind/providers/applications.core/environments/kind-kind"),
+		Name:     to.Ptr("kind-kind"),
+		Type:     to.Ptr("applications.core/environments"),
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.EnvironmentProperties{
+			Recipes: map[string]map[string]v20231001preview.RecipePropertiesClassification{
+				ds_ctrl.RedisCachesResourceType: {
+					"default": &v20231001preview.BicepRecipeProperties{
+						TemplateKind:  to.Ptr(recipes.TemplateKindBicep),
+						TemplatePath:  to.Ptr("ghcr.io/testpublicrecipe/bicep/modules/rediscaches:2.0"),
+						RecipeVersion: to.Ptr("2.0"),
+					},
+				},
+			},
+		},
+	}
+
+	redisID := func(name string) string {
+		return "/planes/radius/local/resourcegroups/kind-kind/providers/Applications.Datastores/redisCaches/" + name
+	}
+	resources := []generated.GenericResource{
+		{
+			ID:         to.Ptr(redisID("redis-b")),
+			Name:       to.Ptr("redis-b"),
+			Properties: map[string]any{"recipe": map[string]any{"name": "default"}},
+		},
+		{
+			ID:         to.Ptr(redisID("redis-a")),
+			Name:       to.Ptr("redis-a"),
+			Properties: map[string]any{"recipe": map[string]any{"name": "default"}},
+		},
+		{
+			ID:         to.Ptr(redisID("redis-manual")),
+			Name:       to.Ptr("redis-manual"),
+			Properties: map[string]any{"resourceProvisioning": "manual"},
+		},
+		{
+			ID:         to.Ptr(redisID("redis-other")),
+			Name:       to.Ptr("redis-other"),
+			Properties: map[string]any{"recipe": map[string]any{"name": "other"}},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesOfTypeInEnvironment(gomock.Any(), "kind-kind", ds_ctrl.RedisCachesResourceType).
+			Return(resources, nil).
+			Times(1)
+		gomock.InOrder(
+			appManagementClient.EXPECT().
+				UpgradeRecipe(gomock.Any(), "kind-kind", redisID("redis-a")).
+				Return(true, nil).
+				Times(1),
+			appManagementClient.EXPECT().
+				UpgradeRecipe(gomock.Any(), "kind-kind", redisID("redis-b")).
+				Return(false, nil).
+				Times(1),
+		)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Upgrading resource %q...",
+				Params: []any{"redis-a"},
+			},
+			output.LogOutput{
+				Format: "Upgrading resource %q...",
+				Params: []any{"redis-b"},
+			},
+			output.LogOutput{
+				Format: "Resource %q already uses the registered version of the recipe.",
+				Params: []any{"redis-b"},
+			},
+			output.LogOutput{
+				Format: "Successfully upgraded the resources using recipe %q in environment %q.",
+				Params: []any{"default", "kind-kind"},
+			},
+		}
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+
+	t.Run("Stops at the first failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		expectedError := errors.New("failed to upgrade the recipe, the resource was rolled back to version \"1.0\" of the recipe")
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesOfTypeInEnvironment(gomock.Any(), "kind-kind", ds_ctrl.RedisCachesResourceType).
+			Return(resources, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			UpgradeRecipe(gomock.Any(), "kind-kind", redisID("redis-a")).
+			Return(false, expectedError).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Equal(t, fmt.Sprintf("Failed to upgrade the resource %q. The remaining resources were not upgraded. Cause: %s.", "redis-a", expectedError.Error()), err.Error())
+	})
+
+	t.Run("No resources using the recipe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvDetails(gomock.Any(), "kind-kind").
+			Return(envResource, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListAllResourcesOfTypeInEnvironment(gomock.Any(), "kind-kind", ds_ctrl.RedisCachesResourceType).
+			Return([]generated.GenericResource{}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Environment: "kind-kind"},
+			RecipeName:        "default",
+			ResourceType:      ds_ctrl.RedisCachesResourceType,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{
+			output.LogOutput{
+				Format: "No resources of type %q use the recipe %q in the environment %q.",
+				Params: []any{ds_ctrl.RedisCachesResourceType, "default", "kind-kind"},
+			},
+		}, outputSink.Writes)
+	})
+}
@@ -58,6 +58,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	
 	# list all resources of a specified type in an application (shorthand flag)
 	rad resource list containers -a icecream-store
+
+	# list the resources of a specified type that failed to deploy, most recently created first
+	rad resource list containers --filter "properties/provisioningState eq 'Failed'" --orderby "systemData/createdAt desc"
 	`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -67,6 +70,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("filter", "", "The OData filter applied to the resources, eg: \"properties/provisioningState eq 'Failed'\"")
+	cmd.Flags().String("orderby", "", "The comma separated list of properties used to sort the resources, each optionally followed by 'asc' or 'desc', eg: \"systemData/createdAt desc\"")
 
 	return cmd, runner
 }
@@ -80,6 +85,8 @@ type Runner struct {
 	ApplicationName   string
 	Format            string
 	ResourceType      string
+	Filter            string
+	OrderBy           string
 }
 
 // NewRunner creates a new instance of the `rad resource list` runner.
@@ -94,8 +101,8 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad resource list` command.
 //
 
-// Validate checks the command line args, workspace, scope, application name, resource type and output format, and
-// returns an error if any of these are invalid.
+// Validate checks the command line args, workspace, scope, application name, resource type, output format, filter and
+// sort order, and returns an error if any of these are invalid.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	// Validate command line args and
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
@@ -128,6 +135,16 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	r.Filter, err = cmd.Flags().GetString("filter")
+	if err != nil {
+		return err
+	}
+
+	r.OrderBy, err = cmd.Flags().GetString("orderby")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -136,8 +153,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run checks if an application name is provided and if so, checks if the application exists in the workspace, then
 // lists all resources of the specified type in the application, and finally writes the resources to the output in the
-// specified format. If no application name is provided, it lists all resources of the specified type. An error is
-// returned if the application does not exist in the workspace.
+// specified format. If no application name is provided, it lists all resources of the specified type. The resources are
+// filtered and sorted by the server when a filter or sort order is provided. An error is returned if the application
+// does not exist in the workspace.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -145,9 +163,10 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	var resourceList []generated.GenericResource
+	options := &clients.ListResourcesOptions{Filter: r.Filter, OrderBy: r.OrderBy}
 
 	if r.ApplicationName == "" {
-		resourceList, err = client.ListAllResourcesByType(ctx, r.ResourceType)
+		resourceList, err = client.ListAllResourcesByType(ctx, r.ResourceType, options)
 		if err != nil {
 			return err
 		}
@@ -159,7 +178,7 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		resourceList, err = client.ListAllResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType)
+		resourceList, err = client.ListAllResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType, options)
 		if err != nil {
 			return err
 		}
@@ -68,6 +68,15 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "Valid List Command with filter and sort order",
+			Input:         []string{"containers", "--filter", "properties/provisioningState eq 'Failed'", "--orderby", "systemData/createdAt desc"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "List Command with invalid resource type",
 			Input:         []string{"invalidResourceType"},
@@ -138,7 +147,7 @@ func Test_Run(t *testing.T) {
 				ShowApplication(gomock.Any(), "test-app").
 				Return(v20231001preview.ApplicationResource{}, nil).Times(1)
 			appManagementClient.EXPECT().
-				ListAllResourcesOfTypeInApplication(gomock.Any(), "test-app", "containers").
+				ListAllResourcesOfTypeInApplication(gomock.Any(), "test-app", "containers", &clients.ListResourcesOptions{}).
 				Return(resources, nil).Times(1)
 
 			outputSink := &output.MockOutput{}
@@ -165,6 +174,48 @@ func Test_Run(t *testing.T) {
 			require.Equal(t, expected, outputSink.Writes)
 		})
 	})
+	t.Run("List resources by type with filter and sort order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resources := []generated.GenericResource{
+			radcli.CreateResource("containers", "B"),
+			radcli.CreateResource("containers", "A"),
+		}
+
+		options := &clients.ListResourcesOptions{
+			Filter:  "properties/provisioningState eq 'Failed'",
+			OrderBy: "systemData/createdAt desc",
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAllResourcesByType(gomock.Any(), "containers", options).
+			Return(resources, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceType:      "containers",
+			Format:            "table",
+			Filter:            options.Filter,
+			OrderBy:           options.OrderBy,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resources,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 	t.Run("List resources by type without application", func(t *testing.T) {
 		t.Run("Success", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -176,7 +227,7 @@ func Test_Run(t *testing.T) {
 
 			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 			appManagementClient.EXPECT().
-				ListAllResourcesByType(gomock.Any(), "containers").
+				ListAllResourcesByType(gomock.Any(), "containers", &clients.ListResourcesOptions{}).
 				Return(resources, nil).Times(1)
 
 			outputSink := &output.MockOutput{}
@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/deadletter"
 	"github.com/radius-project/radius/pkg/cli/deployment"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
@@ -46,6 +47,7 @@ type Factory interface {
 	CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error)
 	CreateApplicationsManagementClient(ctx context.Context, workspace workspaces.Workspace) (clients.ApplicationsManagementClient, error)
 	CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error)
+	CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletter.Client, error)
 }
 
 var _ Factory = (*impl)(nil)
@@ -233,3 +235,30 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 
 	return cpClient, nil
 }
+
+// CreateDeadLetterClient connects to the workspace, tests the connection, and returns a client to manage the
+// dead-lettered async operations in the plane of the workspace.
+func (*impl) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletter.Client, error) {
+	connection, err := workspace.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	err = sdk.TestConnection(ctx, connection)
+	if errors.Is(err, &sdk.ErrRadiusNotInstalled{}) {
+		return nil, clierrors.MessageWithCause(err, "Could not connect to Radius.")
+	} else if err != nil {
+		return nil, err
+	}
+
+	id, err := resources.ParseScope(workspace.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadletter.UCPClient{
+		Pipeline:   sdk.NewPipeline(connection),
+		Endpoint:   connection.Endpoint(),
+		PlaneScope: id.PlaneScope(),
+	}, nil
+}
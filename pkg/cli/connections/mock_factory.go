@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/deadletter"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 )
 
@@ -30,6 +31,7 @@ type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
 	CredentialManagementClient   cli_credential.CredentialManagementClient
 	DiagnosticsClient            clients.DiagnosticsClient
+	DeadLetterClient             deadletter.Client
 }
 
 // CreateDeploymentClient function takes in a context and a workspace and returns a DeploymentClient and an error, if any.
@@ -52,3 +54,8 @@ func (f *MockFactory) CreateApplicationsManagementClient(ctx context.Context, wo
 func (f *MockFactory) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	return f.CredentialManagementClient, nil
 }
+
+// CreateDeadLetterClient function takes in a context and a workspace and returns a dead-letter Client and does not return an error.
+func (f *MockFactory) CreateDeadLetterClient(ctx context.Context, workspace workspaces.Workspace) (deadletter.Client, error) {
+	return f.DeadLetterClient, nil
+}
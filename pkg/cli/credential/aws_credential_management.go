@@ -42,8 +42,14 @@ const (
 )
 
 type AWSCredentialProperties struct {
-	// AccessKeyID is the access key ID for the AWS credential.
+	// AccessKeyID is the access key ID for the AWS credential. It is not set for IRSA credentials.
 	AccessKeyID *string
+
+	// Kind is the kind of the AWS credential.
+	Kind *string
+
+	// RoleARN is the ARN of the role assumed by AssumeRole and IRSA credentials.
+	RoleARN *string
 }
 
 // AWSCredentialManagementClient is used to interface with cloud provider configuration and credentials.
@@ -84,8 +90,25 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 	if err != nil {
 		return ProviderCredentialConfiguration{}, err
 	}
-	awsAccessKeyCredentials, ok := resp.AwsCredentialResource.Properties.(*ucp.AwsAccessKeyCredentialProperties)
-	if !ok {
+	var awsCredentials *AWSCredentialProperties
+	switch p := resp.AwsCredentialResource.Properties.(type) {
+	case *ucp.AwsAccessKeyCredentialProperties:
+		awsCredentials = &AWSCredentialProperties{
+			AccessKeyID: p.AccessKeyID,
+			Kind:        (*string)(p.Kind),
+		}
+	case *ucp.AwsAssumeRoleCredentialProperties:
+		awsCredentials = &AWSCredentialProperties{
+			AccessKeyID: p.AccessKeyID,
+			Kind:        (*string)(p.Kind),
+			RoleARN:     p.RoleARN,
+		}
+	case *ucp.AwsIRSACredentialProperties:
+		awsCredentials = &AWSCredentialProperties{
+			Kind:    (*string)(p.Kind),
+			RoleARN: p.RoleARN,
+		}
+	default:
 		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", AWSCredential)
 	}
 
@@ -94,9 +117,7 @@ func (cpm *AWSCredentialManagementClient) Get(ctx context.Context, credentialNam
 			Name:    AWSCredential,
 			Enabled: true,
 		},
-		AWSCredentials: &AWSCredentialProperties{
-			AccessKeyID: awsAccessKeyCredentials.AccessKeyID,
-		},
+		AWSCredentials: awsCredentials,
 	}
 	return providerCredentialConfiguration, nil
 }
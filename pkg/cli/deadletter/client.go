@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deadletter provides the client used by the CLI to manage the dead-lettered async operations of a
+// resource provider.
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+)
+
+const (
+	// DefaultProviderNamespace is the resource provider namespace used when none is specified.
+	DefaultProviderNamespace = "Applications.Core"
+
+	apiVersion = "2023-10-01-preview"
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=deadletter -self_package github.com/radius-project/radius/pkg/cli/deadletter github.com/radius-project/radius/pkg/cli/deadletter Client
+
+// Client is used to manage the dead-lettered async operations of a resource provider.
+type Client interface {
+	// List lists the dead-lettered operations of the resource provider namespace.
+	List(ctx context.Context, providerNamespace string) ([]v1.DeadLetterOperation, error)
+	// Show gets the dead-lettered operation with the given ID.
+	Show(ctx context.Context, providerNamespace string, id string) (*v1.DeadLetterOperation, error)
+	// Requeue moves the dead-lettered operation with the given ID back to the queue.
+	Requeue(ctx context.Context, providerNamespace string, id string) error
+	// Delete deletes the dead-lettered operation with the given ID. It returns false if the operation does not exist.
+	Delete(ctx context.Context, providerNamespace string, id string) (bool, error)
+}
+
+// UCPClient implements Client by calling the dead-letter operations endpoints of the resource provider through UCP.
+type UCPClient struct {
+	// Pipeline is the pipeline used to send requests.
+	Pipeline runtime.Pipeline
+	// Endpoint is the UCP endpoint.
+	Endpoint string
+	// PlaneScope is the plane scope of the workspace, for example '/planes/radius/local'.
+	PlaneScope string
+}
+
+var _ Client = (*UCPClient)(nil)
+
+// List lists the dead-lettered operations of the resource provider namespace.
+func (c *UCPClient) List(ctx context.Context, providerNamespace string) ([]v1.DeadLetterOperation, error) {
+	resp, err := c.send(ctx, http.MethodGet, providerNamespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := struct {
+		Value []v1.DeadLetterOperation `json:"value"`
+	}{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
+
+// Show gets the dead-lettered operation with the given ID.
+func (c *UCPClient) Show(ctx context.Context, providerNamespace string, id string) (*v1.DeadLetterOperation, error) {
+	resp, err := c.send(ctx, http.MethodGet, providerNamespace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, notFoundError(providerNamespace, id)
+	} else if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := &v1.DeadLetterOperation{}
+	if err := runtime.UnmarshalAsJSON(resp, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Requeue moves the dead-lettered operation with the given ID back to the queue.
+func (c *UCPClient) Requeue(ctx context.Context, providerNamespace string, id string) error {
+	resp, err := c.send(ctx, http.MethodPost, providerNamespace, id+"/requeue")
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return notFoundError(providerNamespace, id)
+	} else if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted, http.StatusNoContent) {
+		return runtime.NewResponseError(resp)
+	}
+
+	return nil
+}
+
+// Delete deletes the dead-lettered operation with the given ID.
+func (c *UCPClient) Delete(ctx context.Context, providerNamespace string, id string) (bool, error) {
+	resp, err := c.send(ctx, http.MethodDelete, providerNamespace, id)
+	if err != nil {
+		return false, err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusNoContent) {
+		return false, runtime.NewResponseError(resp)
+	}
+
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+func (c *UCPClient) send(ctx context.Context, method string, providerNamespace string, path string) (*http.Response, error) {
+	urlPath := fmt.Sprintf("%s/providers/%s/deadletteroperations", c.PlaneScope, url.PathEscape(strings.ToLower(providerNamespace)))
+	if path != "" {
+		urlPath += "/" + path
+	}
+
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.Endpoint, urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	return c.Pipeline.Do(req)
+}
+
+func notFoundError(providerNamespace string, id string) error {
+	return clierrors.Message("The dead-lettered operation %q was not found in %q.", id, providerNamespace)
+}
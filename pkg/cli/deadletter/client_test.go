@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadletter
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, operations map[string]v1.DeadLetterOperation) *UCPClient {
+	r := chi.NewRouter()
+	base := "/planes/radius/local/providers/applications.core/deadletteroperations"
+
+	r.Get(base, func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, apiVersion, req.URL.Query().Get("api-version"))
+
+		result := map[string][]v1.DeadLetterOperation{"value": {}}
+		for _, op := range operations {
+			result["value"] = append(result["value"], op)
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	})
+	r.Get(base+"/{id}", func(w http.ResponseWriter, req *http.Request) {
+		op, ok := operations[chi.URLParam(req, "id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(op)
+	})
+	r.Post(base+"/{id}/requeue", func(w http.ResponseWriter, req *http.Request) {
+		if _, ok := operations[chi.URLParam(req, "id")]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(operations, chi.URLParam(req, "id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	r.Delete(base+"/{id}", func(w http.ResponseWriter, req *http.Request) {
+		if _, ok := operations[chi.URLParam(req, "id")]; !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		delete(operations, chi.URLParam(req, "id"))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	return &UCPClient{
+		Pipeline:   sdk.NewPipeline(connection),
+		Endpoint:   connection.Endpoint(),
+		PlaneScope: "/planes/radius/local",
+	}
+}
+
+func Test_UCPClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestClient(t, map[string]v1.DeadLetterOperation{
+		"1": {ID: "1", Reason: "MaxRetryCountExceeded"},
+		"2": {ID: "2", Reason: "InvalidMessage"},
+	})
+
+	operations, err := client.List(ctx, "Applications.Core")
+	require.NoError(t, err)
+	require.Len(t, operations, 2)
+
+	operation, err := client.Show(ctx, "Applications.Core", "1")
+	require.NoError(t, err)
+	require.Equal(t, "MaxRetryCountExceeded", operation.Reason)
+
+	_, err = client.Show(ctx, "Applications.Core", "3")
+	require.True(t, clierrors.IsFriendlyError(err))
+
+	err = client.Requeue(ctx, "Applications.Core", "1")
+	require.NoError(t, err)
+
+	err = client.Requeue(ctx, "Applications.Core", "1")
+	require.True(t, clierrors.IsFriendlyError(err))
+
+	deleted, err := client.Delete(ctx, "Applications.Core", "2")
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	deleted, err = client.Delete(ctx, "Applications.Core", "2")
+	require.NoError(t, err)
+	require.False(t, deleted)
+}
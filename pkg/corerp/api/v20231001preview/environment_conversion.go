@@ -18,21 +18,25 @@ package v20231001preview
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	types "github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 
 	rp_util "github.com/radius-project/radius/pkg/rp/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 const (
 	EnvironmentComputeKindKubernetes = "kubernetes"
 	invalidLocalModulePathFmt        = "local module paths are not supported with Terraform Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
+	invalidHelmChartPathFmt          = "the 'templatePath' '%s' of a Helm Recipe must be an OCI reference beginning with 'oci://' or the URL of a chart in a chart repository beginning with 'https://' or 'http://'."
 )
 
 // ConvertTo converts from the versioned Environment resource to version-agnostic datamodel.
@@ -93,12 +97,14 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 	if src.Properties.Providers != nil {
 		if src.Properties.Providers.Azure != nil {
 			converted.Properties.Providers.Azure = datamodel.ProvidersAzure{
-				Scope: to.String(src.Properties.Providers.Azure.Scope),
+				Scope:      to.String(src.Properties.Providers.Azure.Scope),
+				Credential: to.String(src.Properties.Providers.Azure.Credential),
 			}
 		}
 		if src.Properties.Providers.Aws != nil {
 			converted.Properties.Providers.AWS = datamodel.ProvidersAWS{
-				Scope: to.String(src.Properties.Providers.Aws.Scope),
+				Scope:      to.String(src.Properties.Providers.Aws.Scope),
+				Credential: to.String(src.Properties.Providers.Aws.Credential),
 			}
 		}
 	}
@@ -107,6 +113,19 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Simulated = true
 	}
 
+	if src.Properties.RecipeConfig != nil && src.Properties.RecipeConfig.Terraform != nil {
+		converted.Properties.RecipeConfig.Terraform, err = toTerraformConfigDataModel(src.Properties.RecipeConfig.Terraform)
+		if err != nil {
+			return &datamodel.Environment{}, err
+		}
+	}
+
+	if src.Properties.RecipeConfig != nil && src.Properties.RecipeConfig.Drift != nil {
+		converted.Properties.RecipeConfig.Drift = datamodel.RecipeDriftConfigProperties{
+			AutoRemediate: to.Bool(src.Properties.RecipeConfig.Drift.AutoRemediate),
+		}
+	}
+
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -155,12 +174,14 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Providers = &Providers{}
 		if env.Properties.Providers.Azure != (datamodel.ProvidersAzure{}) {
 			dst.Properties.Providers.Azure = &ProvidersAzure{
-				Scope: to.Ptr(env.Properties.Providers.Azure.Scope),
+				Scope:      to.Ptr(env.Properties.Providers.Azure.Scope),
+				Credential: fromCredentialName(env.Properties.Providers.Azure.Credential),
 			}
 		}
 		if env.Properties.Providers.AWS != (datamodel.ProvidersAWS{}) {
 			dst.Properties.Providers.Aws = &ProvidersAws{
-				Scope: to.Ptr(env.Properties.Providers.AWS.Scope),
+				Scope:      to.Ptr(env.Properties.Providers.AWS.Scope),
+				Credential: fromCredentialName(env.Properties.Providers.AWS.Credential),
 			}
 		}
 	}
@@ -169,6 +190,21 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
 
+	if terraformConfig := fromTerraformConfigDataModel(env.Properties.RecipeConfig.Terraform); terraformConfig != nil {
+		dst.Properties.RecipeConfig = &RecipeConfigProperties{
+			Terraform: terraformConfig,
+		}
+	}
+
+	if env.Properties.RecipeConfig.Drift.AutoRemediate {
+		if dst.Properties.RecipeConfig == nil {
+			dst.Properties.RecipeConfig = &RecipeConfigProperties{}
+		}
+		dst.Properties.RecipeConfig.Drift = &RecipeDriftConfigProperties{
+			AutoRemediate: to.Ptr(true),
+		}
+	}
+
 	var extensions []ExtensionClassification
 	if env.Properties.Extensions != nil {
 		for _, e := range env.Properties.Extensions {
@@ -180,6 +216,180 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toTerraformConfigDataModel(config *TerraformConfigProperties) (datamodel.TerraformConfigProperties, error) {
+	converted := datamodel.TerraformConfigProperties{
+		Version: to.String(config.Version),
+	}
+
+	if config.Backend != nil {
+		converted.Backend = datamodel.TerraformBackendProperties{
+			Kind:   to.String(config.Backend.Kind),
+			Config: to.StringMap(config.Backend.Config),
+		}
+
+		if err := backends.ValidateBackendConfig(converted.Backend.Kind, converted.Backend.Config); err != nil {
+			return datamodel.TerraformConfigProperties{}, v1.NewClientErrInvalidRequest(err.Error())
+		}
+	}
+
+	if config.ProviderMirror != nil {
+		mirrorURL := to.String(config.ProviderMirror.URL)
+		if u, err := url.Parse(mirrorURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return datamodel.TerraformConfigProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid terraform provider mirror url %q: the mirror must be served over https", mirrorURL))
+		}
+
+		converted.ProviderMirror = datamodel.TerraformProviderMirrorProperties{
+			URL:     mirrorURL,
+			Include: stringSlice(config.ProviderMirror.Include),
+			Exclude: stringSlice(config.ProviderMirror.Exclude),
+		}
+	}
+
+	if config.Registries != nil {
+		converted.Registries = map[string]datamodel.TerraformRegistryProperties{}
+		for host, registry := range config.Registries {
+			if registry == nil {
+				continue
+			}
+
+			secret := to.String(registry.Secret)
+			if secret != "" && !isSecretStoreID(secret) {
+				return datamodel.TerraformConfigProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret for terraform registry %q: %q is not a valid secret store resource id", host, secret))
+			}
+
+			converted.Registries[host] = datamodel.TerraformRegistryProperties{
+				Secret:   secret,
+				Services: to.StringMap(registry.Services),
+			}
+		}
+	}
+
+	if config.Providers != nil {
+		providers, err := toProviderConfigsDataModel(config.Providers)
+		if err != nil {
+			return datamodel.TerraformConfigProperties{}, err
+		}
+		converted.Providers = providers
+	}
+
+	return converted, nil
+}
+
+func toProviderConfigsDataModel(providers map[string][]*ProviderConfigProperties) (map[string][]datamodel.ProviderConfigProperties, error) {
+	converted := map[string][]datamodel.ProviderConfigProperties{}
+	for name, configs := range providers {
+		// Terraform requires an alias for each additional configuration of a provider.
+		defaultConfigs := 0
+		for _, config := range configs {
+			if config == nil {
+				continue
+			}
+
+			if _, ok := config.AdditionalProperties["alias"]; !ok {
+				defaultConfigs++
+			}
+
+			secrets := map[string]datamodel.SecretReference{}
+			for property, secret := range config.Secrets {
+				if secret == nil {
+					continue
+				}
+
+				if _, ok := config.AdditionalProperties[property]; ok {
+					return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("property %q of terraform provider %q cannot be set both as a value and as a secret", property, name))
+				}
+				if !isSecretStoreID(to.String(secret.Source)) || to.String(secret.Key) == "" {
+					return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid secret for property %q of terraform provider %q: the source must be a secret store resource id and the key must be set", property, name))
+				}
+
+				secrets[property] = datamodel.SecretReference{
+					Source: to.String(secret.Source),
+					Key:    to.String(secret.Key),
+				}
+			}
+
+			converted[name] = append(converted[name], datamodel.ProviderConfigProperties{
+				AdditionalProperties: config.AdditionalProperties,
+				Secrets:              secrets,
+			})
+		}
+
+		if defaultConfigs > 1 {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("terraform provider %q has more than one configuration without an alias", name))
+		}
+	}
+
+	return converted, nil
+}
+
+func fromProviderConfigsDataModel(providers map[string][]datamodel.ProviderConfigProperties) map[string][]*ProviderConfigProperties {
+	converted := map[string][]*ProviderConfigProperties{}
+	for name, configs := range providers {
+		for _, config := range configs {
+			secrets := map[string]*SecretReference{}
+			for property, secret := range config.Secrets {
+				secrets[property] = &SecretReference{
+					Source: to.Ptr(secret.Source),
+					Key:    to.Ptr(secret.Key),
+				}
+			}
+
+			converted[name] = append(converted[name], &ProviderConfigProperties{
+				AdditionalProperties: config.AdditionalProperties,
+				Secrets:              secrets,
+			})
+		}
+	}
+
+	return converted
+}
+
+// isSecretStoreID returns true if id is the resource ID of an Applications.Core/secretStores resource.
+func isSecretStoreID(id string) bool {
+	parsed, err := resources.ParseResource(id)
+	return err == nil && strings.EqualFold(parsed.Type(), datamodel.SecretStoreResourceType)
+}
+
+func fromTerraformConfigDataModel(config datamodel.TerraformConfigProperties) *TerraformConfigProperties {
+	if config.Version == "" && config.Backend.Kind == "" && config.ProviderMirror.URL == "" && len(config.Registries) == 0 && len(config.Providers) == 0 {
+		return nil
+	}
+
+	converted := &TerraformConfigProperties{}
+	if config.Version != "" {
+		converted.Version = to.Ptr(config.Version)
+	}
+	if config.Backend.Kind != "" {
+		converted.Backend = &TerraformBackendProperties{
+			Kind:   to.Ptr(config.Backend.Kind),
+			Config: *to.StringMapPtr(config.Backend.Config),
+		}
+	}
+	if config.ProviderMirror.URL != "" {
+		converted.ProviderMirror = &TerraformProviderMirrorProperties{
+			URL:     to.Ptr(config.ProviderMirror.URL),
+			Include: to.SliceOfPtrs(config.ProviderMirror.Include...),
+			Exclude: to.SliceOfPtrs(config.ProviderMirror.Exclude...),
+		}
+	}
+	if len(config.Providers) > 0 {
+		converted.Providers = fromProviderConfigsDataModel(config.Providers)
+	}
+	if len(config.Registries) > 0 {
+		converted.Registries = map[string]*TerraformRegistryProperties{}
+		for host, registry := range config.Registries {
+			converted.Registries[host] = &TerraformRegistryProperties{
+				Services: *to.StringMapPtr(registry.Services),
+			}
+			if registry.Secret != "" {
+				converted.Registries[host].Secret = to.Ptr(registry.Secret)
+			}
+		}
+	}
+
+	return converted
+}
+
 func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.EnvironmentCompute, error) {
 	switch v := h.(type) {
 	case *KubernetesCompute:
@@ -192,6 +402,10 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"}
 		}
 
+		if strings.Contains(to.String(v.Cluster), "/") {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.cluster", ValidValue: "the name of a Kubernetes plane"}
+		}
+
 		var identity *rpv1.IdentitySettings
 		if v.Identity != nil {
 			identity = &rpv1.IdentitySettings{
@@ -206,6 +420,7 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 			KubernetesCompute: rpv1.KubernetesComputeProperties{
 				ResourceID: to.String(v.ResourceID),
 				Namespace:  to.String(v.Namespace),
+				Cluster:    to.String(v.Cluster),
 			},
 			Identity: identity,
 		}, nil
@@ -237,6 +452,9 @@ func fromEnvironmentComputeDataModel(envCompute *rpv1.EnvironmentCompute) Enviro
 		if envCompute.KubernetesCompute.ResourceID != "" {
 			compute.ResourceID = to.Ptr(envCompute.KubernetesCompute.ResourceID)
 		}
+		if envCompute.KubernetesCompute.Cluster != "" {
+			compute.Cluster = to.Ptr(envCompute.KubernetesCompute.Cluster)
+		}
 		return compute
 	default:
 		return nil
@@ -309,13 +527,35 @@ func toEnvironmentRecipeProperties(e RecipePropertiesClassification) (datamodel.
 			TemplateVersion: to.String(c.TemplateVersion),
 			TemplatePath:    to.String(c.TemplatePath),
 			Parameters:      c.Parameters,
+			RecipeVersion:   to.String(c.RecipeVersion),
 		}, nil
 	case *BicepRecipeProperties:
 		return datamodel.EnvironmentRecipeProperties{
-			TemplateKind: types.TemplateKindBicep,
-			TemplatePath: to.String(c.TemplatePath),
-			PlainHTTP:    to.Bool(c.PlainHTTP),
-			Parameters:   c.Parameters,
+			TemplateKind:  types.TemplateKindBicep,
+			TemplatePath:  to.String(c.TemplatePath),
+			PlainHTTP:     to.Bool(c.PlainHTTP),
+			Parameters:    c.Parameters,
+			RecipeVersion: to.String(c.RecipeVersion),
+		}, nil
+	case *HelmRecipeProperties:
+		templatePath := to.String(c.TemplatePath)
+		if !strings.HasPrefix(templatePath, "oci://") && !strings.HasPrefix(templatePath, "https://") && !strings.HasPrefix(templatePath, "http://") {
+			return datamodel.EnvironmentRecipeProperties{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(invalidHelmChartPathFmt, templatePath))
+		}
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:    types.TemplateKindHelm,
+			TemplateVersion: to.String(c.TemplateVersion),
+			TemplatePath:    templatePath,
+			Parameters:      c.Parameters,
+			RecipeVersion:   to.String(c.RecipeVersion),
+		}, nil
+	case *KubernetesRecipeProperties:
+		return datamodel.EnvironmentRecipeProperties{
+			TemplateKind:  types.TemplateKindKubernetes,
+			TemplatePath:  to.String(c.TemplatePath),
+			PlainHTTP:     to.Bool(c.PlainHTTP),
+			Parameters:    c.Parameters,
+			RecipeVersion: to.String(c.RecipeVersion),
 		}, nil
 	}
 	return datamodel.EnvironmentRecipeProperties{}, nil
@@ -329,14 +569,48 @@ func fromRecipePropertiesClassificationDatamodel(e datamodel.EnvironmentRecipePr
 			TemplateVersion: to.Ptr(e.TemplateVersion),
 			TemplatePath:    to.Ptr(e.TemplatePath),
 			Parameters:      e.Parameters,
+			RecipeVersion:   fromRecipeVersion(e.RecipeVersion),
 		}
 	case types.TemplateKindBicep:
 		return &BicepRecipeProperties{
-			TemplateKind: to.Ptr(e.TemplateKind),
-			TemplatePath: to.Ptr(e.TemplatePath),
-			Parameters:   e.Parameters,
-			PlainHTTP:    to.Ptr(e.PlainHTTP),
+			TemplateKind:  to.Ptr(e.TemplateKind),
+			TemplatePath:  to.Ptr(e.TemplatePath),
+			Parameters:    e.Parameters,
+			PlainHTTP:     to.Ptr(e.PlainHTTP),
+			RecipeVersion: fromRecipeVersion(e.RecipeVersion),
+		}
+	case types.TemplateKindHelm:
+		return &HelmRecipeProperties{
+			TemplateKind:    to.Ptr(e.TemplateKind),
+			TemplateVersion: to.Ptr(e.TemplateVersion),
+			TemplatePath:    to.Ptr(e.TemplatePath),
+			Parameters:      e.Parameters,
+			RecipeVersion:   fromRecipeVersion(e.RecipeVersion),
+		}
+	case types.TemplateKindKubernetes:
+		return &KubernetesRecipeProperties{
+			TemplateKind:  to.Ptr(e.TemplateKind),
+			TemplatePath:  to.Ptr(e.TemplatePath),
+			Parameters:    e.Parameters,
+			PlainHTTP:     to.Ptr(e.PlainHTTP),
+			RecipeVersion: fromRecipeVersion(e.RecipeVersion),
 		}
 	}
 	return nil
 }
+
+// fromRecipeVersion returns nil for recipes registered without a version.
+func fromRecipeVersion(version string) *string {
+	if version == "" {
+		return nil
+	}
+	return to.Ptr(version)
+}
+
+// fromCredentialName returns nil for providers using the default credential.
+func fromCredentialName(name string) *string {
+	if name == "" {
+		return nil
+	}
+	return to.Ptr(name)
+}
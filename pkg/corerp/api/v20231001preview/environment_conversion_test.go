@@ -114,14 +114,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 							Scope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup",
 						},
 						AWS: datamodel.ProvidersAWS{
-							Scope: "/planes/aws/aws/accounts/140313373712/regions/us-west-2",
+							Scope:      "/planes/aws/aws/accounts/140313373712/regions/us-west-2",
+							Credential: "account2",
 						},
 					},
 					Recipes: map[string]map[string]datamodel.EnvironmentRecipeProperties{
 						ds_ctrl.MongoDatabasesResourceType: {
 							"cosmos-recipe": datamodel.EnvironmentRecipeProperties{
-								TemplateKind: recipes.TemplateKindBicep,
-								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/mongodatabases",
+								TemplateKind:  recipes.TemplateKindBicep,
+								TemplatePath:  "br:ghcr.io/sampleregistry/radius/recipes/mongodatabases",
+								RecipeVersion: "2.0",
 								Parameters: map[string]any{
 									"throughput": float64(400),
 								},
@@ -142,6 +144,16 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 								TemplatePath: "br:ghcr.io/sampleregistry/radius/recipes/rediscaches",
 								PlainHTTP:    true,
 							},
+							"helm-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind:    recipes.TemplateKindHelm,
+								TemplatePath:    "oci://ghcr.io/sampleregistry/radius/charts/redis",
+								TemplateVersion: "1.2.0",
+							},
+							"kubernetes-recipe": datamodel.EnvironmentRecipeProperties{
+								TemplateKind: recipes.TemplateKindKubernetes,
+								TemplatePath: "ghcr.io/sampleregistry/radius/manifests/redis:1.0",
+								PlainHTTP:    true,
+							},
 						},
 						dapr_ctrl.DaprStateStoresResourceType: {
 							"statestore-recipe": datamodel.EnvironmentRecipeProperties{
@@ -269,6 +281,250 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-cluster.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							Namespace: "default",
+							Cluster:   "prod",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-version.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Version: "1.5.7",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-drift-autoremediate.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Drift: datamodel.RecipeDriftConfigProperties{
+							AutoRemediate: true,
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-backend.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Version: "1.5.7",
+							Backend: datamodel.TerraformBackendProperties{
+								Kind: "s3",
+								Config: map[string]string{
+									"bucket": "tfstate",
+									"region": "us-west-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-registries.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							ProviderMirror: datamodel.TerraformProviderMirrorProperties{
+								URL:     "https://mirror.example.com/providers/",
+								Include: []string{"registry.terraform.io/hashicorp/*"},
+							},
+							Registries: map[string]datamodel.TerraformRegistryProperties{
+								"app.terraform.io": {
+									Secret:   "/planes/radius/local/resourcegroups/radius-test-rg/providers/Applications.Core/secretStores/tfc",
+									Services: map[string]string{},
+								},
+								"registry.example.com": {
+									Services: map[string]string{
+										"modules.v1": "https://registry.example.com/modules/v1/",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			filename: "environmentresource-with-terraform-providers.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: "kubernetes",
+						KubernetesCompute: rpv1.KubernetesComputeProperties{
+							ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Microsoft.ContainerService/managedClusters/radiusTestCluster",
+							Namespace:  "default",
+						},
+					},
+					RecipeConfig: datamodel.RecipeConfigProperties{
+						Terraform: datamodel.TerraformConfigProperties{
+							Providers: map[string][]datamodel.ProviderConfigProperties{
+								"helm": {
+									{
+										AdditionalProperties: map[string]any{
+											"kubernetes": map[string]any{"config_path": "/home/radius/.kube/config"},
+										},
+										Secrets: map[string]datamodel.SecretReference{},
+									},
+								},
+								"postgresql": {
+									{
+										AdditionalProperties: map[string]any{
+											"host": "postgres.example.com",
+											"port": float64(5432),
+										},
+										Secrets: map[string]datamodel.SecretReference{
+											"password": {
+												Source: "/planes/radius/local/resourcegroups/radius-test-rg/providers/Applications.Core/secretStores/postgres",
+												Key:    "password",
+											},
+										},
+									},
+									{
+										AdditionalProperties: map[string]any{
+											"alias": "replica",
+											"host":  "replica.postgres.example.com",
+										},
+										Secrets: map[string]datamodel.SecretReference{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
@@ -277,22 +533,46 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			filename: "environmentresource-invalid-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
 		},
+		{
+			filename: "environmentresource-invalid-cluster.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.cluster", ValidValue: "the name of a Kubernetes plane"},
+		},
 		{
 			filename: "environmentresource-invalid-resourcetype.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid resource type: \"Applications.Dapr/pubsub\""},
 		},
 		{
 			filename: "environmentresource-invalid-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
 		},
 		{
 			filename: "environmentresource-missing-templatekind.json",
-			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\""},
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid template kind. Allowed formats: \"bicep\", \"terraform\", \"helm\", \"kubernetes\""},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-backend.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "terraform backend \"s3\" credentials must be set in the environment of the Radius control plane instead of the configuration: secret_key"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-mirror.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid terraform provider mirror url \"http://mirror.example.com/providers/\": the mirror must be served over https"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-registry.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "invalid secret for terraform registry \"app.terraform.io\": \"/planes/radius/local/resourcegroups/radius-test-rg/providers/Applications.Core/environments/env0\" is not a valid secret store resource id"},
+		},
+		{
+			filename: "environmentresource-invalid-terraform-providers.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: "terraform provider \"helm\" has more than one configuration without an alias"},
 		},
 		{
 			filename: "environmentresource-terraformrecipe-localpath.json",
 			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidLocalModulePathFmt, "../not-allowed/")},
 		},
+		{
+			filename: "environmentresource-helmrecipe-invalidpath.json",
+			err:      &v1.ErrClientRP{Code: v1.CodeInvalid, Message: fmt.Sprintf(invalidHelmChartPathFmt, "charts/redis")},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -366,20 +646,33 @@ func TestConvertDataModelToVersioned(t *testing.T) {
 				require.Equal(t, 1, len(versioned.Properties.Extensions))
 				recipeDetails := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"]
 				if tt.filename == "environmentresourcedatamodel.json" {
+					require.Equal(t, "2.0", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["cosmos-recipe"].GetRecipeProperties().RecipeVersion))
 					require.Equal(t, "Azure/cosmosdb/azurerm", string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplatePath))
 					require.Equal(t, recipes.TemplateKindTerraform, string(*versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["terraform-recipe"].GetRecipeProperties().TemplateKind))
+					require.Equal(t, "1.5.7", string(*versioned.Properties.RecipeConfig.Terraform.Version))
+					require.Equal(t, "account2", string(*versioned.Properties.Providers.Aws.Credential))
+					require.Nil(t, versioned.Properties.Providers.Azure.Credential)
 					switch c := recipeDetails.(type) {
 					case *TerraformRecipeProperties:
 						require.Equal(t, "1.1.0", string(*c.TemplateVersion))
 					case *BicepRecipeProperties:
 						require.Equal(t, true, bool(*c.PlainHTTP))
 					}
+					helmRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["helm-recipe"].(*HelmRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, "oci://ghcr.io/sampleregistry/radius/charts/mongodb", string(*helmRecipe.TemplatePath))
+					require.Equal(t, "1.2.0", string(*helmRecipe.TemplateVersion))
+					kubernetesRecipe, ok := versioned.Properties.Recipes[ds_ctrl.MongoDatabasesResourceType]["kubernetes-recipe"].(*KubernetesRecipeProperties)
+					require.True(t, ok)
+					require.Equal(t, "ghcr.io/sampleregistry/radius/manifests/mongodb:1.0", string(*kubernetesRecipe.TemplatePath))
+					require.True(t, *kubernetesRecipe.PlainHTTP)
 				}
 				if tt.filename == "environmentresourcedatamodelemptyext.json" {
 					switch c := recipeDetails.(type) {
 					case *TerraformRecipeProperties:
 						require.Nil(t, c.TemplateVersion)
 					}
+					require.Nil(t, versioned.Properties.RecipeConfig)
 
 				}
 
@@ -441,6 +734,28 @@ func TestConvertDataModelWithIdentityToVersioned(t *testing.T) {
 	require.Equal(t, "https://oidcurl/guid", string(*versioned.Properties.Compute.GetEnvironmentCompute().Identity.OidcIssuer))
 }
 
+func TestConvertDataModelWithClusterToVersioned(t *testing.T) {
+	r := &datamodel.Environment{
+		Properties: datamodel.EnvironmentProperties{
+			Compute: rpv1.EnvironmentCompute{
+				Kind: rpv1.KubernetesComputeKind,
+				KubernetesCompute: rpv1.KubernetesComputeProperties{
+					Namespace: "default",
+					Cluster:   "prod",
+				},
+			},
+		},
+	}
+
+	versioned := &EnvironmentResource{}
+	err := versioned.ConvertFrom(r)
+	require.NoError(t, err)
+
+	compute := versioned.Properties.Compute.(*KubernetesCompute)
+	require.Equal(t, "default", to.String(compute.Namespace))
+	require.Equal(t, "prod", to.String(compute.Cluster))
+}
+
 func TestConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -21,6 +21,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources"
 	types "github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
 )
@@ -39,12 +40,13 @@ func (dst *RecipeGetMetadataResponse) ConvertFrom(src v1.DataModelInterface) err
 	dst.TemplateKind = to.Ptr(recipe.TemplateKind)
 	dst.TemplatePath = to.Ptr(recipe.TemplatePath)
 	switch recipe.TemplateKind {
-	case types.TemplateKindTerraform:
+	case types.TemplateKindTerraform, types.TemplateKindHelm:
 		dst.TemplateVersion = to.Ptr(recipe.TemplateVersion)
-	case types.TemplateKindBicep:
+	case types.TemplateKindBicep, types.TemplateKindKubernetes:
 		dst.PlainHTTP = to.Ptr(recipe.PlainHTTP)
 	}
 	dst.Parameters = recipe.Parameters
+	dst.OutputSchema = recipe.OutputSchema
 	return nil
 }
 
@@ -55,3 +57,56 @@ func (src *RecipeGetMetadata) ConvertTo() (v1.DataModelInterface, error) {
 		ResourceType: to.String(src.ResourceType),
 	}, nil
 }
+
+// ConvertTo converts from the versioned recipe plan request to version-agnostic datamodel.
+func (src *RecipePlanRequest) ConvertTo() (v1.DataModelInterface, error) {
+	if src.ResourceID == nil || *src.ResourceID == "" {
+		return nil, v1.NewClientErrInvalidRequest("resourceId is required")
+	}
+
+	recipeName := to.String(src.RecipeName)
+	if recipeName == "" {
+		recipeName = portableresources.DefaultRecipeName
+	}
+
+	return &datamodel.RecipePlanRequest{
+		ResourceID:    to.String(src.ResourceID),
+		ApplicationID: to.String(src.Application),
+		RecipeName:    recipeName,
+		Parameters:    src.Parameters,
+	}, nil
+}
+
+// ConvertTo converts from the versioned recipe upgrade request to version-agnostic datamodel.
+func (src *RecipeUpgradeRequest) ConvertTo() (v1.DataModelInterface, error) {
+	if src.ResourceID == nil || *src.ResourceID == "" {
+		return nil, v1.NewClientErrInvalidRequest("resourceId is required")
+	}
+
+	return &datamodel.RecipeUpgradeRequest{
+		ResourceID: to.String(src.ResourceID),
+	}, nil
+}
+
+// ConvertTo returns an error as it does not support converting the recipe plan to a version-agnostic object.
+func (src *RecipePlanResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, fmt.Errorf("converting recipe plan to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned recipe plan.
+func (dst *RecipePlanResponse) ConvertFrom(src v1.DataModelInterface) error {
+	plan, ok := src.(*datamodel.RecipePlan)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Changes = []*RecipeResourceChange{}
+	for _, change := range plan.Changes {
+		dst.Changes = append(dst.Changes, &RecipeResourceChange{
+			Action:       to.Ptr(change.Action),
+			ResourceType: to.Ptr(change.ResourceType),
+			Name:         to.Ptr(change.Name),
+		})
+	}
+	return nil
+}
@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"testing"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	types "github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +60,7 @@ func TestEnvironmentRecipePropertiesConvertDataModelToVersioned(t *testing.T) {
 				require.Equal(t, r.TemplateVersion, string(*versioned.TemplateVersion))
 			}
 			require.Equal(t, r.Parameters, versioned.Parameters)
+			require.Equal(t, r.OutputSchema, versioned.OutputSchema)
 		})
 	}
 }
@@ -122,3 +125,71 @@ func TestRecipeConvertVersionedToDataModel(t *testing.T) {
 		require.Equal(t, expected, ct)
 	})
 }
+
+func TestRecipePlanRequestConvertVersionedToDataModel(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo0"
+
+	t.Run("Convert to Data Model", func(t *testing.T) {
+		r := &RecipePlanRequest{
+			ResourceID:  to.Ptr(resourceID),
+			Application: to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0"),
+			RecipeName:  to.Ptr("mongo-azure"),
+			Parameters:  map[string]any{"throughput": float64(400)},
+		}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipePlanRequest{
+			ResourceID:    resourceID,
+			ApplicationID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0",
+			RecipeName:    "mongo-azure",
+			Parameters:    map[string]any{"throughput": float64(400)},
+		}, dm)
+	})
+
+	t.Run("Default recipe name", func(t *testing.T) {
+		r := &RecipePlanRequest{ResourceID: to.Ptr(resourceID)}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, "default", dm.(*datamodel.RecipePlanRequest).RecipeName)
+	})
+
+	t.Run("Missing resource id", func(t *testing.T) {
+		r := &RecipePlanRequest{}
+		_, err := r.ConvertTo()
+		require.Equal(t, v1.NewClientErrInvalidRequest("resourceId is required"), err)
+	})
+}
+
+func TestRecipeUpgradeRequestConvertVersionedToDataModel(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo0"
+
+	t.Run("Convert to Data Model", func(t *testing.T) {
+		r := &RecipeUpgradeRequest{ResourceID: to.Ptr(resourceID)}
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		require.Equal(t, &datamodel.RecipeUpgradeRequest{ResourceID: resourceID}, dm)
+	})
+
+	t.Run("Missing resource id", func(t *testing.T) {
+		r := &RecipeUpgradeRequest{}
+		_, err := r.ConvertTo()
+		require.Equal(t, v1.NewClientErrInvalidRequest("resourceId is required"), err)
+	})
+}
+
+func TestRecipePlanResponseConvertDataModelToVersioned(t *testing.T) {
+	plan := &datamodel.RecipePlan{
+		Changes: []datamodel.RecipeResourceChange{
+			{Action: "create", ResourceType: "aws_s3_bucket", Name: "module.default.aws_s3_bucket.bucket"},
+		},
+	}
+	versioned := &RecipePlanResponse{}
+	err := versioned.ConvertFrom(plan)
+	require.NoError(t, err)
+	require.Equal(t, []*RecipeResourceChange{
+		{Action: to.Ptr("create"), ResourceType: to.Ptr("aws_s3_bucket"), Name: to.Ptr("module.default.aws_s3_bucket.bucket")},
+	}, versioned.Changes)
+
+	_, err = versioned.ConvertTo()
+	require.ErrorContains(t, err, "converting recipe plan to a version-agnostic object is not supported")
+}
@@ -309,6 +309,63 @@ func (client *EnvironmentsClient) listByScopeHandleResponse(resp *http.Response)
 	return result, nil
 }
 
+// PlanRecipe - Gets the changes the deployment of a recipe would make to its resources without making them.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe
+//     method.
+func (client *EnvironmentsClient) PlanRecipe(ctx context.Context, environmentName string, body RecipePlanRequest, options *EnvironmentsClientPlanRecipeOptions) (EnvironmentsClientPlanRecipeResponse, error) {
+	var err error
+	req, err := client.planRecipeCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	resp, err := client.planRecipeHandleResponse(httpResp)
+	return resp, err
+}
+
+// planRecipeCreateRequest creates the PlanRecipe request.
+func (client *EnvironmentsClient) planRecipeCreateRequest(ctx context.Context, environmentName string, body RecipePlanRequest, options *EnvironmentsClientPlanRecipeOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/planRecipe"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// planRecipeHandleResponse handles the PlanRecipe response.
+func (client *EnvironmentsClient) planRecipeHandleResponse(resp *http.Response) (EnvironmentsClientPlanRecipeResponse, error) {
+	result := EnvironmentsClientPlanRecipeResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.RecipePlanResponse); err != nil {
+		return EnvironmentsClientPlanRecipeResponse{}, err
+	}
+	return result, nil
+}
+
 // Update - Update a EnvironmentResource
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -365,3 +422,70 @@ func (client *EnvironmentsClient) updateHandleResponse(resp *http.Response) (Env
 	return result, nil
 }
 
+// BeginUpgradeRecipe - Upgrades a portable resource to the current version of its recipe. The portable resource is rolled
+// back to the version of the recipe it was deployed with if the upgrade fails.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - environmentName - environment name
+//   - body - The content of the action request
+//   - options - EnvironmentsClientBeginUpgradeRecipeOptions contains the optional parameters for the EnvironmentsClient.BeginUpgradeRecipe
+//     method.
+func (client *EnvironmentsClient) BeginUpgradeRecipe(ctx context.Context, environmentName string, body RecipeUpgradeRequest, options *EnvironmentsClientBeginUpgradeRecipeOptions) (*runtime.Poller[EnvironmentsClientUpgradeRecipeResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.upgradeRecipe(ctx, environmentName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[EnvironmentsClientUpgradeRecipeResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken[EnvironmentsClientUpgradeRecipeResponse](options.ResumeToken, client.internal.Pipeline(), nil)
+	}
+}
+
+// UpgradeRecipe - Upgrades a portable resource to the current version of its recipe. The portable resource is rolled back
+// to the version of the recipe it was deployed with if the upgrade fails.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *EnvironmentsClient) upgradeRecipe(ctx context.Context, environmentName string, body RecipeUpgradeRequest, options *EnvironmentsClientBeginUpgradeRecipeOptions) (*http.Response, error) {
+	var err error
+	req, err := client.upgradeRecipeCreateRequest(ctx, environmentName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// upgradeRecipeCreateRequest creates the UpgradeRecipe request.
+func (client *EnvironmentsClient) upgradeRecipeCreateRequest(ctx context.Context, environmentName string, body RecipeUpgradeRequest, options *EnvironmentsClientBeginUpgradeRecipeOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/Applications.Core/environments/{environmentName}/upgradeRecipe"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	if environmentName == "" {
+		return nil, errors.New("parameter environmentName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{environmentName}", url.PathEscape(environmentName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
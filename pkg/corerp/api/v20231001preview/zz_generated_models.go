@@ -126,6 +126,9 @@ type ApplicationResourceUpdateProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -188,12 +191,17 @@ type BicepRecipeProperties struct {
 	// Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
 // for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
 	PlainHTTP *bool
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
 }
 
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type BicepRecipeProperties.
 func (b *BicepRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
 		Parameters: b.Parameters,
+		RecipeVersion: b.RecipeVersion,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
 	}
@@ -211,6 +219,10 @@ type BicepRecipePropertiesUpdate struct {
 // for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
 	PlainHTTP *bool
 
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
 	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 }
@@ -219,6 +231,7 @@ type BicepRecipePropertiesUpdate struct {
 func (b *BicepRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
 	return &RecipePropertiesUpdate{
 		Parameters: b.Parameters,
+		RecipeVersion: b.RecipeVersion,
 		TemplateKind: b.TemplateKind,
 		TemplatePath: b.TemplatePath,
 	}
@@ -553,6 +566,9 @@ type EnvironmentProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigProperties
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesClassification
 
@@ -616,6 +632,9 @@ type EnvironmentResourceUpdateProperties struct {
 	// Cloud providers configuration for the environment.
 	Providers *ProvidersUpdate
 
+	// Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+	RecipeConfig *RecipeConfigPropertiesUpdate
+
 	// Specifies Recipes linked to the Environment.
 	Recipes map[string]map[string]RecipePropertiesUpdateClassification
 
@@ -1077,6 +1096,64 @@ type HealthProbeProperties struct {
 // GetHealthProbeProperties implements the HealthProbePropertiesClassification interface for type HealthProbeProperties.
 func (h *HealthProbeProperties) GetHealthProbeProperties() *HealthProbeProperties { return h }
 
+// HelmRecipeProperties - Represents Helm recipe properties.
+type HelmRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
+	// Version of the Helm chart to deploy. The latest version of the chart is used when it is omitted.
+	TemplateVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type HelmRecipeProperties.
+func (h *HelmRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: h.Parameters,
+		RecipeVersion: h.RecipeVersion,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
+// HelmRecipePropertiesUpdate - Represents Helm recipe properties.
+type HelmRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Version of the Helm chart to deploy. The latest version of the chart is used when it is omitted.
+	TemplateVersion *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type HelmRecipePropertiesUpdate.
+func (h *HelmRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: h.Parameters,
+		RecipeVersion: h.RecipeVersion,
+		TemplateKind: h.TemplateKind,
+		TemplatePath: h.TemplatePath,
+	}
+}
+
 // IamProperties - IAM properties
 type IamProperties struct {
 	// REQUIRED; The kind of IAM provider to configure
@@ -1139,6 +1216,9 @@ type KubernetesCompute struct {
 	// REQUIRED; The namespace to use for the environment.
 	Namespace *string
 
+	// The name of the Kubernetes plane of the cluster to deploy to. The cluster Radius runs in is used when it is not set.
+	Cluster *string
+
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
@@ -1160,6 +1240,9 @@ type KubernetesComputeUpdate struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
 	Kind *string
 
+	// The name of the Kubernetes plane of the cluster to deploy to. The cluster Radius runs in is used when it is not set.
+	Cluster *string
+
 	// Configuration for supported external identity providers
 	Identity *IdentitySettingsUpdate
 
@@ -1214,6 +1297,66 @@ func (k *KubernetesNamespaceExtension) GetExtension() *Extension {
 	}
 }
 
+// KubernetesRecipeProperties - Represents Kubernetes recipe properties.
+type KubernetesRecipeProperties struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// REQUIRED; Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Connect to the OCI registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
+// for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+}
+
+// GetRecipeProperties implements the RecipePropertiesClassification interface for type KubernetesRecipeProperties.
+func (k *KubernetesRecipeProperties) GetRecipeProperties() *RecipeProperties {
+	return &RecipeProperties{
+		Parameters: k.Parameters,
+		RecipeVersion: k.RecipeVersion,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+	}
+}
+
+// KubernetesRecipePropertiesUpdate - Represents Kubernetes recipe properties.
+type KubernetesRecipePropertiesUpdate struct {
+	// REQUIRED; Discriminator property for RecipeProperties.
+	TemplateKind *string
+
+	// Key/value parameters to pass to the recipe template at deployment
+	Parameters map[string]any
+
+	// Connect to the OCI registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
+// for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
+	PlainHTTP *bool
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
+	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
+	TemplatePath *string
+}
+
+// GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type KubernetesRecipePropertiesUpdate.
+func (k *KubernetesRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
+	return &RecipePropertiesUpdate{
+		Parameters: k.Parameters,
+		RecipeVersion: k.RecipeVersion,
+		TemplateKind: k.TemplateKind,
+		TemplatePath: k.TemplatePath,
+	}
+}
+
 // KubernetesRuntimeProperties - The runtime configuration properties for Kubernetes
 type KubernetesRuntimeProperties struct {
 	// The serialized YAML manifest which represents the base Kubernetes resources to deploy, such as Deployment, Service, ServiceAccount,
@@ -1326,6 +1469,26 @@ func (p *PersistentVolume) GetVolume() *Volume {
 	}
 }
 
+// ProviderConfigProperties - The configuration of a Terraform provider. The properties are added as is to the provider
+// block of the Terraform configuration of the Recipes.
+type ProviderConfigProperties struct {
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// The provider configuration properties whose values are read from secret stores, keyed by the name of the property.
+	Secrets map[string]*SecretReference
+}
+
+// ProviderConfigPropertiesUpdate - The configuration of a Terraform provider. The properties are added as is to the provider
+// block of the Terraform configuration of the Recipes.
+type ProviderConfigPropertiesUpdate struct {
+	// OPTIONAL; Contains additional key/value pairs not defined in the schema.
+	AdditionalProperties map[string]any
+
+	// The provider configuration properties whose values are read from secret stores, keyed by the name of the property.
+	Secrets map[string]*SecretReferenceUpdate
+}
+
 // Providers - The Cloud providers configuration
 type Providers struct {
 	// The AWS cloud provider configuration
@@ -1339,10 +1502,18 @@ type Providers struct {
 type ProvidersAws struct {
 	// REQUIRED; Target scope for AWS resources to be deployed into. For example: '/planes/aws/aws/accounts/000000000000/regions/us-west-2'
 	Scope *string
+
+	// The name of the credential registered with the AWS plane which is used to deploy the AWS resources of the environment.
+	// The credential named 'default' is used when it is not set.
+	Credential *string
 }
 
 // ProvidersAwsUpdate - The AWS cloud provider definition
 type ProvidersAwsUpdate struct {
+	// The name of the credential registered with the AWS plane which is used to deploy the AWS resources of the environment.
+	// The credential named 'default' is used when it is not set.
+	Credential *string
+
 	// Target scope for AWS resources to be deployed into. For example: '/planes/aws/aws/accounts/000000000000/regions/us-west-2'
 	Scope *string
 }
@@ -1351,10 +1522,18 @@ type ProvidersAwsUpdate struct {
 type ProvidersAzure struct {
 	// REQUIRED; Target scope for Azure resources to be deployed into. For example: '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup'
 	Scope *string
+
+	// The name of the credential registered with the Azure plane which is used to deploy the Azure resources of the environment.
+	// The credential named 'default' is used when it is not set.
+	Credential *string
 }
 
 // ProvidersAzureUpdate - The Azure cloud provider definition
 type ProvidersAzureUpdate struct {
+	// The name of the credential registered with the Azure plane which is used to deploy the Azure resources of the environment.
+	// The credential named 'default' is used when it is not set.
+	Credential *string
+
 	// Target scope for Azure resources to be deployed into. For example: '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup'
 	Scope *string
 }
@@ -1377,6 +1556,63 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeConfigProperties - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigProperties struct {
+	// Configuration for the drift detection of the resources deployed by the Recipes of the environment.
+	Drift *RecipeDriftConfigProperties
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
+	Terraform *TerraformConfigProperties
+}
+
+// RecipeConfigPropertiesUpdate - Configuration for Recipes. Defines how each type of Recipe should be configured and run.
+type RecipeConfigPropertiesUpdate struct {
+	// Configuration for the drift detection of the resources deployed by the Recipes of the environment.
+	Drift *RecipeDriftConfigPropertiesUpdate
+
+	// Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as part of Recipe deployment.
+	Terraform *TerraformConfigPropertiesUpdate
+}
+
+// RecipeDriftConfigProperties - Configuration for the drift detection of the resources deployed by Recipes.
+type RecipeDriftConfigProperties struct {
+	// Redeploy the Recipe of a portable resource when its deployed resources have drifted from the Recipe. Defaults to false,
+	// drift is only recorded on the status of the portable resource.
+	AutoRemediate *bool
+}
+
+// RecipeDriftConfigPropertiesUpdate - Configuration for the drift detection of the resources deployed by Recipes.
+type RecipeDriftConfigPropertiesUpdate struct {
+	// Redeploy the Recipe of a portable resource when its deployed resources have drifted from the Recipe. Defaults to false,
+	// drift is only recorded on the status of the portable resource.
+	AutoRemediate *bool
+}
+
+// RecipeDriftStatus - The result of a drift check of the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; Whether the resources deployed by the recipe have drifted from the recipe.
+	Drifted *bool
+
+	// REQUIRED; The time of the last drift check.
+	LastCheckedAt *time.Time
+
+	// The resources which have drifted from the recipe.
+	Resources []*RecipeDriftedResource
+}
+
+// RecipeDriftedResource - A resource deployed by a recipe which has drifted from the recipe.
+type RecipeDriftedResource struct {
+	// REQUIRED; The change the deployment of the recipe would make to remediate the drift of the resource. Allowed values: create,
+	// update, replace, delete.
+	Action *string
+
+	// REQUIRED; The name or the resource ID of the resource.
+	Name *string
+
+	// REQUIRED; The type of the resource. For example: 'aws_s3_bucket' or 'Microsoft.Storage/storageAccounts'.
+	ResourceType *string
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment
@@ -1391,12 +1627,15 @@ type RecipeGetMetadataResponse struct {
 	// REQUIRED; The key/value parameters to pass to the recipe template at deployment.
 	Parameters map[string]any
 
-	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform.
+	// REQUIRED; The format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 	TemplateKind *string
 
 	// REQUIRED; The path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
+	// The JSON schema of the outputs the recipe must return for the resource type.
+	OutputSchema map[string]any
+
 	// Connect to the Bicep registry using HTTP (not-HTTPS). This should be used when the registry is known not to support HTTPS,
 // for example in a locally-hosted registry. Defaults to false (use HTTPS/TLS).
 	PlainHTTP *bool
@@ -1406,7 +1645,28 @@ type RecipeGetMetadataResponse struct {
 	TemplateVersion *string
 }
 
-// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePlanRequest - Represents the request body of the planRecipe action.
+type RecipePlanRequest struct {
+	// REQUIRED; Fully qualified resource ID of the portable resource the recipe is deployed for.
+	ResourceID *string
+
+	// Fully qualified resource ID of the application the portable resource is consumed by.
+	Application *string
+
+	// Key/value parameters to pass to the recipe template. Overrides the parameters set by the environment.
+	Parameters map[string]any
+
+	// The name of the recipe registered to the environment. Defaults to 'default'.
+	RecipeName *string
+}
+
+// RecipePlanResponse - Represents the response of the planRecipe action.
+type RecipePlanResponse struct {
+	// REQUIRED; The changes the deployment of the recipe would make to its resources.
+	Changes []*RecipeResourceChange
+}
+
+// RecipeProperties - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 type RecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1416,12 +1676,16 @@ type RecipeProperties struct {
 
 	// Key/value parameters to pass to the recipe template at deployment
 	Parameters map[string]any
+
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
 }
 
 // GetRecipeProperties implements the RecipePropertiesClassification interface for type RecipeProperties.
 func (r *RecipeProperties) GetRecipeProperties() *RecipeProperties { return r }
 
-// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform.
+// RecipePropertiesUpdate - Format of the template provided by the recipe. Allowed values: bicep, terraform, helm, kubernetes.
 type RecipePropertiesUpdate struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
 	TemplateKind *string
@@ -1429,6 +1693,10 @@ type RecipePropertiesUpdate struct {
 	// Key/value parameters to pass to the recipe template at deployment
 	Parameters map[string]any
 
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
 	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 }
@@ -1436,6 +1704,19 @@ type RecipePropertiesUpdate struct {
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type RecipePropertiesUpdate.
 func (r *RecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate { return r }
 
+// RecipeResourceChange - Represents a change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// REQUIRED; The action taken on the resource. Allowed values: create, update, replace, delete, noop.
+	Action *string
+
+	// REQUIRED; The name of the resource. This is the resource ID for Bicep recipes and the resource address for Terraform
+// recipes.
+	Name *string
+
+	// REQUIRED; The type of the resource.
+	ResourceType *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -1444,6 +1725,12 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
+	// RecipeVersion is the version of the recipe the portable resource was deployed with.
+	RecipeVersion *string
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -1457,6 +1744,12 @@ type RecipeUpdate struct {
 	Parameters map[string]any
 }
 
+// RecipeUpgradeRequest - Represents the request body of the upgradeRecipe action.
+type RecipeUpgradeRequest struct {
+	// REQUIRED; Fully qualified resource ID of the portable resource to upgrade to the current version of its recipe.
+	ResourceID *string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -1511,6 +1804,25 @@ type SecretObjectProperties struct {
 	Version *string
 }
 
+// SecretReference - A reference to a secret of a secret store.
+type SecretReference struct {
+	// REQUIRED; The name of the secret in the secret store.
+	Key *string
+
+	// REQUIRED; The resource ID of the secret store. For example:
+	// '/planes/radius/local/resourceGroups/default/providers/Applications.Core/secretStores/github'.
+	Source *string
+}
+
+// SecretReferenceUpdate - A reference to a secret of a secret store.
+type SecretReferenceUpdate struct {
+	// The name of the secret in the secret store.
+	Key *string
+
+	// The resource ID of the secret store. For example: '/planes/radius/local/resourceGroups/default/providers/Applications.Core/secretStores/github'.
+	Source *string
+}
+
 // SecretStoreListSecretsResult - The list of secrets
 type SecretStoreListSecretsResult struct {
 	// REQUIRED; An object to represent key-value type secrets
@@ -1669,6 +1981,99 @@ func (t *TCPHealthProbeProperties) GetHealthProbeProperties() *HealthProbeProper
 	}
 }
 
+// TerraformBackendProperties - The Terraform backend storing the state of Terraform Recipes.
+type TerraformBackendProperties struct {
+	// REQUIRED; The type of the Terraform backend. Allowed values: kubernetes, s3, azurerm, pg, local.
+	Kind *string
+
+	// The configuration of the backend, for example the bucket and region of the s3 backend. Credentials must not be set here,
+	// they are read from the environment of the Radius control plane.
+	Config map[string]*string
+}
+
+// TerraformBackendPropertiesUpdate - The Terraform backend storing the state of Terraform Recipes.
+type TerraformBackendPropertiesUpdate struct {
+	// The configuration of the backend, for example the bucket and region of the s3 backend. Credentials must not be set here,
+	// they are read from the environment of the Radius control plane.
+	Config map[string]*string
+
+	// The type of the Terraform backend. Allowed values: kubernetes, s3, azurerm, pg, local.
+	Kind *string
+}
+
+// TerraformConfigProperties - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates as
+// part of Recipe deployment.
+type TerraformConfigProperties struct {
+	// The backend storing the Terraform state of the Recipes of the environment. Defaults to the kubernetes backend.
+	Backend *TerraformBackendProperties
+
+	// The network mirror Terraform installs the providers required by the Recipes of the environment from. Defaults to the
+	// origin registries of the providers.
+	ProviderMirror *TerraformProviderMirrorProperties
+
+	// Configuration of the Terraform providers used by the Recipes of the environment, keyed by the provider name. For example:
+	// 'helm'. A provider configured more than once must set an 'alias' on all but one of its configurations.
+	Providers map[string][]*ProviderConfigProperties
+
+	// The private module and provider registries used by the Recipes of the environment, keyed by the hostname of the
+	// registry. For example: 'app.terraform.io'.
+	Registries map[string]*TerraformRegistryProperties
+
+	// Version of Terraform used to deploy the Terraform Recipes of the environment. For example: '1.5.7'. Defaults to the version
+	// configured for the Radius installation.
+	Version *string
+}
+
+// TerraformConfigPropertiesUpdate - Configuration for Terraform Recipes. Controls how Terraform plans and applies templates
+// as part of Recipe deployment.
+type TerraformConfigPropertiesUpdate struct {
+	// The backend storing the Terraform state of the Recipes of the environment. Defaults to the kubernetes backend.
+	Backend *TerraformBackendPropertiesUpdate
+
+	// The network mirror Terraform installs the providers required by the Recipes of the environment from. Defaults to the
+	// origin registries of the providers.
+	ProviderMirror *TerraformProviderMirrorPropertiesUpdate
+
+	// Configuration of the Terraform providers used by the Recipes of the environment, keyed by the provider name. For example:
+	// 'helm'. A provider configured more than once must set an 'alias' on all but one of its configurations.
+	Providers map[string][]*ProviderConfigPropertiesUpdate
+
+	// The private module and provider registries used by the Recipes of the environment, keyed by the hostname of the
+	// registry. For example: 'app.terraform.io'.
+	Registries map[string]*TerraformRegistryPropertiesUpdate
+
+	// Version of Terraform used to deploy the Terraform Recipes of the environment. For example: '1.5.7'. Defaults to the version
+	// configured for the Radius installation.
+	Version *string
+}
+
+// TerraformProviderMirrorProperties - The network mirror Terraform installs providers from.
+type TerraformProviderMirrorProperties struct {
+	// REQUIRED; The HTTPS URL of the provider network mirror. For example:
+	// 'https://terraform-mirror.example.com/providers/'.
+	URL *string
+
+	// The providers never installed from the mirror.
+	Exclude []*string
+
+	// The providers installed from the mirror, for example 'registry.terraform.io/hashicorp/*'. Providers which are not
+	// included are installed from their origin registries. Defaults to all providers.
+	Include []*string
+}
+
+// TerraformProviderMirrorPropertiesUpdate - The network mirror Terraform installs providers from.
+type TerraformProviderMirrorPropertiesUpdate struct {
+	// The providers never installed from the mirror.
+	Exclude []*string
+
+	// The providers installed from the mirror, for example 'registry.terraform.io/hashicorp/*'. Providers which are not
+	// included are installed from their origin registries. Defaults to all providers.
+	Include []*string
+
+	// The HTTPS URL of the provider network mirror. For example: 'https://terraform-mirror.example.com/providers/'.
+	URL *string
+}
+
 // TerraformRecipeProperties - Represents Terraform recipe properties.
 type TerraformRecipeProperties struct {
 	// REQUIRED; Discriminator property for RecipeProperties.
@@ -1680,6 +2085,10 @@ type TerraformRecipeProperties struct {
 	// Key/value parameters to pass to the recipe template at deployment
 	Parameters map[string]any
 
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
 	// Version of the template to deploy. For Terraform recipes using a module registry this is required, but must be omitted
 // for other module sources.
 	TemplateVersion *string
@@ -1689,6 +2098,7 @@ type TerraformRecipeProperties struct {
 func (t *TerraformRecipeProperties) GetRecipeProperties() *RecipeProperties {
 	return &RecipeProperties{
 		Parameters: t.Parameters,
+		RecipeVersion: t.RecipeVersion,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
 	}
@@ -1702,6 +2112,10 @@ type TerraformRecipePropertiesUpdate struct {
 	// Key/value parameters to pass to the recipe template at deployment
 	Parameters map[string]any
 
+	// The version of the recipe. Portable resources keep using the version of the recipe they were deployed with until they
+	// are upgraded using the upgradeRecipe action.
+	RecipeVersion *string
+
 	// Path to the template provided by the recipe. Currently only link to Azure Container Registry is supported.
 	TemplatePath *string
 
@@ -1710,10 +2124,31 @@ type TerraformRecipePropertiesUpdate struct {
 	TemplateVersion *string
 }
 
+// TerraformRegistryProperties - The configuration of a private Terraform registry.
+type TerraformRegistryProperties struct {
+	// The resource ID of the secret store holding the API token of the registry under the 'token' key.
+	Secret *string
+
+	// The service discovery overrides of the registry host, for example {'modules.v1':
+	// 'https://registry.example.com/modules/v1/'}.
+	Services map[string]*string
+}
+
+// TerraformRegistryPropertiesUpdate - The configuration of a private Terraform registry.
+type TerraformRegistryPropertiesUpdate struct {
+	// The resource ID of the secret store holding the API token of the registry under the 'token' key.
+	Secret *string
+
+	// The service discovery overrides of the registry host, for example {'modules.v1':
+	// 'https://registry.example.com/modules/v1/'}.
+	Services map[string]*string
+}
+
 // GetRecipePropertiesUpdate implements the RecipePropertiesUpdateClassification interface for type TerraformRecipePropertiesUpdate.
 func (t *TerraformRecipePropertiesUpdate) GetRecipePropertiesUpdate() *RecipePropertiesUpdate {
 	return &RecipePropertiesUpdate{
 		Parameters: t.Parameters,
+		RecipeVersion: t.RecipeVersion,
 		TemplateKind: t.TemplateKind,
 		TemplatePath: t.TemplatePath,
 	}
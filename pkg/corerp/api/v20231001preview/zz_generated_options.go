@@ -66,6 +66,13 @@ type ContainersClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientBeginUpgradeRecipeOptions contains the optional parameters for the EnvironmentsClient.BeginUpgradeRecipe
+// method.
+type EnvironmentsClientBeginUpgradeRecipeOptions struct {
+	// Resumes the LRO from the provided token.
+	ResumeToken string
+}
+
 // EnvironmentsClientCreateOrUpdateOptions contains the optional parameters for the EnvironmentsClient.CreateOrUpdate method.
 type EnvironmentsClientCreateOrUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -91,6 +98,11 @@ type EnvironmentsClientListByScopeOptions struct {
 	// placeholder for future optional parameters
 }
 
+// EnvironmentsClientPlanRecipeOptions contains the optional parameters for the EnvironmentsClient.PlanRecipe method.
+type EnvironmentsClientPlanRecipeOptions struct {
+	// placeholder for future optional parameters
+}
+
 // EnvironmentsClientUpdateOptions contains the optional parameters for the EnvironmentsClient.Update method.
 type EnvironmentsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -100,12 +100,23 @@ type EnvironmentsClientListByScopeResponse struct {
 	EnvironmentResourceListResult
 }
 
+// EnvironmentsClientPlanRecipeResponse contains the response from method EnvironmentsClient.PlanRecipe.
+type EnvironmentsClientPlanRecipeResponse struct {
+	// Represents the response of the planRecipe action.
+	RecipePlanResponse
+}
+
 // EnvironmentsClientUpdateResponse contains the response from method EnvironmentsClient.Update.
 type EnvironmentsClientUpdateResponse struct {
 	// The environment resource
 	EnvironmentResource
 }
 
+// EnvironmentsClientUpgradeRecipeResponse contains the response from method EnvironmentsClient.BeginUpgradeRecipe.
+type EnvironmentsClientUpgradeRecipeResponse struct {
+	// placeholder for future response values
+}
+
 // ExtendersClientCreateOrUpdateResponse contains the response from method ExtendersClient.BeginCreateOrUpdate.
 type ExtendersClientCreateOrUpdateResponse struct {
 	// ExtenderResource portable resource
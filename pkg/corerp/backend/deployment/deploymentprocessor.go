@@ -40,8 +40,10 @@ import (
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/resourcemodel"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
@@ -134,7 +136,7 @@ func (dp *deploymentProcessor) Render(ctx context.Context, resourceID resources.
 	}
 
 	// Check if the output resources have the corresponding provider supported in Radius
-	for _, or := range rendererOutput.Resources {
+	for i, or := range rendererOutput.Resources {
 		resourceType := or.GetResourceType()
 		if resourceType.Provider == "" {
 			return renderers.RendererOutput{}, fmt.Errorf("output resource %q does not have a provider specified", or.LocalID)
@@ -142,6 +144,11 @@ func (dp *deploymentProcessor) Render(ctx context.Context, resourceID resources.
 		if !dp.appmodel.IsProviderSupported(resourceType.Provider) {
 			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("provider %s is not configured. Cannot support resource type %s", resourceType.Provider, resourceType.Type))
 		}
+
+		// Renderers produce Kubernetes resources for the local cluster. Target the cluster of the environment instead.
+		if resourceType.Provider == resourcemodel.ProviderKubernetes && !or.ID.IsEmpty() && !resources_kubernetes.IsLocalPlane(envOptions.Cluster) {
+			rendererOutput.Resources[i].ID = resources_kubernetes.WithPlaneName(or.ID, envOptions.Cluster)
+		}
 	}
 
 	rendererOutput.RadiusResource = resource
@@ -396,6 +403,7 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 			return renderers.EnvironmentOptions{}, errors.New("kubernetes' namespace is not specified")
 		}
 		envOpts.Namespace = kubeProp.Namespace
+		envOpts.Cluster = kubeProp.Cluster
 
 	default:
 		return renderers.EnvironmentOptions{}, fmt.Errorf("%s is unsupported", env.Properties.Compute.Kind)
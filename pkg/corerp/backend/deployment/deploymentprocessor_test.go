@@ -47,6 +47,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type SharedMocks struct {
@@ -467,6 +469,83 @@ func Test_Render(t *testing.T) {
 		require.Equal(t, len(testRendererOutput.Resources), len(rendererOutput.Resources))
 	})
 
+	t.Run("verify render targets the cluster of the environment", func(t *testing.T) {
+		testResource := getLowerCaseTestResource()
+		testRendererOutput := renderers.RendererOutput{
+			Resources: []rpv1.OutputResource{
+				rpv1.NewKubernetesOutputResource(rpv1.LocalIDService, &corev1.Service{TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"}}, metav1.ObjectMeta{Name: "test-service", Namespace: "radius-test"}),
+			},
+		}
+		resourceID := getTestResourceID(testResource.ID)
+
+		depId1, _ := resources.ParseResource("/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/httpRoutes/A")
+		requiredResources := []resources.ID{depId1}
+
+		mocks.renderer.EXPECT().Render(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(testRendererOutput, nil)
+		mocks.renderer.EXPECT().GetDependencyIDs(gomock.Any(), gomock.Any()).Times(1).Return(requiredResources, nil, nil)
+		mocks.dbProvider.EXPECT().GetStorageClient(gomock.Any(), gomock.Any()).Times(4).Return(mocks.db, nil)
+
+		cr := store.Object{
+			Metadata: store.Metadata{
+				ID: testResource.ID,
+			},
+			Data: testResource,
+		}
+		mocks.db.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&cr, nil)
+		application := datamodel.Application{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/applications/test-application",
+				},
+			},
+			Properties: datamodel.ApplicationProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/environments/env0",
+				},
+			},
+		}
+		ar := store.Object{
+			Metadata: store.Metadata{
+				ID: application.ID,
+			},
+			Data: application,
+		}
+		mocks.db.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&ar, nil)
+		clusterEnv := env
+		clusterEnv.Properties.Compute.KubernetesCompute.Cluster = "prod"
+		er := store.Object{
+			Metadata: store.Metadata{
+				ID: clusterEnv.ID,
+			},
+			Data: clusterEnv,
+		}
+		mocks.db.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&er, nil)
+		httprouteA := datamodel.HTTPRoute{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/httpRoutes/A",
+				},
+			},
+			Properties: &datamodel.HTTPRouteProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Core/applications/test-application",
+				},
+			},
+		}
+		nr := store.Object{
+			Metadata: store.Metadata{
+				ID: httprouteA.ID,
+			},
+			Data: httprouteA,
+		}
+		mocks.db.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&nr, nil)
+
+		rendererOutput, err := dp.Render(ctx, resourceID, &testResource)
+		require.NoError(t, err)
+		require.Len(t, rendererOutput.Resources, 1)
+		require.Equal(t, "/planes/kubernetes/prod/namespaces/radius-test/providers/core/Service/test-service", rendererOutput.Resources[0].ID.String())
+	})
+
 	t.Run("verify render success uppercase resourcetype", func(t *testing.T) {
 		testResource := getUpperCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
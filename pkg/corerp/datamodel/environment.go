@@ -38,11 +38,98 @@ func (e *Environment) ResourceTypeName() string {
 
 // EnvironmentProperties represents the properties of Environment.
 type EnvironmentProperties struct {
-	Compute    rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
-	Recipes    map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
-	Providers  Providers                                         `json:"providers,omitempty"`
-	Extensions []Extension                                       `json:"extensions,omitempty"`
-	Simulated  bool                                              `json:"simulated,omitempty"`
+	Compute      rpv1.EnvironmentCompute                           `json:"compute,omitempty"`
+	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
+	Providers    Providers                                         `json:"providers,omitempty"`
+	Extensions   []Extension                                       `json:"extensions,omitempty"`
+	Simulated    bool                                              `json:"simulated,omitempty"`
+	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+}
+
+// RecipeConfigProperties represents the configuration for the recipes of the environment.
+type RecipeConfigProperties struct {
+	// Terraform is the configuration for the Terraform recipes.
+	Terraform TerraformConfigProperties `json:"terraform,omitempty"`
+
+	// Drift is the configuration for the drift detection of the resources deployed by the recipes.
+	Drift RecipeDriftConfigProperties `json:"drift,omitempty"`
+}
+
+// RecipeDriftConfigProperties represents the configuration for the drift detection of the resources deployed by the
+// recipes of the environment.
+type RecipeDriftConfigProperties struct {
+	// AutoRemediate redeploys the recipe of a portable resource when its deployed resources have drifted. Drift is only
+	// recorded on the status of the portable resource when it is false.
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
+}
+
+// TerraformConfigProperties represents the configuration for the Terraform recipes of the environment.
+type TerraformConfigProperties struct {
+	// Version is the version of Terraform used to deploy the recipes. The version configured for the Radius
+	// installation is used when it is empty.
+	Version string `json:"version,omitempty"`
+
+	// Backend is the backend storing the Terraform state of the recipes. The Kubernetes backend is used when it is empty.
+	Backend TerraformBackendProperties `json:"backend,omitempty"`
+
+	// ProviderMirror is the network mirror the providers required by the recipes are installed from. The providers are
+	// installed from their origin registries when it is empty.
+	ProviderMirror TerraformProviderMirrorProperties `json:"providerMirror,omitempty"`
+
+	// Registries is the configuration of the private module and provider registries, keyed by the registry hostname.
+	Registries map[string]TerraformRegistryProperties `json:"registries,omitempty"`
+
+	// Providers is the configuration of the Terraform providers used by the recipes, keyed by the provider name.
+	Providers map[string][]ProviderConfigProperties `json:"providers,omitempty"`
+}
+
+// TerraformBackendProperties represents the Terraform backend storing the state of the Terraform recipes.
+type TerraformBackendProperties struct {
+	// Kind is the type of the Terraform backend, such as kubernetes, s3, azurerm, pg or local.
+	Kind string `json:"kind,omitempty"`
+
+	// Config is the Terraform backend configuration.
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// TerraformProviderMirrorProperties represents the network mirror Terraform installs providers from.
+type TerraformProviderMirrorProperties struct {
+	// URL is the HTTPS URL of the provider network mirror.
+	URL string `json:"url,omitempty"`
+
+	// Include is the list of provider address patterns installed from the mirror. All providers are installed from the
+	// mirror when it is empty.
+	Include []string `json:"include,omitempty"`
+
+	// Exclude is the list of provider address patterns never installed from the mirror.
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// TerraformRegistryProperties represents the configuration of a private Terraform registry.
+type TerraformRegistryProperties struct {
+	// Secret is the resource ID of the secret store holding the API token of the registry under the "token" key.
+	Secret string `json:"secret,omitempty"`
+
+	// Services is the service discovery overrides of the registry host.
+	Services map[string]string `json:"services,omitempty"`
+}
+
+// ProviderConfigProperties represents the configuration of a Terraform provider.
+type ProviderConfigProperties struct {
+	// AdditionalProperties is the provider configuration added as is to the provider block.
+	AdditionalProperties map[string]any `json:"additionalProperties,omitempty"`
+
+	// Secrets is the provider configuration whose values are read from secret stores, keyed by the property name.
+	Secrets map[string]SecretReference `json:"secrets,omitempty"`
+}
+
+// SecretReference represents a reference to a secret of a secret store.
+type SecretReference struct {
+	// Source is the resource ID of the secret store.
+	Source string `json:"source,omitempty"`
+
+	// Key is the name of the secret in the secret store.
+	Key string `json:"key,omitempty"`
 }
 
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
@@ -52,6 +139,11 @@ type EnvironmentRecipeProperties struct {
 	TemplateVersion string         `json:"templateVersion,omitempty"`
 	Parameters      map[string]any `json:"parameters,omitempty"`
 	PlainHTTP       bool           `json:"plainHttp,omitempty"`
+	RecipeVersion   string         `json:"recipeVersion,omitempty"`
+
+	// OutputSchema is the JSON schema of the outputs the recipe must return for the resource type. It is only set in the
+	// response of the getMetadata API, and is not stored in the environment.
+	OutputSchema map[string]any `json:"outputSchema,omitempty"`
 }
 
 // Recipe represents input properties for recipe getMetadata api.
@@ -68,6 +160,60 @@ func (e *Recipe) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
+// RecipePlanRequest represents input properties for recipe planRecipe api.
+type RecipePlanRequest struct {
+	// ResourceID is the fully qualified resource ID of the portable resource the recipe is deployed for.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// ApplicationID is the fully qualified resource ID of the application the portable resource is consumed by.
+	ApplicationID string `json:"application,omitempty"`
+
+	// RecipeName is the name of the recipe registered to the environment.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Parameters are key/value pairs to pass into the recipe template. Overrides any parameters set by the environment.
+	Parameters map[string]any `json:"parameters,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlanRequest instance.
+func (e *RecipePlanRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeUpgradeRequest represents input properties for recipe upgradeRecipe api.
+type RecipeUpgradeRequest struct {
+	// ResourceID is the fully qualified resource ID of the portable resource to upgrade to the current version of its recipe.
+	ResourceID string `json:"resourceId,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipeUpgradeRequest instance.
+func (e *RecipeUpgradeRequest) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipePlan represents the output of recipe planRecipe api.
+type RecipePlan struct {
+	// Changes are the changes the deployment of the recipe would make to its resources.
+	Changes []RecipeResourceChange `json:"changes,omitempty"`
+}
+
+// ResourceTypeName returns the resource type of the RecipePlan instance.
+func (e *RecipePlan) ResourceTypeName() string {
+	return "Applications.Core/environments"
+}
+
+// RecipeResourceChange represents a change the deployment of a recipe would make to a resource.
+type RecipeResourceChange struct {
+	// Action is the action taken on the resource.
+	Action string `json:"action,omitempty"`
+
+	// ResourceType is the type of the resource.
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
 // ResourceTypeName returns the resource type of the EnvironmentRecipeProperties instance.
 func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
@@ -85,10 +231,16 @@ type Providers struct {
 type ProvidersAzure struct {
 	// Scope is the target level for deploying the azure resources
 	Scope string `json:"scope,omitempty"`
+	// Credential is the name of the credential of the Azure plane used to deploy the azure resources. The default
+	// credential is used when it is empty.
+	Credential string `json:"credential,omitempty"`
 }
 
 // ProvidersAWS represents the aws provider configs
 type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
+	// Credential is the name of the credential of the AWS plane used to deploy the aws resources. The default
+	// credential is used when it is empty.
+	Credential string `json:"credential,omitempty"`
 }
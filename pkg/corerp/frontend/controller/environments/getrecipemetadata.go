@@ -55,7 +55,7 @@ func NewGetRecipeMetadata(opts ctrl.Options, engine engine.Engine) (ctrl.Control
 }
 
 // Run retrieves the recipe metadata from the registry for a given recipe name and template path, and returns
-// a response containing the recipe parameters.
+// a response containing the recipe parameters and the schema of the outputs the recipe must return.
 func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
@@ -93,6 +93,9 @@ func (r *GetRecipeMetadata) Run(ctx context.Context, w http.ResponseWriter, req
 		TemplateVersion: recipeProperties.TemplateVersion,
 		Parameters:      recipeParams,
 	}
+	if schema := r.Engine.GetOutputSchema(recipeDatamodel.ResourceType); schema != nil {
+		ret.OutputSchema = schema.Document()
+	}
 
 	versioned, err := converter.EnvironmentRecipePropertiesDataModelToVersioned(&ret, serviceCtx.APIVersion)
 	if err != nil {
@@ -74,7 +74,10 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 				"mongodbName":    map[string]any{"type": "string"},
 			},
 		}
+		outputSchema, err := recipes.NewOutputSchema(*envInput.ResourceType, []byte(`{"type": "object", "properties": {"values": {"type": "object", "required": ["host", "port", "database"]}}}`))
+		require.NoError(t, err)
 		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetOutputSchema(*envInput.ResourceType).Return(outputSchema)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -123,6 +126,7 @@ func TestGetRecipeMetadataRun_20231001Preview(t *testing.T) {
 			},
 		}
 		mEngine.EXPECT().GetRecipeMetadata(ctx, recipeDefinition).Return(recipeData, nil)
+		mEngine.EXPECT().GetOutputSchema(*envInput.ResourceType).Return(nil)
 
 		opts := ctrl.Options{
 			StorageClient: mStorageClient,
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+var _ ctrl.Controller = (*PlanRecipe)(nil)
+
+// PlanRecipe is the controller implementation to get the changes the deployment of a recipe would make to the resources
+// of a portable resource, without making them.
+type PlanRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+	engine.Engine
+}
+
+// NewPlanRecipe creates a new controller for planning the recipe of a portable resource in an environment.
+func NewPlanRecipe(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &PlanRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+		engine,
+	}, nil
+}
+
+// Run plans the recipe registered to the environment for the type of the portable resource and returns the changes
+// the deployment of the recipe would make to its resources. The output resources of the previous deployment of the
+// portable resource are taken into account, so resources which would be removed are reported as well.
+func (r *PlanRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	planRequest, err := converter.RecipePlanRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID, err := resources.ParseResource(planRequest.ResourceID)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("%q is not a valid resource id.", planRequest.ResourceID)), nil
+	}
+
+	exists := false
+	if recipe, ok := resource.Properties.Recipes[resourceID.Type()]; ok {
+		_, exists = recipe[planRequest.RecipeName]
+	}
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", planRequest.RecipeName, resourceID.Type(), serviceCtx.ResourceID)), nil
+	}
+
+	previous, err := r.getPortableResource(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationID := planRequest.ApplicationID
+	prevState := []string{}
+	var recipeStatus *rpv1.RecipeStatus
+	if previous != nil {
+		recipeStatus = previous.Status.Recipe
+		if applicationID == "" {
+			applicationID = previous.Application
+		}
+		for _, outputResource := range previous.Status.OutputResources {
+			prevState = append(prevState, outputResource.ID.String())
+		}
+	}
+
+	recipePlan, err := r.Engine.Plan(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          planRequest.RecipeName,
+				EnvironmentID: serviceCtx.ResourceID.String(),
+				ApplicationID: applicationID,
+				ResourceID:    resourceID.String(),
+				Parameters:    planRequest.Parameters,
+				Status:        recipeStatus,
+			},
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		var recipeError *recipes.RecipeError
+		if errors.As(err, &recipeError) {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: recipeError.ErrorDetails}), nil
+		}
+		return nil, err
+	}
+
+	ret := &datamodel.RecipePlan{Changes: []datamodel.RecipeResourceChange{}}
+	for _, change := range recipePlan.Changes {
+		ret.Changes = append(ret.Changes, datamodel.RecipeResourceChange{
+			Action:       string(change.Action),
+			ResourceType: change.Type,
+			Name:         change.Name,
+		})
+	}
+
+	versioned, err := converter.RecipePlanDataModelToVersioned(ret, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	return rest.NewOKResponse(versioned), nil
+}
+
+// getPortableResource returns the properties of the portable resource which are common to all resource types, or nil
+// if the resource has not been deployed yet.
+func (r *PlanRecipe) getPortableResource(ctx context.Context, id resources.ID) (*rpv1.BasicResourceProperties, error) {
+	sc, err := r.DataProvider().GetStorageClient(ctx, id.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := sc.Get(ctx, id.String())
+	if errors.Is(&store.ErrNotFound{ID: id.String()}, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s. Error: %w", id.String(), err)
+	}
+
+	resource := struct {
+		Properties rpv1.BasicResourceProperties `json:"properties"`
+	}{}
+	if err := obj.As(&resource); err != nil {
+		return nil, err
+	}
+
+	return &resource.Properties, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	planRecipeResourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo0"
+	planRecipeApplicationID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app0"
+	planRecipeOutputID      = "/planes/aws/aws/accounts/000/regions/us-east-1/providers/AWS.DocDB/DBCluster/mongo0"
+)
+
+func TestPlanRecipeRun_20231001Preview(t *testing.T) {
+	envDataModel := &datamodel.Environment{}
+	_ = json.Unmarshal(testutil.ReadFixture("environmentgetrecipemetadata20231001preview_datamodel.json"), envDataModel)
+
+	setup := func(t *testing.T) (*store.MockStorageClient, *store.MockStorageClient, *engine.MockEngine, ctrl.Options) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		mResourceStorageClient := store.NewMockStorageClient(mctrl)
+		mDataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+		mDataProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Datastores/mongoDatabases").Return(mResourceStorageClient, nil).AnyTimes()
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return &store.Object{
+					Metadata: store.Metadata{ID: id, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil
+			})
+
+		return mStorageClient, mResourceStorageClient, engine.NewMockEngine(mctrl), ctrl.Options{
+			StorageClient: mStorageClient,
+			DataProvider:  mDataProvider,
+		}
+	}
+
+	t.Run("plan recipe of deployed resource", func(t *testing.T) {
+		_, mResourceStorageClient, mEngine, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(&store.Object{
+				Metadata: store.Metadata{ID: planRecipeResourceID},
+				Data: map[string]any{
+					"properties": map[string]any{
+						"application": planRecipeApplicationID,
+						"status": map[string]any{
+							"outputResources": []map[string]any{{"id": planRecipeOutputID}},
+						},
+					},
+				},
+			}, nil)
+
+		input := &v20231001preview.RecipePlanRequest{
+			ResourceID: to.Ptr(planRecipeResourceID),
+			RecipeName: to.Ptr("mongo-terraform"),
+			Parameters: map[string]any{"size": "small"},
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mEngine.EXPECT().
+			Plan(ctx, engine.PlanOptions{
+				BaseOptions: engine.BaseOptions{
+					Recipe: recipes.ResourceMetadata{
+						Name:          "mongo-terraform",
+						EnvironmentID: envDataModel.ID,
+						ApplicationID: planRecipeApplicationID,
+						ResourceID:    planRecipeResourceID,
+						Parameters:    map[string]any{"size": "small"},
+					},
+				},
+				PreviousState: []string{planRecipeOutputID},
+			}).
+			Return(&recipes.RecipePlan{
+				Changes: []recipes.ResourceChange{
+					{Action: recipes.ChangeActionCreate, Type: "aws_docdb_cluster", Name: "module.default.aws_docdb_cluster.mongo"},
+				},
+			}, nil)
+
+		ctl, err := NewPlanRecipe(opts, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 200, w.Result().StatusCode)
+
+		actualOutput := &v20231001preview.RecipePlanResponse{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, &v20231001preview.RecipePlanResponse{
+			Changes: []*v20231001preview.RecipeResourceChange{
+				{Action: to.Ptr("create"), ResourceType: to.Ptr("aws_docdb_cluster"), Name: to.Ptr("module.default.aws_docdb_cluster.mongo")},
+			},
+		}, actualOutput)
+	})
+
+	t.Run("plan recipe non existing recipe", func(t *testing.T) {
+		_, _, mEngine, opts := setup(t)
+
+		input := &v20231001preview.RecipePlanRequest{
+			ResourceID: to.Ptr(planRecipeResourceID),
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewPlanRecipe(opts, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 404, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeNotFound, armerr.Error.Code)
+		require.Contains(t, armerr.Error.Message, "Either recipe with name \"default\" or resource type \"Applications.Datastores/mongoDatabases\" not found")
+	})
+
+	t.Run("plan recipe fails", func(t *testing.T) {
+		_, mResourceStorageClient, mEngine, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(nil, &store.ErrNotFound{ID: planRecipeResourceID})
+
+		input := &v20231001preview.RecipePlanRequest{
+			ResourceID: to.Ptr(planRecipeResourceID),
+			RecipeName: to.Ptr("mongo-parameters"),
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		mEngine.EXPECT().
+			Plan(ctx, gomock.Any()).
+			Return(nil, recipes.NewRecipeError(recipes.RecipePlanFailed, "failed to plan recipe", recipes_util.ExecutionError, nil))
+
+		ctl, err := NewPlanRecipe(opts, mEngine)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, 400, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err = json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, recipes.RecipePlanFailed, armerr.Error.Code)
+	})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/portableresources"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+const (
+	// upgradeRecipeTimeout is the timeout of the operation redeploying a portable resource with a new version of its recipe.
+	upgradeRecipeTimeout = time.Duration(60) * time.Minute
+)
+
+var _ ctrl.Controller = (*UpgradeRecipe)(nil)
+
+// UpgradeRecipe is the controller implementation to upgrade a portable resource to the version of its recipe registered
+// to the environment.
+type UpgradeRecipe struct {
+	ctrl.Operation[*datamodel.Environment, datamodel.Environment]
+}
+
+// NewUpgradeRecipe creates a new controller for upgrading the recipe of a portable resource in an environment.
+func NewUpgradeRecipe(opts ctrl.Options) (ctrl.Controller, error) {
+	return &UpgradeRecipe{
+		ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.Environment]{
+				RequestConverter:  converter.EnvironmentDataModelFromVersioned,
+				ResponseConverter: converter.EnvironmentDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run queues an operation redeploying the portable resource with the version of the recipe registered to the
+// environment, and returns the async operation of the portable resource. The portable resource is rolled back to the
+// version of the recipe it was deployed with if the redeployment fails.
+func (r *UpgradeRecipe) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	environment, _, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+	upgradeRequest, err := converter.RecipeUpgradeRequestDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceID, err := resources.ParseResource(upgradeRequest.ResourceID)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("%q is not a valid resource id.", upgradeRequest.ResourceID)), nil
+	}
+
+	sc, err := r.DataProvider().GetStorageClient(ctx, resourceID.Type())
+	if err != nil {
+		return nil, err
+	}
+	obj, err := sc.Get(ctx, resourceID.String())
+	if errors.Is(&store.ErrNotFound{ID: resourceID.String()}, err) {
+		return rest.NewNotFoundResponse(resourceID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s. Error: %w", resourceID.String(), err)
+	}
+
+	resource := struct {
+		v1.BaseResource
+		Properties struct {
+			rpv1.BasicResourceProperties
+			Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+			ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+		} `json:"properties"`
+	}{}
+	if err := obj.As(&resource); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(resource.Properties.Environment, serviceCtx.ResourceID.String()) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Resource %q is not deployed to environment %q.", resourceID.String(), serviceCtx.ResourceID.String())), nil
+	}
+	current := resource.Properties.Status.Recipe
+	if resource.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual || current == nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Resource %q is not deployed with a recipe.", resourceID.String())), nil
+	}
+	if state := resource.ProvisioningState(); !state.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf(ctrl.InProgressStateMessageFormat, state)), nil
+	}
+
+	recipeName := resource.Properties.Recipe.Name
+	if recipeName == "" {
+		recipeName = portableresources.DefaultRecipeName
+	}
+	definition, exists := environment.Properties.Recipes[resourceID.Type()][recipeName]
+	if !exists {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("Either recipe with name %q or resource type %q not found on environment with id %q", recipeName, resourceID.Type(), serviceCtx.ResourceID)), nil
+	}
+
+	// The resource is already deployed with the version of the recipe registered to the environment.
+	if current.RecipeVersion == definition.RecipeVersion {
+		return rest.NewNoContentResponse(), nil
+	}
+
+	// The portable resource is updated as a map, so that the properties specific to its type are preserved. Replacing
+	// the recipe status unpins the resource from the version of the recipe it was deployed with, and records that
+	// version so that the resource can be rolled back to it.
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return nil, err
+	}
+	properties, _ := data["properties"].(map[string]any)
+	resourceStatus, _ := properties["status"].(map[string]any)
+	if resourceStatus == nil {
+		return nil, errors.New("the portable resource has no status")
+	}
+	resourceStatus["recipe"] = &rpv1.RecipeStatus{UpgradeFrom: current}
+	data["provisioningState"] = v1.ProvisioningStateAccepted
+
+	resourceCtx := &v1.ARMRequestContext{
+		ResourceID:  resourceID,
+		OperationID: uuid.New(),
+		OperationType: v1.OperationType{
+			Type:   strings.ToUpper(resourceID.Type()),
+			Method: v1.OperationPut,
+		},
+		APIVersion:     resource.InternalMetadata.UpdatedAPIVersion,
+		HomeTenantID:   serviceCtx.HomeTenantID,
+		ClientObjectID: serviceCtx.ClientObjectID,
+	}
+
+	err = r.StatusManager().QueueAsyncOperation(ctx, resourceCtx, sm.QueueOperationOptions{
+		OperationTimeout: upgradeRecipeTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+		Resource: &sm.ResourceUpdate{
+			StorageClient: sc,
+			Object: &store.Object{
+				Metadata: store.Metadata{
+					ID: obj.ID,
+				},
+				Data: data,
+			},
+			ETag: obj.ETag,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, resourceID, resourceCtx.OperationID, serviceCtx.APIVersion, "", ""), nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeRecipeRun_20231001Preview(t *testing.T) {
+	envDataModel := &datamodel.Environment{}
+	_ = json.Unmarshal(testutil.ReadFixture("environmentgetrecipemetadata20231001preview_datamodel.json"), envDataModel)
+	definition := envDataModel.Properties.Recipes["Applications.Datastores/mongoDatabases"]["mongo-parameters"]
+	definition.RecipeVersion = "2.0"
+	envDataModel.Properties.Recipes["Applications.Datastores/mongoDatabases"]["mongo-parameters"] = definition
+
+	setup := func(t *testing.T) (*store.MockStorageClient, *sm.MockStatusManager, ctrl.Options) {
+		mctrl := gomock.NewController(t)
+		mStorageClient := store.NewMockStorageClient(mctrl)
+		mResourceStorageClient := store.NewMockStorageClient(mctrl)
+		mStatusManager := sm.NewMockStatusManager(mctrl)
+		mDataProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+		mDataProvider.EXPECT().GetStorageClient(gomock.Any(), "Applications.Datastores/mongoDatabases").Return(mResourceStorageClient, nil).AnyTimes()
+
+		mStorageClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id string, _ ...store.GetOptions) (*store.Object, error) {
+				return &store.Object{
+					Metadata: store.Metadata{ID: id, ETag: "etag"},
+					Data:     envDataModel,
+				}, nil
+			})
+
+		return mResourceStorageClient, mStatusManager, ctrl.Options{
+			StorageClient: mStorageClient,
+			DataProvider:  mDataProvider,
+			StatusManager: mStatusManager,
+		}
+	}
+
+	portableResource := func(environmentID string, provisioningState v1.ProvisioningState, recipeVersion string) *store.Object {
+		return &store.Object{
+			Metadata: store.Metadata{ID: planRecipeResourceID, ETag: "resource-etag"},
+			Data: map[string]any{
+				"id":                planRecipeResourceID,
+				"provisioningState": provisioningState,
+				"updatedApiVersion": "2023-10-01-preview",
+				"properties": map[string]any{
+					"environment": environmentID,
+					"recipe": map[string]any{
+						"name": "mongo-parameters",
+					},
+					"status": map[string]any{
+						"outputResources": []map[string]any{{"id": planRecipeOutputID}},
+						"recipe": map[string]any{
+							"templateKind":  "bicep",
+							"templatePath":  "ghcr.io/radius-project/dev/recipes/functionaltest/parameters/mongodatabases/azure:0.1",
+							"recipeVersion": recipeVersion,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	run := func(t *testing.T, opts ctrl.Options) *httptest.ResponseRecorder {
+		input := &v20231001preview.RecipeUpgradeRequest{
+			ResourceID: to.Ptr(planRecipeResourceID),
+		}
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), v1.OperationPost.HTTPMethod(), testHeaderfilegetrecipemetadata, input)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewUpgradeRecipe(opts)
+		require.NoError(t, err)
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		return w
+	}
+
+	t.Run("upgrade recipe", func(t *testing.T) {
+		mResourceStorageClient, mStatusManager, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(portableResource(envDataModel.ID, v1.ProvisioningStateSucceeded, "1.0"), nil)
+
+		mStatusManager.
+			EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options sm.QueueOperationOptions) error {
+				require.Equal(t, planRecipeResourceID, sCtx.ResourceID.String())
+				require.Equal(t, v1.OperationType{Type: "APPLICATIONS.DATASTORES/MONGODATABASES", Method: v1.OperationPut}, sCtx.OperationType)
+				require.Equal(t, "resource-etag", options.Resource.ETag)
+
+				data := options.Resource.Object.Data.(map[string]any)
+				require.Equal(t, v1.ProvisioningStateAccepted, data["provisioningState"])
+				status := data["properties"].(map[string]any)["status"].(map[string]any)
+				require.Equal(t, &rpv1.RecipeStatus{
+					UpgradeFrom: &rpv1.RecipeStatus{
+						TemplateKind:  "bicep",
+						TemplatePath:  "ghcr.io/radius-project/dev/recipes/functionaltest/parameters/mongodatabases/azure:0.1",
+						RecipeVersion: "1.0",
+					},
+				}, status["recipe"])
+				return nil
+			})
+
+		w := run(t, opts)
+		require.Equal(t, 202, w.Result().StatusCode)
+		require.NotEmpty(t, w.Header().Get("Location"))
+		require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+	})
+
+	t.Run("upgrade recipe already up to date", func(t *testing.T) {
+		mResourceStorageClient, _, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(portableResource(envDataModel.ID, v1.ProvisioningStateSucceeded, "2.0"), nil)
+
+		w := run(t, opts)
+		require.Equal(t, 204, w.Result().StatusCode)
+	})
+
+	t.Run("upgrade recipe of resource in progress", func(t *testing.T) {
+		mResourceStorageClient, _, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(portableResource(envDataModel.ID, v1.ProvisioningStateUpdating, "1.0"), nil)
+
+		w := run(t, opts)
+		require.Equal(t, 409, w.Result().StatusCode)
+	})
+
+	t.Run("upgrade recipe of resource in another environment", func(t *testing.T) {
+		mResourceStorageClient, _, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(portableResource("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env1", v1.ProvisioningStateSucceeded, "1.0"), nil)
+
+		w := run(t, opts)
+		require.Equal(t, 400, w.Result().StatusCode)
+
+		armerr := v1.ErrorResponse{}
+		err := json.Unmarshal(w.Body.Bytes(), &armerr)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeInvalid, armerr.Error.Code)
+		require.Contains(t, armerr.Error.Message, "is not deployed to environment")
+	})
+
+	t.Run("upgrade recipe of non existing resource", func(t *testing.T) {
+		mResourceStorageClient, _, opts := setup(t)
+		mResourceStorageClient.
+			EXPECT().
+			Get(gomock.Any(), planRecipeResourceID).
+			Return(nil, &store.ErrNotFound{ID: planRecipeResourceID})
+
+		w := run(t, opts)
+		require.Equal(t, 404, w.Result().StatusCode)
+	})
+}
@@ -56,13 +56,15 @@ type ResourceWaiter interface {
 	waitUntilReady(ctx context.Context, item client.Object) error
 }
 
-// NewKubernetesHandler creates a new KubernetesHandler which is used to handle Kubernetes resources.
-func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discoveryClient discovery.ServerResourcesInterface, dynamicClientSet dynamic.Interface) ResourceHandler {
+// NewKubernetesHandler creates a new KubernetesHandler which is used to handle Kubernetes resources. The given clients
+// target the cluster Radius runs in, and clusters provides the clients of the clusters registered as Kubernetes planes.
+func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discoveryClient discovery.ServerResourcesInterface, dynamicClientSet dynamic.Interface, clusters kubeutil.ClusterClientsProvider) ResourceHandler {
 	return &kubernetesHandler{
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
+		clusters:           clusters,
 	}
 }
 
@@ -72,10 +74,37 @@ type kubernetesHandler struct {
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
 	deploymentWaiter   ResourceWaiter
+	// clusters provides the clients of the non-local clusters. This may be nil when only the local cluster is supported.
+	clusters kubeutil.ClusterClientsProvider
+}
+
+// forPlane returns the handler that targets the cluster of the given Kubernetes plane.
+func (handler *kubernetesHandler) forPlane(ctx context.Context, planeName string) (*kubernetesHandler, error) {
+	if resources_kubernetes.IsLocalPlane(planeName) {
+		return handler, nil
+	}
+
+	if handler.clusters == nil {
+		return nil, fmt.Errorf("cannot deploy to Kubernetes plane %q: only the local cluster is supported", planeName)
+	}
+
+	clients, err := handler.clusters.GetClients(ctx, planeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the clients of Kubernetes plane %q: %w", planeName, err)
+	}
+
+	return &kubernetesHandler{
+		client:             clients.RuntimeClient,
+		k8sDiscoveryClient: clients.DiscoveryClient,
+		httpProxyWaiter:    NewHTTPProxyWaiter(clients.DynamicClient),
+		deploymentWaiter:   NewDeploymentWaiter(clients.ClientSet),
+		clusters:           handler.clusters,
+	}, nil
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment, it also waits until the deployment is ready. The resource is stored in the cluster of the Kubernetes plane
+// of its ID, which is the cluster Radius runs in when the ID is not set.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -84,6 +113,12 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		return map[string]string{}, nil
 	}
 
+	planeName := resources_kubernetes.PlaneName(options.Resource.ID)
+	handler, err := handler.forPlane(ctx, planeName)
+	if err != nil {
+		return nil, err
+	}
+
 	item, err := convertToUnstructured(*options.Resource)
 	if err != nil {
 		return nil, err
@@ -97,9 +132,12 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		ResourceName:            item.GetName(),
 	}
 
-	err = kubeutil.PatchNamespace(ctx, handler.client, item.GetNamespace())
-	if err != nil {
-		return nil, err
+	// Cluster scoped resources have no namespace.
+	if item.GetNamespace() != "" {
+		err = kubeutil.PatchNamespace(ctx, handler.client, item.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = handler.client.Patch(ctx, &item, client.Apply, &client.PatchOptions{FieldManager: kubernetes.FieldManager})
@@ -113,7 +151,7 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 	}
 
 	id := resources_kubernetes.IDFromParts(
-		resources_kubernetes.PlaneNameTODO,
+		resources_kubernetes.PlaneNameOrDefault(planeName),
 		groupVersion.Group,
 		item.GetKind(),
 		item.GetNamespace(),
@@ -146,6 +184,11 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 // Delete decodes the identity data from the DeleteOptions, creates an unstructured object from the identity data,
 // and then attempts to delete the object from the Kubernetes cluster, returning an error if one occurs.
 func (handler *kubernetesHandler) Delete(ctx context.Context, options *DeleteOptions) error {
+	handler, err := handler.forPlane(ctx, resources_kubernetes.PlaneName(options.Resource.ID))
+	if err != nil {
+		return err
+	}
+
 	apiVersion, err := handler.lookupKubernetesAPIVersion(ctx, options.Resource.ID)
 	if err != nil {
 		return err
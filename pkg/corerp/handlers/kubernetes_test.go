@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
@@ -29,10 +30,13 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestPut(t *testing.T) {
@@ -70,6 +74,34 @@ func TestPut(t *testing.T) {
 				"resourcename":         "test-secret",
 			},
 		},
+		{
+			name: "cluster scoped resource",
+			in: &PutOptions{
+				Resource: &rpv1.OutputResource{
+					CreateResource: &rpv1.Resource{
+						ResourceType: resourcemodel.ResourceType{
+							Provider: resourcemodel.ProviderKubernetes,
+							Type:     "rbac.authorization.k8s.io/ClusterRole",
+						},
+						Data: &rbacv1.ClusterRole{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "ClusterRole",
+								APIVersion: "rbac.authorization.k8s.io/v1",
+							},
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "test-cluster-role",
+							},
+						},
+					},
+				},
+			},
+			out: map[string]string{
+				"kubernetesapiversion": "rbac.authorization.k8s.io/v1",
+				"kuberneteskind":       "ClusterRole",
+				"kubernetesnamespace":  "",
+				"resourcename":         "test-cluster-role",
+			},
+		},
 		{
 			name: "deploment resource",
 			in: &PutOptions{
@@ -120,6 +152,89 @@ func TestPut(t *testing.T) {
 	}
 }
 
+type fakeClusterClientsProvider struct {
+	clients map[string]*kubeutil.Clients
+}
+
+func (p *fakeClusterClientsProvider) GetClients(ctx context.Context, planeName string) (*kubeutil.Clients, error) {
+	clients, ok := p.clients[planeName]
+	if !ok {
+		return nil, errors.New("plane not found")
+	}
+	return clients, nil
+}
+
+func TestPut_NonLocalPlane(t *testing.T) {
+	newOptions := func(planeName string) *PutOptions {
+		return &PutOptions{
+			Resource: &rpv1.OutputResource{
+				ID: resources_kubernetes.IDFromParts(planeName, "", "Secret", "test-namespace", "test-secret"),
+				CreateResource: &rpv1.Resource{
+					ResourceType: resourcemodel.ResourceType{
+						Provider: resourcemodel.ProviderKubernetes,
+						Type:     "core/Secret",
+					},
+					Data: &corev1.Secret{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "Secret",
+							APIVersion: "v1",
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-secret",
+							Namespace: "test-namespace",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("resource is stored in the cluster of the plane", func(t *testing.T) {
+		ctx := context.Background()
+		local := k8sutil.NewFakeKubeClient(nil)
+		remote := k8sutil.NewFakeKubeClient(nil)
+		handler := kubernetesHandler{
+			client: local,
+			clusters: &fakeClusterClientsProvider{
+				clients: map[string]*kubeutil.Clients{
+					"prod": {RuntimeClient: remote},
+				},
+			},
+		}
+
+		options := newOptions("prod")
+		_, err := handler.Put(ctx, options)
+		require.NoError(t, err)
+		require.Equal(t, "/planes/kubernetes/prod/namespaces/test-namespace/providers/core/Secret/test-secret", options.Resource.ID.String())
+
+		secret := &corev1.Secret{}
+		err = remote.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: "test-secret"}, secret)
+		require.NoError(t, err)
+
+		err = local.Get(ctx, client.ObjectKey{Namespace: "test-namespace", Name: "test-secret"}, secret)
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("plane is not registered", func(t *testing.T) {
+		handler := kubernetesHandler{
+			client:   k8sutil.NewFakeKubeClient(nil),
+			clusters: &fakeClusterClientsProvider{},
+		}
+
+		_, err := handler.Put(context.Background(), newOptions("prod"))
+		require.ErrorContains(t, err, "failed to initialize the clients of Kubernetes plane \"prod\"")
+	})
+
+	t.Run("only the local cluster is supported", func(t *testing.T) {
+		handler := kubernetesHandler{
+			client: k8sutil.NewFakeKubeClient(nil),
+		}
+
+		_, err := handler.Put(context.Background(), newOptions("prod"))
+		require.ErrorContains(t, err, "only the local cluster is supported")
+	})
+}
+
 func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	// Create first deployment that will be watched
@@ -64,6 +64,8 @@ type RendererDependency struct {
 type EnvironmentOptions struct {
 	// Namespace represents the Kubernetes namespace.
 	Namespace string
+	// Cluster represents the name of the Kubernetes plane of the cluster to deploy to. Empty means the local cluster.
+	Cluster string
 	// Providers represents the cloud provider's configurations.
 	CloudProviders *datamodel.Providers
 	// Gateway represents the gateway options.
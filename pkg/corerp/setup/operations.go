@@ -69,6 +69,26 @@ var operationList = []v1.Operation{
 		},
 		IsDataAction: false,
 	},
+	{
+		Name: "Applications.Core/environments/planrecipe/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Plan recipe",
+			Description: "Get the changes the deployment of a recipe would make.",
+		},
+		IsDataAction: false,
+	},
+	{
+		Name: "Applications.Core/environments/upgraderecipe/action",
+		Display: &v1.OperationDisplayProperties{
+			Provider:    "Applications.Core",
+			Resource:    "environments",
+			Operation:   "Upgrade recipe",
+			Description: "Upgrade a portable resource to the version of its recipe registered to the environment.",
+		},
+		IsDataAction: false,
+	},
 	{
 		Name: "Applications.Core/environments/join/action",
 		Display: &v1.OperationDisplayProperties{
@@ -65,6 +65,14 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 					return env_ctrl.NewGetRecipeMetadata(opt, recipeControllerConfig.Engine)
 				},
 			},
+			"planrecipe": {
+				APIController: func(opt apictrl.Options) (apictrl.Controller, error) {
+					return env_ctrl.NewPlanRecipe(opt, recipeControllerConfig.Engine)
+				},
+			},
+			"upgraderecipe": {
+				APIController: env_ctrl.NewUpgradeRecipe,
+			},
 		},
 	})
 
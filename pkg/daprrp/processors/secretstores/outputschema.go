@@ -0,0 +1,28 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstores
+
+import (
+	_ "embed"
+)
+
+// RecipeOutputSchema is the JSON schema of the outputs the recipes of Dapr secret stores may return. The recipe engine
+// validates the outputs of the recipes against it. No output is required since Radius computes the component name
+// when the recipe does not return it.
+//
+//go:embed outputschema.json
+var RecipeOutputSchema []byte
@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -237,6 +238,27 @@ func Test_fromRecipeStatus(t *testing.T) {
 			TemplatePath:    to.Ptr("/path/to/template.bicep"),
 			TemplateVersion: nil,
 		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "/path/to/template.bicep",
+			Drift: &rpv1.RecipeDriftStatus{
+				Drifted:       true,
+				LastCheckedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				Resources: []rpv1.DriftedResource{
+					{Action: "create", Type: "Microsoft.Cache/redis", Name: "/subscriptions/0000/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test"},
+				},
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+			TemplatePath: to.Ptr("/path/to/template.bicep"),
+			Drift: &RecipeDriftStatus{
+				Drifted:       to.Ptr(true),
+				LastCheckedAt: to.Ptr(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)),
+				Resources: []*RecipeDriftedResource{
+					{Action: to.Ptr("create"), ResourceType: to.Ptr("Microsoft.Cache/redis"), Name: to.Ptr("/subscriptions/0000/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test")},
+				},
+			},
+		}},
 	}
 
 	for _, tt := range testCases {
@@ -78,6 +78,9 @@ type KubernetesCompute struct {
 	// REQUIRED; The namespace to use for the environment.
 	Namespace *string
 
+	// The name of the Kubernetes plane of the cluster to deploy to. The cluster Radius runs in is used when it is not set.
+	Cluster *string
+
 	// Configuration for supported external identity providers
 	Identity *IdentitySettings
 
@@ -298,6 +301,31 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeDriftStatus - The result of a drift check of the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// REQUIRED; Whether the resources deployed by the recipe have drifted from the recipe.
+	Drifted *bool
+
+	// REQUIRED; The time of the last drift check.
+	LastCheckedAt *time.Time
+
+	// The resources which have drifted from the recipe.
+	Resources []*RecipeDriftedResource
+}
+
+// RecipeDriftedResource - A resource deployed by a recipe which has drifted from the recipe.
+type RecipeDriftedResource struct {
+	// REQUIRED; The change the deployment of the recipe would make to remediate the drift of the resource. Allowed values: create,
+	// update, replace, delete.
+	Action *string
+
+	// REQUIRED; The name or the resource ID of the resource.
+	Name *string
+
+	// REQUIRED; The type of the resource. For example: 'aws_s3_bucket' or 'Microsoft.Storage/storageAccounts'.
+	ResourceType *string
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -306,6 +334,12 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
+	// RecipeVersion is the version of the recipe the portable resource was deployed with.
+	RecipeVersion *string
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -43,11 +43,14 @@ type Clients struct {
 
 	// DynamicClient is the Kubernetes client-go dynamic client.
 	DynamicClient dynamic.Interface
+
+	// Config is the configuration the clients were created with.
+	Config *rest.Config
 }
 
 // NewClients creates a new Kubernetes client set and controller runtime client using the given config.
 func NewClients(config *rest.Config) (*Clients, error) {
-	c := &Clients{}
+	c := &Clients{Config: config}
 
 	var err error
 	c.RuntimeClient, err = NewRuntimeClient(config)
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+// ClusterClientsProvider provides the clients of the Kubernetes clusters registered as Kubernetes planes in UCP.
+type ClusterClientsProvider interface {
+	// GetClients returns the clients of the cluster registered as the Kubernetes plane with the given name.
+	GetClients(ctx context.Context, planeName string) (*Clients, error)
+}
+
+// NewUCPClusterClientsProvider creates a ClusterClientsProvider which sends the requests to the clusters through the
+// UCP proxy of the Kubernetes planes, so that UCP authenticates them with the credentials registered for the plane.
+// The clients of each cluster are created once and reused.
+func NewUCPClusterClientsProvider(connection sdk.Connection) ClusterClientsProvider {
+	return &ucpClusterClientsProvider{
+		connection: connection,
+		clients:    map[string]*Clients{},
+	}
+}
+
+type ucpClusterClientsProvider struct {
+	connection sdk.Connection
+
+	mutex   sync.Mutex
+	clients map[string]*Clients
+}
+
+// GetClients returns the clients of the cluster registered as the Kubernetes plane with the given name. The clients
+// send the requests to /planes/kubernetes/{planeName} of the UCP endpoint.
+func (p *ucpClusterClientsProvider) GetClients(ctx context.Context, planeName string) (*Clients, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if c, ok := p.clients[planeName]; ok {
+		return c, nil
+	}
+
+	config := &rest.Config{
+		Host:      strings.TrimSuffix(p.connection.Endpoint(), "/") + "/planes/kubernetes/" + planeName,
+		Transport: p.connection.Client().Transport,
+	}
+
+	c, err := NewClients(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the clients of the Kubernetes plane %q: %w", planeName, err)
+	}
+
+	p.clients[planeName] = c
+	return c, nil
+}
@@ -112,6 +112,24 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 		status.TemplateVersion = to.Ptr(recipeStatus.TemplateVersion)
 	}
 
+	if recipeStatus.RecipeVersion != "" {
+		status.RecipeVersion = to.Ptr(recipeStatus.RecipeVersion)
+	}
+
+	if recipeStatus.Drift != nil {
+		status.Drift = &RecipeDriftStatus{
+			Drifted:       to.Ptr(recipeStatus.Drift.Drifted),
+			LastCheckedAt: to.Ptr(recipeStatus.Drift.LastCheckedAt),
+		}
+		for _, resource := range recipeStatus.Drift.Resources {
+			status.Drift.Resources = append(status.Drift.Resources, &RecipeDriftedResource{
+				Action:       to.Ptr(resource.Action),
+				ResourceType: to.Ptr(resource.Type),
+				Name:         to.Ptr(resource.Name),
+			})
+		}
+	}
+
 	return status
 }
 
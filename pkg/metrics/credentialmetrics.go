@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// credentialExpiryWarnings is the metric name for the number of warnings emitted for expiring or expired credentials.
+	credentialExpiryWarnings = "credential.expiry.warnings"
+
+	// credentialExpiryStateAttrKey is the attribute name for the expiry state of a credential.
+	credentialExpiryStateAttrKey = attribute.Key("credential_expiry_state")
+
+	// CredentialExpiryStateExpiring is the expiry state of a credential which expires within the warning threshold.
+	CredentialExpiryStateExpiring = "expiring"
+
+	// CredentialExpiryStateExpired is the expiry state of a credential which has expired.
+	CredentialExpiryStateExpired = "expired"
+)
+
+type credentialMetrics struct {
+	counters map[string]metric.Int64Counter
+}
+
+func newCredentialMetrics() *credentialMetrics {
+	return &credentialMetrics{
+		counters: make(map[string]metric.Int64Counter),
+	}
+}
+
+// Init initializes the credential metrics.
+func (m *credentialMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("credential-metrics")
+
+	var err error
+	m.counters[credentialExpiryWarnings], err = meter.Int64Counter(credentialExpiryWarnings)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordCredentialExpiryWarning records a warning emitted for an expiring or expired credential with the given attributes.
+func (m *credentialMetrics) RecordCredentialExpiryWarning(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[credentialExpiryWarnings] != nil {
+		m.counters[credentialExpiryWarnings].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// NewCredentialAttributes generates common attributes for credential metrics.
+func NewCredentialAttributes(resourceType, expiryState string) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0)
+
+	if resourceType != "" {
+		attrs = append(attrs, resourceTypeAttrKey.String(strings.ToLower(resourceType)))
+	}
+
+	if expiryState != "" {
+		attrs = append(attrs, credentialExpiryStateAttrKey.String(expiryState))
+	}
+
+	return attrs
+}
@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultCredentialMetrics holds credential metrics definitions.
+	DefaultCredentialMetrics = newCredentialMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultCredentialMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -46,12 +46,27 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// recipeDriftedResources is the metric name for the number of drifted resources deployed by recipes.
+	recipeDriftedResources = "recipe.drift.resources"
+
+	// recipeDriftRemediations is the metric name for the number of recipe deployments remediating drift.
+	recipeDriftRemediations = "recipe.drift.remediations"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
 	// RecipeEngineOperationDelete represents the Delete operation of the Recipe Engine.
 	RecipeEngineOperationDelete = "delete"
 
+	// RecipeEngineOperationPlan represents the Plan operation of the Recipe Engine.
+	RecipeEngineOperationPlan = "plan"
+
+	// RecipeEngineOperationDetectDrift represents the DetectDrift operation of the Recipe Engine.
+	RecipeEngineOperationDetectDrift = "detect.drift"
+
+	// RecipeEngineOperationRemediateDrift represents the remediation of the drift of the resources deployed by a recipe.
+	RecipeEngineOperationRemediateDrift = "remediate.drift"
+
 	// RecipeEngineOperationDownloadRecipe represents the Download Recipe operation of the Recipe Engine.
 	RecipeEngineOperationDownloadRecipe = "download.recipe"
 
@@ -96,6 +111,16 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeDriftedResources], err = meter.Int64Counter(recipeDriftedResources)
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeDriftRemediations], err = meter.Int64Counter(recipeDriftRemediations)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -148,6 +173,20 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordRecipeDrift records the number of drifted resources deployed by a recipe with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeDrift(ctx context.Context, driftedResources int, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftedResources] != nil {
+		m.counters[recipeDriftedResources].Add(ctx, int64(driftedResources), metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordRecipeDriftRemediation records a recipe deployment remediating drift with the given attributes.
+func (m *recipeEngineMetrics) RecordRecipeDriftRemediation(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftRemediations] != nil {
+		m.counters[recipeDriftRemediations].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
@@ -18,9 +18,14 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
+	"golang.org/x/exp/slices"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -95,6 +100,27 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	// Now we're ready to process recipes (if needed).
 	recipeDataModel := any(data).(datamodel.RecipeDataModel)
 	recipeOutput, err := c.executeRecipeIfNeeded(ctx, data, previousOutputResources, config.Simulated)
+
+	// If the resource was being upgraded to a new version of its recipe, roll it back to the version of the recipe it
+	// was deployed with, which is executed with the parameters of the environment recorded for that version. The
+	// resources deployed by the failed upgrade are part of the previous state of the rollback, so that the resources
+	// which are not part of the version the resource is rolled back to are deleted. The resource is then saved as
+	// usual, and the operation reports the failure of the upgrade.
+	var upgradeErr error
+	previous := data.ResourceMetadata().Status.Recipe.UpgradeFrom
+	if err != nil && previous != nil {
+		upgradeErr = err
+		logger.Error(err, fmt.Sprintf("failed to upgrade the recipe, rolling back to version %q", previous.RecipeVersion))
+		data.ResourceMetadata().Status.Recipe = previous
+		rollbackState := previousOutputResources
+		if recipeOutput != nil {
+			rollbackState = appendMissingResources(previousOutputResources, recipeOutput.Resources)
+		}
+		recipeOutput, err = c.executeRecipeIfNeeded(ctx, data, rollbackState, config.Simulated)
+		if recipeError, ok := err.(*recipes.RecipeError); ok {
+			recipeError.ErrorDetails.Message = fmt.Sprintf("failed to roll back to version %q of the recipe after the upgrade failed with %q: %s", previous.RecipeVersion, upgradeErr.Error(), recipeError.ErrorDetails.Message)
+		}
+	}
 	if err != nil {
 		if recipeError, ok := err.(*recipes.RecipeError); ok {
 			logger.Error(err, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeError.ErrorDetails.Target))
@@ -129,6 +155,14 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		recipeDataModel.Recipe().DeploymentStatus = util.Success
 	}
 
+	// The resources were just deployed by the recipe, so the result of the previous drift check no longer applies.
+	if recipeOutput != nil && data.ResourceMetadata().Status.Recipe != nil {
+		data.ResourceMetadata().Status.Recipe.Drift = nil
+	}
+	if data.ResourceMetadata().Status.Recipe != nil {
+		data.ResourceMetadata().Status.Recipe.UpgradeFrom = nil
+	}
+
 	update := &store.Object{
 		Metadata: store.Metadata{
 			ID: req.ResourceID,
@@ -140,6 +174,13 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	if upgradeErr != nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    recipes.RecipeUpgradeFailed,
+			Message: fmt.Sprintf("failed to upgrade the recipe, the resource was rolled back to version %q of the recipe: %s", previous.RecipeVersion, upgradeErr.Error()),
+		}), nil
+	}
+
 	return ctrl.Result{}, err
 }
 
@@ -151,6 +192,18 @@ func (c *CreateOrUpdateResource[P, T]) copyOutputResources(data P) []string {
 	return previousOutputResources
 }
 
+// appendMissingResources returns the resource IDs of current followed by the resource IDs of additional which are not
+// part of current. Resource IDs are compared case-insensitively.
+func appendMissingResources(current []string, additional []string) []string {
+	result := append([]string{}, current...)
+	for _, id := range additional {
+		if !slices.ContainsFunc(result, func(existing string) bool { return strings.EqualFold(existing, id) }) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
 func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, data P, prevState []string, simulated bool) (*recipes.RecipeOutput, error) {
 	// 'any' is required here to convert to an interface type, only then can we use a type assertion.
 	recipeDataModel, supportsRecipes := any(data).(datamodel.RecipeDataModel)
@@ -162,12 +215,19 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 	if input == nil {
 		return nil, nil
 	}
+	properties, err := resourceProperties(data)
+	if err != nil {
+		return nil, err
+	}
+
 	request := recipes.ResourceMetadata{
 		Name:          input.Name,
 		Parameters:    input.Parameters,
+		Properties:    properties,
 		EnvironmentID: data.ResourceMetadata().Environment,
 		ApplicationID: data.ResourceMetadata().Application,
 		ResourceID:    data.GetBaseResource().ID,
+		Status:        data.ResourceMetadata().Status.Recipe,
 	}
 
 	return c.engine.Execute(ctx, engine.ExecuteOptions{
@@ -178,3 +238,22 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		Simulated:     simulated,
 	})
 }
+
+// resourceProperties returns the properties set on the resource, so that the recipe engine accepts the outputs which the
+// resource sets itself. The status is populated by Radius and is left out.
+func resourceProperties(data any) (map[string]any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := struct {
+		Properties map[string]any `json:"properties"`
+	}{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil, err
+	}
+
+	delete(resource.Properties, "status")
+	return resource.Properties, nil
+}
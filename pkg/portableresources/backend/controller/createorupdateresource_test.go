@@ -18,8 +18,10 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -87,6 +89,20 @@ type TestResourceProperties struct {
 	Recipe      portableresources.ResourceRecipe `json:"recipe,omitempty"`
 }
 
+// testResourceProperties returns the properties of the test resource in the form the controller passes them to the
+// recipe engine.
+func testResourceProperties(t *testing.T, data map[string]any) map[string]any {
+	b, err := json.Marshal(data["properties"])
+	require.NoError(t, err)
+
+	resource := &TestResource{}
+	require.NoError(t, json.Unmarshal(b, &resource.Properties))
+
+	properties, err := resourceProperties(resource)
+	require.NoError(t, err)
+	return properties
+}
+
 type SuccessProcessor struct {
 }
 
@@ -336,6 +352,8 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 				Parameters: map[string]any{
 					"p1": "v1",
 				},
+				Properties: testResourceProperties(t, data),
+				Status:     &rpv1.RecipeStatus{},
 			}
 			prevState := []string{
 				oldOutputResourceResourceID,
@@ -437,3 +455,154 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrUpdateResource_Run_RecipeUpgradeRollback(t *testing.T) {
+	previousStatus := &rpv1.RecipeStatus{
+		TemplateKind:  recipes.TemplateKindBicep,
+		TemplatePath:  "radiusdev.azurecr.io/recipes/redis:1.0",
+		RecipeVersion: "1.0",
+		Parameters:    map[string]any{"sku": "standard"},
+	}
+	upgradeErr := recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "upgrade failed", "", nil)
+
+	existingResourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/existing"
+	upgradeResourceID := "/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/upgrade"
+	rolledBack := ctrl.NewFailedResult(v1.ErrorDetails{
+		Code:    recipes.RecipeUpgradeFailed,
+		Message: fmt.Sprintf("failed to upgrade the recipe, the resource was rolled back to version %q of the recipe: %s", "1.0", upgradeErr.Error()),
+	})
+
+	cases := []struct {
+		description           string
+		upgradeOutput         *recipes.RecipeOutput
+		rollbackErr           error
+		expectedRollbackState []string
+		expectedResult        ctrl.Result
+	}{
+		{
+			description:           "rollback-success",
+			expectedRollbackState: []string{existingResourceID},
+			expectedResult:        rolledBack,
+		},
+		{
+			// The resources created by the failed upgrade are deleted by the rollback, as they are part of its
+			// previous state but not of the rolled back version of the recipe.
+			description:           "rollback-deletes-resources-of-upgrade",
+			upgradeOutput:         &recipes.RecipeOutput{Resources: []string{strings.ToUpper(existingResourceID), upgradeResourceID}},
+			expectedRollbackState: []string{existingResourceID, upgradeResourceID},
+			expectedResult:        rolledBack,
+		},
+		{
+			description:           "rollback-failure",
+			rollbackErr:           recipes.NewRecipeError(recipes.RecipeDeploymentFailed, "rollback failed", "", nil),
+			expectedRollbackState: []string{existingResourceID},
+			expectedResult: ctrl.NewFailedResult(v1.ErrorDetails{
+				Code:    recipes.RecipeDeploymentFailed,
+				Message: fmt.Sprintf("failed to roll back to version %q of the recipe after the upgrade failed with %q: %s", "1.0", upgradeErr.Error(), "rollback failed"),
+			}),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.description, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := store.NewMockStorageClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			cfg := configloader.NewMockConfigurationLoader(mctrl)
+			client := processors.NewMockResourceClient(mctrl)
+
+			req := &ctrl.Request{
+				OperationID:      uuid.New(),
+				OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+				ResourceID:       TestResourceID,
+				CorrelationID:    uuid.NewString(),
+				OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+			}
+
+			data := map[string]any{
+				"name":     "tr",
+				"type":     "Applications.Test/testResources",
+				"id":       TestResourceID,
+				"location": v1.LocationGlobal,
+				"properties": map[string]any{
+					"application":       TestApplicationID,
+					"environment":       TestEnvironmentID,
+					"provisioningState": "Accepted",
+					"status": map[string]any{
+						"outputResources": []map[string]any{
+							{
+								"id": existingResourceID,
+							},
+						},
+						"recipe": map[string]any{
+							"upgradeFrom": map[string]any{
+								"templateKind":  previousStatus.TemplateKind,
+								"templatePath":  previousStatus.TemplatePath,
+								"recipeVersion": previousStatus.RecipeVersion,
+								"parameters":    previousStatus.Parameters,
+							},
+						},
+					},
+					"recipe": map[string]any{
+						"name": "test-recipe",
+					},
+				},
+			}
+
+			msc.EXPECT().
+				Get(gomock.Any(), TestResourceID).
+				Return(&store.Object{Data: data}, nil).
+				Times(1)
+			cfg.EXPECT().
+				LoadConfiguration(gomock.Any(), gomock.Any()).
+				Return(&recipes.Configuration{}, nil).
+				Times(1)
+
+			upgradeMetadata := recipes.ResourceMetadata{
+				Name:          "test-recipe",
+				EnvironmentID: TestEnvironmentID,
+				ApplicationID: TestApplicationID,
+				ResourceID:    TestResourceID,
+				Properties:    testResourceProperties(t, data),
+				Status:        &rpv1.RecipeStatus{UpgradeFrom: previousStatus},
+			}
+			rollbackMetadata := upgradeMetadata
+			rollbackMetadata.Status = previousStatus
+
+			gomock.InOrder(
+				eng.EXPECT().
+					Execute(gomock.Any(), engine.ExecuteOptions{
+						BaseOptions:   engine.BaseOptions{Recipe: upgradeMetadata},
+						PreviousState: []string{existingResourceID},
+					}).
+					Return(tt.upgradeOutput, upgradeErr).
+					Times(1),
+				eng.EXPECT().
+					Execute(gomock.Any(), engine.ExecuteOptions{
+						BaseOptions:   engine.BaseOptions{Recipe: rollbackMetadata},
+						PreviousState: tt.expectedRollbackState,
+					}).
+					Return(&recipes.RecipeOutput{Status: previousStatus}, tt.rollbackErr).
+					Times(1),
+			)
+
+			var saved *TestResource
+			msc.EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
+					saved = obj.Data.(*TestResource)
+					return nil
+				}).
+				Times(1)
+
+			genCtrl, err := NewCreateOrUpdateResource(ctrl.Options{StorageClient: msc}, successProcessorReference, eng, client, cfg)
+			require.NoError(t, err)
+
+			res, err := genCtrl.Run(context.Background(), req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedResult, res)
+			require.Equal(t, "1.0", saved.Properties.Status.Recipe.RecipeVersion)
+			require.Nil(t, saved.Properties.Status.Recipe.UpgradeFrom)
+		})
+	}
+}
@@ -85,6 +85,7 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 			ApplicationID: data.ResourceMetadata().Application,
 			Parameters:    recipeDataModel.Recipe().Parameters,
 			ResourceID:    id.String(),
+			Status:        data.ResourceMetadata().Status.Recipe,
 		}
 
 		err = c.engine.Delete(ctx, engine.DeleteOptions{
@@ -0,0 +1,249 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// remediationTimeout is the timeout of the operation redeploying the recipe of a drifted portable resource.
+	remediationTimeout = time.Duration(60) * time.Minute
+)
+
+// portableResource is the part of a portable resource which is common to all the portable resource types supporting
+// recipes.
+type portableResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		rpv1.BasicResourceProperties
+		Recipe               portableresources.ResourceRecipe       `json:"recipe,omitempty"`
+		ResourceProvisioning portableresources.ResourceProvisioning `json:"resourceProvisioning,omitempty"`
+	} `json:"properties"`
+}
+
+// Checker checks the resources deployed by the recipes of portable resources for drift, records the drift on the
+// status of the portable resources and redeploys their recipe when the environment opts in to auto-remediation.
+type Checker struct {
+	storageProvider dataprovider.DataStorageProvider
+	statusManager   sm.StatusManager
+	engine          engine.Engine
+	configLoader    configloader.ConfigurationLoader
+	resourceTypes   []string
+}
+
+// NewChecker creates a new Checker for the portable resources of the given resource types.
+func NewChecker(storageProvider dataprovider.DataStorageProvider, statusManager sm.StatusManager, engine engine.Engine, configLoader configloader.ConfigurationLoader, resourceTypes []string) *Checker {
+	return &Checker{
+		storageProvider: storageProvider,
+		statusManager:   statusManager,
+		engine:          engine,
+		configLoader:    configLoader,
+		resourceTypes:   resourceTypes,
+	}
+}
+
+// Check checks the resources deployed by the recipes of all the portable resources for drift. A failure to check a
+// portable resource is logged and does not prevent checking the others.
+func (c *Checker) Check(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, resourceType := range c.resourceTypes {
+		sc, err := c.storageProvider.GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+
+		query := store.Query{
+			RootScope:      "/planes",
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}
+
+		err = store.QueryEach(ctx, sc, query, func(obj *store.Object) error {
+			if err := c.checkResource(ctx, sc, obj); err != nil {
+				logger.Error(err, "failed to check recipe drift", ucplog.LogFieldResourceID, obj.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query %s resources: %w", resourceType, err)
+		}
+	}
+
+	return nil
+}
+
+// checkResource checks the resources deployed by the recipe of a portable resource for drift. Portable resources which
+// are provisioned manually, being deployed, or deployed to a simulated environment are skipped.
+func (c *Checker) checkResource(ctx context.Context, sc store.StorageClient, obj *store.Object) error {
+	resource := &portableResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	if resource.Properties.ResourceProvisioning == portableresources.ResourceProvisioningManual ||
+		resource.Properties.Status.Recipe == nil || resource.Properties.Status.Recipe.TemplateKind == "" ||
+		resource.InternalMetadata.AsyncProvisioningState != v1.ProvisioningStateSucceeded {
+		return nil
+	}
+
+	metadata := recipes.ResourceMetadata{
+		Name:          resource.Properties.Recipe.Name,
+		Parameters:    resource.Properties.Recipe.Parameters,
+		EnvironmentID: resource.Properties.Environment,
+		ApplicationID: resource.Properties.Application,
+		ResourceID:    obj.ID,
+		Status:        resource.Properties.Status.Recipe,
+	}
+
+	config, err := c.configLoader.LoadConfiguration(ctx, metadata)
+	if err != nil {
+		return err
+	}
+	if config.Simulated {
+		return nil
+	}
+
+	prevState := []string{}
+	for _, outputResource := range resource.Properties.Status.OutputResources {
+		prevState = append(prevState, outputResource.ID.String())
+	}
+
+	drift, err := c.engine.DetectDrift(ctx, engine.PlanOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState: prevState,
+	})
+	if err != nil {
+		return err
+	}
+
+	status := &rpv1.RecipeDriftStatus{
+		Drifted:       drift.Drifted(),
+		LastCheckedAt: time.Now().UTC(),
+	}
+	for _, change := range drift.Resources {
+		status.Resources = append(status.Resources, rpv1.DriftedResource{
+			Action: string(change.Action),
+			Type:   change.Type,
+			Name:   change.Name,
+		})
+	}
+
+	// Redeploying the recipe would also apply the changes made to the recipe in the environment since the resource was
+	// deployed, which upgrades the resource. Such resources are only remediated when they are redeployed by the user.
+	remediate := status.Drifted && config.RecipeConfig.Drift.AutoRemediate
+	if remediate && drift.DefinitionChanged {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Info("Skipping recipe drift remediation: the recipe was changed in the environment since the resource was deployed", ucplog.LogFieldResourceID, obj.ID)
+		remediate = false
+	}
+
+	return c.saveDriftStatus(ctx, sc, obj, resource, status, remediate)
+}
+
+// saveDriftStatus records the drift status on the recipe status of the portable resource. When remediate is true the
+// portable resource is saved together with a new operation redeploying its recipe.
+func (c *Checker) saveDriftStatus(ctx context.Context, sc store.StorageClient, obj *store.Object, resource *portableResource, status *rpv1.RecipeDriftStatus, remediate bool) error {
+	// The portable resource is updated as a map, so that the properties specific to its type are preserved.
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return err
+	}
+
+	properties, _ := data["properties"].(map[string]any)
+	resourceStatus, _ := properties["status"].(map[string]any)
+	recipeStatus, _ := resourceStatus["recipe"].(map[string]any)
+	if recipeStatus == nil {
+		return errors.New("the portable resource has no recipe status")
+	}
+	recipeStatus["drift"] = status
+
+	update := &store.Object{
+		Metadata: store.Metadata{
+			ID: obj.ID,
+		},
+		Data: data,
+	}
+
+	if !remediate {
+		return sc.Save(ctx, update, store.WithETag(obj.ETag))
+	}
+
+	id, err := resources.ParseResource(obj.ID)
+	if err != nil {
+		return err
+	}
+
+	data["provisioningState"] = v1.ProvisioningStateAccepted
+	serviceCtx := &v1.ARMRequestContext{
+		ResourceID:  id,
+		OperationID: uuid.New(),
+		OperationType: v1.OperationType{
+			Type:   strings.ToUpper(id.Type()),
+			Method: v1.OperationPut,
+		},
+		APIVersion: resource.InternalMetadata.UpdatedAPIVersion,
+	}
+
+	err = c.statusManager.QueueAsyncOperation(ctx, serviceCtx, sm.QueueOperationOptions{
+		OperationTimeout: remediationTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+		// Remediation is background work which should not delay the deployments requested by users.
+		Priority: queue.PriorityLow,
+		Resource: &sm.ResourceUpdate{
+			StorageClient: sc,
+			Object:        update,
+			ETag:          obj.ETag,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftRemediation(ctx,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationRemediateDrift, resource.Properties.Recipe.Name,
+			&recipes.EnvironmentDefinition{
+				Driver:       resource.Properties.Status.Recipe.TemplateKind,
+				ResourceType: id.Type(),
+				TemplatePath: resource.Properties.Status.Recipe.TemplatePath,
+			}, ""))
+
+	return nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	resourceType  = "Applications.Datastores/redisCaches"
+	resourceID    = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis0"
+	environmentID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env0"
+	outputID      = "/planes/kubernetes/local/namespaces/test-namespace/providers/apps/Deployment/redis0"
+)
+
+type mocks struct {
+	storageClient *store.MockStorageClient
+	statusManager *sm.MockStatusManager
+	engine        *engine.MockEngine
+	configLoader  *configloader.MockConfigurationLoader
+}
+
+func setup(t *testing.T) (*Checker, mocks) {
+	mctrl := gomock.NewController(t)
+	m := mocks{
+		storageClient: store.NewMockStorageClient(mctrl),
+		statusManager: sm.NewMockStatusManager(mctrl),
+		engine:        engine.NewMockEngine(mctrl),
+		configLoader:  configloader.NewMockConfigurationLoader(mctrl),
+	}
+
+	storageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), resourceType).Return(m.storageClient, nil).AnyTimes()
+
+	return NewChecker(storageProvider, m.statusManager, m.engine, m.configLoader, []string{resourceType}), m
+}
+
+func newResource(provisioningState v1.ProvisioningState, recipeStatus map[string]any) store.Object {
+	return store.Object{
+		Metadata: store.Metadata{ID: resourceID, ETag: "etag"},
+		Data: map[string]any{
+			"id":                resourceID,
+			"type":              resourceType,
+			"provisioningState": string(provisioningState),
+			"updatedApiVersion": "2023-10-01-preview",
+			"properties": map[string]any{
+				"environment": environmentID,
+				"host":        "redis.example.com",
+				"recipe":      map[string]any{"name": "default", "parameters": map[string]any{"size": "small"}},
+				"status": map[string]any{
+					"outputResources": []any{map[string]any{"id": outputID}},
+					"recipe":          recipeStatus,
+				},
+			},
+		},
+	}
+}
+
+func expectQuery(m mocks, items ...store.Object) {
+	m.storageClient.EXPECT().
+		Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: resourceType}, gomock.Any()).
+		Return(&store.ObjectQueryResult{Items: items}, nil)
+}
+
+func driftOf(t *testing.T, obj *store.Object) *rpv1.RecipeDriftStatus {
+	resource := &portableResource{}
+	require.NoError(t, obj.As(resource))
+	require.NotNil(t, resource.Properties.Status.Recipe)
+	return resource.Properties.Status.Recipe.Drift
+}
+
+func Test_Check(t *testing.T) {
+	recipeStatus := map[string]any{"templateKind": recipes.TemplateKindTerraform, "templatePath": "registry/redis"}
+	expectedMetadata := recipes.ResourceMetadata{
+		Name:          "default",
+		Parameters:    map[string]any{"size": "small"},
+		EnvironmentID: environmentID,
+		ResourceID:    resourceID,
+		Status:        &rpv1.RecipeStatus{TemplateKind: recipes.TemplateKindTerraform, TemplatePath: "registry/redis"},
+	}
+	drifted := &recipes.RecipeDrift{
+		Resources: []recipes.ResourceChange{
+			{Action: recipes.ChangeActionUpdate, Type: "kubernetes_deployment", Name: "module.default.kubernetes_deployment.redis"},
+		},
+	}
+
+	t.Run("drift is recorded", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m, newResource(v1.ProvisioningStateSucceeded, recipeStatus))
+		m.configLoader.EXPECT().LoadConfiguration(gomock.Any(), expectedMetadata).Return(&recipes.Configuration{}, nil)
+		m.engine.EXPECT().
+			DetectDrift(gomock.Any(), engine.PlanOptions{BaseOptions: engine.BaseOptions{Recipe: expectedMetadata}, PreviousState: []string{outputID}}).
+			Return(drifted, nil)
+		m.storageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				drift := driftOf(t, obj)
+				require.True(t, drift.Drifted)
+				require.False(t, drift.LastCheckedAt.IsZero())
+				require.Equal(t, []rpv1.DriftedResource{{Action: "update", Type: "kubernetes_deployment", Name: "module.default.kubernetes_deployment.redis"}}, drift.Resources)
+
+				// The properties specific to the resource type are preserved.
+				data := obj.Data.(map[string]any)
+				require.Equal(t, "redis.example.com", data["properties"].(map[string]any)["host"])
+				require.Equal(t, "Succeeded", data["provisioningState"])
+				return nil
+			})
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("no drift is recorded", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m, newResource(v1.ProvisioningStateSucceeded, recipeStatus))
+		m.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil)
+		m.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(&recipes.RecipeDrift{}, nil)
+		m.storageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				drift := driftOf(t, obj)
+				require.False(t, drift.Drifted)
+				require.Empty(t, drift.Resources)
+				return nil
+			})
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("drift is remediated", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m, newResource(v1.ProvisioningStateSucceeded, recipeStatus))
+		m.configLoader.EXPECT().
+			LoadConfiguration(gomock.Any(), gomock.Any()).
+			Return(&recipes.Configuration{RecipeConfig: datamodel.RecipeConfigProperties{Drift: datamodel.RecipeDriftConfigProperties{AutoRemediate: true}}}, nil)
+		m.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(drifted, nil)
+		m.statusManager.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options sm.QueueOperationOptions) error {
+				require.Equal(t, resourceID, sCtx.ResourceID.String())
+				require.Equal(t, "APPLICATIONS.DATASTORES/REDISCACHES|PUT", sCtx.OperationType.String())
+				require.Equal(t, "2023-10-01-preview", sCtx.APIVersion)
+				require.Equal(t, queue.PriorityLow, options.Priority)
+				require.Equal(t, "etag", options.Resource.ETag)
+
+				require.True(t, driftOf(t, options.Resource.Object).Drifted)
+				require.Equal(t, v1.ProvisioningStateAccepted, options.Resource.Object.Data.(map[string]any)["provisioningState"])
+				return nil
+			})
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("drift is not remediated when the recipe changed", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m, newResource(v1.ProvisioningStateSucceeded, recipeStatus))
+		m.configLoader.EXPECT().
+			LoadConfiguration(gomock.Any(), gomock.Any()).
+			Return(&recipes.Configuration{RecipeConfig: datamodel.RecipeConfigProperties{Drift: datamodel.RecipeDriftConfigProperties{AutoRemediate: true}}}, nil)
+		m.engine.EXPECT().
+			DetectDrift(gomock.Any(), gomock.Any()).
+			Return(&recipes.RecipeDrift{Resources: drifted.Resources, DefinitionChanged: true}, nil)
+		m.storageClient.EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, obj *store.Object, _ ...store.SaveOptions) error {
+				require.True(t, driftOf(t, obj).Drifted)
+				require.Equal(t, "Succeeded", obj.Data.(map[string]any)["provisioningState"])
+				return nil
+			})
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("resources which are not deployed by a recipe are skipped", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m,
+			newResource(v1.ProvisioningStateUpdating, recipeStatus),
+			newResource(v1.ProvisioningStateSucceeded, nil),
+		)
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("simulated environments are skipped", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m, newResource(v1.ProvisioningStateSucceeded, recipeStatus))
+		m.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{Simulated: true}, nil)
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("a failed check does not stop the others", func(t *testing.T) {
+		checker, m := setup(t)
+		expectQuery(m,
+			newResource(v1.ProvisioningStateSucceeded, recipeStatus),
+			newResource(v1.ProvisioningStateSucceeded, recipeStatus),
+		)
+		m.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil).Times(2)
+		m.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to plan"))
+		m.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(&recipes.RecipeDrift{}, nil)
+		m.storageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("query fails", func(t *testing.T) {
+		checker, m := setup(t)
+		m.storageClient.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("query failed"))
+
+		err := checker.Check(context.Background())
+		require.ErrorContains(t, err, "query failed")
+	})
+}
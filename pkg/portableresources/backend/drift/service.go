@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	qprovider "github.com/radius-project/radius/pkg/ucp/queue/provider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// defaultInterval is the default interval between two drift checks.
+	defaultInterval = time.Hour
+
+	// leaseResourceType is the resource type of the lease letting one replica check the drift at a time.
+	leaseResourceType = "Applications.Core/leases"
+)
+
+// ResourceTypes is the list of the portable resource types supporting recipes, whose recipe deployed resources are
+// checked for drift.
+var ResourceTypes = []string{
+	datamodel.ExtenderResourceType,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	msg_ctrl.RabbitMQQueuesResourceType,
+}
+
+// Service is a service to periodically check the resources deployed by the recipes of portable resources for drift.
+type Service struct {
+	options      hostoptions.HostOptions
+	engine       engine.Engine
+	configLoader configloader.ConfigurationLoader
+}
+
+// NewService creates a new drift detection service using the given recipe engine and configuration loader.
+func NewService(options hostoptions.HostOptions, engine engine.Engine, configLoader configloader.ConfigurationLoader) *Service {
+	return &Service{
+		options:      options,
+		engine:       engine,
+		configLoader: configLoader,
+	}
+}
+
+// Name represents the service name.
+func (s *Service) Name() string {
+	return "recipedrift"
+}
+
+// Run checks the portable resources for drift at the configured interval until the context is cancelled.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval := defaultInterval
+	if s.options.Config.RecipeDrift.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(s.options.Config.RecipeDrift.Interval)
+		if err != nil {
+			return fmt.Errorf("invalid recipe drift interval %q: %w", s.options.Config.RecipeDrift.Interval, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("invalid recipe drift interval %q: the interval must be positive", s.options.Config.RecipeDrift.Interval)
+		}
+	}
+
+	storageProvider := dataprovider.NewStorageProvider(s.options.Config.StorageProvider)
+	queueClient, err := qprovider.New(s.options.Config.QueueProvider).GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	statusManager := statusmanager.New(storageProvider, queueClient, s.options.Config.Env.RoleLocation)
+
+	checker := NewChecker(storageProvider, statusManager, s.engine, s.configLoader, ResourceTypes)
+
+	// Every replica of the service runs the drift detection, but only the holder of the lease checks the drift so that
+	// the portable resources are not checked and remediated by several replicas at once. The holder renews the lease at
+	// every check, and another replica takes over when the lease expires.
+	leaseClient, err := storageProvider.GetStorageClient(ctx, leaseResourceType)
+	if err != nil {
+		return err
+	}
+	hostname, _ := os.Hostname()
+	leaseID := fmt.Sprintf("/planes/radius/local/providers/applications.core/locations/%s/leases/%s", s.options.Config.Env.RoleLocation, s.Name())
+	lease := store.NewLease(leaseClient, leaseID, hostname+"-"+uuid.NewString(), 2*interval)
+
+	logger.Info(fmt.Sprintf("Checking recipe drift every %s", interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Recipe drift detection stopped...")
+			return nil
+		case <-ticker.C:
+			held, err := lease.TryAcquire(ctx)
+			if err != nil {
+				logger.Error(err, "failed to acquire the recipe drift lease")
+				continue
+			} else if !held {
+				logger.V(ucplog.LevelDebug).Info("Skipping recipe drift check: another replica holds the lease")
+				continue
+			}
+
+			if err := checker.Check(ctx); err != nil {
+				logger.Error(err, "failed to check recipe drift")
+			}
+		}
+	}
+}
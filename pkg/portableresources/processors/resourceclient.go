@@ -28,13 +28,16 @@ import (
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/trace"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -56,11 +59,16 @@ type resourceClient struct {
 
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
+
+	// clusters provides the clients of the Kubernetes resources of non-local clusters. This may be nil when only the
+	// local cluster is supported.
+	clusters kubeutil.ClusterClientsProvider
 }
 
-// NewResourceClient creates a new resourceClient instance with the given parameters.
-func NewResourceClient(arm *armauth.ArmConfig, connection sdk.Connection, k8sClient runtime_client.Client, k8sDiscoveryClient discovery.ServerResourcesInterface) *resourceClient {
-	return &resourceClient{arm: arm, connection: connection, k8sClient: k8sClient, k8sDiscoveryClient: k8sDiscoveryClient}
+// NewResourceClient creates a new resourceClient instance with the given parameters. The Kubernetes clients target the
+// cluster Radius runs in, and clusters provides the clients of the clusters registered as Kubernetes planes.
+func NewResourceClient(arm *armauth.ArmConfig, connection sdk.Connection, k8sClient runtime_client.Client, k8sDiscoveryClient discovery.ServerResourcesInterface, clusters kubeutil.ClusterClientsProvider) *resourceClient {
+	return &resourceClient{arm: arm, connection: connection, k8sClient: k8sClient, k8sDiscoveryClient: k8sDiscoveryClient, clusters: clusters}
 }
 
 // Delete attempts to delete a resource, either through UCP, Azure, or Kubernetes, depending on the resource type.
@@ -89,6 +97,50 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// Exists checks whether a resource exists, either through UCP, Azure, or Kubernetes, depending on the resource type.
+func (c *resourceClient) Exists(ctx context.Context, id string) (bool, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return false, err
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.Exists", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	var exists bool
+	ns := strings.ToLower(parsed.PlaneNamespace())
+	if !parsed.IsUCPQualified() || strings.HasPrefix(ns, "azure/") {
+		exists, err = c.azureResourceExists(ctx, parsed)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		exists, err = c.kubernetesResourceExists(ctx, parsed)
+	} else {
+		exists, err = c.ucpResourceExists(ctx, parsed)
+	}
+
+	return exists, c.wrapError(parsed, err)
+}
+
+// GetKubernetesObject reads the Kubernetes object with the given id from the cluster of its Kubernetes plane. It returns
+// nil if the object does not exist.
+func (c *resourceClient) GetKubernetesObject(ctx context.Context, id string) (*unstructured.Unstructured, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(strings.ToLower(parsed.PlaneNamespace()), "kubernetes/") {
+		return nil, fmt.Errorf("resource %q is not a Kubernetes resource", id)
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.GetKubernetesObject", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	obj, err := c.getKubernetesObject(ctx, parsed)
+	return obj, c.wrapError(parsed, err)
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -139,6 +191,35 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) azureResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	var err error
+	if id.IsUCPQualified() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.GetByID(ctx, id.String(), apiVersion, &armresources.ClientGetByIDOptions{})
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -179,6 +260,7 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	// For AWS resources, the server does not yet validate the API version.
 	//
 	// In the future we should change this to look up API versions dynamically like we do for ARM.
+	ctx = withPlaneCredential(ctx, id)
 	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
 	if err != nil {
 		return err
@@ -207,8 +289,60 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) ucpResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	// NOTE: the API version passed in here is ignored, see deleteUCPResource.
+	ctx = withPlaneCredential(ctx, id)
+	client, err := generated.NewGenericResourcesClient(id.RootScope(), id.Type(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Get(ctx, id.Name(), nil)
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// withPlaneCredential returns a context selecting the credential which the context selects for the plane of the given
+// resource, for example with the providers of an environment, in the request proxied by UCP.
+func withPlaneCredential(ctx context.Context, id resources.ID) context.Context {
+	if !id.IsUCPQualified() || len(id.ScopeSegments()) == 0 {
+		return ctx
+	}
+
+	return sdk_cred.WithCredentialName(ctx, sdk_cred.PlaneCredentialName(ctx, id.ScopeSegments()[0].Type))
+}
+
+// kubernetesClients returns the clients of the cluster of the Kubernetes plane of the given resource.
+func (c *resourceClient) kubernetesClients(ctx context.Context, id resources.ID) (runtime_client.Client, discovery.ServerResourcesInterface, error) {
+	planeName := resources_kubernetes.PlaneName(id)
+	if resources_kubernetes.IsLocalPlane(planeName) {
+		return c.k8sClient, c.k8sDiscoveryClient, nil
+	}
+
+	if c.clusters == nil {
+		return nil, nil, fmt.Errorf("cannot access Kubernetes plane %q: only the local cluster is supported", planeName)
+	}
+
+	clients, err := c.clusters.GetClients(ctx, planeName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize the clients of Kubernetes plane %q: %w", planeName, err)
+	}
+
+	return clients.RuntimeClient, clients.DiscoveryClient, nil
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
-	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, id)
+	k8sClient, k8sDiscoveryClient, err := c.kubernetesClients(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, k8sDiscoveryClient, id)
 	if err != nil {
 		return err
 	}
@@ -234,7 +368,7 @@ func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resour
 		},
 	}
 
-	err = runtime_client.IgnoreNotFound(c.k8sClient.Delete(ctx, &obj))
+	err = runtime_client.IgnoreNotFound(k8sClient.Delete(ctx, &obj))
 	if err != nil {
 		return err
 	}
@@ -242,17 +376,58 @@ func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resour
 	return nil
 }
 
-func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, id resources.ID) (string, error) {
+func (c *resourceClient) kubernetesResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	obj, err := c.getKubernetesObject(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	return obj != nil, nil
+}
+
+// getKubernetesObject reads the Kubernetes object from the cluster of its Kubernetes plane. It returns nil if the
+// object does not exist.
+func (c *resourceClient) getKubernetesObject(ctx context.Context, id resources.ID) (*unstructured.Unstructured, error) {
+	k8sClient, k8sDiscoveryClient, err := c.kubernetesClients(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion, err := c.lookupKubernetesAPIVersion(ctx, k8sDiscoveryClient, id)
+	if err != nil {
+		return nil, err
+	}
+
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	if group != "" {
+		apiVersion = fmt.Sprintf("%s/%s", group, apiVersion)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+
+	err = k8sClient.Get(ctx, runtime_client.ObjectKey{Namespace: namespace, Name: name}, obj)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (c *resourceClient) lookupKubernetesAPIVersion(ctx context.Context, k8sDiscoveryClient discovery.ServerResourcesInterface, id resources.ID) (string, error) {
 	group, kind, namespace, _ := resources_kubernetes.ToParts(id)
 	var resourceLists []*v1.APIResourceList
 	var err error
 	if namespace == "" {
-		resourceLists, err = c.k8sDiscoveryClient.ServerPreferredResources()
+		resourceLists, err = k8sDiscoveryClient.ServerPreferredResources()
 		if err != nil {
 			return "", fmt.Errorf("could not find API version for type %q: %w", id.Type(), err)
 		}
 	} else {
-		resourceLists, err = c.k8sDiscoveryClient.ServerPreferredNamespacedResources()
+		resourceLists, err = k8sDiscoveryClient.ServerPreferredNamespacedResources()
 		if err != nil {
 			return "", fmt.Errorf("could not find API version for type %q: %w", id.Type(), err)
 		}
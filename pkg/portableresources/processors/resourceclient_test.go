@@ -31,8 +31,10 @@ import (
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -51,7 +53,7 @@ const (
 )
 
 func Test_Delete_InvalidResourceID(t *testing.T) {
-	c := NewResourceClient(nil, nil, nil, nil)
+	c := NewResourceClient(nil, nil, nil, nil, nil)
 	err := c.Delete(context.Background(), "invalid")
 	require.Error(t, err)
 }
@@ -68,7 +70,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -96,7 +98,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -119,7 +121,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -146,7 +148,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -160,7 +162,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -178,7 +180,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -202,7 +204,7 @@ func Test_Delete_ARM(t *testing.T) {
 		server := httptest.NewServer(mux)
 		defer server.Close()
 
-		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil)
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
 		c.armClientOptions = newClientOptions(server.Client(), server.URL)
 
 		err := c.Delete(context.Background(), ARMResourceID)
@@ -238,7 +240,7 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.NoError(t, err)
@@ -266,7 +268,7 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.NoError(t, err)
@@ -284,7 +286,7 @@ func Test_Delete_Kubernetes(t *testing.T) {
 			Resources: []*metav1.APIResourceList{},
 		}
 
-		c := NewResourceClient(nil, nil, client, dc)
+		c := NewResourceClient(nil, nil, client, dc, nil)
 
 		err := c.Delete(context.Background(), KubernetesCoreGroupResourceID)
 		require.Error(t, err)
@@ -303,7 +305,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.NoError(t, err)
@@ -319,7 +321,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.NoError(t, err)
@@ -339,7 +341,7 @@ func Test_Delete_UCP(t *testing.T) {
 		connection, err := sdk.NewDirectConnection(server.URL)
 		require.NoError(t, err)
 
-		c := NewResourceClient(nil, connection, nil, nil)
+		c := NewResourceClient(nil, connection, nil, nil, nil)
 
 		err = c.Delete(context.Background(), AWSResourceID)
 		require.Error(t, err)
@@ -347,6 +349,271 @@ func Test_Delete_UCP(t *testing.T) {
 	})
 }
 
+func Test_Exists_InvalidResourceID(t *testing.T) {
+	c := NewResourceClient(nil, nil, nil, nil, nil)
+	_, err := c.Exists(context.Background(), "invalid")
+	require.Error(t, err)
+}
+
+func Test_Exists_ARM(t *testing.T) {
+	provider := handleJSONResponse(t, armresources.Provider{
+		Namespace: to.Ptr("Microsoft.Compute"),
+		ResourceTypes: []*armresources.ProviderResourceType{
+			{
+				ResourceType:      to.Ptr("virtualMachines"),
+				DefaultAPIVersion: to.Ptr(ARMAPIVersion),
+			},
+		},
+	}, 200)
+
+	t.Run("success - resource exists", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, armresources.GenericResource{ID: to.Ptr(ARMResourceID)}, 200))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		exists, err := c.Exists(context.Background(), AzureUCPResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource does not exist", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleNotFound(t))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+
+		exists, err := c.Exists(context.Background(), ARMResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("failure - get fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(ARMResourceID, handleJSONResponse(t, v1.ErrorResponse{
+			Error: v1.ErrorDetails{
+				Code: v1.CodeInternal,
+			},
+		}, 500))
+		mux.HandleFunc(ARMProviderPath, provider)
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		c := NewResourceClient(newArmOptions(server.URL), nil, nil, nil, nil)
+		c.armClientOptions = newClientOptions(server.Client(), server.URL)
+		c.armClientOptions.Retry.MaxRetries = -1
+
+		_, err := c.Exists(context.Background(), ARMResourceID)
+		require.Error(t, err)
+		require.IsType(t, &ResourceError{}, err)
+	})
+}
+
+func Test_Exists_Kubernetes(t *testing.T) {
+	dc := &k8sutil.DiscoveryClient{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{
+						Name:    "api1",
+						Version: "v1",
+						Kind:    "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success - resource exists", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+		}).Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		exists, err := c.Exists(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource does not exist", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		exists, err := c.Exists(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func Test_GetKubernetesObject(t *testing.T) {
+	dc := &k8sutil.DiscoveryClient{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{
+						Name:    "api1",
+						Version: "v1",
+						Kind:    "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("success - resource exists", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-name",
+				Namespace: "test-namespace",
+			},
+			StringData: map[string]string{"key": "value"},
+		}).Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		obj, err := c.GetKubernetesObject(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.NotNil(t, obj)
+		require.Equal(t, "test-name", obj.GetName())
+		require.Equal(t, map[string]any{"key": "value"}, obj.Object["stringData"])
+	})
+
+	t.Run("success - resource does not exist", func(t *testing.T) {
+		client := fake.NewClientBuilder().Build()
+
+		c := NewResourceClient(nil, nil, client, dc, nil)
+
+		obj, err := c.GetKubernetesObject(context.Background(), KubernetesCoreGroupResourceID)
+		require.NoError(t, err)
+		require.Nil(t, obj)
+	})
+
+	t.Run("failure - not a kubernetes resource", func(t *testing.T) {
+		c := NewResourceClient(nil, nil, fake.NewClientBuilder().Build(), dc, nil)
+
+		_, err := c.GetKubernetesObject(context.Background(), "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env")
+		require.ErrorContains(t, err, "is not a Kubernetes resource")
+	})
+}
+
+func Test_Exists_Kubernetes_NonLocalPlane(t *testing.T) {
+	const id = "/planes/kubernetes/prod/namespaces/test-namespace/providers/core/Secret/test-name"
+
+	t.Run("success - resource exists in the cluster of the plane", func(t *testing.T) {
+		paths := []string{}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/planes/kubernetes/prod/api", handleJSONResponse(t, map[string]any{"kind": "APIVersions", "versions": []string{"v1"}}, 200))
+		mux.HandleFunc("/planes/kubernetes/prod/apis", handleJSONResponse(t, map[string]any{"kind": "APIGroupList", "apiVersion": "v1", "groups": []any{}}, 200))
+		mux.HandleFunc("/planes/kubernetes/prod/api/v1", handleJSONResponse(t, map[string]any{
+			"kind":         "APIResourceList",
+			"groupVersion": "v1",
+			"resources":    []any{map[string]any{"name": "secrets", "singularName": "secret", "namespaced": true, "kind": "Secret", "verbs": []string{"get", "delete"}}},
+		}, 200))
+		mux.HandleFunc("/planes/kubernetes/prod/api/v1/namespaces/test-namespace/secrets/test-name", func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			handleJSONResponse(t, map[string]any{"kind": "Secret", "apiVersion": "v1", "metadata": map[string]any{"name": "test-name", "namespace": "test-namespace"}}, 200)(w, r)
+		})
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		// The local clients are not used for resources of other planes.
+		c := NewResourceClient(nil, nil, nil, nil, kubeutil.NewUCPClusterClientsProvider(connection))
+
+		exists, err := c.Exists(context.Background(), id)
+		require.NoError(t, err)
+		require.True(t, exists)
+		require.Equal(t, []string{"/planes/kubernetes/prod/api/v1/namespaces/test-namespace/secrets/test-name"}, paths)
+	})
+
+	t.Run("failure - only the local cluster is supported", func(t *testing.T) {
+		c := NewResourceClient(nil, nil, fake.NewClientBuilder().Build(), &k8sutil.DiscoveryClient{}, nil)
+
+		_, err := c.Exists(context.Background(), id)
+		require.ErrorContains(t, err, "cannot access Kubernetes plane \"prod\": only the local cluster is supported")
+	})
+}
+
+func Test_Exists_UCP(t *testing.T) {
+	t.Run("success - resource exists", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleJSONResponse(t, map[string]any{"id": AWSResourceID}, 200))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		exists, err := c.Exists(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("success - resource does not exist", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc(AWSResourceID, handleNotFound(t))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		connection, err := sdk.NewDirectConnection(server.URL)
+		require.NoError(t, err)
+
+		c := NewResourceClient(nil, connection, nil, nil, nil)
+
+		exists, err := c.Exists(context.Background(), AWSResourceID)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+}
+
+func Test_Exists_UCP_SelectedCredential(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(AWSResourceID, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "account2", r.Header.Get(sdk_cred.CredentialNameHeader))
+		handleJSONResponse(t, map[string]any{"id": AWSResourceID}, 200)(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	c := NewResourceClient(nil, connection, nil, nil, nil)
+
+	// Only the credential selected for the AWS plane is used for the AWS resource.
+	ctx := sdk_cred.WithPlaneCredentialName(context.Background(), "aws", "account2")
+	ctx = sdk_cred.WithPlaneCredentialName(ctx, "azure", "subscription2")
+	exists, err := c.Exists(ctx, AWSResourceID)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
 func newArmOptions(url string) *armauth.ArmConfig {
 	return &armauth.ArmConfig{
 		ClientOptions: clientv2.Options{
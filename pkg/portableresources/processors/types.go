@@ -22,6 +22,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // ResourceProcessor is responsible for processing the results of recipe execution or any
@@ -66,6 +67,13 @@ type ResourceClient interface {
 	//
 	// If the API version is omitted, then an attempt will be made to look up the API version.
 	Delete(ctx context.Context, id string) error
+
+	// Exists returns true if the resource with the given id exists.
+	Exists(ctx context.Context, id string) (bool, error)
+
+	// GetKubernetesObject returns the Kubernetes object with the given id as it is in the cluster, or nil if the
+	// object does not exist.
+	GetKubernetesObject(ctx context.Context, id string) (*unstructured.Unstructured, error)
 }
 
 // ResourceError represents an error that occurred while processing a resource.
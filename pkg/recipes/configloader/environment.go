@@ -76,9 +76,11 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		Providers: datamodel.Providers{},
 	}
 
-	switch environment.Properties.Compute.(type) {
+	switch compute := environment.Properties.Compute.(type) {
 	case *v20231001preview.KubernetesCompute:
-		config.Runtime.Kubernetes = &recipes.KubernetesRuntime{}
+		config.Runtime.Kubernetes = &recipes.KubernetesRuntime{
+			Cluster: to.String(compute.Cluster),
+		}
 		var err error
 
 		// Environment-scoped namespace must be given all the time.
@@ -105,9 +107,11 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 	if providers != nil {
 		if providers.Aws != nil {
 			config.Providers.AWS.Scope = to.String(providers.Aws.Scope)
+			config.Providers.AWS.Credential = to.String(providers.Aws.Credential)
 		}
 		if providers.Azure != nil {
 			config.Providers.Azure.Scope = to.String(providers.Azure.Scope)
+			config.Providers.Azure.Credential = to.String(providers.Azure.Credential)
 		}
 	}
 
@@ -115,6 +119,62 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		config.Simulated = true
 	}
 
+	recipeConfig := environment.Properties.RecipeConfig
+	if recipeConfig != nil && recipeConfig.Terraform != nil {
+		config.RecipeConfig.Terraform.Version = to.String(recipeConfig.Terraform.Version)
+		if backend := recipeConfig.Terraform.Backend; backend != nil {
+			config.RecipeConfig.Terraform.Backend = datamodel.TerraformBackendProperties{
+				Kind:   to.String(backend.Kind),
+				Config: to.StringMap(backend.Config),
+			}
+		}
+		if mirror := recipeConfig.Terraform.ProviderMirror; mirror != nil {
+			config.RecipeConfig.Terraform.ProviderMirror = datamodel.TerraformProviderMirrorProperties{
+				URL:     to.String(mirror.URL),
+				Include: stringSlice(mirror.Include),
+				Exclude: stringSlice(mirror.Exclude),
+			}
+		}
+		if len(recipeConfig.Terraform.Registries) > 0 {
+			config.RecipeConfig.Terraform.Registries = map[string]datamodel.TerraformRegistryProperties{}
+			for host, registry := range recipeConfig.Terraform.Registries {
+				if registry == nil {
+					continue
+				}
+				config.RecipeConfig.Terraform.Registries[host] = datamodel.TerraformRegistryProperties{
+					Secret:   to.String(registry.Secret),
+					Services: to.StringMap(registry.Services),
+				}
+			}
+		}
+		if len(recipeConfig.Terraform.Providers) > 0 {
+			config.RecipeConfig.Terraform.Providers = map[string][]datamodel.ProviderConfigProperties{}
+			for name, providerConfigs := range recipeConfig.Terraform.Providers {
+				for _, providerConfig := range providerConfigs {
+					if providerConfig == nil {
+						continue
+					}
+
+					secrets := map[string]datamodel.SecretReference{}
+					for property, secret := range providerConfig.Secrets {
+						if secret != nil {
+							secrets[property] = datamodel.SecretReference{Source: to.String(secret.Source), Key: to.String(secret.Key)}
+						}
+					}
+
+					config.RecipeConfig.Terraform.Providers[name] = append(config.RecipeConfig.Terraform.Providers[name], datamodel.ProviderConfigProperties{
+						AdditionalProperties: providerConfig.AdditionalProperties,
+						Secrets:              secrets,
+					})
+				}
+			}
+		}
+	}
+
+	if recipeConfig != nil && recipeConfig.Drift != nil {
+		config.RecipeConfig.Drift.AutoRemediate = to.Bool(recipeConfig.Drift.AutoRemediate)
+	}
+
 	return &config, nil
 }
 
@@ -152,11 +212,12 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 	}
 
 	definition := &recipes.EnvironmentDefinition{
-		Name:         recipeName,
-		Driver:       *found.GetRecipeProperties().TemplateKind,
-		ResourceType: resource.Type(),
-		Parameters:   found.GetRecipeProperties().Parameters,
-		TemplatePath: *found.GetRecipeProperties().TemplatePath,
+		Name:          recipeName,
+		Driver:        *found.GetRecipeProperties().TemplateKind,
+		ResourceType:  resource.Type(),
+		Parameters:    found.GetRecipeProperties().Parameters,
+		TemplatePath:  *found.GetRecipeProperties().TemplatePath,
+		RecipeVersion: to.String(found.GetRecipeProperties().RecipeVersion),
 	}
 	switch c := found.(type) {
 	case *v20231001preview.TerraformRecipeProperties:
@@ -165,7 +226,26 @@ func getRecipeDefinition(environment *v20231001preview.EnvironmentResource, reci
 		if c.PlainHTTP != nil {
 			definition.PlainHTTP = *c.PlainHTTP
 		}
+	case *v20231001preview.HelmRecipeProperties:
+		if c.TemplateVersion != nil {
+			definition.TemplateVersion = *c.TemplateVersion
+		}
+	case *v20231001preview.KubernetesRecipeProperties:
+		if c.PlainHTTP != nil {
+			definition.PlainHTTP = *c.PlainHTTP
+		}
 	}
 
 	return definition, nil
 }
+
+// stringSlice converts a slice of string pointers of the versioned API to a slice of strings.
+func stringSlice(s []*string) []string {
+	var r []string
+	for _, v := range s {
+		if v != nil {
+			r = append(r, *v)
+		}
+	}
+	return r
+}
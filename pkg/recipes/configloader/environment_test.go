@@ -103,6 +103,63 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "env resource targeting a cluster",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+						Cluster:    to.Ptr("prod"),
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+						Cluster:              "prod",
+					},
+				},
+				Providers: datamodel.Providers{},
+			},
+		},
+		{
+			name: "aws provider with selected credential",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					Providers: &model.Providers{
+						Aws: &model.ProvidersAws{
+							Scope:      to.Ptr(awsScope),
+							Credential: to.Ptr("account2"),
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{
+					AWS: datamodel.ProvidersAWS{
+						Scope:      awsScope,
+						Credential: "account2",
+					},
+				},
+			},
+		},
 		{
 			name: "aws provider with env and app resource",
 			envResource: &model.EnvironmentResource{
@@ -140,6 +197,97 @@ func TestGetConfiguration(t *testing.T) {
 				Providers: createAWSProvider(),
 			},
 		},
+		{
+			name: "terraform config with env resource",
+			envResource: &model.EnvironmentResource{
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.KubernetesCompute{
+						Kind:       to.Ptr(kind),
+						Namespace:  to.Ptr(envNamespace),
+						ResourceID: to.Ptr(envResourceId),
+					},
+					RecipeConfig: &model.RecipeConfigProperties{
+						Terraform: &model.TerraformConfigProperties{
+							Version: to.Ptr("1.5.7"),
+							Backend: &model.TerraformBackendProperties{
+								Kind:   to.Ptr("s3"),
+								Config: map[string]*string{"bucket": to.Ptr("tfstate")},
+							},
+							ProviderMirror: &model.TerraformProviderMirrorProperties{
+								URL:     to.Ptr("https://mirror.example.com/providers/"),
+								Include: []*string{to.Ptr("registry.terraform.io/hashicorp/*")},
+							},
+							Registries: map[string]*model.TerraformRegistryProperties{
+								"app.terraform.io": {
+									Secret: to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/tfc"),
+								},
+							},
+							Providers: map[string][]*model.ProviderConfigProperties{
+								"postgresql": {
+									{
+										AdditionalProperties: map[string]any{"host": "postgres.example.com"},
+										Secrets: map[string]*model.SecretReference{
+											"password": {
+												Source: to.Ptr("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/postgres"),
+												Key:    to.Ptr("password"),
+											},
+										},
+									},
+								},
+							},
+						},
+						Drift: &model.RecipeDriftConfigProperties{
+							AutoRemediate: to.Ptr(true),
+						},
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{},
+				RecipeConfig: datamodel.RecipeConfigProperties{
+					Terraform: datamodel.TerraformConfigProperties{
+						Version: "1.5.7",
+						Backend: datamodel.TerraformBackendProperties{
+							Kind:   "s3",
+							Config: map[string]string{"bucket": "tfstate"},
+						},
+						ProviderMirror: datamodel.TerraformProviderMirrorProperties{
+							URL:     "https://mirror.example.com/providers/",
+							Include: []string{"registry.terraform.io/hashicorp/*"},
+						},
+						Registries: map[string]datamodel.TerraformRegistryProperties{
+							"app.terraform.io": {
+								Secret:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/tfc",
+								Services: map[string]string{},
+							},
+						},
+						Providers: map[string][]datamodel.ProviderConfigProperties{
+							"postgresql": {
+								{
+									AdditionalProperties: map[string]any{"host": "postgres.example.com"},
+									Secrets: map[string]datamodel.SecretReference{
+										"password": {
+											Source: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/postgres",
+											Key:    "password",
+										},
+									},
+								},
+							},
+						},
+					},
+					Drift: datamodel.RecipeDriftConfigProperties{
+						AutoRemediate: true,
+					},
+				},
+			},
+		},
 		{
 			name: "invalid app resource",
 			envResource: &model.EnvironmentResource{
@@ -239,6 +387,17 @@ func TestGetRecipeDefinition(t *testing.T) {
 						TemplatePath:    to.Ptr("Azure/cosmosdb/azurerm"),
 						TemplateVersion: to.Ptr("1.1.0"),
 					},
+					"helm": &model.HelmRecipeProperties{
+						TemplateKind:    to.Ptr(recipes.TemplateKindHelm),
+						TemplatePath:    to.Ptr("oci://ghcr.io/radius-project/charts/mongodb"),
+						TemplateVersion: to.Ptr("1.2.0"),
+						RecipeVersion:   to.Ptr("2.1.0"),
+					},
+					"kubernetes": &model.KubernetesRecipeProperties{
+						TemplateKind: to.Ptr(recipes.TemplateKindKubernetes),
+						TemplatePath: to.Ptr("localhost:8000/manifests/mongodb:1.0"),
+						PlainHTTP:    to.Ptr(true),
+					},
 				},
 			},
 		},
@@ -310,6 +469,41 @@ func TestGetRecipeDefinition(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, recipeDef, &expected)
 	})
+	t.Run("success-helm", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "helm",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:            "helm",
+			Driver:          recipes.TemplateKindHelm,
+			ResourceType:    "Applications.Datastores/mongoDatabases",
+			TemplatePath:    "oci://ghcr.io/radius-project/charts/mongodb",
+			TemplateVersion: "1.2.0",
+			RecipeVersion:   "2.1.0",
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, recipeDef, &expected)
+	})
+	t.Run("success-kubernetes", func(t *testing.T) {
+		metadata := recipes.ResourceMetadata{
+			Name:          "kubernetes",
+			EnvironmentID: envResourceId,
+			ResourceID:    mongoResourceID,
+		}
+		expected := recipes.EnvironmentDefinition{
+			Name:         "kubernetes",
+			Driver:       recipes.TemplateKindKubernetes,
+			ResourceType: "Applications.Datastores/mongoDatabases",
+			TemplatePath: "localhost:8000/manifests/mongodb:1.0",
+			PlainHTTP:    true,
+		}
+		recipeDef, err := getRecipeDefinition(&envResource, &metadata)
+		require.NoError(t, err)
+		require.Equal(t, recipeDef, &expected)
+	})
 	t.Run("no recipes registered to the environment", func(t *testing.T) {
 		envResourceNilRecipe := envResource
 		envResourceNilRecipe.Properties.Recipes = nil
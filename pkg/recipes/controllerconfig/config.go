@@ -21,12 +21,24 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/daprrp/processors/pubsubbrokers"
+	"github.com/radius-project/radius/pkg/daprrp/processors/secretstores"
+	"github.com/radius-project/radius/pkg/daprrp/processors/statestores"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/datastoresrp/processors/mongodatabases"
+	"github.com/radius-project/radius/pkg/datastoresrp/processors/rediscaches"
+	"github.com/radius-project/radius/pkg/datastoresrp/processors/sqldatabases"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/messagingrp/processors/rabbitmqqueues"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/helm"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/secret/provider"
@@ -51,6 +63,9 @@ type RecipeControllerConfig struct {
 
 	// UCPConnection is the connection to UCP
 	UCPConnection *sdk.Connection
+
+	// Clusters provides the Kubernetes clients of the clusters registered as Kubernetes planes.
+	Clusters kubeutil.ClusterClientsProvider
 }
 
 // New creates a new RecipeControllerConfig instance with the given host options.
@@ -63,8 +78,9 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 	}
 
 	cfg.UCPConnection = &options.UCPConnection
+	cfg.Clusters = kubeutil.NewUCPClusterClientsProvider(options.UCPConnection)
 
-	cfg.ResourceClient = processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.K8sClients.RuntimeClient, cfg.K8sClients.DiscoveryClient)
+	cfg.ResourceClient = processors.NewResourceClient(options.Arm, options.UCPConnection, cfg.K8sClients.RuntimeClient, cfg.K8sClients.DiscoveryClient, cfg.Clusters)
 	clientOptions := sdk.NewClientOptions(options.UCPConnection)
 
 	cfg.DeploymentEngineClient, err = clients.NewResourceDeploymentsClient(&clients.Options{
@@ -86,9 +102,15 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	outputSchemas, err := newOutputSchemas()
+	if err != nil {
+		return nil, err
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
+		OutputSchemas:       outputSchemas,
 		Drivers: map[string]driver.Driver{
 			recipes.TemplateKindBicep: driver.NewBicepDriver(
 				clientOptions,
@@ -101,10 +123,43 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 			),
 			recipes.TemplateKindTerraform: driver.NewTerraformDriver(options.UCPConnection, provider.NewSecretProvider(options.Config.SecretProvider),
 				driver.TerraformOptions{
-					Path: options.Config.Terraform.Path,
+					Path:       options.Config.Terraform.Path,
+					Version:    options.Config.Terraform.Version,
+					ExecPath:   options.Config.Terraform.ExecPath,
+					ExecSHA256: options.Config.Terraform.ExecSHA256,
+					MirrorDir:  options.Config.Terraform.MirrorDir,
+					CacheDir:   options.Config.Terraform.CacheDir,
 				}, cfg.K8sClients.ClientSet),
+			recipes.TemplateKindHelm: driver.NewHelmDriver(helm.NewClient(options.K8sConfig), cfg.ResourceClient, cfg.Clusters),
+			recipes.TemplateKindKubernetes: driver.NewKubernetesDriver(
+				handlers.NewKubernetesHandler(cfg.K8sClients.RuntimeClient, cfg.K8sClients.ClientSet, cfg.K8sClients.DiscoveryClient, cfg.K8sClients.DynamicClient, cfg.Clusters),
+				cfg.ResourceClient,
+			),
 		},
 	})
 
 	return cfg, nil
 }
+
+// newOutputSchemas parses the schemas of the outputs the recipes of each portable resource type must return.
+func newOutputSchemas() (map[string]*recipes.OutputSchema, error) {
+	raw := map[string][]byte{
+		dapr_ctrl.DaprStateStoresResourceType:   statestores.RecipeOutputSchema,
+		dapr_ctrl.DaprPubSubBrokersResourceType: pubsubbrokers.RecipeOutputSchema,
+		dapr_ctrl.DaprSecretStoresResourceType:  secretstores.RecipeOutputSchema,
+		ds_ctrl.MongoDatabasesResourceType:      mongodatabases.RecipeOutputSchema,
+		ds_ctrl.RedisCachesResourceType:         rediscaches.RecipeOutputSchema,
+		ds_ctrl.SqlDatabasesResourceType:        sqldatabases.RecipeOutputSchema,
+		msg_ctrl.RabbitMQQueuesResourceType:     rabbitmqqueues.RecipeOutputSchema,
+	}
+
+	schemas := map[string]*recipes.OutputSchema{}
+	for resourceType, schema := range raw {
+		outputSchema, err := recipes.NewOutputSchema(resourceType, schema)
+		if err != nil {
+			return nil, err
+		}
+		schemas[resourceType] = outputSchema
+	}
+	return schemas, nil
+}
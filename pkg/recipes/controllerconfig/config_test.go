@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllerconfig
+
+import (
+	"testing"
+
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newOutputSchemas(t *testing.T) {
+	schemas, err := newOutputSchemas()
+	require.NoError(t, err)
+	require.Len(t, schemas, 7)
+
+	tests := []struct {
+		resourceType string
+		output       *recipes.RecipeOutput
+		noRequired   bool
+	}{
+		{
+			resourceType: dapr_ctrl.DaprStateStoresResourceType,
+			output:       &recipes.RecipeOutput{Values: map[string]any{"componentName": "statestore"}},
+			noRequired:   true,
+		},
+		{
+			resourceType: dapr_ctrl.DaprPubSubBrokersResourceType,
+			output:       &recipes.RecipeOutput{Values: map[string]any{"componentName": "pubsub"}},
+			noRequired:   true,
+		},
+		{
+			resourceType: dapr_ctrl.DaprSecretStoresResourceType,
+			output:       &recipes.RecipeOutput{Values: map[string]any{"componentName": "secretstore"}},
+			noRequired:   true,
+		},
+		{
+			resourceType: ds_ctrl.MongoDatabasesResourceType,
+			output: &recipes.RecipeOutput{
+				Values:  map[string]any{"host": "mongo", "port": float64(27017), "database": "db"},
+				Secrets: map[string]any{"connectionString": "mongodb://mongo:27017/db"},
+			},
+		},
+		{
+			resourceType: ds_ctrl.RedisCachesResourceType,
+			output: &recipes.RecipeOutput{
+				Values:  map[string]any{"host": "redis", "port": float64(6379), "tls": true},
+				Secrets: map[string]any{"password": "password"},
+			},
+		},
+		{
+			resourceType: ds_ctrl.SqlDatabasesResourceType,
+			output: &recipes.RecipeOutput{
+				Values: map[string]any{"server": "sql", "port": float64(1433), "database": "db"},
+			},
+		},
+		{
+			resourceType: msg_ctrl.RabbitMQQueuesResourceType,
+			output: &recipes.RecipeOutput{
+				Values:  map[string]any{"queue": "queue", "host": "rabbitmq", "port": float64(5672)},
+				Secrets: map[string]any{"uri": "amqp://rabbitmq:5672"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			schema, ok := schemas[tt.resourceType]
+			require.True(t, ok)
+			require.NoError(t, schema.Validate(tt.output, nil))
+
+			err := schema.Validate(&recipes.RecipeOutput{}, nil)
+			if tt.noRequired {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, recipes.MissingRecipeOutputs, recipes.GetErrorDetails(err).Code)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -29,6 +30,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"oras.land/oras-go/v2/registry/remote"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
@@ -40,7 +42,10 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
+	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
 	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
@@ -85,45 +90,11 @@ type bicepDriver struct {
 func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+	ctx = withEnvironmentCredential(ctx, opts.Configuration)
 
-	recipeData := make(map[string]any)
-	downloadStartTime := time.Now()
-
-	err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, d.RegistryClient)
-	if err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
-		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
-		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
-
-	// create the context object to be passed to the recipe deployment
-	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// get the parameters after resolving the conflict between developer and operator parameters
-	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
-	isContextParameterDefined := hasContextParameter(recipeData)
-	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
-
-	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
-	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts, recipes.RecipeDeploymentFailed)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
-	}
-
-	// Provider config will specify the Azure and AWS scopes (if provided).
-	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
-
-	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
-	if providerConfig.AWS != nil {
-		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
-	}
-	if providerConfig.Az != nil {
-		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+		return nil, err
 	}
 
 	if opts.Configuration.Simulated {
@@ -131,19 +102,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 		return nil, nil
 	}
 
-	poller, err := d.DeploymentClient.CreateOrUpdate(
-		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
-		deploymentID.String(),
-		clients.DeploymentsClientAPIVersion,
-	)
+	poller, err := d.DeploymentClient.CreateOrUpdate(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
 
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
@@ -151,12 +110,27 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 
 	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
 	if err != nil {
+		if v1.IsOperationCanceled(ctx) {
+			// The deployment keeps running in the deployment engine unless it is canceled as well. The request context
+			// is already canceled, so the cancellation is sent without it.
+			if cancelErr := d.DeploymentClient.Cancel(context.WithoutCancel(ctx), deploymentID.String(), clients.DeploymentsClientAPIVersion); cancelErr != nil {
+				logger.Error(cancelErr, "failed to cancel the recipe deployment", "deploymentID", deploymentID)
+			} else {
+				logger.Info("canceled the recipe deployment", "deploymentID", deploymentID)
+			}
+		}
+
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
 	recipeResponse, err := d.prepareRecipeResponse(opts.BaseOptions.Definition.TemplatePath, resp.Properties.Outputs, resp.Properties.OutputResources)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		// The resources of the template are deployed, so they are returned with the error.
+		deployed := &recipes.RecipeOutput{Resources: []string{}}
+		for _, id := range resp.Properties.OutputResources {
+			deployed.Resources = append(deployed.Resources, *id.ID)
+		}
+		return deployed, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
 	// When a Radius portable resource consuming a recipe is redeployed, Garbage collection of the recipe resources that aren't included
@@ -164,25 +138,293 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 	// as bicep does not take care of automatically deleting the unused resources.
 	// Identify the output resources that are no longer relevant to the recipe.
 	garbageCollectionStartTime := time.Now()
-	diff, err := d.getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
 	if err != nil {
-		return nil, err
+		return recipeResponse, err
 	}
 
 	// Deleting obsolete output resources.
 	err = d.Delete(ctx, DeleteOptions{
+		BaseOptions:     opts.BaseOptions,
 		OutputResources: diff,
 	})
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.FailedOperationState))
-		return nil, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+		return recipeResponse, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
 	return recipeResponse, nil
 }
 
+// Plan fetches recipe contents from container registry and predicts the changes the deployment of the bicep template for
+// the recipe would make using the what-if operation of UCP deployment client. The output resources of the previous
+// deployment that are not part of the prediction are reported as deleted, as they are garbage collected on deployment.
+func (d *bicepDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Planning recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+	ctx = withEnvironmentCredential(ctx, opts.Configuration)
+
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	recipePlan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping what-if")
+		return recipePlan, nil
+	}
+
+	changes, err := d.whatIf(ctx, deploymentID, deployment)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, fmt.Sprintf("failed to plan recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	planned := map[string]bool{}
+	for _, change := range changes {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		action, ok := toChangeAction(*change.ChangeType)
+		if !ok {
+			continue
+		}
+
+		id, err := resources.ParseResource(*change.ResourceID)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		planned[strings.ToLower(id.String())] = true
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{
+			Action: action,
+			Type:   id.Type(),
+			Name:   id.String(),
+		})
+	}
+
+	for _, prev := range opts.PrevState {
+		if planned[strings.ToLower(prev)] {
+			continue
+		}
+
+		id, err := resources.ParseResource(prev)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{
+			Action: recipes.ChangeActionDelete,
+			Type:   id.Type(),
+			Name:   id.String(),
+		})
+	}
+
+	return recipePlan, nil
+}
+
+// DetectDrift predicts the changes the deployment of the recipe would make using the what-if operation of UCP deployment
+// client. The recipe is deployed with the version and the parameters the resource was deployed with, so the resources the
+// deployment would create or modify have drifted: they were deleted or their properties were changed out-of-band. The
+// output resources of the previous deployment whose changes what-if cannot predict are only checked for existence.
+func (d *bicepDriver) DetectDrift(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeDrift, error) {
+	if opts.Configuration.Simulated {
+		return &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}, nil
+	}
+	ctx = withEnvironmentCredential(ctx, opts.Configuration)
+
+	deploymentID, deployment, err := d.prepareDeployment(ctx, opts, recipes.RecipeDriftDetectionFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := d.whatIf(ctx, deploymentID, deployment)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, fmt.Sprintf("failed to detect drift of recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	drift := &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}
+	predicted := map[string]bool{}
+	for _, change := range changes {
+		if change == nil || change.ResourceID == nil || change.ChangeType == nil {
+			continue
+		}
+
+		// What-if predicts a deployment without changes for the resources whose changes it cannot determine.
+		changeType := *change.ChangeType
+		if changeType != armresources.ChangeTypeCreate && changeType != armresources.ChangeTypeModify &&
+			changeType != armresources.ChangeTypeNoChange && changeType != armresources.ChangeTypeDelete {
+			continue
+		}
+
+		id, err := resources.ParseResource(*change.ResourceID)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		predicted[strings.ToLower(id.String())] = true
+		if changeType == armresources.ChangeTypeCreate || changeType == armresources.ChangeTypeModify {
+			action, _ := toChangeAction(changeType)
+			drift.Resources = append(drift.Resources, recipes.ResourceChange{
+				Action: action,
+				Type:   id.Type(),
+				Name:   id.String(),
+			})
+		}
+	}
+
+	unpredicted := []string{}
+	for _, prev := range opts.PrevState {
+		if !predicted[strings.ToLower(prev)] {
+			unpredicted = append(unpredicted, prev)
+		}
+	}
+
+	deleted, err := detectDeletedResources(ctx, d.ResourceClient, unpredicted)
+	if err != nil {
+		return nil, err
+	}
+	drift.Resources = append(drift.Resources, deleted.Resources...)
+
+	return drift, nil
+}
+
+// whatIf predicts the changes the deployment of the recipe would make to its resources using the what-if operation of
+// UCP deployment client.
+func (d *bicepDriver) whatIf(ctx context.Context, deploymentID resources.ID, deployment clients.Deployment) ([]*armresources.WhatIfChange, error) {
+	poller, err := d.DeploymentClient.WhatIf(ctx, deployment, deploymentID.String(), clients.DeploymentsClientAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := poller.PollUntilDone(ctx, &runtime.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Properties == nil {
+		return nil, nil
+	}
+	return resp.Properties.Changes, nil
+}
+
+// withEnvironmentCredential returns a context selecting the AWS and Azure credentials referenced by the environment, so
+// that the recipe resources are managed in the account and subscription of the environment.
+func withEnvironmentCredential(ctx context.Context, configuration recipes.Configuration) context.Context {
+	ctx = credentials.WithPlaneCredentialName(ctx, resources_aws.PlaneTypeAWS, configuration.Providers.AWS.Credential)
+	return credentials.WithPlaneCredentialName(ctx, resources_azure.PlaneTypeAzure, configuration.Providers.Azure.Credential)
+}
+
+// detectDeletedResources returns the resources deployed by a recipe which no longer exist as drifted resources, which
+// are created again when the recipe is redeployed.
+func detectDeletedResources(ctx context.Context, client processors.ResourceClient, prevState []string) (*recipes.RecipeDrift, error) {
+	drift := &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}
+	for _, prev := range prevState {
+		id, err := resources.ParseResource(prev)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		exists, err := client.Exists(ctx, prev)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, fmt.Sprintf("failed to get resource %s", prev), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		if !exists {
+			drift.Resources = append(drift.Resources, recipes.ResourceChange{
+				Action: recipes.ChangeActionCreate,
+				Type:   id.Type(),
+				Name:   id.String(),
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// toChangeAction maps the change type predicted by the what-if operation to the action of the resource change.
+// Resources which are ignored by the deployment or not supported by what-if are not reported.
+func toChangeAction(changeType armresources.ChangeType) (recipes.ChangeAction, bool) {
+	switch changeType {
+	case armresources.ChangeTypeCreate:
+		return recipes.ChangeActionCreate, true
+	case armresources.ChangeTypeModify, armresources.ChangeTypeDeploy:
+		return recipes.ChangeActionUpdate, true
+	case armresources.ChangeTypeDelete:
+		return recipes.ChangeActionDelete, true
+	case armresources.ChangeTypeNoChange:
+		return recipes.ChangeActionNoOp, true
+	default:
+		return "", false
+	}
+}
+
+// prepareDeployment fetches recipe contents from container registry, creates a deployment ID, a recipe context parameter,
+// recipe parameters and a provider config, and returns the deployment of the bicep template for the recipe. Errors are
+// reported with the given error code.
+func (d *bicepDriver) prepareDeployment(ctx context.Context, opts ExecuteOptions, errorCode string) (resources.ID, clients.Deployment, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	recipeData := make(map[string]any)
+	downloadStartTime := time.Now()
+
+	err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, d.RegistryClient)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	// create the context object to be passed to the recipe deployment
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// get the parameters after resolving the conflict between developer and operator parameters
+	// if the recipe template also has the context parameter defined then add it to the parameter for deployment
+	isContextParameterDefined := hasContextParameter(recipeData)
+	parameters := createRecipeParameters(opts.Recipe.Parameters, opts.Definition.Parameters, isContextParameterDefined, recipeContext)
+
+	deploymentName := deploymentPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	deploymentID, err := createDeploymentID(recipeContext.Resource.ID, deploymentName)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// The deployment engine deploys the template with the default credentials of the AWS and Azure planes, so a recipe
+	// can't be deployed to the account or subscription of another credential selected by the environment.
+	err = validateProviderCredentials(opts.Configuration.Providers)
+	if err != nil {
+		return resources.ID{}, clients.Deployment{}, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, nil)
+	}
+
+	// Provider config will specify the Azure and AWS scopes (if provided).
+	providerConfig := newProviderConfig(deploymentID.FindScope(resources_radius.ScopeResourceGroups), opts.Configuration.Providers)
+
+	logger.Info("deploying bicep template for recipe", "deploymentID", deploymentID)
+	if providerConfig.AWS != nil {
+		logger.Info("using AWS provider", "deploymentID", deploymentID, "scope", providerConfig.AWS.Value.Scope)
+	}
+	if providerConfig.Az != nil {
+		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
+	}
+
+	return deploymentID, clients.Deployment{
+		Properties: &clients.DeploymentProperties{
+			Mode:           armresources.DeploymentModeIncremental,
+			ProviderConfig: &providerConfig,
+			Parameters:     parameters,
+			Template:       recipeData,
+		},
+	}, nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -190,6 +432,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipe
 // all in parallel. Since some resources may depend on others, we may need to retry.
 func (d *bicepDriver) Delete(ctx context.Context, opts DeleteOptions) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
+	ctx = withEnvironmentCredential(ctx, opts.Configuration)
 
 	// Create a waitgroup to track the deletion of each output resource
 	g, groupCtx := errgroup.WithContext(ctx)
@@ -317,6 +560,18 @@ func createDeploymentID(resourceID string, deploymentName string) (resources.ID,
 	return resources.ParseResource(fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s", resourceGroup, deploymentName))
 }
 
+// validateProviderCredentials returns an error if the providers select a credential other than the default credential
+// of their plane, which the deployment engine does not support.
+func validateProviderCredentials(envProviders coredm.Providers) error {
+	if name := credentials.CredentialNameOrDefault(envProviders.AWS.Credential); name != credentials.DefaultCredentialName {
+		return fmt.Errorf("bicep recipes do not support the credential %q selected for the AWS provider", name)
+	}
+	if name := credentials.CredentialNameOrDefault(envProviders.Azure.Credential); name != credentials.DefaultCredentialName {
+		return fmt.Errorf("bicep recipes do not support the credential %q selected for the Azure provider", name)
+	}
+	return nil
+}
+
 func newProviderConfig(resourceGroup string, envProviders coredm.Providers) clients.ProviderConfig {
 	config := clients.NewDefaultProviderConfig(resourceGroup)
 
@@ -381,7 +636,7 @@ func (d *bicepDriver) prepareRecipeResponse(templatePath string, outputs any, re
 
 // getGCOutputResources [GC stands for Garbage Collection] compares two slices of resource ids and
 // returns a slice of OutputResources that contains the elements that are in the "previous" slice but not in the "current".
-func (d *bicepDriver) getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
+func getGCOutputResources(current []string, previous []string) ([]rpv1.OutputResource, error) {
 	// We can easily determine which resources have changed via a brute-force search comparing IDs.
 	// The lists of resources we work with are small, so this is fine.
 	diff := []rpv1.OutputResource{}
@@ -17,20 +17,29 @@ limitations under the License.
 package driver
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	corerp_datamodel "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/rp/util/registrytest"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
 	clients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/test/testcontext"
@@ -412,6 +421,268 @@ func Test_Bicep_Execute_SimulatedEnvironment(t *testing.T) {
 	require.Nil(t, recipesOutput)
 }
 
+func Test_Bicep_Execute_SelectedCredentials(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	// The deployment engine deploys the template with the default credentials, so the deployment is not sent.
+	de := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Fail(t, "unexpected request to the deployment engine", "%s %s", r.Method, r.URL.Path)
+	}))
+	t.Cleanup(de.Close)
+
+	deploymentClient, err := clients.NewResourceDeploymentsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          de.URL,
+		ARMClientOptions: &arm.ClientOptions{ClientOptions: policy.ClientOptions{Retry: policy.RetryOptions{MaxRetries: -1}}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		providers corerp_datamodel.Providers
+		message   string
+	}{
+		{
+			name:      "aws",
+			providers: corerp_datamodel.Providers{AWS: corerp_datamodel.ProvidersAWS{Scope: "/planes/aws/aws/accounts/0000/regions/us-west-2", Credential: "account2"}},
+			message:   `bicep recipes do not support the credential "account2" selected for the AWS provider`,
+		},
+		{
+			name:      "azure",
+			providers: corerp_datamodel.Providers{Azure: corerp_datamodel.ProvidersAzure{Scope: "/subscriptions/0000/resourceGroups/test-rg", Credential: "subscription2"}},
+			message:   `bicep recipes do not support the credential "subscription2" selected for the Azure provider`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			driver, _ := setupDeleteInputs(t)
+			driver.DeploymentClient = deploymentClient
+			driver.RegistryClient = ts.TestServer.Client()
+
+			opts := ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Configuration: recipes.Configuration{
+						Runtime: recipes.RuntimeConfiguration{
+							Kubernetes: &recipes.KubernetesRuntime{
+								Namespace: "test-namespace",
+							},
+						},
+						Providers: tc.providers,
+					},
+					Recipe: recipes.ResourceMetadata{
+						EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+						Name:          "test-recipe",
+						ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/mongoDatabases/test-db",
+					},
+					Definition: recipes.EnvironmentDefinition{
+						Name:         "test-recipe",
+						Driver:       recipes.TemplateKindBicep,
+						TemplatePath: ts.TestImageURL,
+						ResourceType: "Applications.Datastores/mongoDatabases",
+					},
+				},
+			}
+
+			recipeOutput, err := driver.Execute(testcontext.New(t), opts)
+			require.Nil(t, recipeOutput)
+			recipeError, ok := err.(*recipes.RecipeError)
+			require.True(t, ok)
+			require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+			require.Equal(t, tc.message, recipeError.ErrorDetails.Message)
+		})
+	}
+}
+
+func Test_Bicep_Plan_SimulatedEnvironment(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	opts := ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace: "test-namespace",
+					},
+				},
+				Simulated: true,
+			},
+			Recipe: recipes.ResourceMetadata{
+				EnvironmentID: "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				Name:          "test-recipe",
+				ResourceID:    "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.Datastores/mongoDatabases/test-db",
+			},
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "test-recipe",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: ts.TestImageURL,
+				ResourceType: "Applications.Datastores/mongoDatabases",
+			},
+		},
+	}
+	ctx := testcontext.New(t)
+	d := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+	recipePlan, err := d.Plan(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}, recipePlan)
+}
+
+// newWhatIfDriver returns a bicep driver with a fake deployment engine predicting the given changes. The fake records
+// the provider config the changes are predicted with in providerConfig.
+func newWhatIfDriver(t *testing.T, changes []*armresources.WhatIfChange, providerConfig *clients.ProviderConfig) (bicepDriver, *processors.MockResourceClient, ExecuteOptions) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	de := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.True(t, strings.HasSuffix(r.URL.Path, "/whatIf"))
+
+		body := struct {
+			Properties struct {
+				ProviderConfig clients.ProviderConfig `json:"providerconfig"`
+			} `json:"properties"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*providerConfig = body.Properties.ProviderConfig
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(armresources.WhatIfOperationResult{
+			Status:     to.Ptr("Succeeded"),
+			Properties: &armresources.WhatIfOperationProperties{Changes: changes},
+		})
+	}))
+	t.Cleanup(de.Close)
+
+	deploymentClient, err := clients.NewResourceDeploymentsClient(&clients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          de.URL,
+		ARMClientOptions: &arm.ClientOptions{ClientOptions: policy.ClientOptions{Retry: policy.RetryOptions{MaxRetries: -1}}},
+	})
+	require.NoError(t, err)
+
+	driver, client := setupDeleteInputs(t)
+	driver.DeploymentClient = deploymentClient
+	driver.RegistryClient = ts.TestServer.Client()
+
+	opts := ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace: "test-namespace",
+					},
+				},
+			},
+			Recipe: recipes.ResourceMetadata{
+				EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				Name:          "test-recipe",
+				ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/mongoDatabases/test-db",
+			},
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "test-recipe",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: ts.TestImageURL,
+				ResourceType: "Applications.Datastores/mongoDatabases",
+			},
+		},
+	}
+
+	return driver, client, opts
+}
+
+func Test_Bicep_DetectDrift(t *testing.T) {
+	account := "/subscriptions/0000/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/account"
+	vault := "/subscriptions/0000/resourceGroups/test-rg/providers/Microsoft.KeyVault/vaults/vault"
+	cache := "/subscriptions/0000/resourceGroups/test-rg/providers/Microsoft.Cache/redis/cache"
+	deployment := "/planes/kubernetes/local/namespaces/recipe-app/providers/apps/Deployment/redis"
+	service := "/planes/kubernetes/local/namespaces/recipe-app/providers/core/Service/redis"
+
+	changes := []*armresources.WhatIfChange{
+		// The properties of the storage account were changed out-of-band.
+		{ResourceID: to.Ptr(account), ChangeType: to.Ptr(armresources.ChangeTypeModify)},
+		// The key vault was deleted out-of-band.
+		{ResourceID: to.Ptr(vault), ChangeType: to.Ptr(armresources.ChangeTypeCreate)},
+		{ResourceID: to.Ptr(cache), ChangeType: to.Ptr(armresources.ChangeTypeNoChange)},
+		// What-if cannot predict the changes to the Kubernetes resources, which are checked for existence.
+		{ResourceID: to.Ptr(deployment), ChangeType: to.Ptr(armresources.ChangeTypeDeploy)},
+		{ResourceID: to.Ptr(service), ChangeType: to.Ptr(armresources.ChangeTypeUnsupported)},
+	}
+
+	ctx := testcontext.New(t)
+	driver, client, opts := newWhatIfDriver(t, changes, &clients.ProviderConfig{})
+	opts.PrevState = []string{account, vault, cache, deployment, service}
+	client.EXPECT().Exists(gomock.Any(), deployment).Return(true, nil)
+	client.EXPECT().Exists(gomock.Any(), service).Return(false, nil)
+
+	drift, err := driver.DetectDrift(ctx, opts)
+	require.NoError(t, err)
+	require.True(t, drift.Drifted())
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionUpdate, Type: "Microsoft.Storage/storageAccounts", Name: account},
+		{Action: recipes.ChangeActionCreate, Type: "Microsoft.KeyVault/vaults", Name: vault},
+		{Action: recipes.ChangeActionCreate, Type: "core/Service", Name: service},
+	}, drift.Resources)
+}
+
+func Test_Bicep_DetectDrift_Error(t *testing.T) {
+	deployment := "/planes/kubernetes/local/namespaces/recipe-app/providers/apps/Deployment/redis"
+
+	ctx := testcontext.New(t)
+	driver, client, opts := newWhatIfDriver(t, nil, &clients.ProviderConfig{})
+	opts.PrevState = []string{deployment}
+	client.EXPECT().Exists(gomock.Any(), deployment).Return(false, errors.New("failed to get resource"))
+
+	_, err := driver.DetectDrift(ctx, opts)
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDriftDetectionFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Bicep_DetectDrift_SelectedCredentials(t *testing.T) {
+	ctx := testcontext.New(t)
+	var providerConfig clients.ProviderConfig
+	driver, _, opts := newWhatIfDriver(t, nil, &providerConfig)
+	opts.Configuration.Providers = corerp_datamodel.Providers{
+		AWS: corerp_datamodel.ProvidersAWS{Scope: "/planes/aws/aws/accounts/0000/regions/us-west-2", Credential: "account2"},
+	}
+
+	drift, err := driver.DetectDrift(ctx, opts)
+	require.Nil(t, drift)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDriftDetectionFailed, recipeError.ErrorDetails.Code)
+
+	// The changes are not predicted with the default credentials.
+	require.Equal(t, clients.ProviderConfig{}, providerConfig)
+}
+
+func Test_ToChangeAction(t *testing.T) {
+	tests := []struct {
+		changeType armresources.ChangeType
+		action     recipes.ChangeAction
+		ok         bool
+	}{
+		{armresources.ChangeTypeCreate, recipes.ChangeActionCreate, true},
+		{armresources.ChangeTypeModify, recipes.ChangeActionUpdate, true},
+		{armresources.ChangeTypeDeploy, recipes.ChangeActionUpdate, true},
+		{armresources.ChangeTypeDelete, recipes.ChangeActionDelete, true},
+		{armresources.ChangeTypeNoChange, recipes.ChangeActionNoOp, true},
+		{armresources.ChangeTypeIgnore, "", false},
+		{armresources.ChangeTypeUnsupported, "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.changeType), func(t *testing.T) {
+			action, ok := toChangeAction(tc.changeType)
+			require.Equal(t, tc.action, action)
+			require.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
 func setupDeleteInputs(t *testing.T) (bicepDriver, *processors.MockResourceClient) {
 	ctrl := gomock.NewController(t)
 	client := processors.NewMockResourceClient(ctrl)
@@ -461,6 +732,50 @@ func Test_Bicep_Delete_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Bicep_Delete_SelectedCredentials(t *testing.T) {
+	stream := "/planes/aws/aws/accounts/0000/regions/us-west-2/providers/AWS.Kinesis/Stream/test-stream"
+
+	// UCP proxies the deletion to the AWS plane with the credential selected by the environment.
+	var credentialName string
+	ucp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodDelete, r.Method)
+		require.Equal(t, stream, r.URL.Path)
+		credentialName = r.Header.Get(credentials.CredentialNameHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ucp.Close)
+
+	connection, err := sdk.NewDirectConnection(ucp.URL)
+	require.NoError(t, err)
+
+	driver := bicepDriver{
+		ResourceClient: processors.NewResourceClient(nil, connection, nil, nil, nil),
+		options: BicepOptions{
+			DeleteRetryCount:        0,
+			DeleteRetryDelaySeconds: 1,
+		},
+	}
+
+	err = driver.Delete(testcontext.New(t), DeleteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: recipes.Configuration{
+				Providers: corerp_datamodel.Providers{
+					AWS:   corerp_datamodel.ProvidersAWS{Scope: "/planes/aws/aws/accounts/0000/regions/us-west-2", Credential: "account2"},
+					Azure: corerp_datamodel.ProvidersAzure{Scope: "/subscriptions/0000/resourceGroups/test-rg", Credential: "subscription2"},
+				},
+			},
+		},
+		OutputResources: []rpv1.OutputResource{
+			{
+				ID:            resources.MustParse(stream),
+				RadiusManaged: to.Ptr(true),
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "account2", credentialName)
+}
+
 func Test_Bicep_Delete_Error(t *testing.T) {
 	ctx := testcontext.New(t)
 	driver, client := setupDeleteInputs(t)
@@ -549,7 +864,6 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 }
 
 func Test_GetGCOutputResources(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -568,13 +882,12 @@ func Test_GetGCOutputResources(t *testing.T) {
 			RadiusManaged: to.Ptr(true),
 		},
 	}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
 
 func Test_GetGCOutputResources_NoDiff(t *testing.T) {
-	d := &bicepDriver{}
 	before := []string{
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource1",
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
@@ -584,7 +897,7 @@ func Test_GetGCOutputResources_NoDiff(t *testing.T) {
 		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/resource2",
 	}
 	exp := []rpv1.OutputResource{}
-	res, err := d.getGCOutputResources(after, before)
+	res, err := getGCOutputResources(after, before)
 	require.NoError(t, err)
 	require.Equal(t, exp, res)
 }
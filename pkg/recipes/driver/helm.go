@@ -0,0 +1,563 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// HelmRecipeOutputLabel is the label of the ConfigMap, Secret and Service objects of a Helm chart which hold the
+	// outputs of the recipe. The 'result' key of the ConfigMap holds the recipe output as a JSON object with the optional
+	// 'values', 'secrets' and 'resources' properties, each key of the Secret is added to the secrets of the recipe
+	// output, and the address of the Service is added to the values of the recipe output as 'host' and 'port'.
+	HelmRecipeOutputLabel = "radapp.io/recipe-output"
+
+	// helmInstallTimeout is the time to wait for the resources of a release to be ready.
+	helmInstallTimeout = time.Duration(10) * time.Minute
+)
+
+var _ Driver = (*helmDriver)(nil)
+
+// NewHelmDriver creates a new instance of driver to execute a Helm recipe. The Helm client deploys to the cluster Radius
+// runs in, and clusters provides the clients of the clusters registered as Kubernetes planes.
+func NewHelmDriver(helmClient helm.HelmClient, resourceClient processors.ResourceClient, clusters kubeutil.ClusterClientsProvider) Driver {
+	return &helmDriver{
+		helmClient:     helmClient,
+		ResourceClient: resourceClient,
+		clusters:       clusters,
+		newHelmClient:  helm.NewClient,
+	}
+}
+
+// helmDriver represents a driver to interact with Helm Recipes - install, upgrade and uninstall the chart of the recipe.
+type helmDriver struct {
+	helmClient     helm.HelmClient
+	ResourceClient processors.ResourceClient
+
+	// clusters provides the clients of the non-local clusters. This may be nil when only the local cluster is supported.
+	clusters kubeutil.ClusterClientsProvider
+
+	// newHelmClient creates the Helm client of a non-local cluster. Override this for testing.
+	newHelmClient func(restConfig *rest.Config) helm.HelmClient
+}
+
+// clientFor returns the Helm client of the cluster the recipe deploys to.
+func (d *helmDriver) clientFor(ctx context.Context, configuration recipes.Configuration) (helm.HelmClient, error) {
+	planeName := kubernetesPlaneOf(configuration)
+	if kubernetesresources.IsLocalPlane(planeName) {
+		return d.helmClient, nil
+	}
+
+	if d.clusters == nil {
+		return nil, fmt.Errorf("cannot deploy to Kubernetes plane %q: only the local cluster is supported", planeName)
+	}
+
+	clients, err := d.clusters.GetClients(ctx, planeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the clients of Kubernetes plane %q: %w", planeName, err)
+	}
+
+	return d.newHelmClient(clients.Config), nil
+}
+
+// Execute installs the chart of the recipe as a release, or upgrades the release deployed for the resource, into the
+// Kubernetes namespace of the recipe in the cluster targeted by the environment. The objects which are no longer part of the chart are deleted by the upgrade.
+// It returns the recipe output read from the objects of the release labelled as recipe output as they are in the cluster
+// after the release is ready, and the objects of the release as output resources.
+func (d *helmDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q, version: %q", opts.Definition.Name, opts.Definition.TemplatePath, opts.Definition.TemplateVersion))
+
+	options, err := d.releaseOptions(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	helmClient, err := d.clientFor(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	rel, err := helmClient.Upgrade(ctx, options)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse, err := prepareHelmRecipeResponse(ctx, d.ResourceClient, opts.Definition, kubernetesPlaneOf(opts.Configuration), rel)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return recipeResponse, nil
+}
+
+// Delete uninstalls the release deployed for the resource, which deletes the objects of the release.
+func (d *helmDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	namespace, releaseName, err := releaseOf(opts.BaseOptions)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	helmClient, err := d.clientFor(ctx, opts.Configuration)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	err = helmClient.Uninstall(ctx, namespace, releaseName)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return nil
+}
+
+// Plan renders the chart of the recipe without deploying it and compares the rendered objects with the objects of
+// the release deployed for the resource. Objects are compared as rendered, not with the objects in the cluster, so
+// changes made to the objects out-of-band are not reported; DetectDrift reports them.
+func (d *helmDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	recipePlan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+
+	options, err := d.releaseOptions(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if opts.Configuration.Simulated {
+		return recipePlan, nil
+	}
+
+	helmClient, err := d.clientFor(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	current, err := helmClient.Get(ctx, options.Namespace, options.ReleaseName)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	options.DryRun = true
+	planned, err := helmClient.Upgrade(ctx, options)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	plannedObjects, err := manifest.Parse(planned.Manifest, options.Namespace)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	currentObjects := []*unstructured.Unstructured{}
+	if current != nil {
+		currentObjects, err = manifest.Parse(current.Manifest, current.Namespace)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+	}
+
+	planeName := kubernetesPlaneOf(opts.Configuration)
+	currentByID := map[string]*unstructured.Unstructured{}
+	for _, obj := range currentObjects {
+		currentByID[kubernetesObjectID(planeName, obj).String()] = obj
+	}
+
+	for _, obj := range plannedObjects {
+		id := kubernetesObjectID(planeName, obj)
+		action := recipes.ChangeActionCreate
+		if prev, ok := currentByID[id.String()]; ok {
+			action = recipes.ChangeActionUpdate
+			if reflect.DeepEqual(prev.Object, obj.Object) {
+				action = recipes.ChangeActionNoOp
+			}
+			delete(currentByID, id.String())
+		}
+
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{Action: action, Type: id.Type(), Name: id.String()})
+	}
+
+	// The objects of the deployed release which are no longer rendered are deleted by the upgrade.
+	for _, obj := range currentObjects {
+		id := kubernetesObjectID(planeName, obj)
+		if _, ok := currentByID[id.String()]; ok {
+			recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{Action: recipes.ChangeActionDelete, Type: id.Type(), Name: id.String()})
+		}
+	}
+
+	return recipePlan, nil
+}
+
+// DetectDrift compares the objects of the release deployed for the resource with the objects in the cluster targeted
+// by the environment. Objects which were deleted out-of-band have drifted as created, and objects whose fields set by
+// the chart were changed out-of-band have drifted as updated. If the release was uninstalled out-of-band, the output
+// resources of the previous deployment of the recipe which no longer exist have drifted.
+func (d *helmDriver) DetectDrift(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeDrift, error) {
+	if opts.Configuration.Simulated {
+		return &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}, nil
+	}
+
+	namespace, releaseName, err := releaseOf(opts.BaseOptions)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	helmClient, err := d.clientFor(ctx, opts.Configuration)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	current, err := helmClient.Get(ctx, namespace, releaseName)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	} else if current == nil {
+		return detectDeletedResources(ctx, d.ResourceClient, opts.PrevState)
+	}
+
+	objects, err := manifest.Parse(current.Manifest, current.Namespace)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return detectKubernetesDrift(ctx, d.ResourceClient, kubernetesPlaneOf(opts.Configuration), objects)
+}
+
+// GetRecipeMetadata downloads the chart of the recipe and returns its top-level values as the parameters of the recipe.
+func (d *helmDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	helmChart, err := d.helmClient.LoadChart(ctx, helm.ChartReference{
+		Path:    opts.Definition.TemplatePath,
+		Version: opts.Definition.TemplateVersion,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	parameters := map[string]any{}
+	for name, value := range helmChart.Values {
+		parameters[name] = map[string]any{
+			"type":         helmValueType(value),
+			"defaultValue": value,
+		}
+	}
+
+	return map[string]any{
+		"parameters": parameters,
+	}, nil
+}
+
+// releaseOptions returns the options to deploy the release of the recipe, with the template values of the recipe as
+// values of the release.
+func (d *helmDriver) releaseOptions(opts BaseOptions) (helm.Options, error) {
+	if opts.Configuration.Runtime.Kubernetes == nil {
+		return helm.Options{}, errors.New("helm recipes require a Kubernetes environment")
+	}
+
+	values, recipeContext, err := templateValues(opts)
+	if err != nil {
+		return helm.Options{}, err
+	}
+
+	return helm.Options{
+		Chart: helm.ChartReference{
+			Path:    opts.Definition.TemplatePath,
+			Version: opts.Definition.TemplateVersion,
+		},
+		Namespace:   recipeContext.Runtime.Kubernetes.Namespace,
+		ReleaseName: helm.ReleaseName(opts.Recipe.ResourceID, recipeContext.Resource.Name),
+		Values:      values,
+		Timeout:     helmInstallTimeout,
+	}, nil
+}
+
+// templateValues returns the values the templates of Helm and Kubernetes recipes are rendered with, and the recipe
+// context. The values are the parameters of the recipe, where the parameters set by the developer take precedence over
+// the parameters set by the operator, and the recipe context under the 'context' key.
+func templateValues(opts BaseOptions) (map[string]any, *recipecontext.Context, error) {
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The values are plain objects, so that templates can access the properties of the context by their JSON names.
+	b, err := json.Marshal(recipeContext)
+	if err != nil {
+		return nil, nil, err
+	}
+	contextValue := map[string]any{}
+	if err := json.Unmarshal(b, &contextValue); err != nil {
+		return nil, nil, err
+	}
+
+	values := map[string]any{}
+	for k, v := range opts.Definition.Parameters {
+		values[k] = v
+	}
+	for k, v := range opts.Recipe.Parameters {
+		values[k] = v
+	}
+	values[recipecontext.RecipeContextParamKey] = contextValue
+
+	return values, recipeContext, nil
+}
+
+// releaseOf returns the namespace and the name of the release of the recipe.
+func releaseOf(opts BaseOptions) (namespace string, releaseName string, err error) {
+	if opts.Configuration.Runtime.Kubernetes == nil {
+		return "", "", errors.New("helm recipes require a Kubernetes environment")
+	}
+
+	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
+	if err != nil {
+		return "", "", err
+	}
+
+	return recipeContext.Runtime.Kubernetes.Namespace, helm.ReleaseName(opts.Recipe.ResourceID, recipeContext.Resource.Name), nil
+}
+
+// prepareHelmRecipeResponse populates the recipe response from the objects of the release labelled as recipe output
+// and returns the objects of the release in the cluster of the given Kubernetes plane as the resources deployed by the
+// recipe.
+func prepareHelmRecipeResponse(ctx context.Context, client processors.ResourceClient, definition recipes.EnvironmentDefinition, planeName string, rel *release.Release) (*recipes.RecipeOutput, error) {
+	if rel == nil {
+		return &recipes.RecipeOutput{}, errors.New("helm release is empty")
+	}
+
+	objects, err := manifest.Parse(rel.Manifest, rel.Namespace)
+	if err != nil {
+		return &recipes.RecipeOutput{}, err
+	}
+
+	recipeResponse, err := prepareObjectsRecipeResponse(ctx, client, objects, planeName, func(obj *unstructured.Unstructured) bool {
+		return obj.GetLabels()[HelmRecipeOutputLabel] == "true"
+	})
+	if err != nil {
+		return &recipes.RecipeOutput{}, err
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind:    recipes.TemplateKindHelm,
+		TemplatePath:    definition.TemplatePath,
+		TemplateVersion: definition.TemplateVersion,
+	}
+
+	return recipeResponse, nil
+}
+
+// prepareObjectsRecipeResponse populates the recipe response from the Kubernetes objects deployed by a recipe. The
+// objects selected as recipe output are read from the cluster of the given Kubernetes plane, so that the values set
+// when the objects are deployed, like the addresses of services, are part of the recipe output. The 'result' key of the
+// ConfigMap objects holds the recipe output as a JSON object, each key of the Secret objects is added to the secrets of
+// the recipe output, and the address of the Service objects is added to the values of the recipe output as 'host' and
+// 'port', unless the values are set by a ConfigMap. The objects are returned as the resources deployed by the recipe.
+func prepareObjectsRecipeResponse(ctx context.Context, client processors.ResourceClient, objects []*unstructured.Unstructured, planeName string, isOutput func(obj *unstructured.Unstructured) bool) (*recipes.RecipeOutput, error) {
+	recipeResponse := &recipes.RecipeOutput{
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+		Resources: []string{},
+	}
+
+	serviceValues := map[string]any{}
+	deployedResources := []string{}
+	for _, obj := range objects {
+		id := kubernetesObjectID(planeName, obj)
+		deployedResources = append(deployedResources, id.String())
+
+		if !isOutput(obj) {
+			continue
+		}
+
+		live, err := client.GetKubernetesObject(ctx, id.String())
+		if err != nil {
+			return &recipes.RecipeOutput{}, fmt.Errorf("failed to read recipe output %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		} else if live == nil {
+			return &recipes.RecipeOutput{}, fmt.Errorf("recipe output %s %q was not found", obj.GetKind(), obj.GetName())
+		}
+		obj = live
+
+		switch obj.GetKind() {
+		case "ConfigMap":
+			result, ok, err := unstructured.NestedString(obj.Object, "data", recipes.ResultPropertyName)
+			if err != nil {
+				return &recipes.RecipeOutput{}, fmt.Errorf("invalid recipe output config map %q: %w", obj.GetName(), err)
+			} else if !ok {
+				continue
+			}
+
+			resultValue := map[string]any{}
+			if err := json.Unmarshal([]byte(result), &resultValue); err != nil {
+				return &recipes.RecipeOutput{}, fmt.Errorf("invalid %q of recipe output config map %q: %w", recipes.ResultPropertyName, obj.GetName(), err)
+			}
+
+			output := &recipes.RecipeOutput{}
+			if err := output.PrepareRecipeResponse(resultValue); err != nil {
+				return &recipes.RecipeOutput{}, fmt.Errorf("invalid %q of recipe output config map %q: %w", recipes.ResultPropertyName, obj.GetName(), err)
+			}
+
+			for k, v := range output.Values {
+				recipeResponse.Values[k] = v
+			}
+			for k, v := range output.Secrets {
+				recipeResponse.Secrets[k] = v
+			}
+			recipeResponse.Resources = append(recipeResponse.Resources, output.Resources...)
+		case "Secret":
+			data, _, err := unstructured.NestedStringMap(obj.Object, "data")
+			if err != nil {
+				return &recipes.RecipeOutput{}, fmt.Errorf("invalid recipe output secret %q: %w", obj.GetName(), err)
+			}
+			for k, v := range data {
+				decoded, err := base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return &recipes.RecipeOutput{}, fmt.Errorf("invalid value of key %q of recipe output secret %q: %w", k, obj.GetName(), err)
+				}
+				recipeResponse.Secrets[k] = string(decoded)
+			}
+		case "Service":
+			host, port, err := serviceAddress(obj)
+			if err != nil {
+				return &recipes.RecipeOutput{}, fmt.Errorf("invalid recipe output service %q: %w", obj.GetName(), err)
+			}
+			if host != "" {
+				serviceValues["host"] = host
+			}
+			if port != 0 {
+				serviceValues["port"] = port
+			}
+		}
+	}
+
+	for k, v := range serviceValues {
+		if _, ok := recipeResponse.Values[k]; !ok {
+			recipeResponse.Values[k] = v
+		}
+	}
+
+	uniqueResourceIDs := []string{}
+	for _, val := range recipeResponse.Resources {
+		uniqueResourceIDs = append(uniqueResourceIDs, strings.ToLower(val))
+	}
+
+	for _, val := range deployedResources {
+		if !slices.Contains(uniqueResourceIDs, strings.ToLower(val)) {
+			recipeResponse.Resources = append(recipeResponse.Resources, val)
+		}
+	}
+
+	return recipeResponse, nil
+}
+
+// serviceAddress returns the address of a service: the first ingress point of its load balancer, or its cluster IP if
+// the service has no load balancer ingress, and its first port.
+func serviceAddress(service *unstructured.Unstructured) (host string, port int64, err error) {
+	ingress, _, err := unstructured.NestedSlice(service.Object, "status", "loadBalancer", "ingress")
+	if err != nil {
+		return "", 0, err
+	}
+	if len(ingress) > 0 {
+		if point, ok := ingress[0].(map[string]any); ok {
+			host, _ = point["hostname"].(string)
+			if ip, ok := point["ip"].(string); ok && ip != "" {
+				host = ip
+			}
+		}
+	}
+
+	if host == "" {
+		host, _, err = unstructured.NestedString(service.Object, "spec", "clusterIP")
+		if err != nil {
+			return "", 0, err
+		}
+		if host == "None" {
+			host = ""
+		}
+	}
+
+	ports, _, err := unstructured.NestedSlice(service.Object, "spec", "ports")
+	if err != nil {
+		return "", 0, err
+	}
+	if len(ports) > 0 {
+		if first, ok := ports[0].(map[string]any); ok {
+			value, _ := toFloat64(first["port"])
+			port = int64(value)
+		}
+	}
+
+	return host, port, nil
+}
+
+// kubernetesObjectID returns the UCP resource ID of a Kubernetes object deployed by a recipe to the cluster of the given
+// Kubernetes plane.
+func kubernetesObjectID(planeName string, obj *unstructured.Unstructured) resources.ID {
+	gvk := schema.FromAPIVersionAndKind(obj.GetAPIVersion(), obj.GetKind())
+	return kubernetesresources.IDFromParts(planeName, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
+
+// kubernetesPlaneOf returns the name of the Kubernetes plane of the cluster the recipe deploys to.
+func kubernetesPlaneOf(configuration recipes.Configuration) string {
+	if configuration.Runtime.Kubernetes == nil {
+		return kubernetesresources.PlaneNameLocal
+	}
+	return kubernetesresources.PlaneNameOrDefault(configuration.Runtime.Kubernetes.Cluster)
+}
+
+// helmValueType returns the type of a value of a chart in the format of the parameters of recipes.
+func helmValueType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "bool"
+	case string:
+		return "string"
+	case int, int64, float64:
+		return "number"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,536 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/helm"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	helmTestResourceID  = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	helmTestReleaseName = "redis-test"
+
+	helmTestDeployment = `---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+spec:
+  replicas: 1
+`
+	helmTestService = `---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+`
+	helmTestOutputs = `---
+# Source: redis/templates/output.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  result: '{"values": {"host": "redis.app-ns.svc.cluster.local", "port": 6379}}'
+---
+# Source: redis/templates/output-secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  password: cGFzc3dvcmQ=
+stringData:
+  connectionString: redis://redis:6379
+`
+)
+
+func setupHelmDriver(t *testing.T) (*helmDriver, *helm.MockHelmClient, *processors.MockResourceClient) {
+	ctrl := gomock.NewController(t)
+	client := helm.NewMockHelmClient(ctrl)
+	resourceClient := processors.NewMockResourceClient(ctrl)
+	return &helmDriver{helmClient: client, ResourceClient: resourceClient}, client, resourceClient
+}
+
+// expectLiveObjects sets up the resource client to return the given objects, by ID, as the objects in the cluster.
+func expectLiveObjects(client *processors.MockResourceClient, live map[string]*unstructured.Unstructured) {
+	client.EXPECT().
+		GetKubernetesObject(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id string) (*unstructured.Unstructured, error) {
+			return live[id], nil
+		}).
+		AnyTimes()
+}
+
+func helmTestOptions() BaseOptions {
+	return BaseOptions{
+		Configuration: recipes.Configuration{
+			Runtime: recipes.RuntimeConfiguration{
+				Kubernetes: &recipes.KubernetesRuntime{
+					Namespace:            "app-ns",
+					EnvironmentNamespace: "env-ns",
+				},
+			},
+		},
+		Recipe: recipes.ResourceMetadata{
+			Name:          "default",
+			EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+			ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+			ResourceID:    helmTestResourceID,
+			Parameters: map[string]any{
+				"replicas": 3,
+			},
+		},
+		Definition: recipes.EnvironmentDefinition{
+			Name:            "default",
+			Driver:          recipes.TemplateKindHelm,
+			TemplatePath:    "oci://ghcr.io/radius-project/charts/redis",
+			TemplateVersion: "1.2.0",
+			ResourceType:    "Applications.Datastores/redisCaches",
+			Parameters: map[string]any{
+				"replicas": 1,
+				"image":    "redis:7",
+			},
+		},
+	}
+}
+
+func Test_Helm_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, resourceClient := setupHelmDriver(t)
+
+	client.EXPECT().
+		Upgrade(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, options helm.Options) (*release.Release, error) {
+			require.Equal(t, helm.ChartReference{Path: "oci://ghcr.io/radius-project/charts/redis", Version: "1.2.0"}, options.Chart)
+			require.Equal(t, "app-ns", options.Namespace)
+			require.Equal(t, helm.ReleaseName(helmTestResourceID, "redis"), options.ReleaseName)
+			require.False(t, options.DryRun)
+
+			// The parameters of the developer take precedence over the parameters of the operator.
+			require.Equal(t, 3, options.Values["replicas"])
+			require.Equal(t, "redis:7", options.Values["image"])
+
+			recipeContext, ok := options.Values["context"].(map[string]any)
+			require.True(t, ok)
+			require.Equal(t, "redis", recipeContext["resource"].(map[string]any)["name"])
+			require.Equal(t, "app-ns", recipeContext["runtime"].(map[string]any)["kubernetes"].(map[string]any)["namespace"])
+
+			return &release.Release{Namespace: "app-ns", Manifest: helmTestDeployment + helmTestService + helmTestOutputs}, nil
+		})
+
+	// The API server merges the string data of the secret into its data.
+	objects, err := manifest.Parse(helmTestOutputs, "app-ns")
+	require.NoError(t, err)
+	secret := objects[1].DeepCopy()
+	delete(secret.Object, "stringData")
+	secret.Object["data"] = map[string]any{"password": "cGFzc3dvcmQ=", "connectionString": "cmVkaXM6Ly9yZWRpczo2Mzc5"}
+	expectLiveObjects(resourceClient, map[string]*unstructured.Unstructured{
+		"/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output": objects[0],
+		"/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-output":    secret,
+	})
+
+	output, err := d.Execute(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.NoError(t, err)
+
+	expected := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "redis.app-ns.svc.cluster.local",
+			"port": float64(6379),
+		},
+		Secrets: map[string]any{
+			"password":         "password",
+			"connectionString": "redis://redis:6379",
+		},
+		Resources: []string{
+			"/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output",
+			"/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-output",
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind:    recipes.TemplateKindHelm,
+			TemplatePath:    "oci://ghcr.io/radius-project/charts/redis",
+			TemplateVersion: "1.2.0",
+		},
+	}
+	require.Equal(t, expected, output)
+}
+
+type fakeClusterClientsProvider struct {
+	clients map[string]*kubeutil.Clients
+}
+
+func (p *fakeClusterClientsProvider) GetClients(ctx context.Context, planeName string) (*kubeutil.Clients, error) {
+	clients, ok := p.clients[planeName]
+	if !ok {
+		return nil, errors.New("plane not found")
+	}
+	return clients, nil
+}
+
+func Test_Helm_RemoteCluster(t *testing.T) {
+	prodConfig := &rest.Config{Host: "https://ucp/planes/kubernetes/prod"}
+	setup := func(t *testing.T) (*helmDriver, *helm.MockHelmClient) {
+		d, local, _ := setupHelmDriver(t)
+		remote := helm.NewMockHelmClient(gomock.NewController(t))
+		d.clusters = &fakeClusterClientsProvider{clients: map[string]*kubeutil.Clients{"prod": {Config: prodConfig}}}
+		d.newHelmClient = func(restConfig *rest.Config) helm.HelmClient {
+			require.Same(t, prodConfig, restConfig)
+			return remote
+		}
+
+		// The client of the local cluster is never used.
+		local.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Times(0)
+		local.EXPECT().Uninstall(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		return d, remote
+	}
+
+	options := func(cluster string) BaseOptions {
+		opts := helmTestOptions()
+		opts.Configuration.Runtime.Kubernetes.Cluster = cluster
+		return opts
+	}
+
+	t.Run("execute installs into the cluster of the plane", func(t *testing.T) {
+		d, remote := setup(t)
+		remote.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(&release.Release{Namespace: "app-ns", Manifest: helmTestDeployment}, nil)
+
+		output, err := d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: options("prod")})
+		require.NoError(t, err)
+		require.Equal(t, []string{"/planes/kubernetes/prod/namespaces/app-ns/providers/apps/Deployment/redis"}, output.Resources)
+	})
+
+	t.Run("delete uninstalls from the cluster of the plane", func(t *testing.T) {
+		d, remote := setup(t)
+		remote.EXPECT().Uninstall(gomock.Any(), "app-ns", helm.ReleaseName(helmTestResourceID, "redis")).Return(nil)
+
+		err := d.Delete(testcontext.New(t), DeleteOptions{BaseOptions: options("prod")})
+		require.NoError(t, err)
+	})
+
+	t.Run("plane is not registered", func(t *testing.T) {
+		d, _ := setup(t)
+
+		_, err := d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: options("test")})
+		require.ErrorContains(t, err, "failed to initialize the clients of Kubernetes plane \"test\"")
+	})
+
+	t.Run("only the local cluster is supported", func(t *testing.T) {
+		d, _, _ := setupHelmDriver(t)
+
+		_, err := d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: options("prod")})
+		require.ErrorContains(t, err, "cannot deploy to Kubernetes plane \"prod\": only the local cluster is supported")
+	})
+}
+
+func Test_Helm_Execute_SimulatedEnvironment(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _ := setupHelmDriver(t)
+
+	opts := helmTestOptions()
+	opts.Configuration.Simulated = true
+
+	output, err := d.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Nil(t, output)
+}
+
+func Test_Helm_Execute_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(nil, errors.New("timed out waiting for the condition"))
+
+	_, err := d.Execute(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_InvalidOutput(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, resourceClient := setupHelmDriver(t)
+
+	outputManifest := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-output
+  labels:
+    radapp.io/recipe-output: "true"
+data:
+  result: '{"unknown": {}}'
+`
+	client.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(&release.Release{Namespace: "app-ns", Manifest: outputManifest}, nil)
+
+	objects, err := manifest.Parse(outputManifest, "app-ns")
+	require.NoError(t, err)
+	expectLiveObjects(resourceClient, map[string]*unstructured.Unstructured{
+		"/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output": objects[0],
+	})
+
+	_, err = d.Execute(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+}
+
+func Test_Helm_Execute_ServiceOutput(t *testing.T) {
+	service := func(status map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata":   map[string]any{"name": "redis", "namespace": "app-ns"},
+			"spec": map[string]any{
+				"type":      "LoadBalancer",
+				"clusterIP": "10.0.0.12",
+				"ports":     []any{map[string]any{"port": int64(6379), "protocol": "TCP"}},
+			},
+			"status": status,
+		}}
+	}
+
+	serviceManifest := `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+  labels:
+    radapp.io/recipe-output: "true"
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 6379
+`
+	serviceID := "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"
+
+	tests := []struct {
+		name     string
+		live     *unstructured.Unstructured
+		expected map[string]any
+	}{
+		{
+			name:     "load balancer ingress",
+			live:     service(map[string]any{"loadBalancer": map[string]any{"ingress": []any{map[string]any{"ip": "20.1.2.3"}}}}),
+			expected: map[string]any{"host": "20.1.2.3", "port": int64(6379)},
+		},
+		{
+			name:     "load balancer ingress hostname",
+			live:     service(map[string]any{"loadBalancer": map[string]any{"ingress": []any{map[string]any{"hostname": "redis.example.com"}}}}),
+			expected: map[string]any{"host": "redis.example.com", "port": int64(6379)},
+		},
+		{
+			name:     "cluster IP",
+			live:     service(map[string]any{}),
+			expected: map[string]any{"host": "10.0.0.12", "port": int64(6379)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, client, resourceClient := setupHelmDriver(t)
+			client.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(&release.Release{Namespace: "app-ns", Manifest: serviceManifest}, nil)
+			expectLiveObjects(resourceClient, map[string]*unstructured.Unstructured{serviceID: tc.live})
+
+			output, err := d.Execute(testcontext.New(t), ExecuteOptions{BaseOptions: helmTestOptions()})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, output.Values)
+		})
+	}
+}
+
+func Test_Helm_Execute_NoKubernetesRuntime(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _ := setupHelmDriver(t)
+
+	opts := helmTestOptions()
+	opts.Configuration.Runtime.Kubernetes = nil
+
+	_, err := d.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.ErrorContains(t, err, "helm recipes require a Kubernetes environment")
+}
+
+func Test_Helm_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().Uninstall(gomock.Any(), "app-ns", helm.ReleaseName(helmTestResourceID, "redis")).Return(nil)
+
+	err := d.Delete(ctx, DeleteOptions{BaseOptions: helmTestOptions()})
+	require.NoError(t, err)
+}
+
+func Test_Helm_Delete_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().Uninstall(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("failed to delete"))
+
+	err := d.Delete(ctx, DeleteOptions{BaseOptions: helmTestOptions()})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeletionFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Helm_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	current := &release.Release{Namespace: "app-ns", Manifest: helmTestDeployment + helmTestService}
+	client.EXPECT().Get(gomock.Any(), "app-ns", helm.ReleaseName(helmTestResourceID, "redis")).Return(current, nil)
+
+	client.EXPECT().
+		Upgrade(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, options helm.Options) (*release.Release, error) {
+			require.True(t, options.DryRun)
+			deployment := `---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+spec:
+  replicas: 3
+`
+			return &release.Release{Namespace: "app-ns", Manifest: deployment + helmTestOutputs}, nil
+		})
+
+	plan, err := d.Plan(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionUpdate, Type: "apps/Deployment", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"},
+		{Action: recipes.ChangeActionCreate, Type: "core/ConfigMap", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output"},
+		{Action: recipes.ChangeActionCreate, Type: "core/Secret", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Secret/redis-output"},
+		{Action: recipes.ChangeActionDelete, Type: "core/Service", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"},
+	}, plan.Changes)
+}
+
+func Test_Helm_Plan_NotInstalled(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	client.EXPECT().Upgrade(gomock.Any(), gomock.Any()).Return(&release.Release{Namespace: "app-ns", Manifest: helmTestDeployment}, nil)
+
+	plan, err := d.Plan(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionCreate, Type: "apps/Deployment", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"},
+	}, plan.Changes)
+}
+
+func Test_Helm_DetectDrift(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, resourceClient := setupHelmDriver(t)
+
+	current := &release.Release{Namespace: "app-ns", Manifest: helmTestDeployment + helmTestService}
+	client.EXPECT().Get(gomock.Any(), "app-ns", helm.ReleaseName(helmTestResourceID, "redis")).Return(current, nil)
+
+	// The deployment was scaled out-of-band, and the service was deleted out-of-band.
+	deploymentID := "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"
+	resourceClient.EXPECT().
+		GetKubernetesObject(gomock.Any(), deploymentID).
+		Return(&unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "redis", "namespace": "app-ns"},
+			"spec":       map[string]any{"replicas": int64(5)},
+		}}, nil)
+	resourceClient.EXPECT().
+		GetKubernetesObject(gomock.Any(), "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis").
+		Return(nil, nil)
+
+	drift, err := d.DetectDrift(ctx, ExecuteOptions{BaseOptions: helmTestOptions()})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionUpdate, Type: "apps/Deployment", Name: deploymentID},
+		{Action: recipes.ChangeActionCreate, Type: "core/Service", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"},
+	}, drift.Resources)
+}
+
+func Test_Helm_DetectDrift_Uninstalled(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, resourceClient := setupHelmDriver(t)
+
+	deploymentID := "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"
+	client.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	resourceClient.EXPECT().Exists(gomock.Any(), deploymentID).Return(false, nil)
+
+	drift, err := d.DetectDrift(ctx, ExecuteOptions{BaseOptions: helmTestOptions(), PrevState: []string{deploymentID}})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionCreate, Type: "apps/Deployment", Name: deploymentID},
+	}, drift.Resources)
+}
+
+func Test_Helm_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().
+		LoadChart(gomock.Any(), helm.ChartReference{Path: "oci://ghcr.io/radius-project/charts/redis", Version: "1.2.0"}).
+		Return(&chart.Chart{Values: map[string]any{"replicas": float64(1), "image": "redis:7", "persistence": map[string]any{"enabled": true}}}, nil)
+
+	metadata, err := d.GetRecipeMetadata(ctx, helmTestOptions())
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"parameters": map[string]any{
+			"replicas":    map[string]any{"type": "number", "defaultValue": float64(1)},
+			"image":       map[string]any{"type": "string", "defaultValue": "redis:7"},
+			"persistence": map[string]any{"type": "object", "defaultValue": map[string]any{"enabled": true}},
+		},
+	}, metadata)
+}
+
+func Test_Helm_GetRecipeMetadata_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, client, _ := setupHelmDriver(t)
+
+	client.EXPECT().LoadChart(gomock.Any(), gomock.Any()).Return(nil, errors.New("chart not found"))
+
+	_, err := d.GetRecipeMetadata(ctx, helmTestOptions())
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeGetMetadataFailed, recipeError.ErrorDetails.Code)
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/releaseutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"oras.land/oras-go/v2/registry/remote"
+
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/manifest"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/rp/util"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	kubernetesresources "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// KubernetesRecipeOutputAnnotation is the annotation of the ConfigMap, Secret and Service objects of a Kubernetes
+	// recipe which hold the outputs of the recipe. The outputs are read from the objects like the outputs of Helm recipes.
+	KubernetesRecipeOutputAnnotation = "radapp.io/recipe-output"
+)
+
+var _ Driver = (*kubernetesDriver)(nil)
+
+// NewKubernetesDriver creates a new instance of driver to execute a Kubernetes recipe. The Kubernetes handler is used to
+// apply the objects of the recipe and wait for them to be ready.
+func NewKubernetesDriver(kubernetesHandler handlers.ResourceHandler, resourceClient processors.ResourceClient) Driver {
+	return &kubernetesDriver{
+		kubernetesHandler: kubernetesHandler,
+		ResourceClient:    resourceClient,
+	}
+}
+
+// kubernetesDriver represents a driver to interact with Kubernetes Recipes - Kubernetes manifests or a kustomization
+// stored in a container registry.
+type kubernetesDriver struct {
+	kubernetesHandler handlers.ResourceHandler
+	ResourceClient    processors.ResourceClient
+
+	// RegistryClient is the optional client used to interact with the container registry.
+	RegistryClient remote.Client
+}
+
+// Execute fetches the manifests of the recipe from the container registry, renders them with the recipe context and the
+// recipe parameters, and applies the objects with server-side apply, waiting for the objects which support it to be
+// ready. The objects of the previous deployment of the recipe which are no longer part of the recipe are deleted.
+// It returns the recipe output read from the objects annotated as recipe output as they are in the cluster after they
+// were applied, and the objects as output resources.
+func (d *kubernetesDriver) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
+
+	objects, err := d.render(ctx, opts.BaseOptions, recipes.RecipeDeploymentFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Configuration.Simulated {
+		logger.Info("simulated environment is set to true, skipping deployment")
+		return nil, nil
+	}
+
+	// The objects are applied to the cluster of the Kubernetes plane of their IDs.
+	// If an object fails to apply, the objects applied so far are returned with the error. The object which failed
+	// is included, as it may have been created before it failed to become ready.
+	planeName := kubernetesPlaneOf(opts.Configuration)
+	applied := &recipes.RecipeOutput{Resources: []string{}}
+	for _, obj := range objects {
+		resource := rpv1.NewKubernetesOutputResource(obj.GetName(), obj, metav1.ObjectMeta{Name: obj.GetName(), Namespace: obj.GetNamespace()})
+		resource.ID = kubernetesresources.WithPlaneName(resource.ID, planeName)
+		applied.Resources = append(applied.Resources, resource.ID.String())
+		_, err := d.kubernetesHandler.Put(ctx, &handlers.PutOptions{Resource: &resource})
+		if err != nil {
+			err = fmt.Errorf("failed to apply %s %q: %w", obj.GetKind(), obj.GetName(), err)
+			return applied, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+	}
+
+	recipeResponse, err := prepareObjectsRecipeResponse(ctx, d.ResourceClient, objects, planeName, func(obj *unstructured.Unstructured) bool {
+		return obj.GetAnnotations()[KubernetesRecipeOutputAnnotation] == "true"
+	})
+	if err != nil {
+		return applied, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output: %s", err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	recipeResponse.Status = &rpv1.RecipeStatus{
+		TemplateKind: recipes.TemplateKindKubernetes,
+		TemplatePath: opts.Definition.TemplatePath,
+	}
+
+	// Identify the output resources that are no longer relevant to the recipe.
+	garbageCollectionStartTime := time.Now()
+	diff, err := getGCOutputResources(recipeResponse.Resources, opts.PrevState)
+	if err != nil {
+		return recipeResponse, err
+	}
+
+	// Deleting obsolete output resources.
+	err = d.Delete(ctx, DeleteOptions{
+		OutputResources: diff,
+	})
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.FailedOperationState))
+		return recipeResponse, recipes.NewRecipeError(recipes.RecipeGarbageCollectionFailed, err.Error(), recipes_util.ExecutionError, nil)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeGarbageCollectionDuration(ctx, garbageCollectionStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationGC, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	return recipeResponse, nil
+}
+
+// Delete deletes the output resources of the recipe managed by Radius, in the reverse order of their deployment.
+func (d *kubernetesDriver) Delete(ctx context.Context, opts DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for i := len(opts.OutputResources) - 1; i >= 0; i-- {
+		outputResource := opts.OutputResources[i]
+		id := outputResource.ID.String()
+
+		// If the resource is not managed by Radius, skip the deletion
+		if !outputResource.IsRadiusManaged() {
+			logger.Info(fmt.Sprintf("Skipping deletion of output resource: %q, not managed by Radius", id))
+			continue
+		}
+
+		err := d.ResourceClient.Delete(ctx, id)
+		if err != nil {
+			return recipes.NewRecipeError(recipes.RecipeDeletionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+		}
+		logger.V(ucplog.LevelInfo).Info(fmt.Sprintf("Deleted output resource: %q", id))
+	}
+
+	return nil
+}
+
+// Plan renders the manifests of the recipe and compares the rendered objects with the output resources of the previous
+// deployment of the recipe. The objects which were deployed before are reported as updated, as the objects are not
+// compared with their state in the cluster; DetectDrift compares them.
+func (d *kubernetesDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	objects, err := d.render(ctx, opts.BaseOptions, recipes.RecipePlanFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	recipePlan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if opts.Configuration.Simulated {
+		return recipePlan, nil
+	}
+
+	previous := map[string]bool{}
+	for _, prev := range opts.PrevState {
+		previous[strings.ToLower(prev)] = true
+	}
+
+	planeName := kubernetesPlaneOf(opts.Configuration)
+	planned := map[string]bool{}
+	for _, obj := range objects {
+		id := kubernetesObjectID(planeName, obj)
+		planned[strings.ToLower(id.String())] = true
+
+		action := recipes.ChangeActionCreate
+		if previous[strings.ToLower(id.String())] {
+			action = recipes.ChangeActionUpdate
+		}
+
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{Action: action, Type: id.Type(), Name: id.String()})
+	}
+
+	for _, prev := range opts.PrevState {
+		if planned[strings.ToLower(prev)] {
+			continue
+		}
+
+		id, err := resources.ParseResource(prev)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipePlanFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{Action: recipes.ChangeActionDelete, Type: id.Type(), Name: id.String()})
+	}
+
+	return recipePlan, nil
+}
+
+// DetectDrift renders the manifests of the recipe and compares the rendered objects with the objects in the cluster of
+// their Kubernetes plane. The engine pins the definition to the template the resource was deployed with, so the
+// rendered objects are the objects of the previous deployment. Objects which were deleted out-of-band have drifted as
+// created, and objects whose fields set by the manifests were changed out-of-band have drifted as updated.
+func (d *kubernetesDriver) DetectDrift(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeDrift, error) {
+	if opts.Configuration.Simulated {
+		return &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}, nil
+	}
+
+	objects, err := d.render(ctx, opts.BaseOptions, recipes.RecipeDriftDetectionFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	return detectKubernetesDrift(ctx, d.ResourceClient, kubernetesPlaneOf(opts.Configuration), objects)
+}
+
+// GetRecipeMetadata fetches the manifests of the recipe from the container registry. Kubernetes recipes don't declare
+// their parameters, so the parameters of the recipe are always empty.
+func (d *kubernetesDriver) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	_, err := util.ReadBytesFromRegistry(ctx, opts.Definition, d.RegistryClient)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+
+	return map[string]any{
+		recipeParameters: map[string]any{},
+	}, nil
+}
+
+// render fetches the manifests of the recipe from the container registry and renders them with the template values of
+// the recipe. The objects are returned in the order in which they are applied. Errors are reported with the given
+// error code.
+func (d *kubernetesDriver) render(ctx context.Context, opts BaseOptions, errorCode string) ([]*unstructured.Unstructured, error) {
+	if opts.Configuration.Runtime.Kubernetes == nil {
+		err := errors.New("kubernetes recipes require a Kubernetes environment")
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	downloadStartTime := time.Now()
+	content, err := util.ReadBytesFromRegistry(ctx, opts.Definition, d.RegistryClient)
+	if err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
+		return nil, recipes.NewRecipeError(recipes.RecipeDownloadFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
+
+	values, recipeContext, err := templateValues(opts)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	objects, err := manifest.Render(content, values, recipeContext.Runtime.Kubernetes.Namespace)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	sortByInstallOrder(objects)
+	return objects, nil
+}
+
+// sortByInstallOrder sorts the objects in the order in which Helm installs the objects of a chart, so that objects are
+// applied after the objects they depend on, like namespaces and custom resource definitions. The order of objects of
+// the same kind is preserved.
+func sortByInstallOrder(objects []*unstructured.Unstructured) {
+	order := map[string]int{}
+	for i, kind := range releaseutil.InstallOrder {
+		order[kind] = i
+	}
+
+	rank := func(obj *unstructured.Unstructured) int {
+		if i, ok := order[obj.GetKind()]; ok {
+			return i
+		}
+		return len(releaseutil.InstallOrder)
+	}
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return rank(objects[i]) < rank(objects[j])
+	})
+}
+
+// detectKubernetesDrift compares the objects deployed by a recipe with the objects in the cluster of the given
+// Kubernetes plane. Objects which no longer exist are reported as created, and objects whose fields differ from the
+// fields of the deployed objects are reported as updated. Only the fields set by the deployed objects are compared, as
+// the objects in the cluster also hold the defaults and the status set by Kubernetes.
+func detectKubernetesDrift(ctx context.Context, client processors.ResourceClient, planeName string, objects []*unstructured.Unstructured) (*recipes.RecipeDrift, error) {
+	drift := &recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}
+	for _, obj := range objects {
+		id := kubernetesObjectID(planeName, obj)
+		live, err := client.GetKubernetesObject(ctx, id.String())
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, fmt.Sprintf("failed to get resource %s", id.String()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		if live == nil {
+			drift.Resources = append(drift.Resources, recipes.ResourceChange{Action: recipes.ChangeActionCreate, Type: id.Type(), Name: id.String()})
+		} else if !matchesLiveObject(obj, live) {
+			drift.Resources = append(drift.Resources, recipes.ResourceChange{Action: recipes.ChangeActionUpdate, Type: id.Type(), Name: id.String()})
+		}
+	}
+
+	return drift, nil
+}
+
+// matchesLiveObject reports whether each field of the deployed object has the same value in the object in the cluster.
+// The status is owned by Kubernetes, and the 'stringData' of secrets is merged into their 'data' by the API server, so
+// they are not compared.
+func matchesLiveObject(deployed *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+	for key, value := range deployed.Object {
+		if key == "status" || (key == "stringData" && deployed.GetKind() == "Secret") {
+			continue
+		}
+
+		if !matchesLiveValue(value, live.Object[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesLiveValue reports whether the live value holds the deployed value. Objects match when each field of the deployed
+// object matches the field of the live object, lists match when they have the same length and their items match, and
+// numbers are compared by value, as numbers decoded from manifests and from the API server may have different types.
+func matchesLiveValue(deployed any, live any) bool {
+	switch deployed := deployed.(type) {
+	case nil:
+		return live == nil
+	case map[string]any:
+		liveMap, ok := live.(map[string]any)
+		if !ok {
+			return len(deployed) == 0 && live == nil
+		}
+		for key, value := range deployed {
+			if !matchesLiveValue(value, liveMap[key]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		liveList, ok := live.([]any)
+		if !ok {
+			return len(deployed) == 0 && live == nil
+		}
+		if len(deployed) != len(liveList) {
+			return false
+		}
+		for i := range deployed {
+			if !matchesLiveValue(deployed[i], liveList[i]) {
+				return false
+			}
+		}
+		return true
+	case int64, float64, int:
+		deployedNumber, _ := toFloat64(deployed)
+		liveNumber, ok := toFloat64(live)
+		return ok && deployedNumber == liveNumber
+	default:
+		return reflect.DeepEqual(deployed, live)
+	}
+}
+
+// toFloat64 converts a number of an unstructured object to a float64.
+func toFloat64(value any) (float64, bool) {
+	switch value := value.(type) {
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/rp/util/registrytest"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	kubernetesTestManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  replicas: {{ .replicas }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .context.resource.name }}
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: {{ .context.runtime.kubernetes.namespace }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .context.resource.name }}-output
+  annotations:
+    radapp.io/recipe-output: "true"
+data:
+  result: '{"values": {"host": "{{ .context.resource.name }}.{{ .context.runtime.kubernetes.namespace }}.svc.cluster.local"}}'
+`
+
+	kubernetesTestDeploymentID = "/planes/kubernetes/local/namespaces/app-ns/providers/apps/Deployment/redis"
+	kubernetesTestServiceID    = "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/redis"
+	kubernetesTestNamespaceID  = "/planes/kubernetes/local/providers/core/Namespace/app-ns"
+	kubernetesTestConfigMapID  = "/planes/kubernetes/local/namespaces/app-ns/providers/core/ConfigMap/redis-output"
+)
+
+func setupKubernetesDriver(t *testing.T) (*kubernetesDriver, *handlers.MockResourceHandler, *processors.MockResourceClient, string) {
+	ts := registrytest.NewFakeRegistryServerWithBlob(t, []byte(kubernetesTestManifest))
+	t.Cleanup(ts.CloseServer)
+
+	ctrl := gomock.NewController(t)
+	handler := handlers.NewMockResourceHandler(ctrl)
+	client := processors.NewMockResourceClient(ctrl)
+	d := &kubernetesDriver{
+		kubernetesHandler: handler,
+		ResourceClient:    client,
+		RegistryClient:    ts.TestServer.Client(),
+	}
+	return d, handler, client, ts.TestImageURL
+}
+
+// kubernetesTestOutput returns the output config map of the test manifest as it is in the cluster, with the given result.
+func kubernetesTestOutput(result string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":        "redis-output",
+			"namespace":   "app-ns",
+			"annotations": map[string]any{KubernetesRecipeOutputAnnotation: "true"},
+		},
+		"data": map[string]any{"result": result},
+	}}
+}
+
+func kubernetesTestOptions(templatePath string) BaseOptions {
+	opts := helmTestOptions()
+	opts.Definition.Driver = recipes.TemplateKindKubernetes
+	opts.Definition.TemplatePath = templatePath
+	opts.Definition.TemplateVersion = ""
+	return opts
+}
+
+func Test_Kubernetes_Execute_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, handler, client, templatePath := setupKubernetesDriver(t)
+
+	applied := []string{}
+	handler.EXPECT().
+		Put(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, options *handlers.PutOptions) (map[string]string, error) {
+			applied = append(applied, options.Resource.ID.String())
+			return map[string]string{}, nil
+		}).
+		Times(4)
+
+	// The service is no longer part of the recipe, so it is deleted.
+	client.EXPECT().Delete(gomock.Any(), "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/old").Return(nil)
+
+	// The output is read from the config map in the cluster, which was updated after it was applied.
+	expectLiveObjects(client, map[string]*unstructured.Unstructured{
+		kubernetesTestConfigMapID: kubernetesTestOutput(`{"values": {"host": "redis.app-ns.svc.cluster.local", "port": 6379}}`),
+	})
+
+	output, err := d.Execute(ctx, ExecuteOptions{
+		BaseOptions: kubernetesTestOptions(templatePath),
+		PrevState:   []string{kubernetesTestDeploymentID, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/old"},
+	})
+	require.NoError(t, err)
+
+	// The objects are applied in the install order of Helm, so namespaces are applied first.
+	require.Equal(t, []string{kubernetesTestNamespaceID, kubernetesTestConfigMapID, kubernetesTestServiceID, kubernetesTestDeploymentID}, applied)
+
+	expected := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "redis.app-ns.svc.cluster.local",
+			"port": float64(6379),
+		},
+		Secrets: map[string]any{},
+		Resources: []string{
+			kubernetesTestNamespaceID,
+			kubernetesTestConfigMapID,
+			kubernetesTestServiceID,
+			kubernetesTestDeploymentID,
+		},
+		Status: &rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindKubernetes,
+			TemplatePath: templatePath,
+		},
+	}
+	require.Equal(t, expected, output)
+}
+
+func Test_Kubernetes_Execute_RemoteCluster(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, handler, client, templatePath := setupKubernetesDriver(t)
+
+	applied := []string{}
+	handler.EXPECT().
+		Put(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, options *handlers.PutOptions) (map[string]string, error) {
+			applied = append(applied, options.Resource.ID.String())
+			return map[string]string{}, nil
+		}).
+		Times(4)
+
+	// The objects of the previous deployment are deleted from the cluster of the plane.
+	client.EXPECT().Delete(gomock.Any(), "/planes/kubernetes/prod/namespaces/app-ns/providers/core/Service/old").Return(nil)
+
+	// The output is read from the cluster of the plane.
+	expectLiveObjects(client, map[string]*unstructured.Unstructured{
+		"/planes/kubernetes/prod/namespaces/app-ns/providers/core/ConfigMap/redis-output": kubernetesTestOutput(`{"values": {}}`),
+	})
+
+	opts := kubernetesTestOptions(templatePath)
+	opts.Configuration.Runtime.Kubernetes.Cluster = "prod"
+
+	output, err := d.Execute(ctx, ExecuteOptions{
+		BaseOptions: opts,
+		PrevState:   []string{"/planes/kubernetes/prod/namespaces/app-ns/providers/core/Service/old"},
+	})
+	require.NoError(t, err)
+
+	expected := []string{
+		"/planes/kubernetes/prod/providers/core/Namespace/app-ns",
+		"/planes/kubernetes/prod/namespaces/app-ns/providers/core/ConfigMap/redis-output",
+		"/planes/kubernetes/prod/namespaces/app-ns/providers/core/Service/redis",
+		"/planes/kubernetes/prod/namespaces/app-ns/providers/apps/Deployment/redis",
+	}
+	require.Equal(t, expected, applied)
+	require.Equal(t, expected, output.Resources)
+}
+
+func Test_Kubernetes_Execute_SimulatedEnvironment(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _, templatePath := setupKubernetesDriver(t)
+
+	opts := kubernetesTestOptions(templatePath)
+	opts.Configuration.Simulated = true
+
+	output, err := d.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.NoError(t, err)
+	require.Nil(t, output)
+}
+
+func Test_Kubernetes_Execute_ApplyFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, handler, _, templatePath := setupKubernetesDriver(t)
+
+	handler.EXPECT().Put(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil)
+	handler.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil, errors.New("deployment timed out"))
+
+	// The objects applied before the failure, and the object which failed, are returned with the error.
+	output, err := d.Execute(ctx, ExecuteOptions{BaseOptions: kubernetesTestOptions(templatePath)})
+	require.Equal(t, []string{kubernetesTestNamespaceID, kubernetesTestConfigMapID}, output.Resources)
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDeploymentFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "failed to apply ConfigMap \"redis-output\": deployment timed out")
+}
+
+func Test_Kubernetes_Execute_GarbageCollectionFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, handler, client, templatePath := setupKubernetesDriver(t)
+
+	handler.EXPECT().Put(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).Times(4)
+	expectLiveObjects(client, map[string]*unstructured.Unstructured{kubernetesTestConfigMapID: kubernetesTestOutput(`{}`)})
+	client.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(errors.New("failed to delete"))
+
+	_, err := d.Execute(ctx, ExecuteOptions{
+		BaseOptions: kubernetesTestOptions(templatePath),
+		PrevState:   []string{"/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/old"},
+	})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeGarbageCollectionFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Kubernetes_Execute_OutputNotFound(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, handler, client, templatePath := setupKubernetesDriver(t)
+
+	handler.EXPECT().Put(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil).Times(4)
+	expectLiveObjects(client, map[string]*unstructured.Unstructured{})
+
+	_, err := d.Execute(ctx, ExecuteOptions{BaseOptions: kubernetesTestOptions(templatePath)})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.InvalidRecipeOutputs, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "recipe output ConfigMap \"redis-output\" was not found")
+}
+
+func Test_Kubernetes_Execute_DownloadFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _, _ := setupKubernetesDriver(t)
+
+	_, err := d.Execute(ctx, ExecuteOptions{BaseOptions: kubernetesTestOptions("invalid-path:")})
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeDownloadFailed, recipeError.ErrorDetails.Code)
+}
+
+func Test_Kubernetes_Delete(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, client, templatePath := setupKubernetesDriver(t)
+
+	outputResources := []rpv1.OutputResource{}
+	for _, id := range []string{kubernetesTestNamespaceID, kubernetesTestDeploymentID} {
+		resource := rpv1.OutputResource{RadiusManaged: to.Ptr(true)}
+		resource.ID = resources.MustParse(id)
+		outputResources = append(outputResources, resource)
+	}
+	outputResources = append(outputResources, rpv1.OutputResource{ID: resources.MustParse(kubernetesTestServiceID), RadiusManaged: to.Ptr(false)})
+
+	// The resources are deleted in the reverse order of their deployment, and the resources not managed by Radius
+	// are skipped.
+	gomock.InOrder(
+		client.EXPECT().Delete(gomock.Any(), kubernetesTestDeploymentID).Return(nil),
+		client.EXPECT().Delete(gomock.Any(), kubernetesTestNamespaceID).Return(nil),
+	)
+
+	err := d.Delete(ctx, DeleteOptions{BaseOptions: kubernetesTestOptions(templatePath), OutputResources: outputResources})
+	require.NoError(t, err)
+}
+
+func Test_Kubernetes_Plan(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _, templatePath := setupKubernetesDriver(t)
+
+	plan, err := d.Plan(ctx, ExecuteOptions{
+		BaseOptions: kubernetesTestOptions(templatePath),
+		PrevState:   []string{kubernetesTestDeploymentID, "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/old"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionCreate, Type: "core/Namespace", Name: kubernetesTestNamespaceID},
+		{Action: recipes.ChangeActionCreate, Type: "core/ConfigMap", Name: kubernetesTestConfigMapID},
+		{Action: recipes.ChangeActionCreate, Type: "core/Service", Name: kubernetesTestServiceID},
+		{Action: recipes.ChangeActionUpdate, Type: "apps/Deployment", Name: kubernetesTestDeploymentID},
+		{Action: recipes.ChangeActionDelete, Type: "core/Service", Name: "/planes/kubernetes/local/namespaces/app-ns/providers/core/Service/old"},
+	}, plan.Changes)
+}
+
+func Test_Kubernetes_DetectDrift(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, client, templatePath := setupKubernetesDriver(t)
+
+	live := map[string]*unstructured.Unstructured{
+		kubernetesTestNamespaceID: {Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": "app-ns", "uid": "1"},
+			"status":     map[string]any{"phase": "Active"},
+		}},
+		kubernetesTestDeploymentID: {Object: map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]any{"name": "redis", "namespace": "app-ns"},
+			"spec":       map[string]any{"replicas": float64(3), "revisionHistoryLimit": int64(10)},
+		}},
+		// The result of the output config map was changed out-of-band.
+		kubernetesTestConfigMapID: {Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"name":        "redis-output",
+				"namespace":   "app-ns",
+				"annotations": map[string]any{KubernetesRecipeOutputAnnotation: "true"},
+			},
+			"data": map[string]any{"result": "{}"},
+		}},
+	}
+	client.EXPECT().
+		GetKubernetesObject(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id string) (*unstructured.Unstructured, error) {
+			return live[id], nil
+		}).
+		Times(4)
+
+	drift, err := d.DetectDrift(ctx, ExecuteOptions{BaseOptions: kubernetesTestOptions(templatePath)})
+	require.NoError(t, err)
+	require.Equal(t, []recipes.ResourceChange{
+		{Action: recipes.ChangeActionUpdate, Type: "core/ConfigMap", Name: kubernetesTestConfigMapID},
+		{Action: recipes.ChangeActionCreate, Type: "core/Service", Name: kubernetesTestServiceID},
+	}, drift.Resources)
+}
+
+func Test_Kubernetes_DetectDrift_GetFailure(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, client, templatePath := setupKubernetesDriver(t)
+
+	client.EXPECT().GetKubernetesObject(gomock.Any(), gomock.Any()).Return(nil, errors.New("forbidden"))
+
+	_, err := d.DetectDrift(ctx, ExecuteOptions{BaseOptions: kubernetesTestOptions(templatePath)})
+	require.ErrorContains(t, err, "failed to get resource")
+}
+
+func Test_MatchesLiveObject(t *testing.T) {
+	deployed := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "redis", "labels": map[string]any{"app": "redis"}},
+		"stringData": map[string]any{"password": "password"},
+		"spec":       map[string]any{"ports": []any{map[string]any{"port": int64(6379)}}},
+	}}
+
+	tests := []struct {
+		name     string
+		live     map[string]any
+		expected bool
+	}{
+		{
+			name: "fields added by kubernetes",
+			live: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "redis", "uid": "1", "labels": map[string]any{"app": "redis", "extra": "label"}},
+				"data":       map[string]any{"password": "cGFzc3dvcmQ="},
+				"spec":       map[string]any{"ports": []any{map[string]any{"port": float64(6379), "protocol": "TCP"}}},
+			},
+			expected: true,
+		},
+		{
+			name: "changed label",
+			live: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "redis", "labels": map[string]any{"app": "other"}},
+				"spec":       map[string]any{"ports": []any{map[string]any{"port": int64(6379)}}},
+			},
+			expected: false,
+		},
+		{
+			name: "added list item",
+			live: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "redis", "labels": map[string]any{"app": "redis"}},
+				"spec":       map[string]any{"ports": []any{map[string]any{"port": int64(6379)}, map[string]any{"port": int64(80)}}},
+			},
+			expected: false,
+		},
+		{
+			name: "removed field",
+			live: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "redis", "labels": map[string]any{"app": "redis"}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, matchesLiveObject(deployed, &unstructured.Unstructured{Object: tc.live}))
+		})
+	}
+}
+
+func Test_Kubernetes_GetRecipeMetadata(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _, templatePath := setupKubernetesDriver(t)
+
+	metadata, err := d.GetRecipeMetadata(ctx, kubernetesTestOptions(templatePath))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{}}, metadata)
+}
+
+func Test_Kubernetes_NoKubernetesRuntime(t *testing.T) {
+	ctx := testcontext.New(t)
+	d, _, _, templatePath := setupKubernetesDriver(t)
+
+	opts := kubernetesTestOptions(templatePath)
+	opts.Configuration.Runtime.Kubernetes = nil
+
+	_, err := d.Execute(ctx, ExecuteOptions{BaseOptions: opts})
+	require.ErrorContains(t, err, "kubernetes recipes require a Kubernetes environment")
+}
@@ -45,12 +45,18 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
+const (
+	// installCacheDir is the sub directory of the Terraform path where the installed versions of Terraform are cached
+	// by default.
+	installCacheDir = ".install-cache"
+)
+
 var _ Driver = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, options TerraformOptions, k8sClientSet kubernetes.Interface) Driver {
 	return &terraformDriver{
-		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet),
+		terraformExecutor: terraform.NewExecutor(ucpConn, secretProvider, k8sClientSet, options.installOptions()),
 		options:           options,
 	}
 }
@@ -59,6 +65,38 @@ func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *ucp_provider.Sec
 type TerraformOptions struct {
 	// Path is the path to the directory mounted to the container where terraform can be installed and executed.
 	Path string
+
+	// Version is the default version of Terraform used to execute recipes. The latest version is used when it is empty.
+	Version string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary used instead of installing Terraform.
+	ExecPath string
+
+	// ExecSHA256 is the hex-encoded SHA256 checksum of the binary at ExecPath.
+	ExecSHA256 string
+
+	// MirrorDir is a local directory mirroring https://releases.hashicorp.com/terraform, used to install Terraform without internet access.
+	MirrorDir string
+
+	// CacheDir is the directory where the installed versions of Terraform are reused across executions. Defaults to a
+	// sub directory of Path.
+	CacheDir string
+}
+
+// installOptions returns the options to install Terraform for each execution.
+func (o TerraformOptions) installOptions() terraform.InstallOptions {
+	cacheDir := o.CacheDir
+	if cacheDir == "" && o.Path != "" {
+		cacheDir = filepath.Join(o.Path, installCacheDir)
+	}
+
+	return terraform.InstallOptions{
+		Version:    o.Version,
+		ExecPath:   o.ExecPath,
+		ExecSHA256: o.ExecSHA256,
+		MirrorDir:  o.MirrorDir,
+		CacheDir:   cacheDir,
+	}
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -135,6 +173,92 @@ func (d *terraformDriver) Delete(ctx context.Context, opts DeleteOptions) error
 	return nil
 }
 
+// Plan creates a unique directory for the execution of terraform and plans the deployment of the recipe using the
+// Terraform CLI through terraform-exec. It returns the changes to the resources of the recipe or an error if planning fails.
+func (d *terraformDriver) Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error) {
+	return d.plan(ctx, opts, recipes.RecipePlanFailed)
+}
+
+// DetectDrift plans the Terraform recipe against the current state of its resources. Terraform refreshes the state
+// before planning, so the resources the plan would change are the resources which have drifted from the recipe. The
+// engine pins the definition to the template and the parameters the resource was deployed with, so changes to the
+// recipe in the environment are not reported as drift.
+func (d *terraformDriver) DetectDrift(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeDrift, error) {
+	recipePlan, err := d.plan(ctx, opts, recipes.RecipeDriftDetectionFailed)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipePlan.Drift(), nil
+}
+
+// plan creates a Terraform execution directory and plans the Terraform module of the recipe. Errors are reported with
+// the given error code.
+func (d *terraformDriver) plan(ctx context.Context, opts ExecuteOptions, errorCode string) (*recipes.RecipePlan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	tfPlan, err := d.terraformExecutor.Plan(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+	})
+	if err != nil {
+		return nil, recipes.NewRecipeError(errorCode, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return newRecipePlan(tfPlan), nil
+}
+
+// newRecipePlan returns the changes to the managed resources in the Terraform plan.
+func newRecipePlan(tfPlan *tfjson.Plan) *recipes.RecipePlan {
+	recipePlan := &recipes.RecipePlan{Changes: []recipes.ResourceChange{}}
+	if tfPlan == nil {
+		return recipePlan
+	}
+
+	for _, rc := range tfPlan.ResourceChanges {
+		// Data sources are read, not changed.
+		if rc == nil || rc.Change == nil || rc.Mode == tfjson.DataResourceMode {
+			continue
+		}
+
+		var action recipes.ChangeAction
+		switch {
+		case rc.Change.Actions.Replace():
+			action = recipes.ChangeActionReplace
+		case rc.Change.Actions.Create():
+			action = recipes.ChangeActionCreate
+		case rc.Change.Actions.Update():
+			action = recipes.ChangeActionUpdate
+		case rc.Change.Actions.Delete():
+			action = recipes.ChangeActionDelete
+		case rc.Change.Actions.NoOp():
+			action = recipes.ChangeActionNoOp
+		default:
+			continue
+		}
+
+		recipePlan.Changes = append(recipePlan.Changes, recipes.ResourceChange{
+			Action: action,
+			Type:   rc.Type,
+			Name:   rc.Address,
+		})
+	}
+
+	return recipePlan
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 func (d *terraformDriver) prepareRecipeResponse(ctx context.Context, definition recipes.EnvironmentDefinition, tfState *tfjson.State) (*recipes.RecipeOutput, error) {
@@ -225,6 +349,7 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts BaseOption
 
 	recipeData, err := d.terraformExecutor.GetRecipeMetadata(ctx, terraform.Options{
 		RootDir:        requestDirPath,
+		EnvConfig:      &opts.Configuration,
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 	})
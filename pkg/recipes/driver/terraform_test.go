@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -778,3 +779,117 @@ func Test_Terraform_PrepareRecipeResponse(t *testing.T) {
 		})
 	}
 }
+
+func Test_Terraform_InstallOptions(t *testing.T) {
+	options := TerraformOptions{Path: "/terraform", Version: "1.5.7", MirrorDir: "/mirror"}
+	require.Equal(t, terraform.InstallOptions{
+		Version:   "1.5.7",
+		MirrorDir: "/mirror",
+		CacheDir:  filepath.Join("/terraform", installCacheDir),
+	}, options.installOptions())
+
+	options.CacheDir = "/cache"
+	require.Equal(t, "/cache", options.installOptions().CacheDir)
+}
+
+func Test_Terraform_Plan_Success(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+
+	tfPlan := &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "module.default.azurerm_redis_cache.redis",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_cache",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.default.azurerm_resource_group.rg",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_resource_group",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+			},
+			{
+				Address: "module.default.azurerm_redis_firewall_rule.rule",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_firewall_rule",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}},
+			},
+			{
+				Address: "module.default.azurerm_redis_linked_server.server",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_redis_linked_server",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+			},
+			{
+				Address: "module.default.azurerm_storage_account.old",
+				Mode:    tfjson.ManagedResourceMode,
+				Type:    "azurerm_storage_account",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+			},
+			{
+				Address: "module.default.data.azurerm_client_config.current",
+				Mode:    tfjson.DataResourceMode,
+				Type:    "azurerm_client_config",
+				Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionRead}},
+			},
+		},
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(tfPlan, nil)
+
+	recipePlan, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{Action: recipes.ChangeActionCreate, Type: "azurerm_redis_cache", Name: "module.default.azurerm_redis_cache.redis"},
+			{Action: recipes.ChangeActionNoOp, Type: "azurerm_resource_group", Name: "module.default.azurerm_resource_group.rg"},
+			{Action: recipes.ChangeActionReplace, Type: "azurerm_redis_firewall_rule", Name: "module.default.azurerm_redis_firewall_rule.rule"},
+			{Action: recipes.ChangeActionUpdate, Type: "azurerm_redis_linked_server", Name: "module.default.azurerm_redis_linked_server.server"},
+			{Action: recipes.ChangeActionDelete, Type: "azurerm_storage_account", Name: "module.default.azurerm_storage_account.old"},
+		},
+	}, recipePlan)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
+
+func Test_Terraform_Plan_Failure(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, driver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeError := recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipePlanFailed,
+			Message: "Failed to plan terraform module",
+		},
+		DeploymentStatus: "executionError",
+	}
+	tfExecutor.EXPECT().Plan(ctx, gomock.Any()).Times(1).Return(nil, errors.New("Failed to plan terraform module"))
+
+	_, err := driver.Plan(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Error(t, err)
+	require.Equal(t, err, &recipeError)
+	verifyDirectoryCleanup(t, driver.options.Path, armCtx.OperationID.String())
+}
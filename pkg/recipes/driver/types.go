@@ -32,6 +32,8 @@ const (
 // Driver is an interface to implement recipe deployment and recipe resources deletion.
 type Driver interface {
 	// Execute fetches the recipe contents and deploys the recipe and returns deployed resources, secrets and values.
+	// If the deployment fails after resources were deployed, Execute returns a recipe output holding the IDs of these
+	// resources with the error, so that they can be deleted.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
 	// Delete handles deletion of output resources for the recipe deployment.
@@ -39,6 +41,12 @@ type Driver interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error)
+
+	// Plan returns the changes the deployment of the recipe would make to its resources without making them.
+	Plan(ctx context.Context, opts ExecuteOptions) (*recipes.RecipePlan, error)
+
+	// DetectDrift returns the resources deployed by the recipe which have drifted from the recipe.
+	DetectDrift(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeDrift, error)
 }
 
 // BaseOptions is the base options for the driver operations.
@@ -19,6 +19,8 @@ package engine
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/radius-project/radius/pkg/metrics"
@@ -36,10 +38,12 @@ func NewEngine(options Options) *engine {
 
 var _ Engine = (*engine)(nil)
 
-// Options represents the configuration loader and type of driver used to deploy recipe.
+// Options represents the configuration loader and type of driver used to deploy recipe, and the schemas of the outputs
+// the recipes of each resource type must return.
 type Options struct {
 	ConfigurationLoader configloader.ConfigurationLoader
 	Drivers             map[string]recipedriver.Driver
+	OutputSchemas       map[string]*recipes.OutputSchema
 }
 
 type engine struct {
@@ -90,7 +94,27 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		PrevState: prevState,
 	})
 	if err != nil {
-		return nil, definition, err
+		// The driver may return the resources it deployed before failing.
+		return res, definition, err
+	}
+
+	// Validate the outputs of the recipe against the outputs required by the resource type, so that a recipe returning
+	// incomplete outputs fails with a clear error instead of failing later while processing the resource. Outputs set by
+	// the properties of the resource are not required from the recipe. The resources of the recipe are deployed, so the
+	// recipe output is returned with the error.
+	if schema := e.GetOutputSchema(definition.ResourceType); res != nil && schema != nil {
+		if err := schema.Validate(res, recipe.Properties); err != nil {
+			return res, definition, err
+		}
+	}
+
+	// Record the recipe, its version and its parameters the resource is deployed with, so that the resource keeps
+	// using them.
+	if res != nil && res.Status != nil {
+		res.Status.RecipeName = definition.Name
+		res.Status.ResourceType = definition.ResourceType
+		res.Status.RecipeVersion = definition.RecipeVersion
+		res.Status.Parameters = definition.Parameters
 	}
 
 	return res, definition, nil
@@ -144,6 +168,119 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 	return definition, nil
 }
 
+// Plan loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then plans the recipe using the driver. It returns the changes the
+// deployment of the recipe would make to its resources and an error if one occurs.
+func (e *engine) Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error) {
+	planStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	recipePlan, definition, err := e.planCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, planStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationPlan, opts.Recipe.Name,
+			definition, result))
+
+	return recipePlan, err
+}
+
+// planCore function is the core logic of the Plan function.
+// Any changes to the core logic of the Plan function should be made here.
+func (e *engine) planCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipePlan, *recipes.EnvironmentDefinition, error) {
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	res, err := driver.Plan(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return res, definition, nil
+}
+
+// DetectDrift loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
+// configuration associated with the recipe, and then calls the driver to detect the drift of the resources deployed by
+// the recipe. It also records metrics for the operation and the detected drift.
+func (e *engine) DetectDrift(ctx context.Context, opts PlanOptions) (*recipes.RecipeDrift, error) {
+	detectStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	drift, definition, err := e.detectDriftCore(ctx, opts.Recipe, opts.PreviousState)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if recipes.GetErrorDetails(err) != nil {
+			result = recipes.GetErrorDetails(err).Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, detectStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name,
+			definition, result))
+	if drift.Drifted() {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDrift(ctx, len(drift.Resources),
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name, definition, ""))
+	}
+
+	return drift, err
+}
+
+// detectDriftCore function is the core logic of the DetectDrift function.
+// Any changes to the core logic of the DetectDrift function should be made here.
+func (e *engine) detectDriftCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeDrift, *recipes.EnvironmentDefinition, error) {
+	definition, _, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The resources are compared with the template and the parameters they were deployed with, so that changes to the
+	// recipe in the environment are not reported as drift.
+	definitionChanged := pinDeployedRecipe(definition, recipe.Status)
+	driver, err := e.driverFor(definition)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	res, err := driver.DetectDrift(ctx, recipedriver.ExecuteOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+		},
+		PrevState: prevState,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+	res.DefinitionChanged = definitionChanged
+
+	return res, definition, nil
+}
+
 // Gets the Recipe metadata and parameters from Recipe's template path.
 func (e *engine) GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error) {
 	recipeData, err := e.getRecipeMetadataCore(ctx, recipeDefinition)
@@ -169,18 +306,90 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, recipeDefinition rec
 	})
 }
 
+// GetOutputSchema returns the schema of the outputs the recipes of the resource type must return, or nil if the resource
+// type does not declare one.
+func (e *engine) GetOutputSchema(resourceType string) *recipes.OutputSchema {
+	for key, schema := range e.options.OutputSchemas {
+		if strings.EqualFold(key, resourceType) {
+			return schema
+		}
+	}
+	return nil
+}
+
 func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
 	// Load Recipe Definition from the environment.
 	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
 	if err != nil {
 		return nil, nil, err
 	}
+	pinRecipeVersion(definition, recipeMetadata.Status)
 
-	// Determine Recipe driver type
+	driver, err := e.driverFor(definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	return definition, driver, nil
+}
+
+// driverFor returns the driver of the recipe definition.
+func (e *engine) driverFor(definition *recipes.EnvironmentDefinition) (recipedriver.Driver, error) {
 	driver, ok := e.options.Drivers[definition.Driver]
 	if !ok {
 		err := fmt.Errorf("could not find driver `%s`", definition.Driver)
-		return nil, nil, recipes.NewRecipeError(recipes.RecipeDriverNotFoundFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+		return nil, recipes.NewRecipeError(recipes.RecipeDriverNotFoundFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
-	return definition, driver, nil
+	return driver, nil
+}
+
+// pinRecipeVersion replaces the template and the parameters of the recipe definition with the ones the resource was
+// deployed with, when the resource was deployed with another version of the recipe. Updating a recipe in the environment
+// does not change the resources deployed with a previous version of the recipe until they are upgraded. The parameters
+// of the environment are kept for resources deployed before the parameters were recorded in their status.
+func pinRecipeVersion(definition *recipes.EnvironmentDefinition, status *rpv1.RecipeStatus) {
+	if !deployedWith(definition, status) || status.RecipeVersion == "" || status.RecipeVersion == definition.RecipeVersion {
+		return
+	}
+
+	definition.Driver = status.TemplateKind
+	definition.TemplatePath = status.TemplatePath
+	definition.TemplateVersion = status.TemplateVersion
+	definition.RecipeVersion = status.RecipeVersion
+	if status.Parameters != nil {
+		definition.Parameters = status.Parameters
+	}
+}
+
+// pinDeployedRecipe replaces the template and the parameters of the recipe definition with the ones the resource was
+// deployed with, regardless of the version of the recipe. It returns true if they differ from the recipe definition.
+func pinDeployedRecipe(definition *recipes.EnvironmentDefinition, status *rpv1.RecipeStatus) bool {
+	if !deployedWith(definition, status) || status.TemplatePath == "" {
+		return false
+	}
+
+	changed := definition.Driver != status.TemplateKind ||
+		definition.TemplatePath != status.TemplatePath ||
+		definition.TemplateVersion != status.TemplateVersion
+	if status.Parameters != nil {
+		changed = changed || !reflect.DeepEqual(definition.Parameters, status.Parameters)
+		definition.Parameters = status.Parameters
+	}
+
+	definition.Driver = status.TemplateKind
+	definition.TemplatePath = status.TemplatePath
+	definition.TemplateVersion = status.TemplateVersion
+	if status.RecipeVersion != "" {
+		definition.RecipeVersion = status.RecipeVersion
+	}
+
+	return changed
+}
+
+// deployedWith returns true if the resource was deployed with the recipe of the definition. A resource whose recipe was
+// changed to another recipe is deployed with the recipe registered to the environment, not the recipe it was deployed
+// with.
+func deployedWith(definition *recipes.EnvironmentDefinition, status *rpv1.RecipeStatus) bool {
+	return status != nil &&
+		status.RecipeName == definition.Name &&
+		strings.EqualFold(status.ResourceType, definition.ResourceType)
 }
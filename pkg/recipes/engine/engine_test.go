@@ -122,6 +122,145 @@ func Test_Engine_Execute_Success(t *testing.T) {
 	require.Equal(t, result, recipeResult)
 }
 
+func Test_Engine_Execute_RecipeVersion(t *testing.T) {
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := recipes.EnvironmentDefinition{
+		Name:          "mongo-azure",
+		Driver:        recipes.TemplateKindBicep,
+		TemplatePath:  "ghcr.io/radius-project/dev/recipes/mongodatabases:2.0",
+		ResourceType:  "Applications.Datastores/mongoDatabases",
+		RecipeVersion: "2.0.0",
+		Parameters:    map[string]any{"size": "large"},
+	}
+	deployedStatus := &rpv1.RecipeStatus{
+		RecipeName:      "mongo-azure",
+		ResourceType:    "Applications.Datastores/mongoDatabases",
+		TemplateKind:    recipes.TemplateKindTerraform,
+		TemplatePath:    "ghcr.io/radius-project/dev/recipes/mongodatabases",
+		TemplateVersion: "1.0.0",
+		RecipeVersion:   "1.0.0",
+		Parameters:      map[string]any{"sku": "standard"},
+	}
+
+	tests := []struct {
+		name               string
+		status             *rpv1.RecipeStatus
+		expectedDefinition recipes.EnvironmentDefinition
+	}{
+		{
+			name:               "new resource uses the current version",
+			status:             nil,
+			expectedDefinition: recipeDefinition,
+		},
+		{
+			name:               "resource deployed without a version uses the current version",
+			status:             &rpv1.RecipeStatus{RecipeName: "mongo-azure", ResourceType: "Applications.Datastores/mongoDatabases", TemplateKind: recipes.TemplateKindBicep, TemplatePath: "ghcr.io/radius-project/dev/recipes/mongodatabases:1.0"},
+			expectedDefinition: recipeDefinition,
+		},
+		{
+			name:   "resource deployed with a previous version is pinned",
+			status: deployedStatus,
+			expectedDefinition: recipes.EnvironmentDefinition{
+				Name:            "mongo-azure",
+				Driver:          recipes.TemplateKindTerraform,
+				TemplatePath:    "ghcr.io/radius-project/dev/recipes/mongodatabases",
+				TemplateVersion: "1.0.0",
+				ResourceType:    "Applications.Datastores/mongoDatabases",
+				RecipeVersion:   "1.0.0",
+				Parameters:      map[string]any{"sku": "standard"},
+			},
+		},
+		{
+			name: "resource deployed with a previous version before its parameters were recorded is pinned",
+			status: &rpv1.RecipeStatus{
+				RecipeName:      "mongo-azure",
+				ResourceType:    "Applications.Datastores/mongoDatabases",
+				TemplateKind:    recipes.TemplateKindTerraform,
+				TemplatePath:    "ghcr.io/radius-project/dev/recipes/mongodatabases",
+				TemplateVersion: "1.0.0",
+				RecipeVersion:   "1.0.0",
+			},
+			expectedDefinition: recipes.EnvironmentDefinition{
+				Name:            "mongo-azure",
+				Driver:          recipes.TemplateKindTerraform,
+				TemplatePath:    "ghcr.io/radius-project/dev/recipes/mongodatabases",
+				TemplateVersion: "1.0.0",
+				ResourceType:    "Applications.Datastores/mongoDatabases",
+				RecipeVersion:   "1.0.0",
+				Parameters:      map[string]any{"size": "large"},
+			},
+		},
+		{
+			name: "resource deployed with a previous version of another recipe uses the current version",
+			status: &rpv1.RecipeStatus{
+				RecipeName:      "mongo-aws",
+				ResourceType:    "Applications.Datastores/mongoDatabases",
+				TemplateKind:    recipes.TemplateKindTerraform,
+				TemplatePath:    "ghcr.io/radius-project/dev/recipes/mongodatabases-aws",
+				TemplateVersion: "1.0.0",
+				RecipeVersion:   "1.0.0",
+				Parameters:      map[string]any{"sku": "standard"},
+			},
+			expectedDefinition: recipeDefinition,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipeMetadata := recipes.ResourceMetadata{
+				Name:          "mongo-azure",
+				EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+				ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+				Status:        tc.status,
+			}
+			ctx := testcontext.New(t)
+			engine, configLoader, driver := setup(t)
+
+			definition := recipeDefinition
+			configLoader.EXPECT().
+				LoadConfiguration(ctx, recipeMetadata).
+				Times(1).
+				Return(envConfig, nil)
+			configLoader.EXPECT().
+				LoadRecipe(ctx, &recipeMetadata).
+				Times(1).
+				Return(&definition, nil)
+			driver.EXPECT().
+				Execute(ctx, recipedriver.ExecuteOptions{
+					BaseOptions: recipedriver.BaseOptions{
+						Configuration: *envConfig,
+						Recipe:        recipeMetadata,
+						Definition:    tc.expectedDefinition,
+					},
+				}).
+				Times(1).
+				Return(&recipes.RecipeOutput{
+					Status: &rpv1.RecipeStatus{
+						TemplateKind: tc.expectedDefinition.Driver,
+						TemplatePath: tc.expectedDefinition.TemplatePath,
+					},
+				}, nil)
+
+			result, err := engine.Execute(ctx, ExecuteOptions{
+				BaseOptions: BaseOptions{
+					Recipe: recipeMetadata,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, "mongo-azure", result.Status.RecipeName)
+			require.Equal(t, "Applications.Datastores/mongoDatabases", result.Status.ResourceType)
+			require.Equal(t, tc.expectedDefinition.RecipeVersion, result.Status.RecipeVersion)
+			require.Equal(t, tc.expectedDefinition.Parameters, result.Status.Parameters)
+		})
+	}
+}
+
 func Test_Engine_Execute_Failure(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -186,6 +325,149 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 	require.Equal(t, err.Error(), "failed to execute recipe")
 }
 
+func Test_Engine_Execute_InvalidOutputs(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"port": 10255,
+		},
+	}
+	outputSchema, err := recipes.NewOutputSchema("Applications.Datastores/mongoDatabases", []byte(`{
+		"type": "object",
+		"properties": {
+			"values": {
+				"type": "object",
+				"properties": {
+					"host": { "type": "string" },
+					"port": { "type": "integer" }
+				},
+				"required": ["host", "port"]
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+	engine.options.OutputSchemas = map[string]*recipes.OutputSchema{
+		"Applications.Datastores/mongoDatabases": outputSchema,
+	}
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		Return(recipeResult, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	// The resources of the recipe are deployed, so the recipe output is returned with the error.
+	require.Equal(t, recipeResult, result)
+	require.Error(t, err)
+	recipeError, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.MissingRecipeOutputs, recipeError.ErrorDetails.Code)
+	require.Equal(t, "values.host", recipeError.ErrorDetails.Details[0].Target)
+}
+
+func Test_Engine_Execute_OutputsSetByResource(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+		Properties: map[string]any{
+			"host": "mongo.example.com",
+		},
+	}
+	envConfig := &recipes.Configuration{}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"port": 10255,
+		},
+	}
+	outputSchema, err := recipes.NewOutputSchema("Applications.Datastores/mongoDatabases", []byte(`{
+		"type": "object",
+		"properties": {
+			"values": {
+				"type": "object",
+				"properties": {
+					"host": { "type": "string" },
+					"port": { "type": "integer" }
+				},
+				"required": ["host", "port"]
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+	engine.options.OutputSchemas = map[string]*recipes.OutputSchema{
+		"Applications.Datastores/mongoDatabases": outputSchema,
+	}
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		Return(recipeResult, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeResult, result)
+}
+
+func Test_Engine_GetOutputSchema(t *testing.T) {
+	outputSchema, err := recipes.NewOutputSchema("Applications.Datastores/mongoDatabases", []byte(`{"type": "object"}`))
+	require.NoError(t, err)
+
+	engine, _, _ := setup(t)
+	engine.options.OutputSchemas = map[string]*recipes.OutputSchema{
+		"Applications.Datastores/mongoDatabases": outputSchema,
+	}
+
+	require.Equal(t, outputSchema, engine.GetOutputSchema("applications.datastores/mongodatabases"))
+	require.Nil(t, engine.GetOutputSchema("Applications.Datastores/redisCaches"))
+}
+
 func Test_Engine_Terraform_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -572,3 +854,229 @@ func getRecipeInputs() (recipes.ResourceMetadata, recipes.EnvironmentDefinition,
 	}
 	return recipeMetadata, recipeDefinition, outputResources
 }
+
+func Test_Engine_Plan_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+	}
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipePlan := &recipes.RecipePlan{
+		Changes: []recipes.ResourceChange{
+			{Action: recipes.ChangeActionDelete, Type: "System.Test/testResources", Name: prevState[0]},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipePlan, nil)
+
+	result, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipePlan, result)
+}
+
+func Test_Engine_DetectDrift_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+	}
+	prevState := []string{
+		"/subscriptions/test-sub/resourceGroups/test-rg/providers/System.Test/testResources/test1",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	recipeDrift := &recipes.RecipeDrift{
+		Resources: []recipes.ResourceChange{
+			{Action: recipes.ChangeActionCreate, Type: "System.Test/testResources", Name: prevState[0]},
+		},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		DetectDrift(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+			PrevState: prevState,
+		}).
+		Times(1).
+		Return(recipeDrift, nil)
+
+	result, err := engine.DetectDrift(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		PreviousState: prevState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeDrift, result)
+}
+
+func Test_Engine_DetectDrift_DefinitionChanged(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		ApplicationID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/app1",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+		Status: &rpv1.RecipeStatus{
+			RecipeName:   "mongo-azure",
+			ResourceType: "Applications.Datastores/mongoDatabases",
+			TemplateKind: recipes.TemplateKindBicep,
+			TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+			Parameters:   map[string]any{"size": "small"},
+		},
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+
+	// The recipe was updated in the environment without a new version since the resource was deployed.
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:2.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+		Parameters:   map[string]any{"size": "large"},
+	}
+	deployedDefinition := recipes.EnvironmentDefinition{
+		Name:         "mongo-azure",
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+		Parameters:   map[string]any{"size": "small"},
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+
+	// The drift is detected against the template and the parameters the resource was deployed with.
+	driver.EXPECT().
+		DetectDrift(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    deployedDefinition,
+			},
+		}).
+		Times(1).
+		Return(&recipes.RecipeDrift{Resources: []recipes.ResourceChange{}}, nil)
+
+	result, err := engine.DetectDrift(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Drifted())
+	require.True(t, result.DefinitionChanged)
+}
+
+func Test_Engine_Plan_Failure(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo",
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindTerraform,
+		TemplatePath: "Azure/cosmosdb/azurerm",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(&recipes.Configuration{}, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Plan(ctx, gomock.Any()).
+		Times(1).
+		Return(nil, errors.New("failed to plan recipe"))
+
+	_, err := engine.Plan(ctx, PlanOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.EqualError(t, err, "failed to plan recipe")
+}
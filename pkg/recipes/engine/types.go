@@ -28,6 +28,7 @@ import (
 type Engine interface {
 	// Execute gathers environment configuration, recipe definition and calls the driver to deploy the recipe.
 	// prevState is added to the driver execute options, which is used to get the obsolete resources for cleanup. It consists list of recipe output resource IDs that were created in the previous deployment.
+	// If the deployment fails after resources were deployed, the recipe output holding these resources is returned with the error.
 	Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error)
 
 	// Delete handles deletion of output resources for the recipe deployment.
@@ -35,6 +36,18 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, recipeDefinition recipes.EnvironmentDefinition) (map[string]any, error)
+
+	// Plan gathers environment configuration, recipe definition and calls the driver to get the changes the deployment
+	// of the recipe would make to its resources, without making them.
+	Plan(ctx context.Context, opts PlanOptions) (*recipes.RecipePlan, error)
+
+	// DetectDrift gathers environment configuration, recipe definition and calls the driver to get the resources
+	// deployed by the recipe which have drifted from the recipe.
+	DetectDrift(ctx context.Context, opts PlanOptions) (*recipes.RecipeDrift, error)
+
+	// GetOutputSchema returns the schema of the outputs the recipes of the resource type must return, or nil if the
+	// resource type does not declare one.
+	GetOutputSchema(resourceType string) *recipes.OutputSchema
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -52,6 +65,13 @@ type ExecuteOptions struct {
 	Simulated bool
 }
 
+// PlanOptions is the options for the Plan and DetectDrift methods.
+type PlanOptions struct {
+	BaseOptions
+	// PreviousState represents previously deployed state of output resource IDs.
+	PreviousState []string
+}
+
 // DeleteOptions is the options for the Delete method.
 type DeleteOptions struct {
 	BaseOptions
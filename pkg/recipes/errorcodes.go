@@ -29,6 +29,9 @@ const (
 	// Used for errors encountered during processing recipe outputs.
 	InvalidRecipeOutputs = "InvalidRecipeOutputs"
 
+	// Used for outputs required by the resource type which are not returned by a recipe.
+	MissingRecipeOutputs = "MissingRecipeOutputs"
+
 	// Used for errors encountered while reading a recipe from registry.
 	RecipeLanguageFailure = "RecipeLanguageFailure"
 
@@ -38,6 +41,15 @@ const (
 	// Used for errors encountered when getting recipe parameters.
 	RecipeGetMetadataFailed = "RecipeGetMetadataFailed"
 
+	// Used for errors encountered when planning a recipe deployment.
+	RecipePlanFailed = "RecipePlanFailed"
+
+	// Used for errors encountered when detecting the drift of the resources deployed by a recipe.
+	RecipeDriftDetectionFailed = "RecipeDriftDetectionFailed"
+
+	// Used for errors encountered when upgrading a resource to a new version of its recipe.
+	RecipeUpgradeFailed = "RecipeUpgradeFailed"
+
 	// Used for errors when checking the existence of a recipe.
 	RecipeNotFoundFailure = "RecipeNotFoundFailure"
 
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/registry"
+)
+
+const (
+	// maxReleaseNameLength is the maximum length of the name of a Helm release.
+	maxReleaseNameLength = 53
+
+	// releaseNameHashLength is the length of the hash of the resource ID appended to the name of the release.
+	releaseNameHashLength = 8
+)
+
+var invalidReleaseNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// parseChartPath returns the name of the chart and the URL of its chart repository from the template path of a recipe.
+// The repository URL is empty for OCI references and URLs of packaged charts, which are downloaded directly.
+func parseChartPath(templatePath string) (name string, repoURL string, err error) {
+	if registry.IsOCI(templatePath) {
+		return templatePath, "", nil
+	}
+
+	u, err := url.Parse(templatePath)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", "", fmt.Errorf("invalid helm chart path %q: the path must be an OCI reference or the URL of a chart in a chart repository", templatePath)
+	}
+
+	if strings.HasSuffix(u.Path, ".tgz") {
+		return templatePath, "", nil
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	i := strings.LastIndex(path, "/")
+	if i < 0 || path[i+1:] == "" {
+		return "", "", fmt.Errorf("invalid helm chart path %q: the path must end with the name of the chart", templatePath)
+	}
+
+	name = path[i+1:]
+	u.Path = path[:i]
+	u.RawPath = ""
+	return name, u.String(), nil
+}
+
+// ReleaseName returns the name of the release of the recipe deployed for the given resource. The name is derived from
+// the name of the resource and made unique with a hash of the resource ID, as resources of different types and
+// applications can share the same name.
+func ReleaseName(resourceID string, resourceName string) string {
+	hash := sha1.Sum([]byte(strings.ToLower(resourceID)))
+	suffix := hex.EncodeToString(hash[:])[:releaseNameHashLength]
+
+	name := invalidReleaseNameChars.ReplaceAllString(strings.ToLower(resourceName), "-")
+	if len(name) > maxReleaseNameLength-releaseNameHashLength-1 {
+		name = name[:maxReleaseNameLength-releaseNameHashLength-1]
+	}
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return suffix
+	}
+
+	return name + "-" + suffix
+}
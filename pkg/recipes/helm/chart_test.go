@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseChartPath(t *testing.T) {
+	tests := []struct {
+		desc    string
+		path    string
+		name    string
+		repoURL string
+		err     string
+	}{
+		{
+			desc: "oci reference",
+			path: "oci://ghcr.io/radius-project/charts/redis",
+			name: "oci://ghcr.io/radius-project/charts/redis",
+		},
+		{
+			desc:    "chart repository",
+			path:    "https://charts.example.com/stable/redis",
+			name:    "redis",
+			repoURL: "https://charts.example.com/stable",
+		},
+		{
+			desc:    "chart repository with trailing slash",
+			path:    "http://charts.example.com/redis/",
+			name:    "redis",
+			repoURL: "http://charts.example.com",
+		},
+		{
+			desc: "packaged chart",
+			path: "https://charts.example.com/stable/redis-1.2.0.tgz",
+			name: "https://charts.example.com/stable/redis-1.2.0.tgz",
+		},
+		{
+			desc: "missing chart name",
+			path: "https://charts.example.com",
+			err:  "the path must end with the name of the chart",
+		},
+		{
+			desc: "local path",
+			path: "./charts/redis",
+			err:  "the path must be an OCI reference or the URL of a chart in a chart repository",
+		},
+		{
+			desc: "unsupported scheme",
+			path: "br:ghcr.io/radius-project/recipes/redis",
+			err:  "the path must be an OCI reference or the URL of a chart in a chart repository",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			name, repoURL, err := parseChartPath(tc.path)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.name, name)
+			require.Equal(t, tc.repoURL, repoURL)
+		})
+	}
+}
+
+func Test_ReleaseName(t *testing.T) {
+	id := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+	name := ReleaseName(id, "redis")
+	require.True(t, strings.HasPrefix(name, "redis-"))
+	require.Len(t, name, len("redis-")+releaseNameHashLength)
+
+	// The name is case insensitive, like the resource ID.
+	require.Equal(t, name, ReleaseName(strings.ToLower(id), "redis"))
+
+	// Resources of different types with the same name are deployed as different releases.
+	require.NotEqual(t, name, ReleaseName("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/redis", "redis"))
+
+	long := ReleaseName(id, "My_Redis_"+strings.Repeat("a", 100))
+	require.Len(t, long, maxReleaseNameLength)
+	require.Regexp(t, `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`, long)
+}
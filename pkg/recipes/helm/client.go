@@ -0,0 +1,240 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// helmDriverSecret is the storage driver of the releases, which stores them in Kubernetes secrets.
+	helmDriverSecret = "secret"
+
+	// uninstallTimeout is the time to wait for the resources of a release to be deleted.
+	uninstallTimeout = time.Duration(10) * time.Minute
+)
+
+var _ HelmClient = (*client)(nil)
+
+// NewClient creates a new HelmClient which deploys the releases of Helm recipes to the Kubernetes cluster of the
+// given configuration.
+func NewClient(restConfig *rest.Config) HelmClient {
+	return &client{restConfig: restConfig}
+}
+
+type client struct {
+	restConfig *rest.Config
+}
+
+// Upgrade installs the chart as a new release when the release does not exist and upgrades it otherwise. Failed
+// deployments are rolled back, so that the next deployment starts from the last successful release.
+func (c *client) Upgrade(ctx context.Context, options Options) (*release.Release, error) {
+	cfg, err := c.actionConfig(ctx, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	helmChart, err := loadChart(cfg, options.Chart)
+	if err != nil {
+		return nil, err
+	}
+
+	history := action.NewHistory(cfg)
+	history.Max = 1
+	_, err = history.Run(options.ReleaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		install := action.NewInstall(cfg)
+		install.ReleaseName = options.ReleaseName
+		install.Namespace = options.Namespace
+		install.CreateNamespace = true
+		install.Timeout = options.Timeout
+		install.DryRun = options.DryRun
+		install.Atomic = !options.DryRun
+		install.Wait = !options.DryRun
+		return install.RunWithContext(ctx, helmChart, options.Values)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the history of release %q: %w", options.ReleaseName, err)
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = options.Namespace
+	upgrade.Timeout = options.Timeout
+	upgrade.DryRun = options.DryRun
+	upgrade.Atomic = !options.DryRun
+	upgrade.Wait = !options.DryRun
+	return upgrade.RunWithContext(ctx, options.ReleaseName, helmChart, options.Values)
+}
+
+// Get returns the latest revision of the release, or nil if the release is not installed.
+func (c *client) Get(ctx context.Context, namespace, releaseName string) (*release.Release, error) {
+	cfg, err := c.actionConfig(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := action.NewGet(cfg).Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return rel, nil
+}
+
+// Uninstall uninstalls the release and waits for its resources to be deleted.
+func (c *client) Uninstall(ctx context.Context, namespace, releaseName string) error {
+	cfg, err := c.actionConfig(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	uninstall := action.NewUninstall(cfg)
+	uninstall.Wait = true
+	uninstall.Timeout = uninstallTimeout
+	_, err = uninstall.Run(releaseName)
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Release %q is not installed, skipping uninstall", releaseName))
+		return nil
+	}
+
+	return err
+}
+
+// LoadChart downloads the chart without connecting to the Kubernetes cluster.
+func (c *client) LoadChart(ctx context.Context, chart ChartReference) (*chart.Chart, error) {
+	registryClient, err := registry.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the registry client: %w", err)
+	}
+
+	return loadChart(&action.Configuration{RegistryClient: registryClient}, chart)
+}
+
+// actionConfig creates the configuration of the Helm actions on the releases in the given namespace.
+func (c *client) actionConfig(ctx context.Context, namespace string) (*action.Configuration, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	cfg := &action.Configuration{}
+	err := cfg.Init(&restClientGetter{restConfig: c.restConfig, namespace: namespace}, namespace, helmDriverSecret, func(format string, v ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, v...))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize helm: %w", err)
+	}
+
+	cfg.RegistryClient, err = registry.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the registry client: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// loadChart downloads the chart to a temporary directory and loads it.
+func loadChart(cfg *action.Configuration, ref ChartReference) (*chart.Chart, error) {
+	name, repoURL, err := parseChartPath(ref.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "helm-recipe")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the chart directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	settings := cli.New()
+	settings.RepositoryCache = filepath.Join(dir, "repository")
+	settings.RepositoryConfig = filepath.Join(dir, "repositories.yaml")
+
+	// The chart path options are taken from an install action, which is the only way to set the registry client used to
+	// download charts from OCI registries.
+	pathOptions := action.NewInstall(cfg).ChartPathOptions
+	pathOptions.RepoURL = repoURL
+	pathOptions.Version = ref.Version
+
+	chartPath, err := pathOptions.LocateChart(name, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download helm chart %q: %w", ref.Path, err)
+	}
+
+	return loader.Load(chartPath)
+}
+
+// restClientGetter provides the Kubernetes clients of the Helm actions from the REST configuration of the cluster
+// rather than from a kubeconfig file.
+type restClientGetter struct {
+	restConfig *rest.Config
+	namespace  string
+}
+
+// ToRESTConfig returns a copy of the REST configuration of the cluster.
+func (g *restClientGetter) ToRESTConfig() (*rest.Config, error) {
+	return rest.CopyConfig(g.restConfig), nil
+}
+
+// ToDiscoveryClient returns a cached discovery client of the cluster.
+func (g *restClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(g.restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return memory.NewMemCacheClient(dc), nil
+}
+
+// ToRESTMapper returns a REST mapper using the discovery client of the cluster.
+func (g *restClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	dc, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(dc)
+	return restmapper.NewShortcutExpander(mapper, dc), nil
+}
+
+// ToRawKubeConfigLoader returns a client configuration which only sets the namespace of the release, used as the
+// default namespace of its resources.
+func (g *restClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return clientcmd.NewDefaultClientConfig(clientcmdapi.Config{}, &clientcmd.ConfigOverrides{
+		Context: clientcmdapi.Context{Namespace: g.namespace},
+	})
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"time"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+//go:generate mockgen -destination=./mock_client.go -package=helm -self_package github.com/radius-project/radius/pkg/recipes/helm github.com/radius-project/radius/pkg/recipes/helm HelmClient
+type HelmClient interface {
+	// Upgrade installs the chart of the recipe as a new release, or upgrades the release when it is already installed,
+	// and returns the deployed release. When DryRun is set the release is rendered without being deployed.
+	Upgrade(ctx context.Context, options Options) (*release.Release, error)
+
+	// Get returns the deployed release with the given name, or nil if the release is not installed.
+	Get(ctx context.Context, namespace, releaseName string) (*release.Release, error)
+
+	// Uninstall uninstalls the release with the given name. Uninstalling a release which is not installed is not an error.
+	Uninstall(ctx context.Context, namespace, releaseName string) error
+
+	// LoadChart downloads and loads the chart of the recipe.
+	LoadChart(ctx context.Context, chart ChartReference) (*chart.Chart, error)
+}
+
+// ChartReference is the location of the chart of a recipe.
+type ChartReference struct {
+	// Path is the template path of the recipe. It is either an OCI reference such as
+	// 'oci://ghcr.io/myregistry/charts/redis' or the URL of a chart in a chart repository such as
+	// 'https://charts.example.com/stable/redis', where the last segment of the path is the name of the chart.
+	// The URL of a packaged chart ending in '.tgz' is also supported.
+	Path string
+
+	// Version is the version of the chart. The latest version is used when it is empty.
+	Version string
+}
+
+// Options represents the options required to install or upgrade the release of a Helm recipe.
+type Options struct {
+	// Chart is the chart of the recipe.
+	Chart ChartReference
+
+	// Namespace is the namespace the release is installed into.
+	Namespace string
+
+	// ReleaseName is the name of the release.
+	ReleaseName string
+
+	// Values are the values of the release.
+	Values map[string]any
+
+	// Timeout is the time to wait for the resources of the release to be ready.
+	Timeout time.Duration
+
+	// DryRun renders the release without deploying it.
+	DryRun bool
+}
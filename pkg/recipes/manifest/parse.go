@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// clusterScopedKinds are the well-known kinds of cluster scoped Kubernetes objects. The objects of other kinds which
+// do not set their namespace are deployed into the namespace of the recipe.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// Parse returns the Kubernetes objects of a rendered manifest, in the order in which they are rendered. The namespace
+// is set on the namespaced objects which do not set their namespace.
+func Parse(manifest string, namespace string) ([]*unstructured.Unstructured, error) {
+	manifests := releaseutil.SplitManifests(manifest)
+
+	keys := make([]string, 0, len(manifests))
+	for key := range manifests {
+		keys = append(keys, key)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	objects := []*unstructured.Unstructured{}
+	for _, key := range keys {
+		content := map[string]any{}
+		if err := yaml.Unmarshal([]byte(manifests[key]), &content); err != nil {
+			return nil, fmt.Errorf("failed to parse the manifest: %w", err)
+		}
+
+		// Documents only made of comments are empty.
+		if len(content) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: content}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("failed to parse the manifest: the object %q has no kind or name", strings.TrimSpace(manifests[key]))
+		}
+
+		if obj.GetNamespace() == "" && !clusterScopedKinds[obj.GetKind()] {
+			obj.SetNamespace(namespace)
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `---
+# Source: redis/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: redis
+spec:
+  ports:
+  - port: 6379
+---
+# Source: redis/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+  namespace: other
+---
+# Source: redis/templates/empty.yaml
+# Rendered empty
+---
+# Source: redis/templates/clusterrole.yaml
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: redis-reader
+`
+
+func Test_Parse(t *testing.T) {
+	objects, err := Parse(testManifest, "recipe-ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	require.Equal(t, "Service", objects[0].GetKind())
+	require.Equal(t, "redis", objects[0].GetName())
+	require.Equal(t, "recipe-ns", objects[0].GetNamespace())
+
+	require.Equal(t, "Deployment", objects[1].GetKind())
+	require.Equal(t, "other", objects[1].GetNamespace())
+
+	require.Equal(t, "ClusterRole", objects[2].GetKind())
+	require.Equal(t, "", objects[2].GetNamespace())
+}
+
+func Test_Parse_Empty(t *testing.T) {
+	objects, err := Parse("", "recipe-ns")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	_, err := Parse("---\napiVersion: v1\nkind: Service\n", "recipe-ns")
+	require.ErrorContains(t, err, "has no kind or name")
+
+	_, err = Parse("---\nkind: [\n", "recipe-ns")
+	require.ErrorContains(t, err, "failed to parse the manifest")
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// kustomizationFileNames are the names of the kustomization file at the root of a recipe, in the order in which
+// kustomize looks for them.
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// Render renders the content of a recipe and returns the Kubernetes objects of the recipe.
+//
+// The content is either a single YAML file holding one or more documents, or a gzip compressed tar archive of a
+// directory of YAML files. The files are rendered as Go templates with the given data, where the functions of the
+// sprig library are available. If the archive holds a kustomization file at its root, the objects are built from the
+// kustomization; otherwise the objects of all the YAML files of the archive are returned, in the lexical order of
+// their paths.
+//
+// The namespace is set on the namespaced objects which do not set their namespace.
+func Render(content []byte, data map[string]any, namespace string) ([]*unstructured.Unstructured, error) {
+	files := map[string][]byte{}
+	if isGzip(content) {
+		var err error
+		files, err = extractArchive(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the archive of the recipe: %w", err)
+		}
+	} else {
+		files["/manifest.yaml"] = content
+	}
+
+	paths := []string{}
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		if !isTemplate(p) {
+			continue
+		}
+
+		rendered, err := renderTemplate(p, files[p], data)
+		if err != nil {
+			return nil, err
+		}
+		files[p] = rendered
+	}
+
+	for _, name := range kustomizationFileNames {
+		if _, ok := files["/"+name]; ok {
+			return build(files, namespace)
+		}
+	}
+
+	documents := []string{}
+	for _, p := range paths {
+		if isManifest(p) {
+			documents = append(documents, string(files[p]))
+		}
+	}
+
+	return Parse(strings.Join(documents, "\n---\n"), namespace)
+}
+
+// build builds the kustomization at the root of the files.
+func build(files map[string][]byte, namespace string) ([]*unstructured.Unstructured, error) {
+	fs := filesys.MakeFsInMemory()
+	for p, b := range files {
+		if err := fs.MkdirAll(path.Dir(p)); err != nil {
+			return nil, err
+		}
+		if err := fs.WriteFile(p, b); err != nil {
+			return nil, err
+		}
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, "/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the kustomization of the recipe: %w", err)
+	}
+
+	b, err := resMap.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the kustomization of the recipe: %w", err)
+	}
+
+	return Parse(string(b), namespace)
+}
+
+// renderTemplate renders a file of the recipe as a Go template. Missing values are rendered as empty strings, like
+// Helm does.
+func renderTemplate(name string, content []byte, data map[string]any) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Option("missingkey=zero").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the template %q: %w", name, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render the template %q: %w", name, err)
+	}
+
+	return []byte(strings.ReplaceAll(buf.String(), "<no value>", "")), nil
+}
+
+// extractArchive returns the regular files of a gzip compressed tar archive by their absolute path.
+func extractArchive(content []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		// Cleaning the path of a rooted path removes any '..' element, so that files can't be written outside the
+		// root of the in-memory file system.
+		files[path.Clean("/"+header.Name)] = b
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("the archive is empty")
+	}
+
+	return files, nil
+}
+
+// isGzip returns true if the content starts with the magic number of the gzip format.
+func isGzip(content []byte) bool {
+	return len(content) > 2 && content[0] == 0x1f && content[1] == 0x8b
+}
+
+// isManifest returns true if the file holds Kubernetes objects.
+func isManifest(p string) bool {
+	ext := path.Ext(p)
+	return (ext == ".yaml" || ext == ".yml") && !isKustomization(p)
+}
+
+// isTemplate returns true if the file is rendered as a template.
+func isTemplate(p string) bool {
+	return isManifest(p) || isKustomization(p)
+}
+
+// isKustomization returns true if the file is a kustomization file.
+func isKustomization(p string) bool {
+	for _, name := range kustomizationFileNames {
+		if path.Base(p) == name {
+			return true
+		}
+	}
+	return false
+}
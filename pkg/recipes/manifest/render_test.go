@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .context.resource.name }}
+spec:
+  replicas: {{ .replicas | default 1 }}
+`
+	testServiceTemplate = `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .context.resource.name }}
+  annotations:
+    example.com/missing: "{{ .missing }}"
+`
+	testKustomization = `namePrefix: {{ .context.resource.name }}-
+resources:
+- deployment.yaml
+- service.yaml
+`
+)
+
+var testData = map[string]any{
+	"replicas": 3,
+	"context": map[string]any{
+		"resource": map[string]any{
+			"name": "redis",
+		},
+	},
+}
+
+func archive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		require.NoError(t, err)
+		_, err = tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func Test_Render_File(t *testing.T) {
+	objects, err := Render([]byte(testDeploymentTemplate+"---\n"+testServiceTemplate), testData, "recipe-ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	require.Equal(t, "Deployment", objects[0].GetKind())
+	require.Equal(t, "redis", objects[0].GetName())
+	require.Equal(t, "recipe-ns", objects[0].GetNamespace())
+	require.Equal(t, float64(3), objects[0].Object["spec"].(map[string]any)["replicas"])
+
+	require.Equal(t, "Service", objects[1].GetKind())
+	require.Equal(t, "", objects[1].GetAnnotations()["example.com/missing"])
+}
+
+func Test_Render_Archive(t *testing.T) {
+	content := archive(t, map[string]string{
+		"redis/service.yaml":    testServiceTemplate,
+		"redis/deployment.yaml": testDeploymentTemplate,
+		"redis/README.md":       "{{ not a template",
+	})
+
+	objects, err := Render(content, testData, "recipe-ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "Deployment", objects[0].GetKind())
+	require.Equal(t, "Service", objects[1].GetKind())
+}
+
+func Test_Render_Kustomization(t *testing.T) {
+	content := archive(t, map[string]string{
+		"kustomization.yaml": testKustomization,
+		"deployment.yaml":    testDeploymentTemplate,
+		"service.yaml":       testServiceTemplate,
+	})
+
+	objects, err := Render(content, testData, "recipe-ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	names := map[string]string{}
+	for _, obj := range objects {
+		names[obj.GetKind()] = obj.GetName()
+		require.Equal(t, "recipe-ns", obj.GetNamespace())
+	}
+	require.Equal(t, map[string]string{"Deployment": "redis-redis", "Service": "redis-redis"}, names)
+}
+
+func Test_Render_PathTraversal(t *testing.T) {
+	content := archive(t, map[string]string{
+		"../../kustomization.yaml": testKustomization,
+		"../deployment.yaml":       testDeploymentTemplate,
+		"service.yaml":             testServiceTemplate,
+	})
+
+	objects, err := Render(content, testData, "recipe-ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+}
+
+func Test_Render_Invalid(t *testing.T) {
+	_, err := Render([]byte("kind: {{ .context.resource.name"), testData, "recipe-ns")
+	require.ErrorContains(t, err, "failed to parse the template")
+
+	_, err = Render([]byte{0x1f, 0x8b, 0x00}, testData, "recipe-ns")
+	require.ErrorContains(t, err, "failed to read the archive of the recipe")
+
+	content := archive(t, map[string]string{
+		"kustomization.yaml": "resources:\n- missing.yaml\n",
+	})
+	_, err = Render(content, testData, "recipe-ns")
+	require.ErrorContains(t, err, "failed to build the kustomization of the recipe")
+}
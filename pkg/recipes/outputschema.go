@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	oai_errors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+// OutputSchema is the JSON schema of the outputs the recipes of a portable resource type must return. The schema
+// describes an object with the "values" and "secrets" of the recipe output.
+type OutputSchema struct {
+	resourceType string
+	document     map[string]any
+	schema       *spec.Schema
+}
+
+// NewOutputSchema parses the JSON schema of the outputs the recipes of the resource type must return.
+func NewOutputSchema(resourceType string, raw []byte) (*OutputSchema, error) {
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(raw, schema); err != nil {
+		return nil, fmt.Errorf("failed to parse the recipe output schema of resource type %q: %w", resourceType, err)
+	}
+
+	document := map[string]any{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse the recipe output schema of resource type %q: %w", resourceType, err)
+	}
+
+	return &OutputSchema{resourceType: resourceType, document: document, schema: schema}, nil
+}
+
+// Document returns the JSON schema document, so that recipe authors can validate the outputs of their recipes.
+func (s *OutputSchema) Document() map[string]any {
+	return s.document
+}
+
+// Validate validates the values and secrets of the recipe output against the schema. The properties of the resource, and
+// the secrets in its "secrets" property, supply the outputs the recipe does not return, as the processors of the resource
+// types accept them from either. It returns a RecipeError with the MissingRecipeOutputs code when the only problems are
+// required outputs neither the recipe nor the resource set, and with the InvalidRecipeOutputs code otherwise. Each
+// problem is reported as a detail of the error targeting the output.
+func (s *OutputSchema) Validate(output *RecipeOutput, properties map[string]any) error {
+	resourceSecrets, _ := properties["secrets"].(map[string]any)
+	data := map[string]any{
+		"values":  s.withResourceProperties("values", output.Values, properties),
+		"secrets": s.withResourceProperties("secrets", output.Secrets, resourceSecrets),
+	}
+
+	result := validate.NewSchemaValidator(s.schema, nil, "", strfmt.Default).Validate(data)
+	if result.IsValid() {
+		return nil
+	}
+
+	code := MissingRecipeOutputs
+	details := []*v1.ErrorDetails{}
+	for _, err := range result.Errors {
+		detail := &v1.ErrorDetails{Code: InvalidRecipeOutputs, Message: err.Error()}
+		if validationErr, ok := err.(*oai_errors.Validation); ok {
+			detail.Target = validationErr.Name
+			detail.Message = strings.Replace(validationErr.Error(), " in "+validationErr.In, "", 1)
+			if validationErr.Code() == oai_errors.RequiredFailCode {
+				detail.Code = MissingRecipeOutputs
+			}
+		}
+		if detail.Code != MissingRecipeOutputs {
+			code = InvalidRecipeOutputs
+		}
+		details = append(details, detail)
+	}
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].Target < details[j].Target
+	})
+
+	messages := []string{}
+	for _, detail := range details {
+		messages = append(messages, detail.Message)
+	}
+	message := fmt.Sprintf("the outputs of the recipe do not match the outputs required by resource type %q: %s", s.resourceType, strings.Join(messages, "; "))
+	return NewRecipeError(code, message, util.ExecutionError, details...)
+}
+
+// withResourceProperties returns the outputs of the recipe in the given section of the schema, completed with the
+// properties of the resource which are declared by the schema and which the recipe did not return.
+func (s *OutputSchema) withResourceProperties(section string, outputs map[string]any, properties map[string]any) map[string]any {
+	merged := map[string]any{}
+	for k, v := range outputs {
+		merged[k] = v
+	}
+
+	for name := range s.schema.Properties[section].Properties {
+		if _, ok := merged[name]; ok {
+			continue
+		}
+		if value, ok := properties[name]; ok && !isZeroValue(value) {
+			merged[name] = value
+		}
+	}
+
+	return merged
+}
+
+// isZeroValue returns true if the value of a resource property is unset.
+func isZeroValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	default:
+		return false
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipes
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/stretchr/testify/require"
+)
+
+const testOutputSchema = `{
+	"type": "object",
+	"properties": {
+		"values": {
+			"type": "object",
+			"properties": {
+				"host": { "type": "string" },
+				"port": { "type": "integer" }
+			},
+			"required": ["host", "port"]
+		},
+		"secrets": {
+			"type": "object",
+			"properties": {
+				"password": { "type": "string" }
+			}
+		}
+	}
+}`
+
+func TestNewOutputSchema(t *testing.T) {
+	t.Run("valid schema", func(t *testing.T) {
+		schema, err := NewOutputSchema("Applications.Test/testResources", []byte(testOutputSchema))
+		require.NoError(t, err)
+		require.Equal(t, "object", schema.Document()["type"])
+		require.Contains(t, schema.Document()["properties"], "values")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := NewOutputSchema("Applications.Test/testResources", []byte("{"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to parse the recipe output schema of resource type \"Applications.Test/testResources\"")
+	})
+}
+
+func TestOutputSchema_Validate(t *testing.T) {
+	schema, err := NewOutputSchema("Applications.Test/testResources", []byte(testOutputSchema))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc        string
+		output      *RecipeOutput
+		properties  map[string]any
+		expectedErr *RecipeError
+	}{
+		{
+			desc: "valid outputs",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "testhost", "port": float64(6379)},
+				Secrets: map[string]any{"password": "testpassword"},
+			},
+		},
+		{
+			desc: "outputs set by the resource",
+			output: &RecipeOutput{
+				Values:  map[string]any{"port": float64(6379)},
+				Secrets: map[string]any{},
+			},
+			properties: map[string]any{"host": "resourcehost", "port": float64(0), "secrets": map[string]any{"password": "resourcepassword"}},
+		},
+		{
+			desc: "outputs unset by the resource",
+			output: &RecipeOutput{
+				Values:  map[string]any{"host": "testhost"},
+				Secrets: map[string]any{},
+			},
+			properties: map[string]any{"host": "resourcehost", "port": float64(0)},
+			expectedErr: &RecipeError{
+				ErrorDetails: v1.ErrorDetails{
+					Code:    MissingRecipeOutputs,
+					Message: "the outputs of the recipe do not match the outputs required by resource type \"Applications.Test/testResources\": values.port is required",
+					Details: []v1.ErrorDetails{
+						{Code: MissingRecipeOutputs, Message: "values.port is required", Target: "values.port"},
+					},
+				},
+				DeploymentStatus: util.ExecutionError,
+			},
+		},
+		{
+			desc: "missing outputs",
+			output: &RecipeOutput{
+				Values:  map[string]any{},
+				Secrets: map[string]any{},
+			},
+			expectedErr: &RecipeError{
+				ErrorDetails: v1.ErrorDetails{
+					Code:    MissingRecipeOutputs,
+					Message: "the outputs of the recipe do not match the outputs required by resource type \"Applications.Test/testResources\": values.host is required; values.port is required",
+					Details: []v1.ErrorDetails{
+						{Code: MissingRecipeOutputs, Message: "values.host is required", Target: "values.host"},
+						{Code: MissingRecipeOutputs, Message: "values.port is required", Target: "values.port"},
+					},
+				},
+				DeploymentStatus: util.ExecutionError,
+			},
+		},
+		{
+			desc: "invalid outputs",
+			output: &RecipeOutput{
+				Values:  map[string]any{"port": "6379"},
+				Secrets: map[string]any{"password": "testpassword"},
+			},
+			expectedErr: &RecipeError{
+				ErrorDetails: v1.ErrorDetails{
+					Code:    InvalidRecipeOutputs,
+					Message: "the outputs of the recipe do not match the outputs required by resource type \"Applications.Test/testResources\": values.host is required; values.port must be of type integer: \"string\"",
+					Details: []v1.ErrorDetails{
+						{Code: MissingRecipeOutputs, Message: "values.host is required", Target: "values.host"},
+						{Code: InvalidRecipeOutputs, Message: "values.port must be of type integer: \"string\"", Target: "values.port"},
+					},
+				},
+				DeploymentStatus: util.ExecutionError,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := schema.Validate(tt.output, tt.properties)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			recipeError := &RecipeError{}
+			require.True(t, errors.As(err, &recipeError))
+			require.Equal(t, tt.expectedErr, recipeError)
+		})
+	}
+}
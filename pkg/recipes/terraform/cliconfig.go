@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// cliConfigFile is the name of the Terraform CLI configuration file generated in the working directory.
+	cliConfigFile = ".terraformrc"
+
+	// cliConfigEnvVar is the environment variable Terraform reads the location of the CLI configuration file from.
+	cliConfigEnvVar = "TF_CLI_CONFIG_FILE"
+
+	// registryTokenKey is the key of the API token of a private registry in its secret store.
+	registryTokenKey = "token"
+)
+
+// generateCLIConfig writes the Terraform CLI configuration of the environment, i.e. the provider network mirror and the
+// private registries, to the working directory and configures Terraform to use it. It must be called before Terraform
+// downloads modules or providers. The API tokens of the registries are read from their secret stores.
+func (e *executor) generateCLIConfig(ctx context.Context, tf *tfexec.Terraform, options Options) error {
+	if options.EnvConfig == nil {
+		return nil
+	}
+
+	terraformConfig := options.EnvConfig.RecipeConfig.Terraform
+	if terraformConfig.ProviderMirror.URL == "" && len(terraformConfig.Registries) == 0 {
+		return nil
+	}
+
+	tokens := map[string]string{}
+	for host, registry := range terraformConfig.Registries {
+		if registry.Secret == "" {
+			continue
+		}
+
+		secrets, err := util.FetchSecrets(ctx, registry.Secret, sdk.NewClientOptions(e.ucpConn))
+		if err != nil {
+			return fmt.Errorf("failed to fetch the secrets of terraform registry %q: %w", host, err)
+		}
+
+		token, ok := secrets[registryTokenKey]
+		if !ok {
+			return fmt.Errorf("secret store %q of terraform registry %q does not contain the %q secret", registry.Secret, host, registryTokenKey)
+		}
+		tokens[host] = token
+	}
+
+	configPath := filepath.Join(tf.WorkingDir(), cliConfigFile)
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Writing Terraform CLI config to file: %s", configPath))
+	// The file holds the registry tokens, so it is only readable by the owner.
+	if err := os.WriteFile(configPath, []byte(buildCLIConfig(terraformConfig, tokens)), 0600); err != nil {
+		return fmt.Errorf("failed to write terraform cli config: %w", err)
+	}
+
+	// Setting the environment of Terraform replaces the environment inherited from the process, which provides the
+	// credentials of the providers and backends, so the process environment is kept.
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			env[k] = v
+		}
+	}
+	env[cliConfigEnvVar] = configPath
+
+	return tf.SetEnv(tfexec.CleanEnv(env))
+}
+
+// buildCLIConfig builds the Terraform CLI configuration with the provider_installation block for the provider network
+// mirror, and the credentials and host blocks for the private registries.
+// https://developer.hashicorp.com/terraform/cli/config/config-file
+func buildCLIConfig(config datamodel.TerraformConfigProperties, tokens map[string]string) string {
+	var b strings.Builder
+
+	if mirror := config.ProviderMirror; mirror.URL != "" {
+		b.WriteString("provider_installation {\n")
+		b.WriteString("  network_mirror {\n")
+		fmt.Fprintf(&b, "    url = %s\n", strconv.Quote(mirror.URL))
+		if len(mirror.Include) > 0 {
+			fmt.Fprintf(&b, "    include = %s\n", quoteList(mirror.Include))
+		}
+		if len(mirror.Exclude) > 0 {
+			fmt.Fprintf(&b, "    exclude = %s\n", quoteList(mirror.Exclude))
+		}
+		b.WriteString("  }\n")
+
+		// Providers which are not installed from the mirror are installed from their origin registries.
+		if len(mirror.Include) > 0 {
+			b.WriteString("  direct {\n")
+			fmt.Fprintf(&b, "    exclude = %s\n", quoteList(mirror.Include))
+			b.WriteString("  }\n")
+		} else if len(mirror.Exclude) > 0 {
+			b.WriteString("  direct {\n")
+			fmt.Fprintf(&b, "    include = %s\n", quoteList(mirror.Exclude))
+			b.WriteString("  }\n")
+		}
+		b.WriteString("}\n")
+	}
+
+	hosts := make([]string, 0, len(config.Registries))
+	for host := range config.Registries {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		if token, ok := tokens[host]; ok {
+			fmt.Fprintf(&b, "credentials %s {\n", strconv.Quote(host))
+			fmt.Fprintf(&b, "  token = %s\n", strconv.Quote(token))
+			b.WriteString("}\n")
+		}
+
+		services := config.Registries[host].Services
+		if len(services) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(services))
+		for name := range services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Fprintf(&b, "host %s {\n", strconv.Quote(host))
+		b.WriteString("  services = {\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "    %s = %s\n", strconv.Quote(name), strconv.Quote(services[name]))
+		}
+		b.WriteString("  }\n")
+		b.WriteString("}\n")
+	}
+
+	return b.String()
+}
+
+// quoteList formats the values as a list of quoted strings.
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
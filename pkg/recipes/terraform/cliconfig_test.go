@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCLIConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   datamodel.TerraformConfigProperties
+		tokens   map[string]string
+		expected string
+	}{
+		{
+			name: "mirror for all providers",
+			config: datamodel.TerraformConfigProperties{
+				ProviderMirror: datamodel.TerraformProviderMirrorProperties{URL: "https://mirror.example.com/providers/"},
+			},
+			expected: `provider_installation {
+  network_mirror {
+    url = "https://mirror.example.com/providers/"
+  }
+}
+`,
+		},
+		{
+			name: "mirror for included providers",
+			config: datamodel.TerraformConfigProperties{
+				ProviderMirror: datamodel.TerraformProviderMirrorProperties{
+					URL:     "https://mirror.example.com/providers/",
+					Include: []string{"registry.terraform.io/hashicorp/*"},
+				},
+			},
+			expected: `provider_installation {
+  network_mirror {
+    url = "https://mirror.example.com/providers/"
+    include = ["registry.terraform.io/hashicorp/*"]
+  }
+  direct {
+    exclude = ["registry.terraform.io/hashicorp/*"]
+  }
+}
+`,
+		},
+		{
+			name: "mirror with excluded providers",
+			config: datamodel.TerraformConfigProperties{
+				ProviderMirror: datamodel.TerraformProviderMirrorProperties{
+					URL:     "https://mirror.example.com/providers/",
+					Exclude: []string{"example.com/*/*"},
+				},
+			},
+			expected: `provider_installation {
+  network_mirror {
+    url = "https://mirror.example.com/providers/"
+    exclude = ["example.com/*/*"]
+  }
+  direct {
+    include = ["example.com/*/*"]
+  }
+}
+`,
+		},
+		{
+			name: "registries",
+			config: datamodel.TerraformConfigProperties{
+				Registries: map[string]datamodel.TerraformRegistryProperties{
+					"registry.example.com": {
+						Secret:   "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/registry",
+						Services: map[string]string{"providers.v1": "https://registry.example.com/providers/v1/", "modules.v1": "https://registry.example.com/modules/v1/"},
+					},
+					"app.terraform.io": {
+						Secret: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/tfc",
+					},
+				},
+			},
+			tokens: map[string]string{"registry.example.com": "registry-token", "app.terraform.io": "tfc-token"},
+			expected: `credentials "app.terraform.io" {
+  token = "tfc-token"
+}
+credentials "registry.example.com" {
+  token = "registry-token"
+}
+host "registry.example.com" {
+  services = {
+    "modules.v1" = "https://registry.example.com/modules/v1/"
+    "providers.v1" = "https://registry.example.com/providers/v1/"
+  }
+}
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, buildCLIConfig(tc.config, tc.tokens))
+		})
+	}
+}
+
+func TestGenerateCLIConfig(t *testing.T) {
+	ctx := testcontext.New(t)
+	workingDir := t.TempDir()
+	tf, err := tfexec.NewTerraform(workingDir, filepath.Join(workingDir, "terraform"))
+	require.NoError(t, err)
+	e := executor{}
+
+	// No CLI config is generated without a provider mirror or private registries.
+	err = e.generateCLIConfig(ctx, tf, Options{EnvConfig: &recipes.Configuration{}})
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(workingDir, cliConfigFile))
+
+	envConfig := &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				ProviderMirror: datamodel.TerraformProviderMirrorProperties{URL: "https://mirror.example.com/providers/"},
+			},
+		},
+	}
+	err = e.generateCLIConfig(ctx, tf, Options{EnvConfig: envConfig})
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(workingDir, cliConfigFile))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// BackendS3 is the Terraform backend storing state in an S3-compatible bucket.
+	BackendS3 = "s3"
+
+	// BackendAzureRM is the Terraform backend storing state in an Azure Blob Storage container.
+	BackendAzureRM = "azurerm"
+
+	// BackendPostgres is the Terraform backend storing state in a PostgreSQL database.
+	BackendPostgres = "pg"
+
+	// BackendLocal is the Terraform backend storing state in the local filesystem, for dev and test purpose.
+	BackendLocal = "local"
+)
+
+var (
+	// SupportedBackends is the list of the Terraform backends supported for Terraform recipes.
+	SupportedBackends = []string{BackendKubernetes, BackendS3, BackendAzureRM, BackendPostgres, BackendLocal}
+
+	// requiredConfig is the configuration required by each backend, which has no default and cannot be set from the
+	// environment of the Radius control plane.
+	requiredConfig = map[string][]string{
+		BackendS3:      {"bucket"},
+		BackendAzureRM: {"storage_account_name", "container_name"},
+		BackendLocal:   {"workspace_dir"},
+	}
+
+	// credentialConfig is the configuration holding credentials, which is stored in plain text in the environment and
+	// must be set in the environment of the Radius control plane instead, e.g. AWS_SECRET_ACCESS_KEY or PG_CONN_STR.
+	credentialConfig = map[string][]string{
+		BackendS3:       {"access_key", "secret_key", "token"},
+		BackendAzureRM:  {"access_key", "sas_token", "client_secret", "client_certificate_password"},
+		BackendPostgres: {"conn_str"},
+	}
+)
+
+// NewBackend creates the backend of the given type with the backend configuration of the environment. The Kubernetes
+// backend is created when kind is empty.
+func NewBackend(kind string, config map[string]string, k8sClientSet kubernetes.Interface) (Backend, error) {
+	if err := ValidateBackendConfig(kind, config); err != nil {
+		return nil, err
+	}
+
+	if kind == "" || kind == BackendKubernetes {
+		return NewKubernetesBackend(k8sClientSet), nil
+	}
+
+	return NewWorkspaceBackend(kind, config), nil
+}
+
+// ValidateBackendConfig validates the backend type and configuration set in the environment.
+func ValidateBackendConfig(kind string, config map[string]string) error {
+	if kind == "" || kind == BackendKubernetes {
+		if len(config) > 0 {
+			return fmt.Errorf("terraform backend %q does not support configuration", BackendKubernetes)
+		}
+		return nil
+	}
+
+	if !slices.Contains(SupportedBackends, kind) {
+		return fmt.Errorf("unsupported terraform backend %q. Supported backends: %s", kind, strings.Join(SupportedBackends, ", "))
+	}
+
+	missing := []string{}
+	for _, k := range requiredConfig[kind] {
+		if config[k] == "" {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("terraform backend %q requires the configuration: %s", kind, strings.Join(missing, ", "))
+	}
+
+	credentials := []string{}
+	for _, k := range credentialConfig[kind] {
+		if _, ok := config[k]; ok {
+			credentials = append(credentials, k)
+		}
+	}
+	if len(credentials) > 0 {
+		sort.Strings(credentials)
+		return fmt.Errorf("terraform backend %q credentials must be set in the environment of the Radius control plane instead of the configuration: %s", kind, strings.Join(credentials, ", "))
+	}
+
+	// The working directory is deleted after each execution, so the local state must be stored outside of it.
+	if kind == BackendLocal && !filepath.IsAbs(config["workspace_dir"]) {
+		return fmt.Errorf("terraform backend %q requires an absolute workspace_dir", BackendLocal)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_ValidateBackendConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   string
+		config map[string]string
+		err    string
+	}{
+		{
+			name: "default backend",
+		},
+		{
+			name: "kubernetes backend",
+			kind: BackendKubernetes,
+		},
+		{
+			name:   "kubernetes backend with config",
+			kind:   BackendKubernetes,
+			config: map[string]string{"namespace": "default"},
+			err:    "terraform backend \"kubernetes\" does not support configuration",
+		},
+		{
+			name: "unsupported backend",
+			kind: "gcs",
+			err:  "unsupported terraform backend \"gcs\"",
+		},
+		{
+			name:   "s3 backend",
+			kind:   BackendS3,
+			config: map[string]string{"bucket": "tfstate", "region": "us-west-2"},
+		},
+		{
+			name:   "s3 backend without bucket",
+			kind:   BackendS3,
+			config: map[string]string{"region": "us-west-2"},
+			err:    "terraform backend \"s3\" requires the configuration: bucket",
+		},
+		{
+			name:   "azurerm backend with credentials",
+			kind:   BackendAzureRM,
+			config: map[string]string{"storage_account_name": "account", "container_name": "tfstate", "sas_token": "token", "access_key": "key"},
+			err:    "credentials must be set in the environment of the Radius control plane instead of the configuration: access_key, sas_token",
+		},
+		{
+			name: "pg backend",
+			kind: BackendPostgres,
+		},
+		{
+			name:   "local backend with relative workspace_dir",
+			kind:   BackendLocal,
+			config: map[string]string{"workspace_dir": "state"},
+			err:    "terraform backend \"local\" requires an absolute workspace_dir",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateBackendConfig(tc.kind, tc.config)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func Test_NewBackend(t *testing.T) {
+	b, err := NewBackend("", nil, fake.NewSimpleClientset())
+	require.NoError(t, err)
+	require.IsType(t, &kubernetesBackend{}, b)
+
+	b, err = NewBackend(BackendS3, map[string]string{"bucket": "tfstate"}, fake.NewSimpleClientset())
+	require.NoError(t, err)
+	require.IsType(t, &workspaceBackend{}, b)
+
+	_, err = NewBackend(BackendS3, nil, fake.NewSimpleClientset())
+	require.Error(t, err)
+}
+
+func Test_WorkspaceBackend_BuildBackend(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+
+	b := NewWorkspaceBackend(BackendS3, map[string]string{"bucket": "tfstate", "region": "us-west-2"})
+	actual, err := b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendS3: map[string]any{
+			"bucket": "tfstate",
+			"region": "us-west-2",
+			"key":    defaultStateKey,
+		},
+	}, actual)
+
+	b = NewWorkspaceBackend(BackendPostgres, map[string]string{"schema_name": "radius"})
+	actual, err = b.BuildBackend(&resourceRecipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		BackendPostgres: map[string]any{
+			"schema_name": "radius",
+		},
+	}, actual)
+}
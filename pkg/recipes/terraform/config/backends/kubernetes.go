@@ -17,12 +17,18 @@ limitations under the License.
 package backends
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha1"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -33,8 +39,6 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-var _ Backend = (*kubernetesBackend)(nil)
-
 const (
 	RadiusNamespace   = "radius-system"
 	BackendKubernetes = "kubernetes"
@@ -45,6 +49,13 @@ const (
 	// https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 	// https://developer.hashicorp.com/terraform/language/state/workspaces
 	KubernetesBackendNamePrefix = "tfstate-default-"
+
+	// kubernetesStateKey is the key of the Kubernetes secret data holding the Terraform state.
+	kubernetesStateKey = "tfstate"
+
+	// migratedStateFile is the file in the working directory where the state migrated from the Kubernetes backend is
+	// written before it is pushed to the new backend.
+	migratedStateFile = "migrated.tfstate"
 )
 
 var _ Backend = (*kubernetesBackend)(nil)
@@ -70,13 +81,17 @@ func (p *kubernetesBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadat
 	return generateKubernetesBackendConfig(secretSuffix)
 }
 
-// ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists.
-// name is the name of the backend Kubernetes secret resource that is created as a part of terraform apply
-// during recipe deployment.
-func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name string) (bool, error) {
+// ValidateBackendExists checks if the Kubernetes secret for Terraform state file exists. The secret is created by
+// Terraform as a part of terraform apply during recipe deployment.
+func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	_, err := p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
+	name, err := kubernetesSecretName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if k8s_errors.IsNotFound(err) {
 			logger.Info(fmt.Sprintf("Kubernetes secret %q does not exist: %s", name, err.Error()))
@@ -89,6 +104,104 @@ func (p *kubernetesBackend) ValidateBackendExists(ctx context.Context, name stri
 	return true, nil
 }
 
+// SelectState is a no-op since each recipe is configured with its own secret suffix and the state is stored in the
+// default Terraform workspace.
+func (p *kubernetesBackend) SelectState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error {
+	return nil
+}
+
+// DeleteState deletes the Kubernetes secret for Terraform state file.
+func (p *kubernetesBackend) DeleteState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error {
+	name, err := kubernetesSecretName(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	err = p.k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+	}
+
+	return nil
+}
+
+// MigrateKubernetesState copies the Terraform state of the recipe stored by the Kubernetes backend into the state
+// selected in tf, and deletes the Kubernetes secret once the state is copied. It is used when the environment of the
+// recipe switches to another backend. Returns false if the Kubernetes backend has no state for the recipe.
+func MigrateKubernetesState(ctx context.Context, k8sClientSet kubernetes.Interface, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	name, err := kubernetesSecretName(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	state, err := readKubernetesState(ctx, k8sClientSet, name)
+	if err != nil {
+		return false, err
+	} else if state == nil {
+		return false, nil
+	}
+
+	logger.Info(fmt.Sprintf("Migrating Terraform state from Kubernetes secret %q", name))
+	statePath := filepath.Join(tf.WorkingDir(), migratedStateFile)
+	if err := os.WriteFile(statePath, state, 0600); err != nil {
+		return false, fmt.Errorf("failed to write terraform state to migrate: %w", err)
+	}
+	defer os.Remove(statePath)
+
+	if err := tf.StatePush(ctx, statePath); err != nil {
+		return false, fmt.Errorf("failed to migrate terraform state from kubernetes secret %q: %w", name, err)
+	}
+
+	// The state is copied, so the secret is deleted to make the new backend the only source of the state.
+	err = k8sClientSet.CoreV1().Secrets(RadiusNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return false, fmt.Errorf("error deleting kubernetes secret for migrated terraform state: %w", err)
+	}
+
+	return true, nil
+}
+
+// readKubernetesState returns the Terraform state stored in the Kubernetes secret, or nil if the secret does not exist.
+// The Kubernetes backend stores the gzip-compressed state under the "tfstate" key of the secret.
+func readKubernetesState(ctx context.Context, k8sClientSet kubernetes.Interface, name string) ([]byte, error) {
+	secret, err := k8sClientSet.CoreV1().Secrets(RadiusNamespace).Get(ctx, name, metav1.GetOptions{})
+	if k8s_errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	data, ok := secret.Data[kubernetesStateKey]
+	if !ok {
+		return nil, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform state from kubernetes secret %q: %w", name, err)
+	}
+	defer r.Close()
+
+	state, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read terraform state from kubernetes secret %q: %w", name, err)
+	}
+
+	return state, nil
+}
+
+// kubernetesSecretName returns the name of the Kubernetes secret storing the Terraform state of the recipe.
+func kubernetesSecretName(resourceRecipe *recipes.ResourceMetadata) (string, error) {
+	secretSuffix, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return "", err
+	}
+
+	return KubernetesBackendNamePrefix + secretSuffix, nil
+}
+
 // generateSecretSuffix returns a unique string from the resourceID, environmentID, and applicationID
 // which is used as key for kubernetes secret in defining terraform backend.
 func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, error) {
@@ -117,8 +230,8 @@ func generateSecretSuffix(resourceRecipe *recipes.ResourceMetadata) (string, err
 	return fmt.Sprintf("%x", hash), nil
 }
 
-// generateKubernetesBackendConfig returns Terraform backend configuration to store Terraform state file for the deployment.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// generateKubernetesBackendConfig returns Terraform backend configuration to store Terraform state file for the deployment
+// in a Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
 func generateKubernetesBackendConfig(secretSuffix string) (map[string]interface{}, error) {
 	backend := map[string]interface{}{
 		BackendKubernetes: map[string]interface{}{
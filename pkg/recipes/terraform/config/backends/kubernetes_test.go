@@ -17,6 +17,8 @@ limitations under the License.
 package backends
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha1"
 	"fmt"
@@ -124,35 +126,82 @@ func Test_GenerateSecretSuffix_invalid_appid(t *testing.T) {
 }
 
 func Test_ValidateBackendExists(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	secretName, err := kubernetesSecretName(&resourceRecipe)
+	require.NoError(t, err)
+
 	clientset := fake.NewSimpleClientset()
+	b := NewKubernetesBackend(clientset)
+
+	// Validate that the function returns false for a non-existent secret.
+	exists, err := b.ValidateBackendExists(context.Background(), nil, &resourceRecipe)
+	require.NoError(t, err)
+	require.False(t, exists)
+
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-secret",
+			Name:      secretName,
 			Namespace: RadiusNamespace,
 		},
 		Data: map[string][]byte{
 			"key": []byte("value"),
 		},
 	}
-	_, err := clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Create(context.Background(), secret, metav1.CreateOptions{})
 	require.NoError(t, err)
 
-	b := NewKubernetesBackend(clientset)
-	exists, err := b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err = b.ValidateBackendExists(context.Background(), nil, &resourceRecipe)
 	require.NoError(t, err)
 	require.True(t, exists)
 
-	// Validate that the function returns false for a non-existent secret.
-	exists, err = b.ValidateBackendExists(context.Background(), "invalid-secret")
-	require.NoError(t, err)
-	require.False(t, exists)
-
 	// Validate error is returned for errors other than NotFound.
 	clientset.Fake.PrependReactor("get", "secrets", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
 		return true, nil, k8s_errors.NewServerTimeout(schema.GroupResource{Resource: "test-secret"}, "get", 1)
 	})
-	exists, err = b.ValidateBackendExists(context.Background(), "test-secret")
+	exists, err = b.ValidateBackendExists(context.Background(), nil, &resourceRecipe)
 	require.Error(t, err)
 	require.True(t, k8s_errors.IsServerTimeout(err))
 	require.False(t, exists)
 }
+
+func Test_DeleteState(t *testing.T) {
+	_, resourceRecipe := getTestInputs()
+	secretName, err := kubernetesSecretName(&resourceRecipe)
+	require.NoError(t, err)
+
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: RadiusNamespace}}
+	clientset := fake.NewSimpleClientset(secret)
+	b := NewKubernetesBackend(clientset)
+
+	err = b.DeleteState(context.Background(), nil, &resourceRecipe)
+	require.NoError(t, err)
+
+	_, err = clientset.CoreV1().Secrets(RadiusNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+	require.True(t, k8s_errors.IsNotFound(err))
+
+	// Deleting a state that does not exist is not an error.
+	err = b.DeleteState(context.Background(), nil, &resourceRecipe)
+	require.NoError(t, err)
+}
+
+func Test_ReadKubernetesState(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(`{"version": 4}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tfstate-default-test", Namespace: RadiusNamespace},
+		Data:       map[string][]byte{kubernetesStateKey: buf.Bytes()},
+	}
+	clientset := fake.NewSimpleClientset(secret)
+
+	state, err := readKubernetesState(context.Background(), clientset, "tfstate-default-test")
+	require.NoError(t, err)
+	require.Equal(t, `{"version": 4}`, string(state))
+
+	state, err = readKubernetesState(context.Background(), clientset, "tfstate-default-missing")
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
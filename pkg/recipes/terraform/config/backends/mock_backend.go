@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	tfexec "github.com/hashicorp/terraform-exec/tfexec"
 	recipes "github.com/radius-project/radius/pkg/recipes"
 )
 
@@ -50,17 +51,45 @@ func (mr *MockBackendMockRecorder) BuildBackend(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildBackend", reflect.TypeOf((*MockBackend)(nil).BuildBackend), arg0)
 }
 
+// DeleteState mocks base method.
+func (m *MockBackend) DeleteState(arg0 context.Context, arg1 *tfexec.Terraform, arg2 *recipes.ResourceMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteState indicates an expected call of DeleteState.
+func (mr *MockBackendMockRecorder) DeleteState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteState", reflect.TypeOf((*MockBackend)(nil).DeleteState), arg0, arg1, arg2)
+}
+
+// SelectState mocks base method.
+func (m *MockBackend) SelectState(arg0 context.Context, arg1 *tfexec.Terraform, arg2 *recipes.ResourceMetadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectState indicates an expected call of SelectState.
+func (mr *MockBackendMockRecorder) SelectState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectState", reflect.TypeOf((*MockBackend)(nil).SelectState), arg0, arg1, arg2)
+}
+
 // ValidateBackendExists mocks base method.
-func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 string) (bool, error) {
+func (m *MockBackend) ValidateBackendExists(arg0 context.Context, arg1 *tfexec.Terraform, arg2 *recipes.ResourceMetadata) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBackendExists", arg0, arg1)
+	ret := m.ctrl.Call(m, "ValidateBackendExists", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ValidateBackendExists indicates an expected call of ValidateBackendExists.
-func (mr *MockBackendMockRecorder) ValidateBackendExists(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) ValidateBackendExists(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBackendExists", reflect.TypeOf((*MockBackend)(nil).ValidateBackendExists), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBackendExists", reflect.TypeOf((*MockBackend)(nil).ValidateBackendExists), arg0, arg1, arg2)
 }
@@ -19,20 +19,30 @@ package backends
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/recipes"
 )
 
 //go:generate mockgen -destination=./mock_backend.go -package=backends -self_package github.com/radius-project/radius/pkg/recipes/terraform/config/backends github.com/radius-project/radius/pkg/recipes/terraform/config/backends Backend
 
-// Backend is an interface for generating Terraform backend configurations.
+// Backend is an interface for generating Terraform backend configurations and managing the Terraform state of recipes
+// stored in the backend.
 type Backend interface {
 	// BuildBackend generates the Terraform backend configuration for the backend.
 	// Returns a map of Terraform backend name to values representing the backend configuration.
 	// Returns an error if the backend configuration cannot be generated.
 	BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error)
 
-	// ValidateBackendExists checks if the Terraform state file backend source exists.
+	// ValidateBackendExists checks if the Terraform state file of the recipe exists in the backend.
 	// For example, for Kubernetes backend, it checks if the Kubernetes secret for Terraform state file exists.
-	// returns true if backend is found, false otherwise.
-	ValidateBackendExists(ctx context.Context, name string) (bool, error)
+	// tf must be initialized with the backend configuration. Returns true if the state is found, false otherwise.
+	ValidateBackendExists(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) (bool, error)
+
+	// SelectState makes Terraform use the state of the recipe, creating the state if it does not exist yet.
+	// tf must be initialized with the backend configuration.
+	SelectState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error
+
+	// DeleteState deletes the Terraform state of the recipe from the backend once the resources of the recipe are
+	// destroyed. It does not return an error if the state does not exist.
+	DeleteState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error
 }
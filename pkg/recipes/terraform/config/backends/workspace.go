@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backends
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// defaultWorkspace is the Terraform workspace which is always present and cannot be deleted.
+	defaultWorkspace = "default"
+
+	// defaultStateKey is the key of the state object in the backends storing state in an object store. Terraform stores
+	// the state of each workspace under a workspace specific prefix of the key.
+	defaultStateKey = "terraform.tfstate"
+)
+
+var _ Backend = (*workspaceBackend)(nil)
+
+// workspaceBackend is a Terraform backend shared by all recipes, such as s3, azurerm, pg or local, which stores the state
+// of each recipe in its own Terraform workspace. Workspaces let Terraform check, create and delete the state of a recipe
+// in any backend supporting them, so that Radius does not need a client for each of the backends.
+type workspaceBackend struct {
+	// kind is the Terraform backend type.
+	kind string
+
+	// config is the backend configuration set in the environment.
+	config map[string]string
+}
+
+// NewWorkspaceBackend creates a backend of the given type which stores the state of each recipe in its own Terraform
+// workspace. The config is the Terraform backend configuration.
+func NewWorkspaceBackend(kind string, config map[string]string) Backend {
+	return &workspaceBackend{kind: kind, config: config}
+}
+
+// BuildBackend generates the Terraform backend configuration from the configuration of the environment. The key of
+// the state object defaults to "terraform.tfstate" for the s3 and azurerm backends.
+// https://developer.hashicorp.com/terraform/language/settings/backends/configuration
+func (p *workspaceBackend) BuildBackend(resourceRecipe *recipes.ResourceMetadata) (map[string]any, error) {
+	if err := ValidateBackendConfig(p.kind, p.config); err != nil {
+		return nil, err
+	}
+
+	backendConfig := map[string]any{}
+	for k, v := range p.config {
+		backendConfig[k] = v
+	}
+
+	if p.kind == BackendS3 || p.kind == BackendAzureRM {
+		if _, ok := backendConfig["key"]; !ok {
+			backendConfig["key"] = defaultStateKey
+		}
+	}
+
+	return map[string]any{
+		p.kind: backendConfig,
+	}, nil
+}
+
+// ValidateBackendExists checks if the Terraform workspace of the recipe exists in the backend.
+func (p *workspaceBackend) ValidateBackendExists(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) (bool, error) {
+	workspace, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return false, err
+	}
+
+	workspaces, _, err := tf.WorkspaceList(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to list terraform workspaces: %w", err)
+	}
+
+	return slices.Contains(workspaces, workspace), nil
+}
+
+// SelectState selects the Terraform workspace of the recipe, creating it if it does not exist yet.
+func (p *workspaceBackend) SelectState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	workspace, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	exists, err := p.ValidateBackendExists(ctx, tf, resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		logger.Info(fmt.Sprintf("Selecting Terraform workspace %q", workspace))
+		err = tf.WorkspaceSelect(ctx, workspace)
+	} else {
+		logger.Info(fmt.Sprintf("Creating Terraform workspace %q", workspace))
+		err = tf.WorkspaceNew(ctx, workspace)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to select terraform workspace %q: %w", workspace, err)
+	}
+
+	return nil
+}
+
+// DeleteState deletes the Terraform workspace of the recipe, which deletes its state from the backend. Terraform
+// refuses to delete a workspace whose state still tracks resources.
+func (p *workspaceBackend) DeleteState(ctx context.Context, tf *tfexec.Terraform, resourceRecipe *recipes.ResourceMetadata) error {
+	workspace, err := generateSecretSuffix(resourceRecipe)
+	if err != nil {
+		return err
+	}
+
+	exists, err := p.ValidateBackendExists(ctx, tf, resourceRecipe)
+	if err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+
+	// The selected workspace cannot be deleted.
+	if err := tf.WorkspaceSelect(ctx, defaultWorkspace); err != nil {
+		return fmt.Errorf("failed to select terraform workspace %q: %w", defaultWorkspace, err)
+	}
+
+	if err := tf.WorkspaceDelete(ctx, workspace); err != nil {
+		return fmt.Errorf("failed to delete terraform workspace %q: %w", workspace, err)
+	}
+
+	return nil
+}
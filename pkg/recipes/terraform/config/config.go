@@ -34,6 +34,10 @@ import (
 const (
 	// modeConfigFile is read/write mode only for the owner of the TF config file.
 	modeConfigFile fs.FileMode = 0600
+
+	// providerAliasKey is the meta-argument of a provider configuration which distinguishes the additional
+	// configurations of a provider.
+	providerAliasKey = "alias"
 )
 
 // New creates TerraformConfig with the given module name and its inputs (module source, version, parameters)
@@ -82,9 +86,11 @@ func (cfg *TerraformConfig) Save(ctx context.Context, workingDir string) error {
 // AddProviders adds provider configurations for requiredProviders that are supported
 // by Radius to generate custom provider configurations. Save() must be called to save
 // the generated providers config. requiredProviders contains a list of provider names
-// that are required for the module.
-func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) error {
-	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig)
+// that are required for the module. envProviderConfigs contains the provider configurations
+// of the environment keyed by provider name, which are merged with the generated configurations.
+// The configurations of providers which are not required by the module are ignored.
+func (cfg *TerraformConfig) AddProviders(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, envProviderConfigs map[string][]map[string]any) error {
+	providerConfigs, err := getProviderConfigs(ctx, requiredProviders, supportedProviders, envConfig, envProviderConfigs)
 	if err != nil {
 		return err
 	}
@@ -136,30 +142,65 @@ func newModuleConfig(moduleSource string, moduleVersion string, params ...Recipe
 }
 
 // getProviderConfigs generates the Terraform provider configurations for the required providers.
-func getProviderConfigs(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration) (map[string]any, error) {
+// A provider with more than one configuration is set to the list of its configurations.
+func getProviderConfigs(ctx context.Context, requiredProviders []string, supportedProviders map[string]providers.Provider, envConfig *recipes.Configuration, envProviderConfigs map[string][]map[string]any) (map[string]any, error) {
 	providerConfigs := make(map[string]any)
 	for _, provider := range requiredProviders {
-		builder, ok := supportedProviders[provider]
-		if !ok {
-			// No-op: For any other provider, Radius doesn't generate any custom configuration.
-			continue
+		var config map[string]any
+		if builder, ok := supportedProviders[provider]; ok {
+			var err error
+			config, err = builder.BuildConfig(ctx, envConfig)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		config, err := builder.BuildConfig(ctx, envConfig)
-		if err != nil {
-			return nil, err
-		}
-		if len(config) > 0 {
-			providerConfigs[provider] = config
+		// No-op: For any other provider without configuration in the environment, Radius doesn't generate any custom configuration.
+		configs := mergeProviderConfigs(config, envProviderConfigs[provider])
+		switch len(configs) {
+		case 0:
+		case 1:
+			providerConfigs[provider] = configs[0]
+		default:
+			providerConfigs[provider] = configs
 		}
 	}
 
 	return providerConfigs, nil
 }
 
-// AddTerraformBackend adds backend configurations to store Terraform state file for the deployment.
-// Save() must be called to save the generated backend config.
-// Currently, the supported backend for Terraform Recipes is Kubernetes secret. https://developer.hashicorp.com/terraform/language/settings/backends/kubernetes
+// mergeProviderConfigs merges the configurations of a provider set in the environment with the configuration generated
+// by Radius. The environment configuration without an alias is layered over the generated configuration, so that its
+// properties take precedence. The configurations with an alias are added as they are after the default configuration.
+func mergeProviderConfigs(generated map[string]any, envConfigs []map[string]any) []map[string]any {
+	defaultConfig := map[string]any{}
+	for k, v := range generated {
+		defaultConfig[k] = v
+	}
+
+	aliasConfigs := []map[string]any{}
+	for _, envConfig := range envConfigs {
+		if _, ok := envConfig[providerAliasKey]; ok {
+			aliasConfigs = append(aliasConfigs, envConfig)
+			continue
+		}
+
+		for k, v := range envConfig {
+			defaultConfig[k] = v
+		}
+	}
+
+	if len(defaultConfig) == 0 {
+		return aliasConfigs
+	}
+
+	return append([]map[string]any{defaultConfig}, aliasConfigs...)
+}
+
+// AddTerraformBackend adds the configuration of the given backend to store the Terraform state file for the deployment,
+// and returns it. The backend is the one configured for the environment: the Kubernetes backend storing the state in
+// a Kubernetes secret by default, or a workspace backend (s3, azurerm, pg or local) storing the state of each recipe in
+// its own Terraform workspace. Save() must be called to save the generated backend config.
 func (cfg *TerraformConfig) AddTerraformBackend(resourceRecipe *recipes.ResourceMetadata, backend backends.Backend) (map[string]any, error) {
 	backendConfig, err := backend.BuildBackend(resourceRecipe)
 	if err != nil {
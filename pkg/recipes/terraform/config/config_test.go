@@ -308,6 +308,7 @@ func Test_AddProviders(t *testing.T) {
 	configTests := []struct {
 		desc               string
 		envConfig          recipes.Configuration
+		envProviderConfigs map[string][]map[string]any
 		requiredProviders  []string
 		expectedProviders  []map[string]any
 		expectedConfigFile string
@@ -347,6 +348,48 @@ func Test_AddProviders(t *testing.T) {
 
 			expectedConfigFile: "testdata/providers-valid.tf.json",
 		},
+		{
+			desc: "environment provider configs",
+			expectedProviders: []map[string]any{
+				{
+					"region": "test-region",
+				},
+			},
+			Err: nil,
+			envConfig: recipes.Configuration{
+				Providers: datamodel.Providers{
+					AWS: datamodel.ProvidersAWS{
+						Scope: "/planes/aws/aws/accounts/0000/regions/test-region",
+					},
+				},
+			},
+			envProviderConfigs: map[string][]map[string]any{
+				providers.AWSProviderName: {
+					{
+						"max_retries": 3,
+					},
+				},
+				"helm": {
+					{
+						"kubernetes": map[string]any{"config_path": "/home/radius/.kube/config"},
+					},
+					{
+						"alias":      "staging",
+						"kubernetes": map[string]any{"config_path": "/home/radius/.kube/staging"},
+					},
+				},
+				"random": {
+					{
+						"seed": "unused",
+					},
+				},
+			},
+			requiredProviders: []string{
+				providers.AWSProviderName,
+				"helm",
+			},
+			expectedConfigFile: "testdata/providers-envconfig.tf.json",
+		},
 		{
 			desc:              "invalid aws scope",
 			expectedProviders: nil,
@@ -423,7 +466,7 @@ func Test_AddProviders(t *testing.T) {
 			if tc.Err != nil {
 				mProvider.EXPECT().BuildConfig(ctx, &tc.envConfig).Times(1).Return(nil, tc.Err)
 			}
-			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig)
+			err := tfconfig.AddProviders(ctx, tc.requiredProviders, supportedProviders, &tc.envConfig, tc.envProviderConfigs)
 			if tc.Err != nil {
 				require.ErrorContains(t, err, tc.Err.Error())
 				return
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -38,9 +39,17 @@ import (
 const (
 	AWSProviderName = "aws"
 
-	awsRegionParam    = "region"
-	awsAccessKeyParam = "access_key"
-	awsSecretKeyParam = "secret_key"
+	awsRegionParam                    = "region"
+	awsAccessKeyParam                 = "access_key"
+	awsSecretKeyParam                 = "secret_key"
+	awsAssumeRoleParam                = "assume_role"
+	awsAssumeRoleWithWebIdentityParam = "assume_role_with_web_identity"
+	awsRoleARNParam                   = "role_arn"
+	awsWebIdentityTokenFileParam      = "web_identity_token_file"
+
+	// awsWebIdentityTokenFileEnv is the environment variable set by the EKS pod identity webhook with the path of the
+	// projected service account token.
+	awsWebIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
 )
 
 var _ Provider = (*awsProvider)(nil)
@@ -70,7 +79,7 @@ func (p *awsProvider) BuildConfig(ctx context.Context, envConfig *recipes.Config
 		return nil, err
 	}
 
-	credentials, err := fetchAWSCredentials(ctx, credentialsProvider)
+	credentials, err := fetchAWSCredentials(ctx, credentialsProvider, awsCredentialName(envConfig))
 	if err != nil {
 		return nil, err
 	}
@@ -105,12 +114,27 @@ func (p *awsProvider) getCredentialsProvider() (*credentials.AWSCredentialProvid
 	return credentials.NewAWSCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
 }
 
-// fetchAWSCredentials fetches AWS credentials from UCP. Returns nil if credentials not found error is received or the credentials are empty.
-func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials.CredentialProvider[credentials.AWSCredential]) (*credentials.AWSCredential, error) {
+// awsCredentialName returns the name of the AWS credential selected by the environment, or the name of the default
+// credential if the environment does not select one.
+func awsCredentialName(envConfig *recipes.Configuration) string {
+	if envConfig == nil {
+		return credentials.DefaultCredentialName
+	}
+	return credentials.CredentialNameOrDefault(envConfig.Providers.AWS.Credential)
+}
+
+// fetchAWSCredentials fetches the named AWS credentials from UCP. Returns nil if credentials not found error is received
+// for the default credential or the credentials are empty. A credential selected by the environment must be registered.
+func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials.CredentialProvider[credentials.AWSCredential], name string) (*credentials.AWSCredential, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	credentials, err := awsCredentialsProvider.Fetch(ctx, credentials.AWSPublic, "default")
+	selected := name != credentials.DefaultCredentialName
+	credentials, err := awsCredentialsProvider.Fetch(ctx, credentials.AWSPublic, name)
 	if err != nil {
 		if errors.Is(err, &secret.ErrNotFound{}) {
+			if selected {
+				return nil, fmt.Errorf("the AWS credential %q selected by the environment is not registered", name)
+			}
+
 			logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 			return nil, nil
 		}
@@ -118,7 +142,17 @@ func fetchAWSCredentials(ctx context.Context, awsCredentialsProvider credentials
 		return nil, err
 	}
 
-	if credentials == nil || credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+	if credentials == nil {
+		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
+		return nil, nil
+	}
+
+	if credentials.IsIRSA() {
+		if credentials.RoleARN == "" {
+			logger.Info("AWS credentials are not registered, skipping credentials configuration.")
+			return nil, nil
+		}
+	} else if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" || (credentials.IsAssumeRole() && credentials.RoleARN == "") {
 		logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -132,9 +166,29 @@ func (p *awsProvider) generateProviderConfigMap(credentials *credentials.AWSCred
 		config[awsRegionParam] = region
 	}
 
-	if credentials != nil && credentials.AccessKeyID != "" && credentials.SecretAccessKey != "" {
+	if credentials == nil {
+		return config
+	}
+
+	if credentials.IsIRSA() {
+		// IRSA assumes the role with the web identity token projected into the pod instead of an access key.
+		if credentials.RoleARN != "" {
+			assumeRole := map[string]any{
+				awsRoleARNParam: credentials.RoleARN,
+			}
+			if tokenFile := os.Getenv(awsWebIdentityTokenFileEnv); tokenFile != "" {
+				assumeRole[awsWebIdentityTokenFileParam] = tokenFile
+			}
+			config[awsAssumeRoleWithWebIdentityParam] = assumeRole
+		}
+	} else if credentials.AccessKeyID != "" && credentials.SecretAccessKey != "" {
 		config[awsAccessKeyParam] = credentials.AccessKeyID
 		config[awsSecretKeyParam] = credentials.SecretAccessKey
+		if credentials.IsAssumeRole() && credentials.RoleARN != "" {
+			config[awsAssumeRoleParam] = map[string]any{
+				awsRoleARNParam: credentials.RoleARN,
+			}
+		}
 	}
 
 	return config
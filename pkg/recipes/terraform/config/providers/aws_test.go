@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,7 @@ import (
 
 var (
 	testRegion         = "test-region"
+	testRoleARN        = "arn:aws:iam::000000000000:role/radius"
 	testAWSCredentials = ucp_credentials.AWSCredential{
 		AccessKeyID:     "testAccessKey",
 		SecretAccessKey: "testSecretKey",
@@ -209,6 +211,48 @@ func TestAWSProvider_FetchCredentials(t *testing.T) {
 			expectedCreds: nil,
 			expectedErr:   false,
 		},
+		{
+			desc: "assume role credentials",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&ucp_credentials.AWSCredential{
+					Kind:            ucp_datamodel.AWSAssumeRoleCredentialKind,
+					AccessKeyID:     testAWSCredentials.AccessKeyID,
+					SecretAccessKey: testAWSCredentials.SecretAccessKey,
+					RoleARN:         testRoleARN,
+				},
+			},
+			expectedCreds: &ucp_credentials.AWSCredential{
+				Kind:            ucp_datamodel.AWSAssumeRoleCredentialKind,
+				AccessKeyID:     testAWSCredentials.AccessKeyID,
+				SecretAccessKey: testAWSCredentials.SecretAccessKey,
+				RoleARN:         testRoleARN,
+			},
+			expectedErr: false,
+		},
+		{
+			desc: "IRSA credentials",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&ucp_credentials.AWSCredential{
+					Kind:    ucp_datamodel.AWSIRSACredentialKind,
+					RoleARN: testRoleARN,
+				},
+			},
+			expectedCreds: &ucp_credentials.AWSCredential{
+				Kind:    ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN: testRoleARN,
+			},
+			expectedErr: false,
+		},
+		{
+			desc: "IRSA credentials without role - no error",
+			credentialsProvider: &mockAWSCredentialsProvider{
+				&ucp_credentials.AWSCredential{
+					Kind: ucp_datamodel.AWSIRSACredentialKind,
+				},
+			},
+			expectedCreds: nil,
+			expectedErr:   false,
+		},
 		{
 			desc: "fetch credential error",
 			credentialsProvider: &mockAWSCredentialsProvider{
@@ -224,7 +268,7 @@ func TestAWSProvider_FetchCredentials(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			c, err := fetchAWSCredentials(testcontext.New(t), tt.credentialsProvider)
+			c, err := fetchAWSCredentials(testcontext.New(t), tt.credentialsProvider, ucp_credentials.DefaultCredentialName)
 			if tt.expectedErr {
 				require.Error(t, err)
 				require.Nil(t, c)
@@ -232,13 +276,50 @@ func TestAWSProvider_FetchCredentials(t *testing.T) {
 				require.NoError(t, err)
 				if tt.expectedCreds != nil {
 					require.Equal(t, *tt.expectedCreds, *c)
+				} else {
+					require.Nil(t, c)
 				}
 			}
 		})
 	}
 }
 
+// namedAWSCredentialsProvider is a credential provider with the named credentials registered.
+type namedAWSCredentialsProvider map[string]*ucp_credentials.AWSCredential
+
+func (p namedAWSCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.AWSCredential, error) {
+	c, ok := p[name]
+	if !ok {
+		return nil, &secret.ErrNotFound{}
+	}
+	return c, nil
+}
+
+func TestAWSProvider_FetchCredentials_Named(t *testing.T) {
+	account2 := &testAWSCredentials
+	provider := namedAWSCredentialsProvider{"account2": account2}
+
+	c, err := fetchAWSCredentials(testcontext.New(t), provider, "account2")
+	require.NoError(t, err)
+	require.Equal(t, account2, c)
+
+	c, err = fetchAWSCredentials(testcontext.New(t), provider, ucp_credentials.DefaultCredentialName)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	_, err = fetchAWSCredentials(testcontext.New(t), provider, "account3")
+	require.EqualError(t, err, "the AWS credential \"account3\" selected by the environment is not registered")
+}
+
+func TestAWSProvider_CredentialName(t *testing.T) {
+	require.Equal(t, "default", awsCredentialName(nil))
+	require.Equal(t, "default", awsCredentialName(&recipes.Configuration{}))
+	require.Equal(t, "account2", awsCredentialName(&recipes.Configuration{Providers: datamodel.Providers{AWS: datamodel.ProvidersAWS{Credential: "account2"}}}))
+}
+
 func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "/var/run/secrets/eks.amazonaws.com/serviceaccount/token")
+
 	tests := []struct {
 		desc           string
 		region         string
@@ -270,6 +351,39 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 				awsRegionParam: testRegion,
 			},
 		},
+		{
+			desc:   "assume role config",
+			region: testRegion,
+			credentials: ucp_credentials.AWSCredential{
+				Kind:            ucp_datamodel.AWSAssumeRoleCredentialKind,
+				AccessKeyID:     testAWSCredentials.AccessKeyID,
+				SecretAccessKey: testAWSCredentials.SecretAccessKey,
+				RoleARN:         testRoleARN,
+			},
+			expectedConfig: map[string]any{
+				awsRegionParam:    testRegion,
+				awsAccessKeyParam: testAWSCredentials.AccessKeyID,
+				awsSecretKeyParam: testAWSCredentials.SecretAccessKey,
+				awsAssumeRoleParam: map[string]any{
+					awsRoleARNParam: testRoleARN,
+				},
+			},
+		},
+		{
+			desc:   "IRSA config",
+			region: testRegion,
+			credentials: ucp_credentials.AWSCredential{
+				Kind:    ucp_datamodel.AWSIRSACredentialKind,
+				RoleARN: testRoleARN,
+			},
+			expectedConfig: map[string]any{
+				awsRegionParam: testRegion,
+				awsAssumeRoleWithWebIdentityParam: map[string]any{
+					awsRoleARNParam:              testRoleARN,
+					awsWebIdentityTokenFileParam: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token",
+				},
+			},
+		},
 		{
 			desc: "invalid credentials",
 			credentials: ucp_credentials.AWSCredential{
@@ -288,6 +402,8 @@ func TestAWSProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[awsRegionParam], config[awsRegionParam])
 			require.Equal(t, tt.expectedConfig[awsAccessKeyParam], config[awsAccessKeyParam])
 			require.Equal(t, tt.expectedConfig[awsSecretKeyParam], config[awsSecretKeyParam])
+			require.Equal(t, tt.expectedConfig[awsAssumeRoleParam], config[awsAssumeRoleParam])
+			require.Equal(t, tt.expectedConfig[awsAssumeRoleWithWebIdentityParam], config[awsAssumeRoleWithWebIdentityParam])
 		})
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
@@ -38,11 +39,17 @@ import (
 const (
 	AzureProviderName = "azurerm"
 
-	azureFeaturesParam     = "features"
-	azureSubIDParam        = "subscription_id"
-	azureClientIDParam     = "client_id"
-	azureClientSecretParam = "client_secret"
-	azureTenantIDParam     = "tenant_id"
+	azureFeaturesParam          = "features"
+	azureSubIDParam             = "subscription_id"
+	azureClientIDParam          = "client_id"
+	azureClientSecretParam      = "client_secret"
+	azureTenantIDParam          = "tenant_id"
+	azureUseOIDCParam           = "use_oidc"
+	azureOIDCTokenFilePathParam = "oidc_token_file_path"
+
+	// azureFederatedTokenFileEnv is the environment variable set by the Azure workload identity webhook with the path
+	// of the projected service account token.
+	azureFederatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 var _ Provider = (*azureProvider)(nil)
@@ -78,7 +85,7 @@ func (p *azureProvider) BuildConfig(ctx context.Context, envConfig *recipes.Conf
 		return nil, err
 	}
 
-	credentials, err := fetchAzureCredentials(ctx, credentialsProvider)
+	credentials, err := fetchAzureCredentials(ctx, credentialsProvider, azureCredentialName(envConfig))
 	if err != nil {
 		return nil, err
 	}
@@ -113,12 +120,28 @@ func (p *azureProvider) getCredentialsProvider() (*credentials.AzureCredentialPr
 	return credentials.NewAzureCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
 }
 
-// fetchAzureCredentials fetches Azure credentials from UCP. Returns nil if credentials not found error is received or the credentials are empty.
-func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credentials.CredentialProvider[credentials.AzureCredential]) (*credentials.AzureCredential, error) {
+// azureCredentialName returns the name of the Azure credential selected by the environment, or the name of the default
+// credential if the environment does not select one.
+func azureCredentialName(envConfig *recipes.Configuration) string {
+	if envConfig == nil {
+		return credentials.DefaultCredentialName
+	}
+	return credentials.CredentialNameOrDefault(envConfig.Providers.Azure.Credential)
+}
+
+// fetchAzureCredentials fetches the named Azure credentials from UCP. Returns nil if credentials not found error is
+// received for the default credential or the credentials are empty. A credential selected by the environment must be
+// registered.
+func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credentials.CredentialProvider[credentials.AzureCredential], name string) (*credentials.AzureCredential, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	credentials, err := azureCredentialsProvider.Fetch(ctx, credentials.AzureCloud, "default")
+	selected := name != credentials.DefaultCredentialName
+	credentials, err := azureCredentialsProvider.Fetch(ctx, credentials.AzureCloud, name)
 	if err != nil {
 		if errors.Is(err, &secret.ErrNotFound{}) {
+			if selected {
+				return nil, fmt.Errorf("the Azure credential %q selected by the environment is not registered", name)
+			}
+
 			logger.Info("AWS credentials are not registered, skipping credentials configuration.")
 			return nil, nil
 		}
@@ -126,7 +149,7 @@ func fetchAzureCredentials(ctx context.Context, azureCredentialsProvider credent
 		return nil, err
 	}
 
-	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || credentials.ClientSecret == "" {
+	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" || (credentials.ClientSecret == "" && !credentials.IsWorkloadIdentity()) {
 		logger.Info("Azure credentials are not registered, skipping credentials configuration.")
 		return nil, nil
 	}
@@ -139,7 +162,19 @@ func (p *azureProvider) generateProviderConfigMap(configMap map[string]any, cred
 		configMap[azureSubIDParam] = subscriptionID
 	}
 
-	if credentials != nil && credentials.ClientID != "" && credentials.TenantID != "" && credentials.ClientSecret != "" {
+	if credentials == nil || credentials.ClientID == "" || credentials.TenantID == "" {
+		return configMap
+	}
+
+	if credentials.IsWorkloadIdentity() {
+		// Workload identity authenticates with the federated token projected into the pod instead of a client secret.
+		configMap[azureClientIDParam] = credentials.ClientID
+		configMap[azureTenantIDParam] = credentials.TenantID
+		configMap[azureUseOIDCParam] = true
+		if tokenFilePath := os.Getenv(azureFederatedTokenFileEnv); tokenFilePath != "" {
+			configMap[azureOIDCTokenFilePathParam] = tokenFilePath
+		}
+	} else if credentials.ClientSecret != "" {
 		configMap[azureClientIDParam] = credentials.ClientID
 		configMap[azureClientSecretParam] = credentials.ClientSecret
 		configMap[azureTenantIDParam] = credentials.TenantID
@@ -25,6 +25,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/sdk"
 	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
@@ -212,6 +213,33 @@ func TestAzureProvider_FetchCredentials(t *testing.T) {
 			expectedCreds: nil,
 			expectedErr:   false,
 		},
+		{
+			desc: "workload identity credentials",
+			credentialsProvider: &mockAzureCredentialsProvider{
+				&ucp_credentials.AzureCredential{
+					Kind:     ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+					TenantID: testAzureCredentials.TenantID,
+					ClientID: testAzureCredentials.ClientID,
+				},
+			},
+			expectedCreds: &ucp_credentials.AzureCredential{
+				Kind:     ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: testAzureCredentials.TenantID,
+				ClientID: testAzureCredentials.ClientID,
+			},
+			expectedErr: false,
+		},
+		{
+			desc: "missing client secret - no error",
+			credentialsProvider: &mockAzureCredentialsProvider{
+				&ucp_credentials.AzureCredential{
+					TenantID: testAzureCredentials.TenantID,
+					ClientID: testAzureCredentials.ClientID,
+				},
+			},
+			expectedCreds: nil,
+			expectedErr:   false,
+		},
 		{
 			desc: "fetch credential error",
 			credentialsProvider: &mockAzureCredentialsProvider{
@@ -228,7 +256,7 @@ func TestAzureProvider_FetchCredentials(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			c, err := fetchAzureCredentials(testcontext.New(t), tt.credentialsProvider)
+			c, err := fetchAzureCredentials(testcontext.New(t), tt.credentialsProvider, ucp_credentials.DefaultCredentialName)
 			if tt.expectedErr {
 				require.Error(t, err)
 				require.Nil(t, c)
@@ -236,13 +264,50 @@ func TestAzureProvider_FetchCredentials(t *testing.T) {
 				require.NoError(t, err)
 				if tt.expectedCreds != nil {
 					require.Equal(t, *tt.expectedCreds, *c)
+				} else {
+					require.Nil(t, c)
 				}
 			}
 		})
 	}
 }
 
+// namedAzureCredentialsProvider is a credential provider with the named credentials registered.
+type namedAzureCredentialsProvider map[string]*ucp_credentials.AzureCredential
+
+func (p namedAzureCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.AzureCredential, error) {
+	c, ok := p[name]
+	if !ok {
+		return nil, &secret.ErrNotFound{}
+	}
+	return c, nil
+}
+
+func TestAzureProvider_FetchCredentials_Named(t *testing.T) {
+	account2 := &testAzureCredentials
+	provider := namedAzureCredentialsProvider{"account2": account2}
+
+	c, err := fetchAzureCredentials(testcontext.New(t), provider, "account2")
+	require.NoError(t, err)
+	require.Equal(t, account2, c)
+
+	c, err = fetchAzureCredentials(testcontext.New(t), provider, ucp_credentials.DefaultCredentialName)
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	_, err = fetchAzureCredentials(testcontext.New(t), provider, "account3")
+	require.EqualError(t, err, "the Azure credential \"account3\" selected by the environment is not registered")
+}
+
+func TestAzureProvider_CredentialName(t *testing.T) {
+	require.Equal(t, "default", azureCredentialName(nil))
+	require.Equal(t, "default", azureCredentialName(&recipes.Configuration{}))
+	require.Equal(t, "account2", azureCredentialName(&recipes.Configuration{Providers: datamodel.Providers{Azure: datamodel.ProvidersAzure{Credential: "account2"}}}))
+}
+
 func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
+	t.Setenv("AZURE_FEDERATED_TOKEN_FILE", "/var/run/secrets/azure/tokens/azure-identity-token")
+
 	tests := []struct {
 		desc           string
 		subscription   string
@@ -279,6 +344,23 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 				azureSubIDParam:    testSubscription,
 			},
 		},
+		{
+			desc:         "workload identity config",
+			subscription: testSubscription,
+			credentials: ucp_credentials.AzureCredential{
+				Kind:     ucp_datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: testAzureCredentials.TenantID,
+				ClientID: testAzureCredentials.ClientID,
+			},
+			expectedConfig: map[string]any{
+				azureFeaturesParam:          map[string]any{},
+				azureSubIDParam:             testSubscription,
+				azureTenantIDParam:          testAzureCredentials.TenantID,
+				azureClientIDParam:          testAzureCredentials.ClientID,
+				azureUseOIDCParam:           true,
+				azureOIDCTokenFilePathParam: "/var/run/secrets/azure/tokens/azure-identity-token",
+			},
+		},
 		{
 			desc: "invalid credentials",
 			credentials: ucp_credentials.AzureCredential{
@@ -305,6 +387,8 @@ func TestAzureProvider_generateProviderConfigMap(t *testing.T) {
 			require.Equal(t, tt.expectedConfig[azureClientIDParam], config[azureClientIDParam])
 			require.Equal(t, tt.expectedConfig[azureClientSecretParam], config[azureClientSecretParam])
 			require.Equal(t, tt.expectedConfig[azureTenantIDParam], config[azureTenantIDParam])
+			require.Equal(t, tt.expectedConfig[azureUseOIDCParam], config[azureUseOIDCParam])
+			require.Equal(t, tt.expectedConfig[azureOIDCTokenFilePathParam], config[azureOIDCTokenFilePathParam])
 		})
 	}
 }
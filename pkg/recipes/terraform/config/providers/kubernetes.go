@@ -19,25 +19,56 @@ package providers
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/credentials"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+// Provider's config parameters need to match the values expected by Terraform
+// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs
 const (
 	KubernetesProviderName = "kubernetes"
+
+	kubernetesHostParam                 = "host"
+	kubernetesTokenParam                = "token"
+	kubernetesClusterCACertificateParam = "cluster_ca_certificate"
+	kubernetesClientCertificateParam    = "client_certificate"
+	kubernetesClientKeyParam            = "client_key"
+	kubernetesInsecureParam             = "insecure"
 )
 
 var _ Provider = (*kubernetesProvider)(nil)
 
-type kubernetesProvider struct{}
+type kubernetesProvider struct {
+	ucpConn        sdk.Connection
+	secretProvider *ucp_provider.SecretProvider
+}
+
+// NewKubernetesProvider creates a new KubernetesProvider instance.
+func NewKubernetesProvider(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider) Provider {
+	return &kubernetesProvider{ucpConn: ucpConn, secretProvider: secretProvider}
+}
 
 // BuildKubernetesProviderConfig generates the Terraform provider configuration for Kubernetes provider.
-// It returns an error if the in cluster config cannot be retrieved, and uses default kubeconfig file if
-// in-cluster config is not present.
-// https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs
+// When the environment targets a cluster registered as a Kubernetes plane, the configuration connects to the URL of
+// the plane with its default credential. Otherwise it returns an error if the in cluster config cannot be retrieved,
+// and uses default kubeconfig file if in-cluster config is not present.
+// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs
 func (p *kubernetesProvider) BuildConfig(ctx context.Context, envConfig *recipes.Configuration) (map[string]any, error) {
+	if cluster := kubernetesCluster(envConfig); !resources_kubernetes.IsLocalPlane(cluster) {
+		return p.buildClusterConfig(ctx, cluster)
+	}
+
 	_, err := rest.InClusterConfig()
 	if err != nil {
 		// If in cluster config is not present, then use default kubeconfig file.
@@ -54,3 +85,98 @@ func (p *kubernetesProvider) BuildConfig(ctx context.Context, envConfig *recipes
 	// https://registry.terraform.io/providers/hashicorp/kubernetes/latest/docs#in-cluster-config
 	return nil, nil
 }
+
+// kubernetesCluster returns the name of the Kubernetes plane targeted by the environment, or an empty string for the
+// cluster Radius runs in.
+func kubernetesCluster(envConfig *recipes.Configuration) string {
+	if envConfig == nil || envConfig.Runtime.Kubernetes == nil {
+		return ""
+	}
+	return envConfig.Runtime.Kubernetes.Cluster
+}
+
+// buildClusterConfig generates the configuration of the cluster of the given Kubernetes plane.
+func (p *kubernetesProvider) buildClusterConfig(ctx context.Context, planeName string) (map[string]any, error) {
+	host, err := p.fetchPlaneURL(ctx, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsProvider, err := credentials.NewKubernetesCredentialProvider(p.secretProvider, p.ucpConn, &tokencredentials.AnonymousCredential{})
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := fetchKubernetesCredentials(ctx, credentialsProvider, planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := credentials.NewKubernetesRESTConfig(host, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.generateProviderConfigMap(config), nil
+}
+
+// fetchPlaneURL fetches the URL of the API server of the given Kubernetes plane from UCP.
+func (p *kubernetesProvider) fetchPlaneURL(ctx context.Context, planeName string) (string, error) {
+	client, err := ucpapi.NewPlanesClient(&tokencredentials.AnonymousCredential{}, sdk.NewClientOptions(p.ucpConn))
+	if err != nil {
+		return "", err
+	}
+
+	plane, err := client.Get(ctx, resources_kubernetes.PlaneTypeKubernetes, planeName, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the Kubernetes plane %q targeted by the environment: %w", planeName, err)
+	}
+
+	if plane.Properties == nil || to.String(plane.Properties.URL) == "" {
+		return "", fmt.Errorf("the Kubernetes plane %q targeted by the environment does not have a URL", planeName)
+	}
+
+	return to.String(plane.Properties.URL), nil
+}
+
+// fetchKubernetesCredentials fetches the default credential of the given Kubernetes plane from UCP. The credential
+// must be registered since the cluster cannot be reached without it.
+func fetchKubernetesCredentials(ctx context.Context, kubernetesCredentialsProvider credentials.CredentialProvider[credentials.KubernetesCredential], planeName string) (*credentials.KubernetesCredential, error) {
+	credential, err := kubernetesCredentialsProvider.Fetch(ctx, planeName, credentials.DefaultCredentialName)
+	if err != nil {
+		if errors.Is(err, &secret.ErrNotFound{}) {
+			return nil, fmt.Errorf("the credential of the Kubernetes plane %q targeted by the environment is not registered", planeName)
+		}
+		return nil, err
+	}
+
+	if credential == nil || (credential.Token == "" && credential.Kubeconfig == "") {
+		return nil, fmt.Errorf("the credential of the Kubernetes plane %q targeted by the environment is empty", planeName)
+	}
+
+	return credential, nil
+}
+
+func (p *kubernetesProvider) generateProviderConfigMap(restConfig *rest.Config) map[string]any {
+	config := map[string]any{
+		kubernetesHostParam: restConfig.Host,
+	}
+
+	if restConfig.BearerToken != "" {
+		config[kubernetesTokenParam] = restConfig.BearerToken
+	}
+	if len(restConfig.TLSClientConfig.CAData) > 0 {
+		config[kubernetesClusterCACertificateParam] = string(restConfig.TLSClientConfig.CAData)
+	}
+	if len(restConfig.TLSClientConfig.CertData) > 0 {
+		config[kubernetesClientCertificateParam] = string(restConfig.TLSClientConfig.CertData)
+	}
+	if len(restConfig.TLSClientConfig.KeyData) > 0 {
+		config[kubernetesClientKeyParam] = string(restConfig.TLSClientConfig.KeyData)
+	}
+	if restConfig.TLSClientConfig.Insecure {
+		config[kubernetesInsecureParam] = true
+	}
+
+	return config
+}
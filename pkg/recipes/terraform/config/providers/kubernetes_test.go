@@ -17,13 +17,33 @@ limitations under the License.
 package providers
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+type mockKubernetesCredentialsProvider struct {
+	testCredential *ucp_credentials.KubernetesCredential
+}
+
+// Fetch returns mock Kubernetes credentials for testing. It returns a not found error if the credential is not set.
+func (p *mockKubernetesCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.KubernetesCredential, error) {
+	if p.testCredential == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+	return p.testCredential, nil
+}
+
 func TestKubernetesProvider_BuildConfig(t *testing.T) {
 	expectedConfig := map[string]any{
 		"config_path": clientcmd.RecommendedHomeFile,
@@ -44,3 +64,101 @@ func TestKubernetesProvider_BuildConfig_Error(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, config)
 }
+
+func TestKubernetesProvider_FetchPlaneURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/planes/kubernetes/prod":
+			_, _ = w.Write([]byte(`{"name":"prod","type":"System.Kubernetes/planes","location":"global","properties":{"kind":"Kubernetes","url":"https://prod.example.com"}}`))
+		case "/planes/kubernetes/nourl":
+			_, _ = w.Write([]byte(`{"name":"nourl","type":"System.Kubernetes/planes","location":"global","properties":{"kind":"Kubernetes"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NotFound","message":"not found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+	p := &kubernetesProvider{ucpConn: connection}
+
+	url, err := p.fetchPlaneURL(testcontext.New(t), "prod")
+	require.NoError(t, err)
+	require.Equal(t, "https://prod.example.com", url)
+
+	_, err = p.fetchPlaneURL(testcontext.New(t), "nourl")
+	require.EqualError(t, err, "the Kubernetes plane \"nourl\" targeted by the environment does not have a URL")
+
+	_, err = p.fetchPlaneURL(testcontext.New(t), "missing")
+	require.ErrorContains(t, err, "failed to get the Kubernetes plane \"missing\" targeted by the environment")
+
+	// The plane must be registered when the environment targets a cluster.
+	_, err = p.BuildConfig(testcontext.New(t), &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{Cluster: "missing"},
+		},
+	})
+	require.ErrorContains(t, err, "failed to get the Kubernetes plane \"missing\" targeted by the environment")
+}
+
+func TestKubernetesProvider_FetchCredentials(t *testing.T) {
+	credential := &ucp_credentials.KubernetesCredential{Token: "token"}
+	c, err := fetchKubernetesCredentials(testcontext.New(t), &mockKubernetesCredentialsProvider{testCredential: credential}, "prod")
+	require.NoError(t, err)
+	require.Equal(t, credential, c)
+
+	_, err = fetchKubernetesCredentials(testcontext.New(t), &mockKubernetesCredentialsProvider{}, "prod")
+	require.EqualError(t, err, "the credential of the Kubernetes plane \"prod\" targeted by the environment is not registered")
+
+	_, err = fetchKubernetesCredentials(testcontext.New(t), &mockKubernetesCredentialsProvider{testCredential: &ucp_credentials.KubernetesCredential{}}, "prod")
+	require.EqualError(t, err, "the credential of the Kubernetes plane \"prod\" targeted by the environment is empty")
+}
+
+func TestKubernetesProvider_generateProviderConfigMap(t *testing.T) {
+	tests := []struct {
+		desc           string
+		config         *rest.Config
+		expectedConfig map[string]any
+	}{
+		{
+			desc: "service account token",
+			config: &rest.Config{
+				Host:            "https://prod.example.com",
+				BearerToken:     "token",
+				TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca-data")},
+			},
+			expectedConfig: map[string]any{
+				kubernetesHostParam:                 "https://prod.example.com",
+				kubernetesTokenParam:                "token",
+				kubernetesClusterCACertificateParam: "ca-data",
+			},
+		},
+		{
+			desc: "client certificate",
+			config: &rest.Config{
+				Host: "https://prod.example.com",
+				TLSClientConfig: rest.TLSClientConfig{
+					CertData: []byte("cert-data"),
+					KeyData:  []byte("key-data"),
+					Insecure: true,
+				},
+			},
+			expectedConfig: map[string]any{
+				kubernetesHostParam:              "https://prod.example.com",
+				kubernetesClientCertificateParam: "cert-data",
+				kubernetesClientKeyParam:         "key-data",
+				kubernetesInsecureParam:          true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &kubernetesProvider{}
+			config := p.generateProviderConfigMap(tt.config)
+			require.Equal(t, tt.expectedConfig, config)
+		})
+	}
+}
@@ -41,6 +41,6 @@ func GetSupportedTerraformProviders(ucpConn sdk.Connection, secretProvider *ucp_
 	return map[string]Provider{
 		AWSProviderName:        NewAWSProvider(ucpConn, secretProvider),
 		AzureProviderName:      NewAzureProvider(ucpConn, secretProvider),
-		KubernetesProviderName: &kubernetesProvider{},
+		KubernetesProviderName: NewKubernetesProvider(ucpConn, secretProvider),
 	}
 }
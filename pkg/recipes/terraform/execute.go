@@ -20,11 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
@@ -36,7 +39,6 @@ import (
 	ucp_provider "github.com/radius-project/radius/pkg/ucp/secret/provider"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -45,11 +47,20 @@ var (
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
 )
 
+const (
+	// rollbackTimeout is the maximum duration to destroy the resources created by a canceled deployment.
+	rollbackTimeout = 10 * time.Minute
+
+	// planFileName is the name of the file Terraform plan saves the plan to in the working directory.
+	planFileName = "tfplan"
+)
+
 var _ TerraformExecutor = (*executor)(nil)
 
 // NewExecutor creates a new Executor with the given UCP connection and secret provider, to execute a Terraform recipe.
-func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface) *executor {
-	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet}
+// Terraform is installed for each execution using the given install options.
+func NewExecutor(ucpConn sdk.Connection, secretProvider *ucp_provider.SecretProvider, k8sClientSet kubernetes.Interface, installOptions InstallOptions) *executor {
+	return &executor{ucpConn: ucpConn, secretProvider: secretProvider, k8sClientSet: k8sClientSet, installOptions: installOptions}
 }
 
 type executor struct {
@@ -61,6 +72,10 @@ type executor struct {
 
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+
+	// installOptions is the default options to install Terraform. The Terraform version can be overridden by the
+	// environment configuration.
+	installOptions InstallOptions
 }
 
 // Deploy installs Terraform, creates a working directory, generates a config, and runs Terraform init and
@@ -68,9 +83,14 @@ type executor struct {
 func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -83,24 +103,36 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	if err := e.generateConfig(ctx, tf, options, backend); err != nil {
+		return nil, err
+	}
+
+	if err := initialize(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	// Check whether the recipe was deployed before, so that resources of a previous deployment are never destroyed when
+	// a canceled deployment is rolled back.
+	backendExisted, err := e.selectState(ctx, tf, backend, options)
 	if err != nil {
 		return nil, err
 	}
 
-	// Run TF Init and Apply in the working directory
-	state, err := initAndApply(ctx, tf)
+	// Run TF Apply in the working directory
+	state, err := apply(ctx, tf)
 	if err != nil {
+		if v1.IsOperationCanceled(ctx) && !backendExisted {
+			e.rollback(ctx, tf, backend, options.ResourceRecipe)
+		}
 		return nil, err
 	}
 
-	// Validate that the terraform state file backend source exists.
-	// Currently only Kubernetes secret backend is supported, which is created by Terraform as a part of Terraform apply.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	// Validate that the terraform state file exists in the backend, which is created by Terraform as a part of Terraform apply.
+	backendExists, err := backend.ValidateBackendExists(ctx, tf, options.ResourceRecipe)
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+		return nil, fmt.Errorf("error retrieving terraform state: %w", err)
 	} else if !backendExists {
-		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+		return nil, errors.New("expected terraform state is not found in the backend")
 	}
 
 	return state, nil
@@ -111,9 +143,14 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 func (e *executor) Delete(ctx context.Context, options Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	backend, err := e.getBackend(options)
+	if err != nil {
+		return err
+	}
+
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -126,40 +163,172 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	}
 
 	// Create Terraform config in the working directory
-	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
-	if err != nil {
+	if err := e.generateConfig(ctx, tf, options, backend); err != nil {
 		return err
 	}
 
-	// Before running terraform init and destroy, ensure that the Terraform state file storage source exists.
-	// If the state file source has been deleted or wasn't created due to a failure during apply then
-	// terraform initialization will fail due to missing backend source.
-	backendExists, err := backends.NewKubernetesBackend(e.k8sClientSet).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	if err := initialize(ctx, tf); err != nil {
+		return err
+	}
+
+	// Before running terraform destroy, ensure that the Terraform state file exists in the backend.
+	// If the state file has been deleted or wasn't created due to a failure during apply then there is nothing to destroy.
+	backendExists, err := e.selectState(ctx, tf, backend, options)
 	if err != nil {
-		// Continue with the delete flow for all errors other than backend not found.
-		// If it is an intermittent error then the delete flow will fail and should be retried from the client.
-		logger.Info(fmt.Sprintf("Error retrieving Terraform state file backend: %s", err.Error()))
+		return err
 	} else if !backendExists {
-		// Skip deletion if the backend does not exist. Delete can't be performed without Terraform state file.
-		logger.Info("Skipping deletion of recipe resources: Terraform state file backend does not exist.")
+		// Skip deletion if the state does not exist. Delete can't be performed without Terraform state file.
+		logger.Info("Skipping deletion of recipe resources: Terraform state file does not exist in the backend.")
 		return nil
 	}
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
-	err = initAndDestroy(ctx, tf)
-	if err != nil {
+	if err := destroy(ctx, tf); err != nil {
 		return err
 	}
 
-	// Delete the kubernetes secret created for terraform state file.
-	err = e.k8sClientSet.CoreV1().
-		Secrets(backends.RadiusNamespace).
-		Delete(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix, metav1.DeleteOptions{})
+	// Delete the terraform state file from the backend.
+	return backend.DeleteState(ctx, tf, options.ResourceRecipe)
+}
+
+// Plan installs Terraform, creates a working directory, generates a config, and runs Terraform init and plan
+// against the state of the recipe in the working directory, returning the planned changes or an error if any of
+// these steps fail.
+func (e *executor) Plan(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	backend, err := e.getBackend(options)
 	if err != nil {
-		return fmt.Errorf("error deleting kubernetes secret for terraform state: %w", err)
+		return nil, err
 	}
 
-	return nil
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
+	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
+	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
+	defer func() {
+		if err := i.Remove(ctx); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform installation: %s", err.Error()))
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create Terraform config in the working directory
+	if err := e.generateConfig(ctx, tf, options, backend); err != nil {
+		return nil, err
+	}
+
+	if err := initialize(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	return planState(ctx, tf, backend, options.ResourceRecipe, func() (*tfjson.Plan, error) {
+		return plan(ctx, tf)
+	})
+}
+
+// planState runs the plan of the recipe against its state in the backend. The state of the recipe is selected before
+// planning, so that backends storing the state of each recipe in its own workspace don't plan against the state of
+// another workspace. Without state, all the resources of the recipe are planned to be created and the state selected
+// or written to the backend while planning is deleted afterwards, so that a later deployment of the recipe is not
+// mistaken for an update.
+func planState(ctx context.Context, tf *tfexec.Terraform, backend backends.Backend, resourceRecipe *recipes.ResourceMetadata, run func() (*tfjson.Plan, error)) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	backendExists, err := backend.ValidateBackendExists(ctx, tf, resourceRecipe)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving terraform state: %w", err)
+	}
+
+	if err := backend.SelectState(ctx, tf, resourceRecipe); err != nil {
+		return nil, err
+	} else if backendExists {
+		return run()
+	}
+
+	result, err := run()
+	if deleteErr := backend.DeleteState(ctx, tf, resourceRecipe); deleteErr != nil {
+		if err != nil {
+			logger.Error(deleteErr, "failed to delete terraform state created by the plan")
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to delete terraform state created by the plan: %w", deleteErr)
+	}
+
+	return result, err
+}
+
+// rollback destroys the resources created by a canceled Terraform apply of a new recipe deployment and deletes its
+// state file. Failures are logged since the deployment has failed already.
+func (e *executor) rollback(ctx context.Context, tf *tfexec.Terraform, backend backends.Backend, resourceRecipe *recipes.ResourceMetadata) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// The request context is canceled, so the rollback runs without it.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	// Terraform apply was killed when the context was canceled, so it did not release the state lock.
+	logger.Info("Rolling back canceled Terraform apply")
+	if err := tf.Destroy(ctx, tfexec.Lock(false)); err != nil {
+		logger.Error(err, "failed to roll back canceled Terraform apply")
+		return
+	}
+
+	if err := backend.DeleteState(ctx, tf, resourceRecipe); err != nil {
+		logger.Error(err, "failed to delete terraform state")
+		return
+	}
+
+	logger.Info("Rolled back canceled Terraform apply")
+}
+
+// getBackend returns the backend storing the Terraform state of the recipe, which is configured for the environment.
+// The Kubernetes backend is used by default.
+func (e *executor) getBackend(options Options) (backends.Backend, error) {
+	var backend datamodel.TerraformBackendProperties
+	if options.EnvConfig != nil {
+		backend = options.EnvConfig.RecipeConfig.Terraform.Backend
+	}
+
+	return backends.NewBackend(backend.Kind, backend.Config, e.k8sClientSet)
+}
+
+// selectState selects the Terraform state of the recipe in the backend and returns whether the state existed before.
+// When the environment switched from the Kubernetes backend to another backend, the state stored by the Kubernetes
+// backend is migrated to the backend.
+func (e *executor) selectState(ctx context.Context, tf *tfexec.Terraform, backend backends.Backend, options Options) (bool, error) {
+	exists, err := backend.ValidateBackendExists(ctx, tf, options.ResourceRecipe)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving terraform state: %w", err)
+	}
+
+	if err := backend.SelectState(ctx, tf, options.ResourceRecipe); err != nil {
+		return false, err
+	}
+
+	kind := ""
+	if options.EnvConfig != nil {
+		kind = options.EnvConfig.RecipeConfig.Terraform.Backend.Kind
+	}
+	if exists || kind == "" || kind == backends.BackendKubernetes {
+		return exists, nil
+	}
+
+	return backends.MigrateKubernetesState(ctx, e.k8sClientSet, tf, options.ResourceRecipe)
+}
+
+// getInstallOptions returns the options to install Terraform for the recipe. The Terraform version configured for the
+// environment takes precedence over the default version, but not over a pre-provisioned Terraform binary.
+func (e *executor) getInstallOptions(options Options) InstallOptions {
+	installOptions := e.installOptions
+	if options.EnvConfig != nil && options.EnvConfig.RecipeConfig.Terraform.Version != "" {
+		installOptions.Version = options.EnvConfig.RecipeConfig.Terraform.Version
+	}
+
+	return installOptions
 }
 
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
@@ -167,7 +336,7 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 
 	// Install Terraform
 	i := install.NewInstaller()
-	tf, err := Install(ctx, i, options.RootDir)
+	tf, err := Install(ctx, i, options.RootDir, e.getInstallOptions(options))
 	// The terraform zip for installation is downloaded in a location outside of the install directory and is only accessible through the installer.Remove function -
 	// stored in latestVersion.pathsToRemove. So this needs to be called for complete cleanup even if the root terraform directory is deleted.
 	defer func() {
@@ -184,6 +353,10 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 		return nil, err
 	}
 
+	if err := e.generateCLIConfig(ctx, tf, options); err != nil {
+		return nil, err
+	}
+
 	result, err := downloadAndInspect(ctx, tf, options)
 	if err != nil {
 		return nil, err
@@ -195,39 +368,39 @@ func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[
 }
 
 // generateConfig generates Terraform configuration with required inputs for the module, providers and backend to be initialized and applied.
-func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options) (string, error) {
+func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, options Options, backend backends.Backend) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	workingDir := tf.WorkingDir()
 
 	tfConfig, err := getTerraformConfig(ctx, workingDir, options)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	// Write the Terraform CLI config before the module and its providers are downloaded from the registries.
+	if err := e.generateCLIConfig(ctx, tf, options); err != nil {
+		return err
 	}
 
 	loadedModule, err := downloadAndInspect(ctx, tf, options)
 	if err != nil {
-		return "", err
+		return err
+	}
+
+	envProviderConfigs, err := e.getEnvProviderConfigs(ctx, loadedModule.RequiredProviders, options)
+	if err != nil {
+		return err
 	}
 
 	// Generate Terraform providers configuration for required providers and add it to the Terraform configuration.
 	logger.Info(fmt.Sprintf("Adding provider config for required providers %+v", loadedModule.RequiredProviders))
 	if err := tfConfig.AddProviders(ctx, loadedModule.RequiredProviders, providers.GetSupportedTerraformProviders(e.ucpConn, e.secretProvider),
-		options.EnvConfig); err != nil {
-		return "", err
+		options.EnvConfig, envProviderConfigs); err != nil {
+		return err
 	}
 
-	backendConfig, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backends.NewKubernetesBackend(e.k8sClientSet))
-	if err != nil {
-		return "", err
-	}
-	// Retrieving the secret_suffix property from backend config to use it to verify secret creation during terraform init.
-	// This is only used for the backend of type kubernetes and should be moved inside an if block when we add more backends.
-	var secretSuffix string
-	if backendDetails, ok := backendConfig[backends.BackendKubernetes]; ok {
-		backendMap := backendDetails.(map[string]any)
-		if secret, ok := backendMap["secret_suffix"]; ok {
-			secretSuffix = secret.(string)
-		}
+	if _, err := tfConfig.AddTerraformBackend(options.ResourceRecipe, backend); err != nil {
+		return err
 	}
 
 	// Add recipe context parameter to the generated Terraform config's module parameters.
@@ -238,16 +411,16 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 		// Create the recipe context object to be passed to the recipe deployment
 		recipectx, err := recipecontext.New(options.ResourceRecipe, options.EnvConfig)
 		if err != nil {
-			return "", err
+			return err
 		}
 
 		if err = tfConfig.AddRecipeContext(ctx, options.EnvRecipe.Name, recipectx); err != nil {
-			return "", err
+			return err
 		}
 	}
 	if loadedModule.ResultOutputExists {
 		if err = tfConfig.AddOutputs(options.EnvRecipe.Name); err != nil {
-			return "", err
+			return err
 		}
 	}
 
@@ -255,10 +428,10 @@ func (e *executor) generateConfig(ctx context.Context, tf *tfexec.Terraform, opt
 
 	// Ensure that we need to save the configuration after adding providers and recipecontext.
 	if err := tfConfig.Save(ctx, workingDir); err != nil {
-		return "", err
+		return err
 	}
 
-	return secretSuffix, nil
+	return nil
 }
 
 // downloadAndInspect handles downloading the TF module and retrieving the necessary information
@@ -313,8 +486,8 @@ func getTerraformConfig(ctx context.Context, workingDir string, options Options)
 	return tfConfig, nil
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, error) {
+// initialize runs Terraform init in the provided working directory.
+func initialize(ctx context.Context, tf *tfexec.Terraform) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
@@ -324,11 +497,18 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
-		return nil, fmt.Errorf("terraform init failure: %w", err)
+		return fmt.Errorf("terraform init failure: %w", err)
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
 
+	return nil
+}
+
+// apply runs Terraform apply in the provided initialized working directory.
+func apply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
 	// Apply Terraform configuration
 	logger.Info("Running Terraform apply")
 	if err := tf.Apply(ctx); err != nil {
@@ -340,20 +520,24 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform) (*tfjson.State, err
 	return tf.Show(ctx)
 }
 
-// initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform) error {
+// plan runs Terraform plan in the provided initialized working directory and returns the saved plan.
+func plan(ctx context.Context, tf *tfexec.Terraform) (*tfjson.Plan, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Initialize Terraform
-	logger.Info("Initializing Terraform")
-	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
-		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
-			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
-
-		return fmt.Errorf("terraform init failure: %w", err)
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	logger.Info("Running Terraform plan")
+	if _, err := tf.Plan(ctx, tfexec.Out(planFile)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
 	}
-	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
+
+	// Load the saved plan to retrieve the resource changes
+	logger.Info("Fetching Terraform plan")
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
+// destroy runs Terraform destroy in the provided initialized working directory.
+func destroy(ctx context.Context, tf *tfexec.Terraform) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Destroy Terraform configuration
 	logger.Info("Running Terraform destroy")
@@ -17,14 +17,21 @@ limitations under the License.
 package terraform
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
+	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGenerateConfig(t *testing.T) {
@@ -55,7 +62,7 @@ func TestGenerateConfig(t *testing.T) {
 			require.NoError(t, err)
 
 			e := executor{}
-			_, err = e.generateConfig(ctx, tf, tc.opts)
+			err = e.generateConfig(ctx, tf, tc.opts, backends.NewKubernetesBackend(nil))
 			require.Error(t, err)
 			require.ErrorContains(t, err, tc.err)
 		})
@@ -114,3 +121,89 @@ func Test_GetTerraformConfig_InvalidDirectory(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "error creating file: open invalid-directory/main.tf.json: no such file or directory")
 }
+
+func Test_PlanState(t *testing.T) {
+	resourceRecipe := &recipes.ResourceMetadata{
+		Name:          "redis-azure",
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+	}
+
+	for _, stateExists := range []bool{false, true} {
+		t.Run(fmt.Sprintf("state exists: %t", stateExists), func(t *testing.T) {
+			ctx := testcontext.New(t)
+			clientset := fake.NewSimpleClientset()
+			backend := backends.NewKubernetesBackend(clientset)
+
+			config, err := backend.BuildBackend(resourceRecipe)
+			require.NoError(t, err)
+			secretSuffix := config[backends.BackendKubernetes].(map[string]any)["secret_suffix"].(string)
+			secret := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      backends.KubernetesBackendNamePrefix + secretSuffix,
+					Namespace: backends.RadiusNamespace,
+				},
+			}
+			if stateExists {
+				_, err = clientset.CoreV1().Secrets(backends.RadiusNamespace).Create(ctx, secret, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			expected := &tfjson.Plan{FormatVersion: "1.2"}
+			result, err := planState(ctx, nil, backend, resourceRecipe, func() (*tfjson.Plan, error) {
+				// Terraform writes the state of the recipe to the Kubernetes backend while planning.
+				if !stateExists {
+					if _, err := clientset.CoreV1().Secrets(backends.RadiusNamespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+						return nil, err
+					}
+				}
+				return expected, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, result)
+
+			exists, err := backend.ValidateBackendExists(ctx, nil, resourceRecipe)
+			require.NoError(t, err)
+			require.Equal(t, stateExists, exists)
+		})
+	}
+}
+
+func Test_PlanState_SelectsWorkspace(t *testing.T) {
+	resourceRecipe := &recipes.ResourceMetadata{
+		Name:          "redis-azure",
+		EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		ApplicationID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+	}
+
+	for _, stateExists := range []bool{false, true} {
+		t.Run(fmt.Sprintf("state exists: %t", stateExists), func(t *testing.T) {
+			ctx := testcontext.New(t)
+			mctrl := gomock.NewController(t)
+			backend := backends.NewMockBackend(mctrl)
+
+			// The workspace of the recipe is selected, or created, before planning so that the plan doesn't run in the
+			// default workspace. A workspace created for the plan is deleted afterwards.
+			selected := false
+			backend.EXPECT().ValidateBackendExists(gomock.Any(), gomock.Any(), resourceRecipe).Return(stateExists, nil)
+			backend.EXPECT().SelectState(gomock.Any(), gomock.Any(), resourceRecipe).
+				DoAndReturn(func(_ any, _ any, _ any) error {
+					selected = true
+					return nil
+				})
+			if !stateExists {
+				backend.EXPECT().DeleteState(gomock.Any(), gomock.Any(), resourceRecipe).Return(nil)
+			}
+
+			expected := &tfjson.Plan{FormatVersion: "1.2"}
+			result, err := planState(ctx, nil, backend, resourceRecipe, func() (*tfjson.Plan, error) {
+				require.True(t, selected, "the workspace of the recipe must be selected before planning")
+				return expected, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, expected, result)
+		})
+	}
+}
@@ -18,11 +18,14 @@ package terraform
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/hashicorp/go-version"
 	install "github.com/hashicorp/hc-install"
 	"github.com/hashicorp/hc-install/product"
 	"github.com/hashicorp/hc-install/releases"
@@ -37,35 +40,54 @@ const (
 	installSubDir                     = "install"
 	installVerificationRetryCount     = 5
 	installVerificationRetryDelaySecs = 3
+
+	// latestVersion is the version reported in metrics when the latest version of Terraform is installed.
+	latestVersion = "latest"
 )
 
-// Install installs Terraform under /install in the provided Terraform root directory for the resource. It installs
-// the latest version of Terraform and returns the path to the installed Terraform executable. It returns an error
-// if the directory creation or Terraform installation fails.
-func Install(ctx context.Context, installer *install.Installer, tfDir string) (*tfexec.Terraform, error) {
+// cacheLocks serializes the installations of the same version of Terraform into the install cache.
+var cacheLocks sync.Map
+
+// InstallOptions represents the options to install Terraform.
+type InstallOptions struct {
+	// Version is the version of Terraform to install, for example "1.5.7". The latest version is installed when it is
+	// empty, unless ExecPath is set.
+	Version string
+
+	// ExecPath is the path to a pre-provisioned Terraform binary. Terraform is not installed when it is set.
+	ExecPath string
+
+	// ExecSHA256 is the hex-encoded SHA256 checksum of the binary at ExecPath. The binary is not verified when it is empty.
+	ExecSHA256 string
+
+	// MirrorDir is a local directory laid out like https://releases.hashicorp.com/terraform, from which Terraform is
+	// installed instead of the internet. Version is required when it is set.
+	MirrorDir string
+
+	// CacheDir is the directory where the pinned versions of Terraform are installed and reused across executions.
+	// Terraform is installed in the execution directory when it is empty.
+	CacheDir string
+}
+
+// Install returns a Terraform executor for the Terraform root directory of the resource. It uses the pre-provisioned
+// Terraform binary if one is configured, reuses a cached installation of the configured version if there is one, and
+// installs Terraform otherwise. Pinned versions are installed into the install cache, while the latest version is
+// installed under /install in the Terraform root directory. It returns an error if the installation or its
+// verification fails.
+func Install(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (*tfexec.Terraform, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Create Terraform installation directory
-	installDir := filepath.Join(tfDir, installSubDir)
-	if err := os.MkdirAll(installDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	versionAttr := options.Version
+	if versionAttr == "" {
+		versionAttr = latestVersion
 	}
 
-	logger.Info(fmt.Sprintf("Installing Terraform in the directory: %q", installDir))
-
 	installStartTime := time.Now()
-	// Re-visit this: consider checking if an existing installation of same version of Terraform is available.
-	// For initial iteration we will always install Terraform for every execution of the recipe driver.
-	execPath, err := installer.Ensure(ctx, []src.Source{
-		&releases.LatestVersion{
-			Product:    product.Terraform,
-			InstallDir: installDir,
-		},
-	})
+	execPath, err := ensureTerraform(ctx, installer, tfDir, options)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 			[]attribute.KeyValue{
-				metrics.TerraformVersionAttrKey.String("latest"),
+				metrics.TerraformVersionAttrKey.String(versionAttr),
 				metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 			},
 		)
@@ -74,12 +96,12 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallationDuration(ctx, installStartTime,
 		[]attribute.KeyValue{
-			metrics.TerraformVersionAttrKey.String("latest"),
+			metrics.TerraformVersionAttrKey.String(versionAttr),
 			metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 		},
 	)
 
-	logger.Info(fmt.Sprintf("Terraform latest version installed to: %q", execPath))
+	logger.Info(fmt.Sprintf("Terraform %s version available at: %q", versionAttr, execPath))
 
 	// Create a new instance of tfexec.Terraform with current Terraform installation path
 	tf, err := NewTerraform(ctx, tfDir, execPath)
@@ -93,7 +115,7 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 		if err == nil {
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState),
 				},
 			)
@@ -103,7 +125,7 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 			logger.Info(fmt.Sprintf("Failed to verify Terraform installation completion: %s. Retrying after %d seconds", err.Error(), installVerificationRetryDelaySecs))
 			metrics.DefaultRecipeEngineMetrics.RecordTerraformInstallVerificationDuration(ctx, installStartTime,
 				[]attribute.KeyValue{
-					metrics.TerraformVersionAttrKey.String("latest"),
+					metrics.TerraformVersionAttrKey.String(versionAttr),
 					metrics.OperationStateAttrKey.String(metrics.FailedOperationState),
 				},
 			)
@@ -118,3 +140,131 @@ func Install(ctx context.Context, installer *install.Installer, tfDir string) (*
 
 	return tf, nil
 }
+
+// ensureTerraform returns the path to a Terraform binary matching the install options, installing Terraform if needed.
+func ensureTerraform(ctx context.Context, installer *install.Installer, tfDir string, options InstallOptions) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if options.ExecPath != "" {
+		logger.Info(fmt.Sprintf("Using pre-provisioned Terraform binary: %q", options.ExecPath))
+		if err := verifyExecPath(options.ExecPath, options.ExecSHA256); err != nil {
+			return "", err
+		}
+		return options.ExecPath, nil
+	}
+
+	if options.Version == "" {
+		if options.MirrorDir != "" {
+			return "", errors.New("terraform version is required to install Terraform from the mirror directory")
+		}
+		return installLatest(ctx, installer, tfDir)
+	}
+
+	v, err := version.NewVersion(options.Version)
+	if err != nil {
+		return "", fmt.Errorf("invalid terraform version %q: %w", options.Version, err)
+	}
+
+	if options.CacheDir == "" {
+		installDir, err := createInstallDir(tfDir)
+		if err != nil {
+			return "", err
+		}
+		return installVersion(ctx, installer, v, options.MirrorDir, installDir)
+	}
+
+	return installCached(ctx, installer, v, options)
+}
+
+// installLatest installs the latest version of Terraform under /install in the Terraform root directory.
+func installLatest(ctx context.Context, installer *install.Installer, tfDir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	installDir, err := createInstallDir(tfDir)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Info(fmt.Sprintf("Installing the latest version of Terraform in the directory: %q", installDir))
+	return installer.Ensure(ctx, []src.Source{
+		&releases.LatestVersion{
+			Product:    product.Terraform,
+			InstallDir: installDir,
+		},
+	})
+}
+
+// installCached returns the cached installation of the Terraform version, installing it into the cache first if
+// the version is not cached yet.
+func installCached(ctx context.Context, installer *install.Installer, v *version.Version, options InstallOptions) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	versionDir := filepath.Join(options.CacheDir, v.String())
+	execPath := filepath.Join(versionDir, product.Terraform.BinaryName())
+
+	lock, _ := cacheLocks.LoadOrStore(versionDir, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if _, err := os.Stat(execPath); err == nil {
+		logger.Info(fmt.Sprintf("Using cached Terraform installation: %q", execPath))
+		return execPath, nil
+	}
+
+	if err := os.MkdirAll(options.CacheDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create terraform install cache directory: %w", err)
+	}
+
+	// Terraform is installed into a temporary directory which is renamed once the installation is complete, so
+	// that a partial installation is never picked up from the cache.
+	tmpDir, err := os.MkdirTemp(options.CacheDir, ".install-"+v.String()+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if _, err := installVersion(ctx, installer, v, options.MirrorDir, tmpDir); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmpDir, versionDir); err != nil {
+		// Another process sharing the cache may have installed the same version in the meantime.
+		if _, statErr := os.Stat(execPath); statErr == nil {
+			return execPath, nil
+		}
+		return "", fmt.Errorf("failed to add terraform installation to the cache: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("Terraform version %s added to the install cache: %q", v.String(), execPath))
+	return execPath, nil
+}
+
+// installVersion installs the Terraform version into installDir, either from the mirror directory or from
+// releases.hashicorp.com. The checksum of the release archive is verified in both cases.
+func installVersion(ctx context.Context, installer *install.Installer, v *version.Version, mirrorDir, installDir string) (string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if mirrorDir != "" {
+		logger.Info(fmt.Sprintf("Installing Terraform version %s from the mirror %q in the directory: %q", v.String(), mirrorDir, installDir))
+		return installFromMirror(mirrorDir, v, installDir)
+	}
+
+	logger.Info(fmt.Sprintf("Installing Terraform version %s in the directory: %q", v.String(), installDir))
+	return installer.Ensure(ctx, []src.Source{
+		&releases.ExactVersion{
+			Product:    product.Terraform,
+			Version:    v,
+			InstallDir: installDir,
+		},
+	})
+}
+
+// createInstallDir creates the Terraform installation directory under the Terraform root directory.
+func createInstallDir(tfDir string) (string, error) {
+	installDir := filepath.Join(tfDir, installSubDir)
+	if err := os.MkdirAll(installDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory for terraform installation for resource: %w", err)
+	}
+
+	return installDir, nil
+}
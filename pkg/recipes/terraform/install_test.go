@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	install "github.com/hashicorp/hc-install"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTerraformVersion = "1.5.7"
+	testTerraformBinary  = "#!/bin/sh\necho terraform\n"
+)
+
+// createMirror creates a mirror directory containing a release archive of the version for the current platform.
+func createMirror(t *testing.T, version string) string {
+	mirrorDir := t.TempDir()
+	releaseDir := filepath.Join(mirrorDir, version)
+	require.NoError(t, os.MkdirAll(releaseDir, 0755))
+
+	archiveName := fmt.Sprintf("terraform_%s_%s_%s.zip", version, runtime.GOOS, runtime.GOARCH)
+	archivePath := filepath.Join(releaseDir, archiveName)
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	fw, err := w.Create("terraform")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(testTerraformBinary))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	b, err := os.ReadFile(archivePath)
+	require.NoError(t, err)
+	sum := sha256.Sum256(b)
+	sums := fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), archiveName)
+	err = os.WriteFile(filepath.Join(releaseDir, fmt.Sprintf("terraform_%s_SHA256SUMS", version)), []byte(sums), 0644)
+	require.NoError(t, err)
+
+	return mirrorDir
+}
+
+func TestEnsureTerraform_Mirror(t *testing.T) {
+	ctx := testcontext.New(t)
+	mirrorDir := createMirror(t, testTerraformVersion)
+	tfDir := t.TempDir()
+
+	execPath, err := ensureTerraform(ctx, install.NewInstaller(), tfDir, InstallOptions{Version: testTerraformVersion, MirrorDir: mirrorDir})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(tfDir, installSubDir, "terraform"), execPath)
+
+	b, err := os.ReadFile(execPath)
+	require.NoError(t, err)
+	require.Equal(t, testTerraformBinary, string(b))
+}
+
+func TestEnsureTerraform_Cache(t *testing.T) {
+	ctx := testcontext.New(t)
+	mirrorDir := createMirror(t, testTerraformVersion)
+	cacheDir := t.TempDir()
+	options := InstallOptions{Version: testTerraformVersion, MirrorDir: mirrorDir, CacheDir: cacheDir}
+
+	execPath, err := ensureTerraform(ctx, install.NewInstaller(), t.TempDir(), options)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(cacheDir, testTerraformVersion, "terraform"), execPath)
+
+	// The cached installation is reused without reading the mirror.
+	require.NoError(t, os.RemoveAll(mirrorDir))
+	cachedExecPath, err := ensureTerraform(ctx, install.NewInstaller(), t.TempDir(), options)
+	require.NoError(t, err)
+	require.Equal(t, execPath, cachedExecPath)
+
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestEnsureTerraform_MirrorChecksumMismatch(t *testing.T) {
+	ctx := testcontext.New(t)
+	mirrorDir := createMirror(t, testTerraformVersion)
+
+	sumsPath := filepath.Join(mirrorDir, testTerraformVersion, fmt.Sprintf("terraform_%s_SHA256SUMS", testTerraformVersion))
+	sums := fmt.Sprintf("%s  terraform_%s_%s_%s.zip\n", hex.EncodeToString(make([]byte, sha256.Size)), testTerraformVersion, runtime.GOOS, runtime.GOARCH)
+	require.NoError(t, os.WriteFile(sumsPath, []byte(sums), 0644))
+
+	_, err := ensureTerraform(ctx, install.NewInstaller(), t.TempDir(), InstallOptions{Version: testTerraformVersion, MirrorDir: mirrorDir, CacheDir: t.TempDir()})
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestEnsureTerraform_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		options InstallOptions
+		err     string
+	}{
+		{
+			name:    "mirror without version",
+			options: InstallOptions{MirrorDir: "/mirror"},
+			err:     "terraform version is required to install Terraform from the mirror directory",
+		},
+		{
+			name:    "invalid version",
+			options: InstallOptions{Version: "not-a-version"},
+			err:     "invalid terraform version \"not-a-version\"",
+		},
+		{
+			name:    "version missing from mirror",
+			options: InstallOptions{Version: "1.0.0", MirrorDir: "/mirror"},
+			err:     "failed to open terraform checksums file",
+		},
+		{
+			name:    "missing pre-provisioned binary",
+			options: InstallOptions{ExecPath: "/does/not/exist/terraform"},
+			err:     "failed to find pre-provisioned terraform binary",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ensureTerraform(testcontext.New(t), install.NewInstaller(), t.TempDir(), tc.options)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestEnsureTerraform_ExecPath(t *testing.T) {
+	ctx := testcontext.New(t)
+	execPath := filepath.Join(t.TempDir(), "terraform")
+	require.NoError(t, os.WriteFile(execPath, []byte(testTerraformBinary), 0755))
+	sum := sha256.Sum256([]byte(testTerraformBinary))
+
+	// The pre-provisioned binary takes precedence over the version.
+	actual, err := ensureTerraform(ctx, install.NewInstaller(), t.TempDir(), InstallOptions{Version: testTerraformVersion, ExecPath: execPath, ExecSHA256: hex.EncodeToString(sum[:])})
+	require.NoError(t, err)
+	require.Equal(t, execPath, actual)
+
+	_, err = ensureTerraform(ctx, install.NewInstaller(), t.TempDir(), InstallOptions{ExecPath: execPath, ExecSHA256: hex.EncodeToString(make([]byte, sha256.Size))})
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestGetInstallOptions(t *testing.T) {
+	e := executor{installOptions: InstallOptions{Version: "1.4.0", MirrorDir: "/mirror"}}
+
+	require.Equal(t, InstallOptions{Version: "1.4.0", MirrorDir: "/mirror"}, e.getInstallOptions(Options{}))
+
+	envConfig := &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{Version: testTerraformVersion},
+		},
+	}
+	require.Equal(t, InstallOptions{Version: testTerraformVersion, MirrorDir: "/mirror"}, e.getInstallOptions(Options{EnvConfig: envConfig}))
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"archive/zip"
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hc-install/product"
+)
+
+// installFromMirror installs the Terraform version from the mirror directory into installDir and returns the path to
+// the Terraform binary. The mirror directory follows the layout of https://releases.hashicorp.com/terraform, i.e. the
+// release archive for the current platform and its checksums are located at
+// <mirrorDir>/<version>/terraform_<version>_<os>_<arch>.zip and <mirrorDir>/<version>/terraform_<version>_SHA256SUMS.
+// The archive is verified against the checksums file before it is extracted.
+func installFromMirror(mirrorDir string, v *version.Version, installDir string) (string, error) {
+	releaseDir := filepath.Join(mirrorDir, v.String())
+	archiveName := fmt.Sprintf("%s_%s_%s_%s.zip", product.Terraform.Name, v.String(), runtime.GOOS, runtime.GOARCH)
+	sumsPath := filepath.Join(releaseDir, fmt.Sprintf("%s_%s_SHA256SUMS", product.Terraform.Name, v.String()))
+	archivePath := filepath.Join(releaseDir, archiveName)
+
+	expected, err := findChecksum(sumsPath, archiveName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := verifyChecksum(archivePath, expected); err != nil {
+		return "", err
+	}
+
+	r, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open terraform release archive %q: %w", archivePath, err)
+	}
+	defer r.Close()
+
+	binaryName := product.Terraform.BinaryName()
+	for _, f := range r.File {
+		if f.Name != binaryName {
+			continue
+		}
+
+		execPath := filepath.Join(installDir, binaryName)
+		if err := extractFile(f, execPath); err != nil {
+			return "", fmt.Errorf("failed to extract terraform binary from %q: %w", archivePath, err)
+		}
+
+		return execPath, nil
+	}
+
+	return "", fmt.Errorf("terraform release archive %q does not contain %q", archivePath, binaryName)
+}
+
+// findChecksum returns the checksum of the file from the SHA256SUMS file.
+func findChecksum(sumsPath, fileName string) (string, error) {
+	f, err := os.Open(sumsPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open terraform checksums file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == fileName {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read terraform checksums file: %w", err)
+	}
+
+	return "", fmt.Errorf("checksum of %q not found in %q", fileName, sumsPath)
+}
+
+// verifyExecPath verifies that the pre-provisioned Terraform binary exists and matches the checksum if one is given.
+func verifyExecPath(execPath, expected string) error {
+	if _, err := os.Stat(execPath); err != nil {
+		return fmt.Errorf("failed to find pre-provisioned terraform binary: %w", err)
+	}
+
+	if expected == "" {
+		return nil
+	}
+
+	return verifyChecksum(execPath, expected)
+}
+
+// verifyChecksum returns an error if the SHA256 checksum of the file does not match the hex-encoded expected checksum.
+func verifyChecksum(path, expected string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %q to verify its checksum: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("failed to compute the checksum of %q: %w", path, err)
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", path, expected, actual)
+	}
+
+	return nil
+}
+
+// extractFile extracts the file from the zip archive to dst as an executable.
+func extractFile(f *zip.File, dst string) error {
+	src, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, src); err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+// getEnvProviderConfigs returns the Terraform provider configurations of the environment for the required providers,
+// keyed by provider name. The values of the secret properties are read from their secret stores.
+func (e *executor) getEnvProviderConfigs(ctx context.Context, requiredProviders []string, options Options) (map[string][]map[string]any, error) {
+	if options.EnvConfig == nil || len(options.EnvConfig.RecipeConfig.Terraform.Providers) == 0 {
+		return nil, nil
+	}
+
+	// Secrets of the same secret store are fetched once.
+	secretStores := map[string]map[string]string{}
+	providerConfigs := map[string][]map[string]any{}
+	for _, provider := range requiredProviders {
+		for _, envConfig := range options.EnvConfig.RecipeConfig.Terraform.Providers[provider] {
+			config := map[string]any{}
+			for k, v := range envConfig.AdditionalProperties {
+				config[k] = v
+			}
+
+			for property, secret := range envConfig.Secrets {
+				secrets, ok := secretStores[secret.Source]
+				if !ok {
+					var err error
+					secrets, err = util.FetchSecrets(ctx, secret.Source, sdk.NewClientOptions(e.ucpConn))
+					if err != nil {
+						return nil, fmt.Errorf("failed to fetch the secrets of terraform provider %q: %w", provider, err)
+					}
+					secretStores[secret.Source] = secrets
+				}
+
+				value, ok := secrets[secret.Key]
+				if !ok {
+					return nil, fmt.Errorf("secret store %q does not contain the %q secret of property %q of terraform provider %q", secret.Source, secret.Key, property, provider)
+				}
+				config[property] = value
+			}
+
+			providerConfigs[provider] = append(providerConfigs[provider], config)
+		}
+	}
+
+	return providerConfigs, nil
+}
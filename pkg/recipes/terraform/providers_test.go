@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnvProviderConfigs(t *testing.T) {
+	ctx := testcontext.New(t)
+	e := executor{}
+
+	configs, err := e.getEnvProviderConfigs(ctx, []string{"helm"}, Options{})
+	require.NoError(t, err)
+	require.Nil(t, configs)
+
+	envConfig := &recipes.Configuration{
+		RecipeConfig: datamodel.RecipeConfigProperties{
+			Terraform: datamodel.TerraformConfigProperties{
+				Providers: map[string][]datamodel.ProviderConfigProperties{
+					"helm": {
+						{AdditionalProperties: map[string]any{"kubernetes": map[string]any{"config_path": "/home/radius/.kube/config"}}},
+						{AdditionalProperties: map[string]any{"alias": "staging"}},
+					},
+					"random": {
+						{AdditionalProperties: map[string]any{"seed": "unused"}},
+					},
+				},
+			},
+		},
+	}
+
+	// Only the configurations of the required providers are returned.
+	configs, err = e.getEnvProviderConfigs(ctx, []string{"helm", "kubernetes"}, Options{EnvConfig: envConfig})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]map[string]any{
+		"helm": {
+			{"kubernetes": map[string]any{"config_path": "/home/radius/.kube/config"}},
+			{"alias": "staging"},
+		},
+	}, configs)
+}
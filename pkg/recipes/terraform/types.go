@@ -43,6 +43,10 @@ type TerraformExecutor interface {
 	// and deletes the Kubernetes secret created for terraform state store.
 	Delete(ctx context.Context, options Options) error
 
+	// Plan installs terraform and runs terraform init and plan on the terraform module referenced by the recipe using terraform-exec,
+	// and returns the changes terraform apply would make without making them.
+	Plan(ctx context.Context, options Options) (*tfjson.Plan, error)
+
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
 }
@@ -32,6 +32,8 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// RecipeConfig is the recipe configuration for the environment, such as the Terraform version.
+	RecipeConfig datamodel.RecipeConfigProperties
 }
 
 // RuntimeConfiguration represents Kubernetes Runtime configuration for the environment.
@@ -45,6 +47,8 @@ type KubernetesRuntime struct {
 	Namespace string `json:"namespace,omitempty"`
 	// EnvironmentNamespace is set to environment namespace.
 	EnvironmentNamespace string `json:"environmentNamespace"`
+	// Cluster is the name of the Kubernetes plane of the cluster to deploy to. Empty means the cluster Radius runs in.
+	Cluster string `json:"cluster,omitempty"`
 }
 
 // EnvironmentDefinition represents the recipe configuration details.
@@ -59,10 +63,12 @@ type EnvironmentDefinition struct {
 	Parameters map[string]any
 	// TemplatePath represents path to the template provided by the recipe.
 	TemplatePath string
-	// TemplateVersion represents the version of the terraform module provided by the recipe.
+	// TemplateVersion represents the version of the terraform module or the helm chart provided by the recipe.
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// RecipeVersion represents the version of the recipe registered to the environment.
+	RecipeVersion string
 }
 
 // ResourceMetadata represents recipe details provided while creating a portable resource.
@@ -77,18 +83,26 @@ type ResourceMetadata struct {
 	ResourceID string
 	// Parameters represents key/value pairs to pass into the recipe template. Overrides any parameters set by the environment.
 	Parameters map[string]any
+	// Properties represents the properties of the resource. They supply the outputs the recipe does not return when
+	// the outputs are validated against the output schema of the resource type.
+	Properties map[string]any
+	// Status represents the recipe status recorded by the last deployment of the resource. The resource keeps using the
+	// version of the recipe it was deployed with until it is upgraded, even when the recipe is updated in the environment.
+	Status *rpv1.RecipeStatus
 }
 
 const (
-	TemplateKindBicep     = "bicep"
-	TemplateKindTerraform = "terraform"
+	TemplateKindBicep      = "bicep"
+	TemplateKindTerraform  = "terraform"
+	TemplateKindHelm       = "helm"
+	TemplateKindKubernetes = "kubernetes"
 
 	// Recipe outputs are expected to be wrapped under an object named "result"
 	ResultPropertyName = "result"
 )
 
 var (
-	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform}
+	SupportedTemplateKind = []string{TemplateKindBicep, TemplateKindTerraform, TemplateKindHelm, TemplateKindKubernetes}
 )
 
 // RecipeOutput represents recipe deployment output.
@@ -106,6 +120,73 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// ChangeAction is the change a recipe deployment would make to a resource.
+type ChangeAction string
+
+const (
+	// ChangeActionCreate means the resource would be created.
+	ChangeActionCreate ChangeAction = "create"
+
+	// ChangeActionUpdate means the resource would be updated in place.
+	ChangeActionUpdate ChangeAction = "update"
+
+	// ChangeActionReplace means the resource would be deleted and created again.
+	ChangeActionReplace ChangeAction = "replace"
+
+	// ChangeActionDelete means the resource would be deleted.
+	ChangeActionDelete ChangeAction = "delete"
+
+	// ChangeActionNoOp means the resource would not change.
+	ChangeActionNoOp ChangeAction = "noop"
+)
+
+// RecipePlan represents the changes a recipe deployment would make, without making them.
+type RecipePlan struct {
+	// Changes represents the changes to the resources of the recipe.
+	Changes []ResourceChange
+}
+
+// ResourceChange represents the change a recipe deployment would make to a resource.
+type ResourceChange struct {
+	// Action is the change made to the resource.
+	Action ChangeAction
+
+	// Type is the type of the resource, for example 'aws_s3_bucket' or 'Microsoft.Cache/redis'.
+	Type string
+
+	// Name identifies the resource, for example the address of a Terraform resource or the ID of an ARM resource.
+	Name string
+}
+
+// RecipeDrift represents the resources deployed by a recipe which have drifted from the recipe.
+type RecipeDrift struct {
+	// Resources represents the drifted resources, with the change the deployment of the recipe would make to remediate
+	// their drift.
+	Resources []ResourceChange
+
+	// DefinitionChanged is true if the template or the parameters of the recipe registered to the environment differ
+	// from the ones the resource was deployed with. Such changes are not drift, since the drift is detected against
+	// the template and the parameters the resource was deployed with, but redeploying the resource would apply them.
+	DefinitionChanged bool
+}
+
+// Drifted returns true if any resource deployed by the recipe has drifted from the recipe.
+func (d *RecipeDrift) Drifted() bool {
+	return d != nil && len(d.Resources) > 0
+}
+
+// Drift returns the resources the plan would change as drifted, as a plan against the current state of the resources
+// is empty unless they were changed out-of-band.
+func (p *RecipePlan) Drift() *RecipeDrift {
+	drift := &RecipeDrift{Resources: []ResourceChange{}}
+	for _, change := range p.Changes {
+		if change.Action != ChangeActionNoOp {
+			drift.Resources = append(drift.Resources, change)
+		}
+	}
+	return drift
+}
+
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
 // outputs map is the value of "result" output from the recipe deployment response.
 func (ro *RecipeOutput) PrepareRecipeResponse(resultValue map[string]any) error {
@@ -33,14 +33,31 @@ import (
 // if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
 // the data fails to be unmarshalled.
 func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client) error {
+	bytes, err := ReadBytesFromRegistry(ctx, definition, client)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(bytes, data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReadBytesFromRegistry reads the content of the first layer of the artifact of the recipe from an OCI compliant registry.
+// It returns an error if the path is invalid, if the client to the registry fails to be created, or if the manifest or
+// the bytes fail to be fetched.
+func ReadBytesFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, client remote.Client) ([]byte, error) {
 	registryRepo, tag, err := parsePath(definition.TemplatePath)
 	if err != nil {
-		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
 	}
 
 	repo, err := remote.NewRepository(registryRepo)
 	if err != nil {
-		return fmt.Errorf("failed to create client to registry %s", err.Error())
+		return nil, fmt.Errorf("failed to create client to registry %s", err.Error())
 	}
 	repo.Client = client
 
@@ -50,20 +67,15 @@ func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinit
 
 	digest, err := getDigestFromManifest(ctx, repo, tag)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
 
 	bytes, err := getBytes(ctx, repo, digest)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
 
-	err = json.Unmarshal(bytes, data)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return bytes, nil
 }
 
 // getDigestFromManifest gets the layers digest from the manifest
@@ -38,7 +38,7 @@ type fakeServerInfo struct {
 
 // NewFakeRegistryServer creates a fake registry server that serves a single blob and index.
 func NewFakeRegistryServer(t *testing.T) fakeServerInfo {
-	blob := []byte(`{
+	return NewFakeRegistryServerWithBlob(t, []byte(`{
 	"parameters": {
 		"documentdbName": {
 			"type": "string"
@@ -48,8 +48,11 @@ func NewFakeRegistryServer(t *testing.T) fakeServerInfo {
 			"type": "string"
 		}
 	}
-}`)
+}`))
+}
 
+// NewFakeRegistryServerWithBlob creates a fake registry server that serves the given blob as the single layer of the image.
+func NewFakeRegistryServerWithBlob(t *testing.T, blob []byte) fakeServerInfo {
 	blobDesc := ocispec.Descriptor{
 		MediaType: "recipe",
 		Digest:    digest.FromBytes(blob),
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	resources "github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// FetchSecrets fetches the secrets of a secret store resource using the provided secretStoreID and ClientOptions,
+// and returns the secret values keyed by the secret name or an error.
+func FetchSecrets(ctx context.Context, secretStoreID string, ucpOptions *arm.ClientOptions) (map[string]string, error) {
+	secretStoreResourceID, err := resources.ParseResource(secretStoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v20231001preview.NewSecretStoresClient(secretStoreResourceID.RootScope(), &aztoken.AnonymousCredential{}, ucpOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.ListSecrets(ctx, secretStoreResourceID.Name(), map[string]any{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := map[string]string{}
+	for name, secret := range response.Data {
+		if secret != nil {
+			secrets[name] = to.String(secret.Value)
+		}
+	}
+
+	return secrets, nil
+}
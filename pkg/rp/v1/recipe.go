@@ -16,8 +16,16 @@ limitations under the License.
 
 package v1
 
+import "time"
+
 // RecipeStatus defines the status of the recipe
 type RecipeStatus struct {
+	// RecipeName specifies the name of the recipe the resource was deployed with.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// ResourceType specifies the type of the resource the recipe was registered for.
+	ResourceType string `json:"resourceType,omitempty"`
+
 	// TemplateKind specifies the kind of template used for the recipe.
 	TemplateKind string `json:"templateKind,omitempty"`
 
@@ -26,4 +34,45 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// RecipeVersion specifies the version of the recipe the resource was deployed with. A resource keeps using this
+	// version of the recipe until it is upgraded, even when the recipe is updated in the environment.
+	RecipeVersion string `json:"recipeVersion,omitempty"`
+
+	// Parameters specifies the parameters of the recipe registered to the environment the resource was deployed with.
+	// They are used together with the template of the version of the recipe the resource keeps using, since a new
+	// version of the recipe may be registered with other parameters.
+	Parameters map[string]any `json:"parameters,omitempty"`
+
+	// UpgradeFrom is the recipe status of the resource before an upgrade of its recipe is started. It is used to roll
+	// the resource back to the previous version of the recipe if the upgrade fails, and is cleared once the resource is
+	// redeployed.
+	UpgradeFrom *RecipeStatus `json:"upgradeFrom,omitempty"`
+
+	// Drift is the result of the last drift check of the resources deployed by the recipe.
+	Drift *RecipeDriftStatus `json:"drift,omitempty"`
+}
+
+// RecipeDriftStatus defines the result of a drift check of the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// Drifted is true if any resource deployed by the recipe has drifted from the recipe.
+	Drifted bool `json:"drifted"`
+
+	// LastCheckedAt is the time of the drift check.
+	LastCheckedAt time.Time `json:"lastCheckedAt"`
+
+	// Resources is the list of the resources which have drifted from the recipe.
+	Resources []DriftedResource `json:"resources,omitempty"`
+}
+
+// DriftedResource defines a resource deployed by a recipe which has drifted from the recipe.
+type DriftedResource struct {
+	// Action is the change the deployment of the recipe would make to remediate the drift of the resource.
+	Action string `json:"action"`
+
+	// Type is the type of the resource.
+	Type string `json:"type"`
+
+	// Name is the name or the resource ID of the resource.
+	Name string `json:"name"`
 }
@@ -71,9 +71,21 @@ func (in *ResourceStatus) DeepCopy(out *ResourceStatus) {
 	in.OutputResources = out.OutputResources
 	if out.Recipe != nil {
 		in.Recipe = &RecipeStatus{
+			RecipeName:      out.Recipe.RecipeName,
+			ResourceType:    out.Recipe.ResourceType,
 			TemplateKind:    out.Recipe.TemplateKind,
 			TemplatePath:    out.Recipe.TemplatePath,
 			TemplateVersion: out.Recipe.TemplateVersion,
+			RecipeVersion:   out.Recipe.RecipeVersion,
+			Parameters:      out.Recipe.Parameters,
+			UpgradeFrom:     out.Recipe.UpgradeFrom,
+		}
+		if out.Recipe.Drift != nil {
+			in.Recipe.Drift = &RecipeDriftStatus{
+				Drifted:       out.Recipe.Drift.Drifted,
+				LastCheckedAt: out.Recipe.Drift.LastCheckedAt,
+				Resources:     append([]DriftedResource(nil), out.Recipe.Drift.Resources...),
+			}
 		}
 	}
 }
@@ -95,6 +107,10 @@ type KubernetesComputeProperties struct {
 
 	// Namespace represents Kubernetes namespace.
 	Namespace string `json:"namespace"`
+
+	// Cluster represents the name of the Kubernetes plane of the cluster to deploy to. The cluster Radius runs in is
+	// used when it is empty.
+	Cluster string `json:"cluster,omitempty"`
 }
 
 // RadiusResourceModel represents the interface of radius resource type.
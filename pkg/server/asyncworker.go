@@ -63,7 +63,7 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
 	}
 
-	appModel, err := model.NewApplicationModel(w.Options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient)
+	appModel, err := model.NewApplicationModel(w.Options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient, kubeutil.NewUCPClusterClientsProvider(w.Options.UCPConnection))
 	if err != nil {
 		return fmt.Errorf("failed to initialize application model: %w", err)
 	}
@@ -91,6 +91,11 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.Options.Config.WorkerServer.MaxPendingOperations != nil {
+			workerOpts.MaxPendingOperations = *w.Options.Config.WorkerServer.MaxPendingOperations
+		}
+		workerOpts.PriorityWeights = w.Options.Config.WorkerServer.PriorityWeights
+		workerOpts.OperationConcurrencyLimits = w.Options.Config.WorkerServer.OperationConcurrencyLimits
 	}
 
 	return w.Start(ctx, workerOpts)
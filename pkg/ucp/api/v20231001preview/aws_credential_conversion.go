@@ -17,8 +17,6 @@ limitations under the License.
 package v20231001preview
 
 import (
-	"fmt"
-
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -62,32 +60,52 @@ func (cr *AwsCredentialResource) getDataModelCredentialProperties() (*datamodel.
 
 	switch p := cr.Properties.(type) {
 	case *AwsAccessKeyCredentialProperties:
-		var storage *datamodel.CredentialStorageProperties
-
-		switch c := p.Storage.(type) {
-		case *InternalCredentialStorageProperties:
-			if c.Kind == nil {
-				return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
-			}
-			storage = &datamodel.CredentialStorageProperties{
-				Kind: datamodel.InternalStorageKind,
-				InternalCredential: &datamodel.InternalCredentialStorageProperties{
-					SecretName: to.String(c.SecretName),
-				},
-			}
-		case nil:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
-		default:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSAccessKeyCredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:            datamodel.AWSAccessKeyCredentialKind,
+				AccessKeyID:     to.String(p.AccessKeyID),
+				SecretAccessKey: to.String(p.SecretAccessKey),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	case *AwsAssumeRoleCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
 		}
 
 		return &datamodel.AWSCredentialResourceProperties{
-			Kind: datamodel.AWSCredentialKind,
+			Kind: datamodel.AWSAssumeRoleCredentialKind,
 			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:            datamodel.AWSAssumeRoleCredentialKind,
 				AccessKeyID:     to.String(p.AccessKeyID),
 				SecretAccessKey: to.String(p.SecretAccessKey),
+				RoleARN:         to.String(p.RoleARN),
 			},
-			Storage: storage,
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	case *AwsIRSACredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AWSCredentialResourceProperties{
+			Kind: datamodel.AWSIRSACredentialKind,
+			AWSCredential: &datamodel.AWSCredentialProperties{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: to.String(p.RoleARN),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
 		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
@@ -120,11 +138,30 @@ func (dst *AwsCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 
 	// DO NOT convert any secret values to versioned model.
 	switch dm.Properties.Kind {
-	case datamodel.AWSCredentialKind:
+	case datamodel.AWSAccessKeyCredentialKind:
 		dst.Properties = &AwsAccessKeyCredentialProperties{
-			Kind:        to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
-			AccessKeyID: to.Ptr(dm.Properties.AWSCredential.AccessKeyID),
-			Storage:     storage,
+			Kind:          to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			AccessKeyID:   to.Ptr(dm.Properties.AWSCredential.AccessKeyID),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
+		}
+	case datamodel.AWSAssumeRoleCredentialKind:
+		dst.Properties = &AwsAssumeRoleCredentialProperties{
+			Kind:          to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			AccessKeyID:   to.Ptr(dm.Properties.AWSCredential.AccessKeyID),
+			RoleARN:       to.Ptr(dm.Properties.AWSCredential.RoleARN),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
+		}
+	case datamodel.AWSIRSACredentialKind:
+		dst.Properties = &AwsIRSACredentialProperties{
+			Kind:          to.Ptr(AWSCredentialKind(dm.Properties.Kind)),
+			RoleARN:       to.Ptr(dm.Properties.AWSCredential.RoleARN),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
 		}
 	default:
 		return v1.ErrInvalidModelConversion
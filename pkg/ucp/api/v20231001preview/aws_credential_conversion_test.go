@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -55,6 +56,7 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 				Properties: &datamodel.AWSCredentialResourceProperties{
 					Kind: "AccessKey",
 					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:            "AccessKey",
 						AccessKeyID:     "00000000-0000-0000-0000-000000000000",
 						SecretAccessKey: "00000000-0000-0000-0000-000000000000",
 					},
@@ -62,6 +64,69 @@ func TestAWSCredentialConvertVersionedToDataModel(t *testing.T) {
 						Kind:               datamodel.InternalStorageKind,
 						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
 					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresource-aws-assumerole.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: "AssumeRole",
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:            "AssumeRole",
+						AccessKeyID:     "00000000-0000-0000-0000-000000000000",
+						SecretAccessKey: "00000000-0000-0000-0000-000000000000",
+						RoleARN:         "arn:aws:iam::000000000000:role/radius",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-aws-irsa.json",
+			expected: &datamodel.AWSCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/aws/aws/providers/System.AWS/credentials/default",
+						Name:     "default",
+						Type:     "System.AWS/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AWSCredentialResourceProperties{
+					Kind: "IRSA",
+					AWSCredential: &datamodel.AWSCredentialProperties{
+						Kind:    "IRSA",
+						RoleARN: "arn:aws:iam::000000000000:role/radius",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
 				},
 			},
 		},
@@ -129,6 +194,49 @@ func TestAWSCredentialConvertDataModelToVersioned(t *testing.T) {
 						Kind:       to.Ptr(CredentialStorageKindInternal),
 						SecretName: to.Ptr("aws-awscloud-default"),
 					},
+					ExpiresAt:     to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+					LastRotatedAt: to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-aws-assumerole.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsAssumeRoleCredentialProperties{
+					Kind:        to.Ptr(AWSCredentialKindAssumeRole),
+					AccessKeyID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					RoleARN:     to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-aws-irsa.json",
+			expected: &AwsCredentialResource{
+				ID:       to.Ptr("/planes/aws/aws/providers/System.AWS/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.AWS/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AwsIRSACredentialProperties{
+					Kind:    to.Ptr(AWSCredentialKindIRSA),
+					RoleARN: to.Ptr("arn:aws:iam::000000000000:role/radius"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("aws-awscloud-default"),
+					},
 				},
 			},
 		},
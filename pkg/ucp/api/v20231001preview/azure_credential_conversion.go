@@ -62,39 +62,62 @@ func (cr *AzureCredentialResource) getDataModelCredentialProperties() (*datamode
 
 	switch p := cr.Properties.(type) {
 	case *AzureServicePrincipalProperties:
-		var storage *datamodel.CredentialStorageProperties
-
-		switch c := p.Storage.(type) {
-		case *InternalCredentialStorageProperties:
-			if c.Kind == nil {
-				return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
-			}
-			storage = &datamodel.CredentialStorageProperties{
-				Kind: datamodel.InternalStorageKind,
-				InternalCredential: &datamodel.InternalCredentialStorageProperties{
-					SecretName: to.String(c.SecretName),
-				},
-			}
-		case nil:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
-		default:
-			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
 		}
 
 		return &datamodel.AzureCredentialResourceProperties{
-			Kind: datamodel.AzureCredentialKind,
+			Kind: datamodel.AzureServicePrincipalCredentialKind,
 			AzureCredential: &datamodel.AzureCredentialProperties{
+				Kind:         datamodel.AzureServicePrincipalCredentialKind,
 				TenantID:     to.String(p.TenantID),
 				ClientID:     to.String(p.ClientID),
 				ClientSecret: to.String(p.ClientSecret),
 			},
-			Storage: storage,
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	case *AzureWorkloadIdentityProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.AzureCredentialResourceProperties{
+			Kind: datamodel.AzureWorkloadIdentityCredentialKind,
+			AzureCredential: &datamodel.AzureCredentialProperties{
+				Kind:     datamodel.AzureWorkloadIdentityCredentialKind,
+				TenantID: to.String(p.TenantID),
+				ClientID: to.String(p.ClientID),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
 		}, nil
 	default:
 		return nil, v1.ErrInvalidModelConversion
 	}
 }
 
+func toCredentialStorageDataModel(storage CredentialStoragePropertiesClassification) (*datamodel.CredentialStorageProperties, error) {
+	switch c := storage.(type) {
+	case *InternalCredentialStorageProperties:
+		if c.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.InternalStorageKind,
+			InternalCredential: &datamodel.InternalCredentialStorageProperties{
+				SecretName: to.String(c.SecretName),
+			},
+		}, nil
+	case nil:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+	}
+}
+
 // ConvertFrom converts from version-agnostic datamodel to the versioned Credential resource.
 func (dst *AzureCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
 	dm, ok := src.(*datamodel.AzureCredential)
@@ -121,12 +144,23 @@ func (dst *AzureCredentialResource) ConvertFrom(src v1.DataModelInterface) error
 
 	// DO NOT convert any secret values to versioned model.
 	switch dm.Properties.Kind {
-	case datamodel.AzureCredentialKind:
+	case datamodel.AzureServicePrincipalCredentialKind:
 		dst.Properties = &AzureServicePrincipalProperties{
-			Kind:     to.Ptr(AzureCredentialKind(dm.Properties.Kind)),
-			ClientID: to.Ptr(dm.Properties.AzureCredential.ClientID),
-			TenantID: to.Ptr(dm.Properties.AzureCredential.TenantID),
-			Storage:  storage,
+			Kind:          to.Ptr(AzureCredentialKind(dm.Properties.Kind)),
+			ClientID:      to.Ptr(dm.Properties.AzureCredential.ClientID),
+			TenantID:      to.Ptr(dm.Properties.AzureCredential.TenantID),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
+		}
+	case datamodel.AzureWorkloadIdentityCredentialKind:
+		dst.Properties = &AzureWorkloadIdentityProperties{
+			Kind:          to.Ptr(AzureCredentialKind(dm.Properties.Kind)),
+			ClientID:      to.Ptr(dm.Properties.AzureCredential.ClientID),
+			TenantID:      to.Ptr(dm.Properties.AzureCredential.TenantID),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
 		}
 	default:
 		return v1.ErrInvalidModelConversion
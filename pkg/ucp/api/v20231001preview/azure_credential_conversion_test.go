@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
@@ -55,6 +56,7 @@ func TestAzureCredentialConvertVersionedToDataModel(t *testing.T) {
 				Properties: &datamodel.AzureCredentialResourceProperties{
 					Kind: "ServicePrincipal",
 					AzureCredential: &datamodel.AzureCredentialProperties{
+						Kind:         "ServicePrincipal",
 						TenantID:     "00000000-0000-0000-0000-000000000000",
 						ClientID:     "00000000-0000-0000-0000-000000000000",
 						ClientSecret: "secret",
@@ -63,6 +65,38 @@ func TestAzureCredentialConvertVersionedToDataModel(t *testing.T) {
 						Kind:               datamodel.InternalStorageKind,
 						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
 					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresource-azure-workloadidentity.json",
+			expected: &datamodel.AzureCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+						Name:     "default",
+						Type:     "System.Azure/credentials",
+						Location: "west-us-2",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.AzureCredentialResourceProperties{
+					Kind: "WorkloadIdentity",
+					AzureCredential: &datamodel.AzureCredentialProperties{
+						Kind:     "WorkloadIdentity",
+						TenantID: "00000000-0000-0000-0000-000000000000",
+						ClientID: "00000000-0000-0000-0000-000000000000",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
 				},
 			},
 		},
@@ -131,6 +165,29 @@ func TestAzureCredentialConvertDataModelToVersioned(t *testing.T) {
 						Kind:       to.Ptr(CredentialStorageKindInternal),
 						SecretName: to.Ptr("azure-azurecloud-default"),
 					},
+					ExpiresAt:     to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+					LastRotatedAt: to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-azure-workloadidentity.json",
+			expected: &AzureCredentialResource{
+				ID:       to.Ptr("/planes/azure/azurecloud/providers/System.Azure/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Azure/credentials"),
+				Location: to.Ptr("west-us-2"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &AzureWorkloadIdentityProperties{
+					Kind:     to.Ptr(AzureCredentialKindWorkloadIdentity),
+					ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("azure-azurecloud-default"),
+					},
 				},
 			},
 		},
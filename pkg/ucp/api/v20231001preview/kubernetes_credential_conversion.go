@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// KubernetesCredentialType represents the ucp kubernetes credential type value.
+	KubernetesCredentialType = "System.Kubernetes/credentials"
+)
+
+// ConvertTo converts from the versioned Credential resource to version-agnostic datamodel.
+func (cr *KubernetesCredentialResource) ConvertTo() (v1.DataModelInterface, error) {
+	prop, err := cr.getDataModelCredentialProperties()
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &datamodel.KubernetesCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(cr.ID),
+				Name:     to.String(cr.Name),
+				Type:     to.String(cr.Type),
+				Location: to.String(cr.Location),
+				Tags:     to.StringMap(cr.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: prop,
+	}
+
+	return converted, nil
+}
+
+func (cr *KubernetesCredentialResource) getDataModelCredentialProperties() (*datamodel.KubernetesCredentialResourceProperties, error) {
+	if cr.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	switch p := cr.Properties.(type) {
+	case *KubernetesServiceAccountTokenCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.KubernetesCredentialResourceProperties{
+			Kind: datamodel.KubernetesServiceAccountTokenCredentialKind,
+			KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+				Kind:                     datamodel.KubernetesServiceAccountTokenCredentialKind,
+				Token:                    to.String(p.Token),
+				CertificateAuthorityData: to.String(p.CertificateAuthorityData),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	case *KubernetesKubeconfigCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.KubernetesCredentialResourceProperties{
+			Kind: datamodel.KubernetesKubeconfigCredentialKind,
+			KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+				Kind:       datamodel.KubernetesKubeconfigCredentialKind,
+				Kubeconfig: to.String(p.Kubeconfig),
+			},
+			Storage:   storage,
+			ExpiresAt: p.ExpiresAt,
+		}, nil
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Credential resource.
+func (dst *KubernetesCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.KubernetesCredential)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = &dm.ID
+	dst.Name = &dm.Name
+	dst.Type = &dm.Type
+	dst.Location = &dm.Location
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	var storage CredentialStoragePropertiesClassification
+	switch dm.Properties.Storage.Kind {
+	case datamodel.InternalStorageKind:
+		storage = &InternalCredentialStorageProperties{
+			Kind:       to.Ptr(CredentialStorageKindInternal),
+			SecretName: to.Ptr(dm.Properties.Storage.InternalCredential.SecretName),
+		}
+	default:
+		return v1.ErrInvalidModelConversion
+	}
+
+	// DO NOT convert any secret values to versioned model. The certificate authority data is not a secret.
+	switch dm.Properties.Kind {
+	case datamodel.KubernetesServiceAccountTokenCredentialKind:
+		properties := &KubernetesServiceAccountTokenCredentialProperties{
+			Kind:          to.Ptr(KubernetesCredentialKind(dm.Properties.Kind)),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
+		}
+		if dm.Properties.KubernetesCredential != nil && dm.Properties.KubernetesCredential.CertificateAuthorityData != "" {
+			properties.CertificateAuthorityData = to.Ptr(dm.Properties.KubernetesCredential.CertificateAuthorityData)
+		}
+		dst.Properties = properties
+	case datamodel.KubernetesKubeconfigCredentialKind:
+		dst.Properties = &KubernetesKubeconfigCredentialProperties{
+			Kind:          to.Ptr(KubernetesCredentialKind(dm.Properties.Kind)),
+			Storage:       storage,
+			ExpiresAt:     dm.Properties.ExpiresAt,
+			LastRotatedAt: dm.Properties.LastRotatedAt,
+		}
+	default:
+		return v1.ErrInvalidModelConversion
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesCredentialConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.KubernetesCredential
+		err      error
+	}{
+		{
+			filename: "credentialresource-kubernetes.json",
+			expected: &datamodel.KubernetesCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default",
+						Name:     "default",
+						Type:     "System.Kubernetes/credentials",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.KubernetesCredentialResourceProperties{
+					Kind: "ServiceAccountToken",
+					KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+						Kind:                     "ServiceAccountToken",
+						Token:                    "token",
+						CertificateAuthorityData: "Y2VydGlmaWNhdGU=",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+					ExpiresAt: to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresource-kubernetes-kubeconfig.json",
+			expected: &datamodel.KubernetesCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default",
+						Name:     "default",
+						Type:     "System.Kubernetes/credentials",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.KubernetesCredentialResourceProperties{
+					Kind: "Kubeconfig",
+					KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+						Kind:       "Kubeconfig",
+						Kubeconfig: "apiVersion: v1\nkind: Config",
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-other.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+		{
+			filename: "credentialresource-empty-properties.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"},
+		},
+		{
+			filename: "credentialresource-empty-storage-kubernetes.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"},
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &KubernetesCredentialResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				ct := dm.(*datamodel.KubernetesCredential)
+				require.Equal(t, tt.expected, ct)
+			}
+		})
+	}
+}
+
+func TestKubernetesCredentialConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *KubernetesCredentialResource
+		err      error
+	}{
+		{
+			filename: "credentialresourcedatamodel-kubernetes.json",
+			expected: &KubernetesCredentialResource{
+				ID:       to.Ptr("/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Kubernetes/credentials"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &KubernetesServiceAccountTokenCredentialProperties{
+					Kind:                     to.Ptr(KubernetesCredentialKindServiceAccountToken),
+					CertificateAuthorityData: to.Ptr("Y2VydGlmaWNhdGU="),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("kubernetes-prod-default"),
+					},
+					ExpiresAt:     to.Ptr(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+					LastRotatedAt: to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-kubernetes-kubeconfig.json",
+			expected: &KubernetesCredentialResource{
+				ID:       to.Ptr("/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default"),
+				Name:     to.Ptr("default"),
+				Type:     to.Ptr("System.Kubernetes/credentials"),
+				Location: to.Ptr("global"),
+				Tags: map[string]*string{
+					"env": to.Ptr("dev"),
+				},
+				Properties: &KubernetesKubeconfigCredentialProperties{
+					Kind: to.Ptr(KubernetesCredentialKindKubeconfig),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       to.Ptr(CredentialStorageKindInternal),
+						SecretName: to.Ptr("kubernetes-prod-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-default.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &datamodel.KubernetesCredential{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			versioned := &KubernetesCredentialResource{}
+			err = versioned.ConvertFrom(r)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
@@ -47,7 +47,8 @@ func (src *PlaneResource) ConvertTo() (v1.DataModelInterface, error) {
 	// Plane validation
 	if *src.Properties.Kind == PlaneKindUCPNative && (src.Properties.ResourceProviders == nil || len(src.Properties.ResourceProviders) == 0) {
 		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.resourceProviders", ValidValue: "at least one provided"}
-	} else if *src.Properties.Kind == PlaneKindAzure && (src.Properties.URL == nil || *src.Properties.URL == "") {
+	} else if (*src.Properties.Kind == PlaneKindAzure || *src.Properties.Kind == PlaneKindKubernetes) && (src.Properties.URL == nil || *src.Properties.URL == "") {
+		// Requests to Azure planes are forwarded to the URL and requests to Kubernetes planes are forwarded to the API server of the cluster.
 		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.URL", ValidValue: "non-empty string"}
 	}
 	// No validation for AWS plane.
@@ -66,6 +66,10 @@ func TestPlaneConvertVersionedToDataModel(t *testing.T) {
 			filename: "planeresource-invalid-missing-url.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.URL", ValidValue: "non-empty string"},
 		},
+		{
+			filename: "planeresource-kubernetes-invalid-missing-url.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.URL", ValidValue: "non-empty string"},
+		},
 		{
 			filename: "planeresource-invalid-unsupported-kind.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.kind", ValidValue: fmt.Sprintf("one of %s", PossiblePlaneKindValues())},
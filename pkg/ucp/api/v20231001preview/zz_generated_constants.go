@@ -18,12 +18,18 @@ type AWSCredentialKind string
 const (
 	// AWSCredentialKindAccessKey - The AWS Access Key credential
 	AWSCredentialKindAccessKey AWSCredentialKind = "AccessKey"
+	// AWSCredentialKindAssumeRole - The AWS STS AssumeRole credential
+	AWSCredentialKindAssumeRole AWSCredentialKind = "AssumeRole"
+	// AWSCredentialKindIRSA - The AWS IAM Roles for Service Accounts credential
+	AWSCredentialKindIRSA AWSCredentialKind = "IRSA"
 )
 
 // PossibleAWSCredentialKindValues returns the possible values for the AWSCredentialKind const type.
 func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	return []AWSCredentialKind{	
 		AWSCredentialKindAccessKey,
+		AWSCredentialKindAssumeRole,
+		AWSCredentialKindIRSA,
 	}
 }
 
@@ -33,12 +39,15 @@ type AzureCredentialKind string
 const (
 	// AzureCredentialKindServicePrincipal - The Service Principal Credential
 	AzureCredentialKindServicePrincipal AzureCredentialKind = "ServicePrincipal"
+	// AzureCredentialKindWorkloadIdentity - The Workload Identity Credential
+	AzureCredentialKindWorkloadIdentity AzureCredentialKind = "WorkloadIdentity"
 )
 
 // PossibleAzureCredentialKindValues returns the possible values for the AzureCredentialKind const type.
 func PossibleAzureCredentialKindValues() []AzureCredentialKind {
 	return []AzureCredentialKind{	
 		AzureCredentialKindServicePrincipal,
+		AzureCredentialKindWorkloadIdentity,
 	}
 }
 
@@ -77,6 +86,24 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// KubernetesCredentialKind - Kubernetes credential kinds supported.
+type KubernetesCredentialKind string
+
+const (
+	// KubernetesCredentialKindKubeconfig - The Kubeconfig Credential
+	KubernetesCredentialKindKubeconfig KubernetesCredentialKind = "Kubeconfig"
+	// KubernetesCredentialKindServiceAccountToken - The Service Account Token Credential
+	KubernetesCredentialKindServiceAccountToken KubernetesCredentialKind = "ServiceAccountToken"
+)
+
+// PossibleKubernetesCredentialKindValues returns the possible values for the KubernetesCredentialKind const type.
+func PossibleKubernetesCredentialKindValues() []KubernetesCredentialKind {
+	return []KubernetesCredentialKind{	
+		KubernetesCredentialKindKubeconfig,
+		KubernetesCredentialKindServiceAccountToken,
+	}
+}
+
 // PlaneKind - Plane kinds supported.
 type PlaneKind string
 
@@ -85,6 +112,8 @@ const (
 	PlaneKindAWS PlaneKind = "AWS"
 	// PlaneKindAzure - Azure Plane
 	PlaneKindAzure PlaneKind = "Azure"
+	// PlaneKindKubernetes - Kubernetes Plane
+	PlaneKindKubernetes PlaneKind = "Kubernetes"
 	// PlaneKindUCPNative - UCP Native Plane
 	PlaneKindUCPNative PlaneKind = "UCPNative"
 )
@@ -94,6 +123,7 @@ func PossiblePlaneKindValues() []PlaneKind {
 	return []PlaneKind{	
 		PlaneKindAWS,
 		PlaneKindAzure,
+		PlaneKindKubernetes,
 		PlaneKindUCPNative,
 	}
 }
@@ -0,0 +1,367 @@
+//go:build go1.18
+// +build go1.18
+
+// Licensed under the Apache License, Version 2.0 . See LICENSE in the repository root for license information.
+// Code generated by Microsoft (R) AutoRest Code Generator. DO NOT EDIT.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+package v20231001preview
+
+import (
+	"context"
+	"errors"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// KubernetesCredentialsClient contains the methods for the KubernetesCredentials group.
+// Don't use this type directly, use NewKubernetesCredentialsClient() instead.
+type KubernetesCredentialsClient struct {
+	internal *arm.Client
+}
+
+// NewKubernetesCredentialsClient creates a new instance of KubernetesCredentialsClient with the specified values.
+//   - credential - used to authorize requests. Usually a credential from azidentity.
+//   - options - pass nil to accept the default values.
+func NewKubernetesCredentialsClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*KubernetesCredentialsClient, error) {
+	cl, err := arm.NewClient(moduleName+".KubernetesCredentialsClient", moduleVersion, credential, options)
+	if err != nil {
+		return nil, err
+	}
+	client := &KubernetesCredentialsClient{
+	internal: cl,
+	}
+	return client, nil
+}
+
+// CreateOrUpdate - Create or update a Kubernetes credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Kubernetes credential name.
+//   - resource - Resource create parameters.
+//   - options - KubernetesCredentialsClientCreateOrUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.CreateOrUpdate
+//     method.
+func (client *KubernetesCredentialsClient) CreateOrUpdate(ctx context.Context, planeName string, credentialName string, resource KubernetesCredentialResource, options *KubernetesCredentialsClientCreateOrUpdateOptions) (KubernetesCredentialsClientCreateOrUpdateResponse, error) {
+	var err error
+	req, err := client.createOrUpdateCreateRequest(ctx, planeName, credentialName, resource, options)
+	if err != nil {
+		return KubernetesCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return KubernetesCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusCreated) {
+		err = runtime.NewResponseError(httpResp)
+		return KubernetesCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	resp, err := client.createOrUpdateHandleResponse(httpResp)
+	return resp, err
+}
+
+// createOrUpdateCreateRequest creates the CreateOrUpdate request.
+func (client *KubernetesCredentialsClient) createOrUpdateCreateRequest(ctx context.Context, planeName string, credentialName string, resource KubernetesCredentialResource, options *KubernetesCredentialsClientCreateOrUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPut, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// createOrUpdateHandleResponse handles the CreateOrUpdate response.
+func (client *KubernetesCredentialsClient) createOrUpdateHandleResponse(resp *http.Response) (KubernetesCredentialsClientCreateOrUpdateResponse, error) {
+	result := KubernetesCredentialsClientCreateOrUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.KubernetesCredentialResource); err != nil {
+		return KubernetesCredentialsClientCreateOrUpdateResponse{}, err
+	}
+	return result, nil
+}
+
+// Delete - Delete a Kubernetes credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Kubernetes credential name.
+//   - options - KubernetesCredentialsClientDeleteOptions contains the optional parameters for the KubernetesCredentialsClient.Delete method.
+func (client *KubernetesCredentialsClient) Delete(ctx context.Context, planeName string, credentialName string, options *KubernetesCredentialsClientDeleteOptions) (KubernetesCredentialsClientDeleteResponse, error) {
+	var err error
+	req, err := client.deleteCreateRequest(ctx, planeName, credentialName, options)
+	if err != nil {
+		return KubernetesCredentialsClientDeleteResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return KubernetesCredentialsClientDeleteResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return KubernetesCredentialsClientDeleteResponse{}, err
+	}
+	return KubernetesCredentialsClientDeleteResponse{}, nil
+}
+
+// deleteCreateRequest creates the Delete request.
+func (client *KubernetesCredentialsClient) deleteCreateRequest(ctx context.Context, planeName string, credentialName string, options *KubernetesCredentialsClientDeleteOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodDelete, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// Get - Get a Kubernetes credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Kubernetes credential name.
+//   - options - KubernetesCredentialsClientGetOptions contains the optional parameters for the KubernetesCredentialsClient.Get method.
+func (client *KubernetesCredentialsClient) Get(ctx context.Context, planeName string, credentialName string, options *KubernetesCredentialsClientGetOptions) (KubernetesCredentialsClientGetResponse, error) {
+	var err error
+	req, err := client.getCreateRequest(ctx, planeName, credentialName, options)
+	if err != nil {
+		return KubernetesCredentialsClientGetResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return KubernetesCredentialsClientGetResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return KubernetesCredentialsClientGetResponse{}, err
+	}
+	resp, err := client.getHandleResponse(httpResp)
+	return resp, err
+}
+
+// getCreateRequest creates the Get request.
+func (client *KubernetesCredentialsClient) getCreateRequest(ctx context.Context, planeName string, credentialName string, options *KubernetesCredentialsClientGetOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getHandleResponse handles the Get response.
+func (client *KubernetesCredentialsClient) getHandleResponse(resp *http.Response) (KubernetesCredentialsClientGetResponse, error) {
+	result := KubernetesCredentialsClientGetResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.KubernetesCredentialResource); err != nil {
+		return KubernetesCredentialsClientGetResponse{}, err
+	}
+	return result, nil
+}
+
+// NewListPager - List Kubernetes credentials
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - options - KubernetesCredentialsClientListOptions contains the optional parameters for the KubernetesCredentialsClient.NewListPager
+//     method.
+func (client *KubernetesCredentialsClient) NewListPager(planeName string, options *KubernetesCredentialsClientListOptions) (*runtime.Pager[KubernetesCredentialsClientListResponse]) {
+	return runtime.NewPager(runtime.PagingHandler[KubernetesCredentialsClientListResponse]{
+		More: func(page KubernetesCredentialsClientListResponse) bool {
+			return page.NextLink != nil && len(*page.NextLink) > 0
+		},
+		Fetcher: func(ctx context.Context, page *KubernetesCredentialsClientListResponse) (KubernetesCredentialsClientListResponse, error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = client.listCreateRequest(ctx, planeName, options)
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, *page.NextLink)
+			}
+			if err != nil {
+				return KubernetesCredentialsClientListResponse{}, err
+			}
+			resp, err := client.internal.Pipeline().Do(req)
+			if err != nil {
+				return KubernetesCredentialsClientListResponse{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return KubernetesCredentialsClientListResponse{}, runtime.NewResponseError(resp)
+			}
+			return client.listHandleResponse(resp)
+		},
+	})
+}
+
+// listCreateRequest creates the List request.
+func (client *KubernetesCredentialsClient) listCreateRequest(ctx context.Context, planeName string, options *KubernetesCredentialsClientListOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// listHandleResponse handles the List response.
+func (client *KubernetesCredentialsClient) listHandleResponse(resp *http.Response) (KubernetesCredentialsClientListResponse, error) {
+	result := KubernetesCredentialsClientListResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.KubernetesCredentialResourceListResult); err != nil {
+		return KubernetesCredentialsClientListResponse{}, err
+	}
+	return result, nil
+}
+
+// Rotate - Rotate the secret of a Kubernetes credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Kubernetes credential name.
+//   - body - The content of the action request
+//   - options - KubernetesCredentialsClientRotateOptions contains the optional parameters for the KubernetesCredentialsClient.Rotate method.
+func (client *KubernetesCredentialsClient) Rotate(ctx context.Context, planeName string, credentialName string, body KubernetesCredentialResource, options *KubernetesCredentialsClientRotateOptions) (KubernetesCredentialsClientRotateResponse, error) {
+	var err error
+	req, err := client.rotateCreateRequest(ctx, planeName, credentialName, body, options)
+	if err != nil {
+		return KubernetesCredentialsClientRotateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return KubernetesCredentialsClientRotateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return KubernetesCredentialsClientRotateResponse{}, err
+	}
+	resp, err := client.rotateHandleResponse(httpResp)
+	return resp, err
+}
+
+// rotateCreateRequest creates the Rotate request.
+func (client *KubernetesCredentialsClient) rotateCreateRequest(ctx context.Context, planeName string, credentialName string, body KubernetesCredentialResource, options *KubernetesCredentialsClientRotateOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials/{credentialName}/rotate"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// rotateHandleResponse handles the Rotate response.
+func (client *KubernetesCredentialsClient) rotateHandleResponse(resp *http.Response) (KubernetesCredentialsClientRotateResponse, error) {
+	result := KubernetesCredentialsClientRotateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.KubernetesCredentialResource); err != nil {
+		return KubernetesCredentialsClientRotateResponse{}, err
+	}
+	return result, nil
+}
+
+// Update - Update a Kubernetes credential
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The name of the plane
+//   - credentialName - The Kubernetes credential name.
+//   - properties - The resource properties to be updated.
+//   - options - KubernetesCredentialsClientUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.Update method.
+func (client *KubernetesCredentialsClient) Update(ctx context.Context, planeName string, credentialName string, properties KubernetesCredentialResourceTagsUpdate, options *KubernetesCredentialsClientUpdateOptions) (KubernetesCredentialsClientUpdateResponse, error) {
+	var err error
+	req, err := client.updateCreateRequest(ctx, planeName, credentialName, properties, options)
+	if err != nil {
+		return KubernetesCredentialsClientUpdateResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return KubernetesCredentialsClientUpdateResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return KubernetesCredentialsClientUpdateResponse{}, err
+	}
+	resp, err := client.updateHandleResponse(httpResp)
+	return resp, err
+}
+
+// updateCreateRequest creates the Update request.
+func (client *KubernetesCredentialsClient) updateCreateRequest(ctx context.Context, planeName string, credentialName string, properties KubernetesCredentialResourceTagsUpdate, options *KubernetesCredentialsClientUpdateOptions) (*policy.Request, error) {
+	urlPath := "/planes/kubernetes/{planeName}/providers/System.Kubernetes/credentials/{credentialName}"
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", planeName)
+	if credentialName == "" {
+		return nil, errors.New("parameter credentialName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{credentialName}", url.PathEscape(credentialName))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, properties); err != nil {
+	return nil, err
+}
+	return req, nil
+}
+
+// updateHandleResponse handles the Update response.
+func (client *KubernetesCredentialsClient) updateHandleResponse(resp *http.Response) (KubernetesCredentialsClientUpdateResponse, error) {
+	result := KubernetesCredentialsClientUpdateResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.KubernetesCredentialResource); err != nil {
+		return KubernetesCredentialsClientUpdateResponse{}, err
+	}
+	return result, nil
+}
+
@@ -23,6 +23,12 @@ type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; The storage properties
 	Storage CredentialStoragePropertiesClassification
 
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -31,6 +37,45 @@ type AwsAccessKeyCredentialProperties struct {
 func (a *AwsAccessKeyCredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
 	return &AwsCredentialProperties{
 		Kind: a.Kind,
+		ExpiresAt: a.ExpiresAt,
+		LastRotatedAt: a.LastRotatedAt,
+		ProvisioningState: a.ProvisioningState,
+	}
+}
+
+// AwsAssumeRoleCredentialProperties - AWS STS AssumeRole credential properties
+type AwsAssumeRoleCredentialProperties struct {
+	// REQUIRED; Access key ID of the base AWS identity which assumes the role
+	AccessKeyID *string
+
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; ARN of the AWS IAM role to assume
+	RoleARN *string
+
+	// REQUIRED; Secret Access Key of the base AWS identity which assumes the role
+	SecretAccessKey *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsAssumeRoleCredentialProperties.
+func (a *AwsAssumeRoleCredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		Kind: a.Kind,
+		ExpiresAt: a.ExpiresAt,
+		LastRotatedAt: a.LastRotatedAt,
 		ProvisioningState: a.ProvisioningState,
 	}
 }
@@ -40,6 +85,12 @@ type AwsCredentialProperties struct {
 	// REQUIRED; The AWS credential kind
 	Kind *AWSCredentialKind
 
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -87,11 +138,48 @@ type AwsCredentialResourceTagsUpdate struct {
 	Tags map[string]*string
 }
 
+// AwsIRSACredentialProperties - AWS IAM Roles for Service Accounts (IRSA) credential properties
+type AwsIRSACredentialProperties struct {
+	// REQUIRED; The AWS credential kind
+	Kind *AWSCredentialKind
+
+	// REQUIRED; ARN of the AWS IAM role assumed with the web identity token of the service account
+	RoleARN *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetAwsCredentialProperties implements the AwsCredentialPropertiesClassification interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) GetAwsCredentialProperties() *AwsCredentialProperties {
+	return &AwsCredentialProperties{
+		Kind: a.Kind,
+		ExpiresAt: a.ExpiresAt,
+		LastRotatedAt: a.LastRotatedAt,
+		ProvisioningState: a.ProvisioningState,
+	}
+}
+
 // AzureCredentialProperties - The base properties of Azure Credential
 type AzureCredentialProperties struct {
 	// REQUIRED; The kind of Azure credential
 	Kind *AzureCredentialKind
 
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -155,6 +243,12 @@ type AzureServicePrincipalProperties struct {
 	// REQUIRED; tenantId for ServicePrincipal
 	TenantID *string
 
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -163,6 +257,42 @@ type AzureServicePrincipalProperties struct {
 func (a *AzureServicePrincipalProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
 	return &AzureCredentialProperties{
 		Kind: a.Kind,
+		ExpiresAt: a.ExpiresAt,
+		LastRotatedAt: a.LastRotatedAt,
+		ProvisioningState: a.ProvisioningState,
+	}
+}
+
+// AzureWorkloadIdentityProperties - The properties of Workload Identity credential storage
+type AzureWorkloadIdentityProperties struct {
+	// REQUIRED; clientId for WorkloadIdentity
+	ClientID *string
+
+	// REQUIRED; The kind of Azure credential
+	Kind *AzureCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// REQUIRED; tenantId for WorkloadIdentity
+	TenantID *string
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetAzureCredentialProperties implements the AzureCredentialPropertiesClassification interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) GetAzureCredentialProperties() *AzureCredentialProperties {
+	return &AzureCredentialProperties{
+		Kind: a.Kind,
+		ExpiresAt: a.ExpiresAt,
+		LastRotatedAt: a.LastRotatedAt,
 		ProvisioningState: a.ProvisioningState,
 	}
 }
@@ -272,6 +402,129 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// KubernetesCredentialProperties - The base properties of Kubernetes Credential
+type KubernetesCredentialProperties struct {
+	// REQUIRED; The kind of Kubernetes credential
+	Kind *KubernetesCredentialKind
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetKubernetesCredentialProperties implements the KubernetesCredentialPropertiesClassification interface for type KubernetesCredentialProperties.
+func (k *KubernetesCredentialProperties) GetKubernetesCredentialProperties() *KubernetesCredentialProperties { return k }
+
+// KubernetesCredentialResource - Represents Kubernetes Credential Resource
+type KubernetesCredentialResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties KubernetesCredentialPropertiesClassification
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// KubernetesCredentialResourceListResult - The response of a KubernetesCredentialResource list operation.
+type KubernetesCredentialResourceListResult struct {
+	// REQUIRED; The KubernetesCredentialResource items on this page
+	Value []*KubernetesCredentialResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// KubernetesCredentialResourceTagsUpdate - The type used for updating tags in KubernetesCredentialResource resources.
+type KubernetesCredentialResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// KubernetesKubeconfigCredentialProperties - The properties of Kubeconfig credential storage
+type KubernetesKubeconfigCredentialProperties struct {
+	// REQUIRED; The kind of Kubernetes credential
+	Kind *KubernetesCredentialKind
+
+	// REQUIRED; The kubeconfig used to authenticate with the cluster. The server of the kubeconfig is overridden by the URL of
+// the plane. Exec plugins, auth providers and references to files are not supported.
+	Kubeconfig *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetKubernetesCredentialProperties implements the KubernetesCredentialPropertiesClassification interface for type KubernetesKubeconfigCredentialProperties.
+func (k *KubernetesKubeconfigCredentialProperties) GetKubernetesCredentialProperties() *KubernetesCredentialProperties {
+	return &KubernetesCredentialProperties{
+		Kind: k.Kind,
+		ExpiresAt: k.ExpiresAt,
+		LastRotatedAt: k.LastRotatedAt,
+		ProvisioningState: k.ProvisioningState,
+	}
+}
+
+// KubernetesServiceAccountTokenCredentialProperties - The properties of Service Account Token credential storage
+type KubernetesServiceAccountTokenCredentialProperties struct {
+	// REQUIRED; The kind of Kubernetes credential
+	Kind *KubernetesCredentialKind
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// REQUIRED; The bearer token of the service account
+	Token *string
+
+	// The base64 encoded PEM certificate authority data used to verify the API server of the cluster
+	CertificateAuthorityData *string
+
+	// The time when the credential expires. Warnings are emitted as the expiry approaches.
+	ExpiresAt *time.Time
+
+	// READ-ONLY; The time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetKubernetesCredentialProperties implements the KubernetesCredentialPropertiesClassification interface for type KubernetesServiceAccountTokenCredentialProperties.
+func (k *KubernetesServiceAccountTokenCredentialProperties) GetKubernetesCredentialProperties() *KubernetesCredentialProperties {
+	return &KubernetesCredentialProperties{
+		Kind: k.Kind,
+		ExpiresAt: k.ExpiresAt,
+		LastRotatedAt: k.LastRotatedAt,
+		ProvisioningState: k.ProvisioningState,
+	}
+}
+
 // PlaneResource - The plane resource
 type PlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
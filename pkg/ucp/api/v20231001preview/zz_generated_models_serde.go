@@ -18,7 +18,9 @@ import (
 func (a AwsAccessKeyCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "accessKeyId", a.AccessKeyID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = AWSCredentialKindAccessKey
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	populate(objectMap, "secretAccessKey", a.SecretAccessKey)
 	populate(objectMap, "storage", a.Storage)
@@ -37,12 +39,73 @@ func (a *AwsAccessKeyCredentialProperties) UnmarshalJSON(data []byte) error {
 		case "accessKeyId":
 				err = unpopulate(val, "AccessKeyID", &a.AccessKeyID)
 			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "secretAccessKey":
+				err = unpopulate(val, "SecretAccessKey", &a.SecretAccessKey)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AwsAssumeRoleCredentialProperties.
+func (a AwsAssumeRoleCredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "accessKeyId", a.AccessKeyID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AWSCredentialKindAssumeRole
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "roleARN", a.RoleARN)
+	populate(objectMap, "secretAccessKey", a.SecretAccessKey)
+	populate(objectMap, "storage", a.Storage)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AwsAssumeRoleCredentialProperties.
+func (a *AwsAssumeRoleCredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "accessKeyId":
+				err = unpopulate(val, "AccessKeyID", &a.AccessKeyID)
+			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
+		case "roleARN":
+				err = unpopulate(val, "RoleARN", &a.RoleARN)
+			delete(rawMsg, key)
 		case "secretAccessKey":
 				err = unpopulate(val, "SecretAccessKey", &a.SecretAccessKey)
 			delete(rawMsg, key)
@@ -60,7 +123,9 @@ func (a *AwsAccessKeyCredentialProperties) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type AwsCredentialProperties.
 func (a AwsCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = a.Kind
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	return json.Marshal(objectMap)
 }
@@ -74,9 +139,15 @@ func (a *AwsCredentialProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
@@ -197,10 +268,59 @@ func (a *AwsCredentialResourceTagsUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type AwsIRSACredentialProperties.
+func (a AwsIRSACredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AWSCredentialKindIRSA
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "roleARN", a.RoleARN)
+	populate(objectMap, "storage", a.Storage)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AwsIRSACredentialProperties.
+func (a *AwsIRSACredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "roleARN":
+				err = unpopulate(val, "RoleARN", &a.RoleARN)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type AzureCredentialProperties.
 func (a AzureCredentialProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = a.Kind
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	return json.Marshal(objectMap)
 }
@@ -214,9 +334,15 @@ func (a *AzureCredentialProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
@@ -342,7 +468,9 @@ func (a AzureServicePrincipalProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "clientId", a.ClientID)
 	populate(objectMap, "clientSecret", a.ClientSecret)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
 	objectMap["kind"] = AzureCredentialKindServicePrincipal
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	populate(objectMap, "storage", a.Storage)
 	populate(objectMap, "tenantId", a.TenantID)
@@ -364,9 +492,66 @@ func (a *AzureServicePrincipalProperties) UnmarshalJSON(data []byte) error {
 		case "clientSecret":
 				err = unpopulate(val, "ClientSecret", &a.ClientSecret)
 			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
 		case "kind":
 				err = unpopulate(val, "Kind", &a.Kind)
 			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
+			delete(rawMsg, key)
+		case "storage":
+			a.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "tenantId":
+				err = unpopulate(val, "TenantID", &a.TenantID)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type AzureWorkloadIdentityProperties.
+func (a AzureWorkloadIdentityProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "clientId", a.ClientID)
+	populateTimeRFC3339(objectMap, "expiresAt", a.ExpiresAt)
+	objectMap["kind"] = AzureCredentialKindWorkloadIdentity
+	populateTimeRFC3339(objectMap, "lastRotatedAt", a.LastRotatedAt)
+	populate(objectMap, "provisioningState", a.ProvisioningState)
+	populate(objectMap, "storage", a.Storage)
+	populate(objectMap, "tenantId", a.TenantID)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type AzureWorkloadIdentityProperties.
+func (a *AzureWorkloadIdentityProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "clientId":
+				err = unpopulate(val, "ClientID", &a.ClientID)
+			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &a.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &a.LastRotatedAt)
+			delete(rawMsg, key)
 		case "provisioningState":
 				err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
@@ -660,6 +845,252 @@ func (i *InternalCredentialStorageProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type KubernetesCredentialProperties.
+func (k KubernetesCredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", k.ExpiresAt)
+	objectMap["kind"] = k.Kind
+	populateTimeRFC3339(objectMap, "lastRotatedAt", k.LastRotatedAt)
+	populate(objectMap, "provisioningState", k.ProvisioningState)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCredentialProperties.
+func (k *KubernetesCredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &k.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &k.Kind)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &k.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &k.ProvisioningState)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesCredentialResource.
+func (k KubernetesCredentialResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "id", k.ID)
+	populate(objectMap, "location", k.Location)
+	populate(objectMap, "name", k.Name)
+	populate(objectMap, "properties", k.Properties)
+	populate(objectMap, "systemData", k.SystemData)
+	populate(objectMap, "tags", k.Tags)
+	populate(objectMap, "type", k.Type)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCredentialResource.
+func (k *KubernetesCredentialResource) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "id":
+				err = unpopulate(val, "ID", &k.ID)
+			delete(rawMsg, key)
+		case "location":
+				err = unpopulate(val, "Location", &k.Location)
+			delete(rawMsg, key)
+		case "name":
+				err = unpopulate(val, "Name", &k.Name)
+			delete(rawMsg, key)
+		case "properties":
+			k.Properties, err = unmarshalKubernetesCredentialPropertiesClassification(val)
+			delete(rawMsg, key)
+		case "systemData":
+				err = unpopulate(val, "SystemData", &k.SystemData)
+			delete(rawMsg, key)
+		case "tags":
+				err = unpopulate(val, "Tags", &k.Tags)
+			delete(rawMsg, key)
+		case "type":
+				err = unpopulate(val, "Type", &k.Type)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesCredentialResourceListResult.
+func (k KubernetesCredentialResourceListResult) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "nextLink", k.NextLink)
+	populate(objectMap, "value", k.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCredentialResourceListResult.
+func (k *KubernetesCredentialResourceListResult) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "nextLink":
+				err = unpopulate(val, "NextLink", &k.NextLink)
+			delete(rawMsg, key)
+		case "value":
+				err = unpopulate(val, "Value", &k.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesCredentialResourceTagsUpdate.
+func (k KubernetesCredentialResourceTagsUpdate) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "tags", k.Tags)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesCredentialResourceTagsUpdate.
+func (k *KubernetesCredentialResourceTagsUpdate) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "tags":
+				err = unpopulate(val, "Tags", &k.Tags)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesKubeconfigCredentialProperties.
+func (k KubernetesKubeconfigCredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateTimeRFC3339(objectMap, "expiresAt", k.ExpiresAt)
+	objectMap["kind"] = KubernetesCredentialKindKubeconfig
+	populate(objectMap, "kubeconfig", k.Kubeconfig)
+	populateTimeRFC3339(objectMap, "lastRotatedAt", k.LastRotatedAt)
+	populate(objectMap, "provisioningState", k.ProvisioningState)
+	populate(objectMap, "storage", k.Storage)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesKubeconfigCredentialProperties.
+func (k *KubernetesKubeconfigCredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &k.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &k.Kind)
+			delete(rawMsg, key)
+		case "kubeconfig":
+				err = unpopulate(val, "Kubeconfig", &k.Kubeconfig)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &k.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &k.ProvisioningState)
+			delete(rawMsg, key)
+		case "storage":
+			k.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type KubernetesServiceAccountTokenCredentialProperties.
+func (k KubernetesServiceAccountTokenCredentialProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "certificateAuthorityData", k.CertificateAuthorityData)
+	populateTimeRFC3339(objectMap, "expiresAt", k.ExpiresAt)
+	objectMap["kind"] = KubernetesCredentialKindServiceAccountToken
+	populateTimeRFC3339(objectMap, "lastRotatedAt", k.LastRotatedAt)
+	populate(objectMap, "provisioningState", k.ProvisioningState)
+	populate(objectMap, "storage", k.Storage)
+	populate(objectMap, "token", k.Token)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type KubernetesServiceAccountTokenCredentialProperties.
+func (k *KubernetesServiceAccountTokenCredentialProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", k, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "certificateAuthorityData":
+				err = unpopulate(val, "CertificateAuthorityData", &k.CertificateAuthorityData)
+			delete(rawMsg, key)
+		case "expiresAt":
+				err = unpopulateTimeRFC3339(val, "ExpiresAt", &k.ExpiresAt)
+			delete(rawMsg, key)
+		case "kind":
+				err = unpopulate(val, "Kind", &k.Kind)
+			delete(rawMsg, key)
+		case "lastRotatedAt":
+				err = unpopulateTimeRFC3339(val, "LastRotatedAt", &k.LastRotatedAt)
+			delete(rawMsg, key)
+		case "provisioningState":
+				err = unpopulate(val, "ProvisioningState", &k.ProvisioningState)
+			delete(rawMsg, key)
+		case "storage":
+			k.Storage, err = unmarshalCredentialStoragePropertiesClassification(val)
+			delete(rawMsg, key)
+		case "token":
+				err = unpopulate(val, "Token", &k.Token)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", k, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type PlaneResource.
 func (p PlaneResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -28,6 +28,11 @@ type AwsCredentialsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AwsCredentialsClientRotateOptions contains the optional parameters for the AwsCredentialsClient.Rotate method.
+type AwsCredentialsClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // AwsCredentialsClientUpdateOptions contains the optional parameters for the AwsCredentialsClient.Update method.
 type AwsCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
@@ -54,11 +59,47 @@ type AzureCredentialsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AzureCredentialsClientRotateOptions contains the optional parameters for the AzureCredentialsClient.Rotate method.
+type AzureCredentialsClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // AzureCredentialsClientUpdateOptions contains the optional parameters for the AzureCredentialsClient.Update method.
 type AzureCredentialsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// KubernetesCredentialsClientCreateOrUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.CreateOrUpdate
+// method.
+type KubernetesCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientDeleteOptions contains the optional parameters for the KubernetesCredentialsClient.Delete method.
+type KubernetesCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientGetOptions contains the optional parameters for the KubernetesCredentialsClient.Get method.
+type KubernetesCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientListOptions contains the optional parameters for the KubernetesCredentialsClient.NewListPager method.
+type KubernetesCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientRotateOptions contains the optional parameters for the KubernetesCredentialsClient.Rotate method.
+type KubernetesCredentialsClientRotateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// KubernetesCredentialsClientUpdateOptions contains the optional parameters for the KubernetesCredentialsClient.Update method.
+type KubernetesCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the PlanesClient.BeginCreateOrUpdate method.
 type PlanesClientBeginCreateOrUpdateOptions struct {
 	// Resumes the LRO from the provided token.
@@ -30,6 +30,12 @@ type AwsCredentialsClientListResponse struct {
 	AwsCredentialResourceListResult
 }
 
+// AwsCredentialsClientRotateResponse contains the response from method AwsCredentialsClient.Rotate.
+type AwsCredentialsClientRotateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	AwsCredentialResource
+}
+
 // AwsCredentialsClientUpdateResponse contains the response from method AwsCredentialsClient.Update.
 type AwsCredentialsClientUpdateResponse struct {
 	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -59,12 +65,53 @@ type AzureCredentialsClientListResponse struct {
 	AzureCredentialResourceListResult
 }
 
+// AzureCredentialsClientRotateResponse contains the response from method AzureCredentialsClient.Rotate.
+type AzureCredentialsClientRotateResponse struct {
+	// Represents Azure Credential Resource
+	AzureCredentialResource
+}
+
 // AzureCredentialsClientUpdateResponse contains the response from method AzureCredentialsClient.Update.
 type AzureCredentialsClientUpdateResponse struct {
 	// Represents Azure Credential Resource
 	AzureCredentialResource
 }
 
+// KubernetesCredentialsClientCreateOrUpdateResponse contains the response from method KubernetesCredentialsClient.CreateOrUpdate.
+type KubernetesCredentialsClientCreateOrUpdateResponse struct {
+	// Represents Kubernetes Credential Resource
+	KubernetesCredentialResource
+}
+
+// KubernetesCredentialsClientDeleteResponse contains the response from method KubernetesCredentialsClient.Delete.
+type KubernetesCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// KubernetesCredentialsClientGetResponse contains the response from method KubernetesCredentialsClient.Get.
+type KubernetesCredentialsClientGetResponse struct {
+	// Represents Kubernetes Credential Resource
+	KubernetesCredentialResource
+}
+
+// KubernetesCredentialsClientListResponse contains the response from method KubernetesCredentialsClient.NewListPager.
+type KubernetesCredentialsClientListResponse struct {
+	// The response of a KubernetesCredentialResource list operation.
+	KubernetesCredentialResourceListResult
+}
+
+// KubernetesCredentialsClientRotateResponse contains the response from method KubernetesCredentialsClient.Rotate.
+type KubernetesCredentialsClientRotateResponse struct {
+	// Represents Kubernetes Credential Resource
+	KubernetesCredentialResource
+}
+
+// KubernetesCredentialsClientUpdateResponse contains the response from method KubernetesCredentialsClient.Update.
+type KubernetesCredentialsClientUpdateResponse struct {
+	// Represents Kubernetes Credential Resource
+	KubernetesCredentialResource
+}
+
 // PlanesClientCreateOrUpdateResponse contains the response from method PlanesClient.BeginCreateOrUpdate.
 type PlanesClientCreateOrUpdateResponse struct {
 	// The plane resource
@@ -16,6 +16,12 @@ limitations under the License.
 
 package aws
 
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
 // Clients contains the AWS clients.
 type Clients struct {
 	// CloudControl is the AWS Cloud Control client.
@@ -23,4 +29,18 @@ type Clients struct {
 
 	// CloudFormation is the AWS Cloud Formation client.
 	CloudFormation AWSCloudFormationClient
+
+	// Credentials resolves the credentials of the UCP credentials selected by the requests. The clients use the
+	// credentials of their configuration when it is nil, for example when UCP credentials are not used.
+	Credentials CredentialResolver
+
+	// Authorizer checks that the requests may use the UCP credentials they select. Requests selecting a credential other
+	// than the default credential are rejected when it is nil.
+	Authorizer sdk_cred.CredentialAuthorizer
+}
+
+// CredentialResolver resolves the AWS credentials of the named UCP credentials.
+type CredentialResolver interface {
+	// Resolve returns the AWS credentials provider of the named UCP credential.
+	Resolve(name string) aws.CredentialsProvider
 }
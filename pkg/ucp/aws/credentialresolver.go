@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
+var _ CredentialResolver = (*UCPCredentialResolver)(nil)
+
+// UCPCredentialResolver resolves the AWS credentials of the named UCP credentials. The credentials provider of each UCP
+// credential is cached so that the retrieved credentials are reused until they expire.
+type UCPCredentialResolver struct {
+	provider       sdk_cred.CredentialProvider[sdk_cred.AWSCredential]
+	expireDuration time.Duration
+
+	mu        sync.Mutex
+	providers map[string]aws.CredentialsProvider
+}
+
+// NewUCPCredentialResolver creates a new UCPCredentialResolver fetching the UCP credentials with the given provider.
+func NewUCPCredentialResolver(provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], expireDuration time.Duration) *UCPCredentialResolver {
+	return &UCPCredentialResolver{
+		provider:       provider,
+		expireDuration: expireDuration,
+		providers:      map[string]aws.CredentialsProvider{},
+	}
+}
+
+// Resolve returns the AWS credentials provider of the named UCP credential. The default credential is used if name is
+// empty.
+func (r *UCPCredentialResolver) Resolve(name string) aws.CredentialsProvider {
+	name = sdk_cred.CredentialNameOrDefault(name)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if p, ok := r.providers[name]; ok {
+		return p
+	}
+
+	p := aws.NewCredentialsCache(NewNamedUCPCredentialProvider(r.provider, name, r.expireDuration))
+	r.providers[name] = p
+	return p
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUCPCredentialResolver(t *testing.T) {
+	mp := newMockProvider()
+	r := NewUCPCredentialResolver(mp, DefaultExpireDuration)
+
+	account2 := r.Resolve("account2")
+	require.Same(t, account2, r.Resolve("account2"))
+	require.NotSame(t, account2, r.Resolve(""))
+	require.Same(t, r.Resolve("default"), r.Resolve(""))
+
+	cred, err := account2.Retrieve(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "fakeid", cred.AccessKeyID)
+	require.Equal(t, "account2", mp.fetchedName)
+}
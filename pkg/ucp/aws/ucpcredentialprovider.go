@@ -20,9 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -33,12 +39,39 @@ var _ aws.CredentialsProvider = (*UCPCredentialProvider)(nil)
 const (
 	// DefaultExpireDuration is the default access key expiry duration.
 	DefaultExpireDuration = time.Minute * time.Duration(15)
+
+	// webIdentityTokenFileEnv is the environment variable set by the EKS pod identity webhook with the path of the
+	// projected service account token.
+	webIdentityTokenFileEnv = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	// regionEnv is the environment variable selecting the region of the AWS clients.
+	regionEnv = "AWS_REGION"
 )
 
+// defaultSTSRegions are the regions of the STS endpoints used for the credentials of each AWS partition when AWS_REGION
+// is not set to a region of the partition.
+var defaultSTSRegions = map[string]string{
+	"aws":        "us-east-1",
+	"aws-cn":     "cn-north-1",
+	"aws-us-gov": "us-gov-west-1",
+	"aws-iso":    "us-iso-east-1",
+	"aws-iso-b":  "us-isob-east-1",
+}
+
+// stsAPIClient is the subset of the STS client used to assume the role of AssumeRole and IRSA credentials and to
+// validate credentials.
+type stsAPIClient interface {
+	stscreds.AssumeRoleAPIClient
+	stscreds.AssumeRoleWithWebIdentityAPIClient
+	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
 // UCPCredentialProvider is the implementation of aws.CredentialsProvider
 // to retrieve credentials for AWS SDK via UCP credentials.
 type UCPCredentialProvider struct {
 	options UCPCredentialOptions
+
+	// newSTSClient creates the STS client of the region used to assume roles with the base credentials.
+	newSTSClient func(region string, base aws.CredentialsProvider) stsAPIClient
 }
 
 // UCPCredentialOptions is a configuration for UCPCredentialProvider.
@@ -46,33 +79,114 @@ type UCPCredentialOptions struct {
 	// Provider is an UCP credential provider.
 	Provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential]
 
+	// Name is the name of the UCP credential.
+	Name string
+
 	// Duration is the duration for the secret keys.
 	Duration time.Duration
 }
 
-// NewUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key using UCP credential APIs.
+// NewUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key of the default credential
+// using UCP credential APIs.
 func NewUCPCredentialProvider(provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], expireDuration time.Duration) *UCPCredentialProvider {
+	return NewNamedUCPCredentialProvider(provider, sdk_cred.DefaultCredentialName, expireDuration)
+}
+
+// NewNamedUCPCredentialProvider creates UCPCredentialProvider provider to fetch Secret Access key of the named credential
+// using UCP credential APIs. The default credential is used if name is empty.
+func NewNamedUCPCredentialProvider(provider sdk_cred.CredentialProvider[sdk_cred.AWSCredential], name string, expireDuration time.Duration) *UCPCredentialProvider {
 	if expireDuration == 0 {
 		expireDuration = DefaultExpireDuration
 	}
 
 	o := UCPCredentialOptions{
 		Provider: provider,
+		Name:     sdk_cred.CredentialNameOrDefault(name),
 		Duration: expireDuration,
 	}
 
-	return &UCPCredentialProvider{options: o}
+	return &UCPCredentialProvider{options: o, newSTSClient: newSTSClient}
+}
+
+func newSTSClient(region string, base aws.CredentialsProvider) stsAPIClient {
+	return sts.New(sts.Options{Region: region, Credentials: base})
+}
+
+// stsRegion returns the region of the STS endpoint for the credential. The partition of the credential is the partition
+// of its role, so that the roles of GovCloud and China accounts are assumed with the STS endpoints of their partition.
+// AWS_REGION is used if it is set to a region of that partition. Access key credentials have no role, and use AWS_REGION
+// or the public partition.
+func stsRegion(s *sdk_cred.AWSCredential) string {
+	region := os.Getenv(regionEnv)
+
+	role, err := arn.Parse(s.RoleARN)
+	if err != nil {
+		if region != "" {
+			return region
+		}
+		return defaultSTSRegions["aws"]
+	}
+
+	if region != "" && regionPartition(region) == role.Partition {
+		return region
+	}
+	if partitionRegion, ok := defaultSTSRegions[role.Partition]; ok {
+		return partitionRegion
+	}
+	if region != "" {
+		return region
+	}
+	return defaultSTSRegions["aws"]
+}
+
+// regionPartition returns the partition of the given AWS region.
+func regionPartition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	default:
+		return "aws"
+	}
 }
 
 // Retrieve fetches credentials from an external provider, checks if they are valid, logs the AccessKeyID, and returns the
-// credentials with an expiration time set. If the credentials are invalid, an error is returned.
+// credentials with an expiration time set. AssumeRole and IRSA credentials are exchanged for the temporary credentials of
+// the role with STS. If the credentials are invalid, an error is returned.
 func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
-	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AWSPublic, "default")
+	s, err := c.options.Provider.Fetch(ctx, sdk_cred.AWSPublic, c.options.Name)
 	if err != nil {
 		return aws.Credentials{}, err
 	}
 
+	switch {
+	case s.IsIRSA():
+		tokenFile := os.Getenv(webIdentityTokenFileEnv)
+		if s.RoleARN == "" || tokenFile == "" {
+			return aws.Credentials{}, errors.New("invalid IRSA credential info")
+		}
+
+		logger.Info(fmt.Sprintf("Retreived AWS IRSA Credential - RoleARN: %s", s.RoleARN))
+		provider := stscreds.NewWebIdentityRoleProvider(c.newSTSClient(stsRegion(s), nil), s.RoleARN, stscreds.IdentityTokenFile(tokenFile))
+		return c.retrieveRole(ctx, provider)
+
+	case s.IsAssumeRole():
+		if s.AccessKeyID == "" || s.SecretAccessKey == "" || s.RoleARN == "" {
+			return aws.Credentials{}, errors.New("invalid assume role credential info")
+		}
+
+		logger.Info(fmt.Sprintf("Retreived AWS AssumeRole Credential - AccessKeyID: %s, RoleARN: %s", s.AccessKeyID, s.RoleARN))
+		base := credentials.NewStaticCredentialsProvider(s.AccessKeyID, s.SecretAccessKey, "")
+		provider := stscreds.NewAssumeRoleProvider(c.newSTSClient(stsRegion(s), base), s.RoleARN)
+		return c.retrieveRole(ctx, provider)
+	}
+
 	if s.AccessKeyID == "" || s.SecretAccessKey == "" {
 		return aws.Credentials{}, errors.New("invalid access key info")
 	}
@@ -90,3 +204,26 @@ func (c *UCPCredentialProvider) Retrieve(ctx context.Context) (aws.Credentials,
 
 	return value, nil
 }
+
+// retrieveRole assumes the role with the STS credentials provider and returns the temporary credentials of the role. The
+// credentials expire no later than the configured duration so that updates of the UCP credential are picked up.
+func (c *UCPCredentialProvider) retrieveRole(ctx context.Context, provider aws.CredentialsProvider) (aws.Credentials, error) {
+	role, err := provider.Retrieve(ctx)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("failed to assume the role of the AWS credential: %w", err)
+	}
+
+	expires := time.Now().UTC().Add(c.options.Duration)
+	if role.CanExpire && role.Expires.Before(expires) {
+		expires = role.Expires
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     role.AccessKeyID,
+		SecretAccessKey: role.SecretAccessKey,
+		SessionToken:    role.SessionToken,
+		Source:          "radiusucp",
+		CanExpire:       true,
+		Expires:         expires,
+	}, nil
+}
@@ -19,21 +19,29 @@ package aws
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/stretchr/testify/require"
 
 	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 type mockProvider struct {
 	fakeCredential *sdk_cred.AWSCredential
+	fetchedName    string
 }
 
 // Fetch gets the AWS credentials from secret storage. It takes in a context, planeName and name and returns
 // an AWSCredential or an error if the fakeCredential is nil.
 func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.AWSCredential, error) {
+	p.fetchedName = name
 	if p.fakeCredential == nil {
 		return nil, errors.New("failed to fetch credential")
 	}
@@ -49,9 +57,70 @@ func newMockProvider() *mockProvider {
 	}
 }
 
+type mockSTSClient struct {
+	region           string
+	base             aws.CredentialsProvider
+	assumeRole       *sts.AssumeRoleInput
+	assumeRoleWithWI *sts.AssumeRoleWithWebIdentityInput
+	expires          time.Time
+
+	callerIdentityErr error
+	callerIdentity    aws.CredentialsProvider
+}
+
+func (c *mockSTSClient) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	c.assumeRole = params
+	return &sts.AssumeRoleOutput{Credentials: c.credentials()}, nil
+}
+
+func (c *mockSTSClient) AssumeRoleWithWebIdentity(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	c.assumeRoleWithWI = params
+	return &sts.AssumeRoleWithWebIdentityOutput{Credentials: c.credentials()}, nil
+}
+
+func (c *mockSTSClient) GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	c.callerIdentity = c.base
+	if c.callerIdentityErr != nil {
+		return nil, c.callerIdentityErr
+	}
+	return &sts.GetCallerIdentityOutput{Account: aws.String("000000000000")}, nil
+}
+
+func (c *mockSTSClient) credentials() *types.Credentials {
+	return &types.Credentials{
+		AccessKeyId:     aws.String("roleid"),
+		SecretAccessKey: aws.String("rolesecretkey"),
+		SessionToken:    aws.String("rolesessiontoken"),
+		Expiration:      aws.Time(c.expires),
+	}
+}
+
+func newTestUCPCredentialProvider(p *mockProvider, stsClient *mockSTSClient) *UCPCredentialProvider {
+	cp := NewUCPCredentialProvider(p, DefaultExpireDuration)
+	cp.newSTSClient = func(region string, base aws.CredentialsProvider) stsAPIClient {
+		stsClient.region = region
+		stsClient.base = base
+		return stsClient
+	}
+	return cp
+}
+
 func TestNewUCPCredentialProvider(t *testing.T) {
 	p := NewUCPCredentialProvider(newMockProvider(), 0)
 	require.Equal(t, DefaultExpireDuration, p.options.Duration)
+	require.Equal(t, "default", p.options.Name)
+}
+
+func TestNewNamedUCPCredentialProvider(t *testing.T) {
+	mp := newMockProvider()
+	p := NewNamedUCPCredentialProvider(mp, "account2", DefaultExpireDuration)
+
+	_, err := p.Retrieve(context.TODO())
+	require.NoError(t, err)
+	require.Equal(t, "account2", mp.fetchedName)
+
+	p = NewNamedUCPCredentialProvider(mp, "", DefaultExpireDuration)
+	require.Equal(t, "default", p.options.Name)
 }
 
 func TestRetrieve(t *testing.T) {
@@ -80,3 +149,167 @@ func TestRetrieve(t *testing.T) {
 		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
 	})
 }
+
+func TestRetrieve_AssumeRole(t *testing.T) {
+	t.Run("invalid credential", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:            datamodel.AWSAssumeRoleCredentialKind,
+				AccessKeyID:     "fakeid",
+				SecretAccessKey: "fakesecretkey",
+			},
+		}
+		cp := newTestUCPCredentialProvider(p, &mockSTSClient{})
+
+		_, err := cp.Retrieve(context.TODO())
+		require.Error(t, err)
+	})
+
+	t.Run("valid credential", func(t *testing.T) {
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:            datamodel.AWSAssumeRoleCredentialKind,
+				AccessKeyID:     "fakeid",
+				SecretAccessKey: "fakesecretkey",
+				RoleARN:         "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		stsClient := &mockSTSClient{expires: time.Now().UTC().Add(time.Minute)}
+		cp := newTestUCPCredentialProvider(p, stsClient)
+
+		cred, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+
+		require.Equal(t, "arn:aws:iam::000000000000:role/radius", aws.ToString(stsClient.assumeRole.RoleArn))
+		base, err := stsClient.base.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "fakeid", base.AccessKeyID)
+		require.Equal(t, "fakesecretkey", base.SecretAccessKey)
+
+		require.Equal(t, "roleid", cred.AccessKeyID)
+		require.Equal(t, "rolesecretkey", cred.SecretAccessKey)
+		require.Equal(t, "rolesessiontoken", cred.SessionToken)
+		require.Equal(t, "radiusucp", cred.Source)
+		require.True(t, cred.CanExpire)
+		// The credentials expire with the role session when it expires before the configured duration.
+		require.Equal(t, stsClient.expires.Unix(), cred.Expires.Unix())
+	})
+
+	t.Run("GovCloud credential", func(t *testing.T) {
+		t.Setenv(regionEnv, "")
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:            datamodel.AWSAssumeRoleCredentialKind,
+				AccessKeyID:     "fakeid",
+				SecretAccessKey: "fakesecretkey",
+				RoleARN:         "arn:aws-us-gov:iam::000000000000:role/radius",
+			},
+		}
+		stsClient := &mockSTSClient{expires: time.Now().UTC().Add(time.Minute)}
+		cp := newTestUCPCredentialProvider(p, stsClient)
+
+		_, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "us-gov-west-1", stsClient.region)
+	})
+}
+
+func TestRetrieve_IRSA(t *testing.T) {
+	t.Run("missing token file", func(t *testing.T) {
+		t.Setenv(webIdentityTokenFileEnv, "")
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		cp := newTestUCPCredentialProvider(p, &mockSTSClient{})
+
+		_, err := cp.Retrieve(context.TODO())
+		require.Error(t, err)
+	})
+
+	t.Run("valid credential", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("faketoken"), 0600))
+		t.Setenv(webIdentityTokenFileEnv, tokenFile)
+
+		p := &mockProvider{
+			fakeCredential: &sdk_cred.AWSCredential{
+				Kind:    datamodel.AWSIRSACredentialKind,
+				RoleARN: "arn:aws:iam::000000000000:role/radius",
+			},
+		}
+		stsClient := &mockSTSClient{expires: time.Now().UTC().Add(time.Hour)}
+		cp := newTestUCPCredentialProvider(p, stsClient)
+
+		expectedExpiry := time.Now().UTC().Add(DefaultExpireDuration)
+		cred, err := cp.Retrieve(context.TODO())
+		require.NoError(t, err)
+
+		require.Nil(t, stsClient.base)
+		require.Equal(t, "arn:aws:iam::000000000000:role/radius", aws.ToString(stsClient.assumeRoleWithWI.RoleArn))
+		require.Equal(t, "faketoken", aws.ToString(stsClient.assumeRoleWithWI.WebIdentityToken))
+
+		require.Equal(t, "roleid", cred.AccessKeyID)
+		require.Equal(t, "rolesessiontoken", cred.SessionToken)
+		require.True(t, cred.CanExpire)
+		// The credentials expire after the configured duration so that updates of the UCP credential are picked up.
+		require.GreaterOrEqual(t, cred.Expires.Unix(), expectedExpiry.Unix())
+		require.Less(t, cred.Expires.Unix(), stsClient.expires.Unix())
+	})
+}
+
+func TestSTSRegion(t *testing.T) {
+	tests := []struct {
+		desc     string
+		region   string
+		roleARN  string
+		expected string
+	}{
+		{
+			desc:     "access key",
+			expected: "us-east-1",
+		},
+		{
+			desc:     "access key with region",
+			region:   "us-gov-east-1",
+			expected: "us-gov-east-1",
+		},
+		{
+			desc:     "public role",
+			roleARN:  "arn:aws:iam::000000000000:role/test",
+			expected: "us-east-1",
+		},
+		{
+			desc:     "public role with region",
+			region:   "eu-west-1",
+			roleARN:  "arn:aws:iam::000000000000:role/test",
+			expected: "eu-west-1",
+		},
+		{
+			desc:     "GovCloud role",
+			roleARN:  "arn:aws-us-gov:iam::000000000000:role/test",
+			expected: "us-gov-west-1",
+		},
+		{
+			desc:     "GovCloud role with region of the partition",
+			region:   "us-gov-east-1",
+			roleARN:  "arn:aws-us-gov:iam::000000000000:role/test",
+			expected: "us-gov-east-1",
+		},
+		{
+			desc:     "China role with region of another partition",
+			region:   "us-west-2",
+			roleARN:  "arn:aws-cn:iam::000000000000:role/test",
+			expected: "cn-north-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Setenv(regionEnv, tt.region)
+			require.Equal(t, tt.expected, stsRegion(&sdk_cred.AWSCredential{RoleARN: tt.roleARN}))
+		})
+	}
+}
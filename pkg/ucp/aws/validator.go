@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+)
+
+var _ sdk_cred.CredentialValidator[sdk_cred.AWSCredential] = (*UCPCredentialValidator)(nil)
+
+// UCPCredentialValidator validates AWS access key, assume role or IRSA credentials by calling STS GetCallerIdentity
+// with them before they are registered with UCP.
+type UCPCredentialValidator struct {
+	// newSTSClient creates the STS client of the region used to assume roles and to get the caller identity.
+	newSTSClient func(region string, base aws.CredentialsProvider) stsAPIClient
+}
+
+// NewUCPCredentialValidator creates a new UCPCredentialValidator.
+func NewUCPCredentialValidator() *UCPCredentialValidator {
+	return &UCPCredentialValidator{newSTSClient: newSTSClient}
+}
+
+// Validate retrieves the AWS credentials in the same way as UCPCredentialProvider, assuming the role of AssumeRole and
+// IRSA credentials, and returns an error if the credential is incomplete or if STS rejects the retrieved credentials.
+func (v *UCPCredentialValidator) Validate(ctx context.Context, s *sdk_cred.AWSCredential) error {
+	provider := &UCPCredentialProvider{
+		options: UCPCredentialOptions{
+			Provider: &staticCredentialProvider{credential: s},
+			Duration: DefaultExpireDuration,
+		},
+		newSTSClient: v.newSTSClient,
+	}
+
+	value, err := provider.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+
+	stsClient := v.newSTSClient(stsRegion(s), credentials.StaticCredentialsProvider{Value: value})
+	if _, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		return fmt.Errorf("failed to authenticate with AWS using the credential: %w", err)
+	}
+
+	return nil
+}
+
+var _ sdk_cred.CredentialProvider[sdk_cred.AWSCredential] = (*staticCredentialProvider)(nil)
+
+// staticCredentialProvider is the UCP credential provider of the credential being validated, which is not stored yet.
+type staticCredentialProvider struct {
+	credential *sdk_cred.AWSCredential
+}
+
+// Fetch returns the credential being validated.
+func (p *staticCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.AWSCredential, error) {
+	return p.credential, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/require"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+func newTestUCPCredentialValidator(stsClient *mockSTSClient) *UCPCredentialValidator {
+	v := NewUCPCredentialValidator()
+	v.newSTSClient = func(region string, base aws.CredentialsProvider) stsAPIClient {
+		stsClient.region = region
+		stsClient.base = base
+		return stsClient
+	}
+	return v
+}
+
+func TestUCPCredentialValidator_Validate(t *testing.T) {
+	t.Run("valid access key", func(t *testing.T) {
+		stsClient := &mockSTSClient{}
+		err := newTestUCPCredentialValidator(stsClient).Validate(context.TODO(), &sdk_cred.AWSCredential{
+			AccessKeyID:     "fakeid",
+			SecretAccessKey: "fakesecretkey",
+		})
+		require.NoError(t, err)
+
+		value, err := stsClient.callerIdentity.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "fakeid", value.AccessKeyID)
+		require.Equal(t, "fakesecretkey", value.SecretAccessKey)
+	})
+
+	t.Run("valid assume role", func(t *testing.T) {
+		stsClient := &mockSTSClient{expires: time.Now().UTC().Add(time.Minute)}
+		err := newTestUCPCredentialValidator(stsClient).Validate(context.TODO(), &sdk_cred.AWSCredential{
+			Kind:            datamodel.AWSAssumeRoleCredentialKind,
+			AccessKeyID:     "fakeid",
+			SecretAccessKey: "fakesecretkey",
+			RoleARN:         "arn:aws:iam::000000000000:role/radius",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "arn:aws:iam::000000000000:role/radius", aws.ToString(stsClient.assumeRole.RoleArn))
+
+		value, err := stsClient.callerIdentity.Retrieve(context.TODO())
+		require.NoError(t, err)
+		require.Equal(t, "roleid", value.AccessKeyID)
+		require.Equal(t, "rolesessiontoken", value.SessionToken)
+	})
+
+	t.Run("incomplete credential", func(t *testing.T) {
+		stsClient := &mockSTSClient{}
+		err := newTestUCPCredentialValidator(stsClient).Validate(context.TODO(), &sdk_cred.AWSCredential{
+			AccessKeyID: "fakeid",
+		})
+		require.EqualError(t, err, "invalid access key info")
+		require.Nil(t, stsClient.callerIdentity)
+	})
+
+	t.Run("rejected credential", func(t *testing.T) {
+		stsClient := &mockSTSClient{callerIdentityErr: errors.New("InvalidClientTokenId")}
+		err := newTestUCPCredentialValidator(stsClient).Validate(context.TODO(), &sdk_cred.AWSCredential{
+			AccessKeyID:     "fakeid",
+			SecretAccessKey: "fakesecretkey",
+		})
+		require.ErrorContains(t, err, "InvalidClientTokenId")
+	})
+}
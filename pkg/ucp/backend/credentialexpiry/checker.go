@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialexpiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ResourceTypes is the list of the credential resource types whose expiry is checked.
+var ResourceTypes = []string{
+	v20231001preview.AzureCredentialType,
+	v20231001preview.AWSCredentialType,
+	v20231001preview.KubernetesCredentialType,
+}
+
+// credentialResource is the part of a credential resource which is common to all the credential resource types.
+type credentialResource struct {
+	v1.BaseResource
+
+	Properties struct {
+		Kind          string     `json:"kind,omitempty"`
+		ExpiresAt     *time.Time `json:"expiresAt,omitempty"`
+		LastRotatedAt *time.Time `json:"lastRotatedAt,omitempty"`
+	} `json:"properties"`
+}
+
+// Checker checks the credentials registered with UCP for expiry and emits warnings and metrics for the credentials
+// which have expired or expire within the warning threshold.
+type Checker struct {
+	storageProvider  dataprovider.DataStorageProvider
+	resourceTypes    []string
+	warningThreshold time.Duration
+	now              func() time.Time
+}
+
+// NewChecker creates a new Checker for the credentials of the given resource types.
+func NewChecker(storageProvider dataprovider.DataStorageProvider, resourceTypes []string, warningThreshold time.Duration) *Checker {
+	return &Checker{
+		storageProvider:  storageProvider,
+		resourceTypes:    resourceTypes,
+		warningThreshold: warningThreshold,
+		now:              time.Now,
+	}
+}
+
+// Check checks all the credentials for expiry. Credentials which do not record an expiry are skipped. A failure to
+// check a credential is logged and does not prevent checking the others.
+func (c *Checker) Check(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	for _, resourceType := range c.resourceTypes {
+		sc, err := c.storageProvider.GetStorageClient(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+
+		query := store.Query{
+			RootScope:      "/planes",
+			ScopeRecursive: true,
+			ResourceType:   resourceType,
+		}
+
+		err = store.QueryEach(ctx, sc, query, func(obj *store.Object) error {
+			if err := c.checkCredential(ctx, resourceType, obj); err != nil {
+				logger.Error(err, "failed to check credential expiry", ucplog.LogFieldResourceID, obj.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to query %s resources: %w", resourceType, err)
+		}
+	}
+
+	return nil
+}
+
+// checkCredential emits a warning and records a metric if the credential has expired or expires within the warning
+// threshold.
+func (c *Checker) checkCredential(ctx context.Context, resourceType string, obj *store.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	credential := &credentialResource{}
+	if err := obj.As(credential); err != nil {
+		return err
+	}
+
+	state := c.expiryState(credential)
+	if state == "" {
+		return nil
+	}
+
+	lastRotatedAt := ""
+	if credential.Properties.LastRotatedAt != nil {
+		lastRotatedAt = credential.Properties.LastRotatedAt.UTC().Format(time.RFC3339)
+	}
+
+	expiresAt := credential.Properties.ExpiresAt.UTC().Format(time.RFC3339)
+	message := fmt.Sprintf("Warning: the credential expires at %s and should be rotated.", expiresAt)
+	if state == metrics.CredentialExpiryStateExpired {
+		message = fmt.Sprintf("Warning: the credential expired at %s and must be rotated.", expiresAt)
+	}
+
+	logger.Info(message, ucplog.LogFieldResourceID, obj.ID, "kind", credential.Properties.Kind, "lastRotatedAt", lastRotatedAt)
+	metrics.DefaultCredentialMetrics.RecordCredentialExpiryWarning(ctx, metrics.NewCredentialAttributes(resourceType, state))
+
+	return nil
+}
+
+// expiryState returns the expiry state of the credential, or an empty string if the credential does not record an
+// expiry or does not expire within the warning threshold.
+func (c *Checker) expiryState(credential *credentialResource) string {
+	if credential.Properties.ExpiresAt == nil {
+		return ""
+	}
+
+	remaining := credential.Properties.ExpiresAt.Sub(c.now())
+	switch {
+	case remaining <= 0:
+		return metrics.CredentialExpiryStateExpired
+	case remaining <= c.warningThreshold:
+		return metrics.CredentialExpiryStateExpiring
+	default:
+		return ""
+	}
+}
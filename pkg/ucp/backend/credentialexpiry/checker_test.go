@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/radius-project/radius/pkg/metrics"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	resourceType = v20231001preview.AzureCredentialType
+	resourceID   = "/planes/azure/azurecloud/providers/System.Azure/credentials/default"
+)
+
+var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func setup(t *testing.T) (*Checker, *store.MockStorageClient) {
+	mctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(mctrl)
+
+	storageProvider := dataprovider.NewMockDataStorageProvider(mctrl)
+	storageProvider.EXPECT().GetStorageClient(gomock.Any(), resourceType).Return(storageClient, nil).AnyTimes()
+
+	checker := NewChecker(storageProvider, []string{resourceType}, 24*time.Hour)
+	checker.now = func() time.Time { return now }
+	return checker, storageClient
+}
+
+func newCredential(expiresAt *time.Time) store.Object {
+	properties := map[string]any{"kind": "ServicePrincipal"}
+	if expiresAt != nil {
+		properties["expiresAt"] = expiresAt.Format(time.RFC3339)
+	}
+
+	return store.Object{
+		Metadata: store.Metadata{ID: resourceID},
+		Data: map[string]any{
+			"id":         resourceID,
+			"type":       resourceType,
+			"properties": properties,
+		},
+	}
+}
+
+func Test_Check(t *testing.T) {
+	expired := now.Add(-time.Hour)
+	expiring := now.Add(time.Hour)
+	valid := now.Add(48 * time.Hour)
+
+	t.Run("credentials are checked", func(t *testing.T) {
+		checker, storageClient := setup(t)
+		storageClient.EXPECT().
+			Query(gomock.Any(), store.Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: resourceType}, gomock.Any()).
+			Return(&store.ObjectQueryResult{Items: []store.Object{newCredential(nil), newCredential(&expired), newCredential(&expiring), newCredential(&valid)}}, nil)
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("all pages are checked", func(t *testing.T) {
+		checker, storageClient := setup(t)
+		gomock.InOrder(
+			storageClient.EXPECT().
+				Query(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.ObjectQueryResult{Items: []store.Object{newCredential(&expired)}, PaginationToken: "next"}, nil),
+			storageClient.EXPECT().
+				Query(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&store.ObjectQueryResult{Items: []store.Object{newCredential(&expiring)}}, nil),
+		)
+
+		err := checker.Check(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		checker, storageClient := setup(t)
+		storageClient.EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("query failed"))
+
+		err := checker.Check(context.Background())
+		require.ErrorContains(t, err, "failed to query System.Azure/credentials resources: query failed")
+	})
+}
+
+func Test_expiryState(t *testing.T) {
+	checker, _ := setup(t)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		expected  string
+	}{
+		{name: "no expiry", expiresAt: nil, expected: ""},
+		{name: "expired", expiresAt: to.Ptr(now.Add(-time.Hour)), expected: metrics.CredentialExpiryStateExpired},
+		{name: "expires now", expiresAt: to.Ptr(now), expected: metrics.CredentialExpiryStateExpired},
+		{name: "expiring", expiresAt: to.Ptr(now.Add(time.Hour)), expected: metrics.CredentialExpiryStateExpiring},
+		{name: "valid", expiresAt: to.Ptr(now.Add(48 * time.Hour)), expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := newCredential(tt.expiresAt)
+			credential := &credentialResource{}
+			require.NoError(t, obj.As(credential))
+			require.Equal(t, tt.expected, checker.expiryState(credential))
+		})
+	}
+}
+
+func Test_parseDuration(t *testing.T) {
+	d, err := parseDuration("", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, d)
+
+	d, err = parseDuration("30m", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Minute, d)
+
+	_, err = parseDuration("invalid", time.Hour)
+	require.Error(t, err)
+
+	_, err = parseDuration("-1h", time.Hour)
+	require.EqualError(t, err, "the duration must be positive")
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialexpiry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// defaultInterval is the default interval between two expiry checks.
+	defaultInterval = time.Hour
+
+	// defaultWarningThreshold is the default remaining lifetime under which warnings are emitted for a credential.
+	defaultWarningThreshold = 7 * 24 * time.Hour
+)
+
+// ServiceOptions represents the options of the credential expiry service.
+type ServiceOptions struct {
+	// StorageProviderOptions is the options of the storage provider of the credentials.
+	StorageProviderOptions dataprovider.StorageProviderOptions
+
+	// Config is the credential configuration of UCP.
+	Config hostoptions.CredentialOptions
+}
+
+// Service is a service to periodically check the credentials registered with UCP for expiry.
+type Service struct {
+	options ServiceOptions
+}
+
+// NewService creates a new credential expiry service.
+func NewService(options ServiceOptions) *Service {
+	return &Service{
+		options: options,
+	}
+}
+
+// Name represents the service name.
+func (s *Service) Name() string {
+	return "credentialexpiry"
+}
+
+// Run checks the credentials for expiry at the configured interval until the context is cancelled.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	interval, err := parseDuration(s.options.Config.ExpiryCheckInterval, defaultInterval)
+	if err != nil {
+		return fmt.Errorf("invalid credential expiry check interval %q: %w", s.options.Config.ExpiryCheckInterval, err)
+	}
+
+	threshold, err := parseDuration(s.options.Config.ExpiryWarningThreshold, defaultWarningThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid credential expiry warning threshold %q: %w", s.options.Config.ExpiryWarningThreshold, err)
+	}
+
+	storageProvider := dataprovider.NewStorageProvider(s.options.StorageProviderOptions)
+	checker := NewChecker(storageProvider, ResourceTypes, threshold)
+
+	logger.Info(fmt.Sprintf("Checking credential expiry every %s", interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Credential expiry check stopped...")
+			return nil
+		case <-ticker.C:
+			if err := checker.Check(ctx); err != nil {
+				logger.Error(err, "failed to check credential expiry")
+			}
+		}
+	}
+}
+
+// parseDuration parses the given duration, which must be positive. The default value is returned if value is empty.
+func parseDuration(value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("the duration must be positive")
+	}
+
+	return d, nil
+}
@@ -68,6 +68,11 @@ func (w *Service) Run(ctx context.Context) error {
 		if w.Options.Config.WorkerServer.MaxOperationRetryCount != nil {
 			workerOpts.MaxOperationRetryCount = *w.Options.Config.WorkerServer.MaxOperationRetryCount
 		}
+		if w.Options.Config.WorkerServer.MaxPendingOperations != nil {
+			workerOpts.MaxPendingOperations = *w.Options.Config.WorkerServer.MaxPendingOperations
+		}
+		workerOpts.PriorityWeights = w.Options.Config.WorkerServer.PriorityWeights
+		workerOpts.OperationConcurrencyLimits = w.Options.Config.WorkerServer.OperationConcurrencyLimits
 	}
 
 	opts := ctrl.Options{
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+// EnvironmentResourceType is the resource type of the environments, whose providers select the credentials of the AWS
+// and Azure planes.
+const EnvironmentResourceType = "Applications.Core/environments"
+
+// CredentialAuthorizer checks that a request proxied by UCP may use the credential of the plane it selects.
+type CredentialAuthorizer interface {
+	// Authorize returns ErrCredentialNotAllowed if the request for the resource id may not use the named credential of
+	// the planes of the given type, for example "aws" or "azure".
+	Authorize(ctx context.Context, planeType string, name string, id resources.ID) error
+}
+
+// CredentialAuthorizerFunc is an adapter to allow the use of an ordinary function as a CredentialAuthorizer.
+type CredentialAuthorizerFunc func(ctx context.Context, planeType string, name string, id resources.ID) error
+
+// Authorize calls f(ctx, planeType, name, id).
+func (f CredentialAuthorizerFunc) Authorize(ctx context.Context, planeType string, name string, id resources.ID) error {
+	return f(ctx, planeType, name, id)
+}
+
+// ErrCredentialNotAllowed is the error returned when a request selects a credential which it may not use.
+type ErrCredentialNotAllowed struct {
+	// Name is the name of the credential.
+	Name string
+
+	// ID is the resource id of the request.
+	ID string
+}
+
+// Error returns the error message.
+func (e *ErrCredentialNotAllowed) Error() string {
+	return fmt.Sprintf("credential %q is not selected by the providers of an environment for %q", e.Name, e.ID)
+}
+
+// Is checks if the target error is an ErrCredentialNotAllowed.
+func (e *ErrCredentialNotAllowed) Is(target error) bool {
+	_, ok := target.(*ErrCredentialNotAllowed)
+	return ok
+}
+
+var _ CredentialAuthorizer = (*EnvironmentCredentialAuthorizer)(nil)
+
+// environmentResource is the part of an environment resource which selects the credentials of the planes.
+type environmentResource struct {
+	Properties struct {
+		Providers map[string]struct {
+			Scope      string `json:"scope,omitempty"`
+			Credential string `json:"credential,omitempty"`
+		} `json:"providers,omitempty"`
+	} `json:"properties"`
+}
+
+// EnvironmentCredentialAuthorizer authorizes the use of the credentials of the planes with the providers of the
+// environments. A request may use a credential other than the default credential only if an environment selects it for
+// the subscription or the account of the resource. The subscription or the account is compared rather than the whole
+// scope of the environment because the operations of long-running requests are at the subscription scope. The
+// environments deploy to the Kubernetes cluster they target with the default credential of its plane, so requests to a
+// Kubernetes plane may only use the default credential.
+type EnvironmentCredentialAuthorizer struct {
+	storageProvider dataprovider.DataStorageProvider
+}
+
+// NewEnvironmentCredentialAuthorizer creates a new EnvironmentCredentialAuthorizer reading the environments from the
+// given storage provider, which must share the storage of the Applications.Core resource provider.
+func NewEnvironmentCredentialAuthorizer(storageProvider dataprovider.DataStorageProvider) *EnvironmentCredentialAuthorizer {
+	return &EnvironmentCredentialAuthorizer{storageProvider: storageProvider}
+}
+
+// Authorize returns ErrCredentialNotAllowed unless name is the default credential or an environment selects the named
+// credential for the subscription or the account of the resource id. Only the default credential of a Kubernetes plane
+// is allowed.
+func (a *EnvironmentCredentialAuthorizer) Authorize(ctx context.Context, planeType string, name string, id resources.ID) error {
+	if CredentialNameOrDefault(name) == DefaultCredentialName {
+		return nil
+	}
+
+	account := accountOf(id)
+	if account == "" || strings.EqualFold(planeType, resources_kubernetes.PlaneTypeKubernetes) {
+		return &ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	}
+
+	sc, err := a.storageProvider.GetStorageClient(ctx, EnvironmentResourceType)
+	if err != nil {
+		return err
+	}
+
+	planeType = strings.ToLower(planeType)
+	query := store.Query{
+		RootScope:      "/planes",
+		ScopeRecursive: true,
+		ResourceType:   EnvironmentResourceType,
+		Conditions: []store.FilterCondition{
+			{Field: "properties.providers." + planeType + ".credential", Operator: store.FilterOperatorEqual, Values: []string{name}},
+		},
+	}
+
+	allowed := false
+	err = store.QueryEach(ctx, sc, query, func(obj *store.Object) error {
+		environment := &environmentResource{}
+		if err := obj.As(environment); err != nil {
+			return err
+		}
+
+		provider := environment.Properties.Providers[planeType]
+		scope, err := resources.ParseScope(provider.Scope)
+		if err != nil || provider.Credential != name {
+			return nil
+		}
+
+		if accountOf(scope) == account {
+			allowed = true
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query the environments selecting credential %q: %w", name, err)
+	}
+
+	if !allowed {
+		return &ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	}
+	return nil
+}
+
+// accountOf returns the Azure subscription or the AWS account of the resource id, or an empty string if it has none.
+func accountOf(id resources.ID) string {
+	for _, segment := range id.ScopeSegments() {
+		if strings.EqualFold(segment.Type, "subscriptions") || strings.EqualFold(segment.Type, "accounts") {
+			return strings.ToLower(segment.Type + "/" + segment.Name)
+		}
+	}
+	return ""
+}
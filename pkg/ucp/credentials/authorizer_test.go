@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+)
+
+func newTestEnvironment(name string, providers map[string]any) store.Object {
+	id := "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/" + name
+	return store.Object{
+		Metadata: store.Metadata{ID: id},
+		Data: map[string]any{
+			"id":   id,
+			"name": name,
+			"properties": map[string]any{
+				"providers": providers,
+			},
+		},
+	}
+}
+
+func TestEnvironmentCredentialAuthorizer(t *testing.T) {
+	environments := []store.Object{
+		newTestEnvironment("aws-env", map[string]any{
+			"aws": map[string]any{"scope": "/planes/aws/aws/accounts/1111/regions/us-west-2", "credential": "account2"},
+		}),
+		newTestEnvironment("azure-env", map[string]any{
+			"azure": map[string]any{"scope": "/subscriptions/0000/resourceGroups/rg", "credential": "subscription2"},
+		}),
+	}
+
+	setup := func(t *testing.T) *EnvironmentCredentialAuthorizer {
+		client := store.NewMockStorageClient(gomock.NewController(t))
+		client.EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+				require.Equal(t, EnvironmentResourceType, query.ResourceType)
+				require.Len(t, query.Conditions, 1)
+				require.Equal(t, store.FilterOperatorEqual, query.Conditions[0].Operator)
+				return &store.ObjectQueryResult{Items: environments}, nil
+			}).
+			AnyTimes()
+
+		provider := dataprovider.NewMockDataStorageProvider(gomock.NewController(t))
+		provider.EXPECT().GetStorageClient(gomock.Any(), EnvironmentResourceType).Return(client, nil).AnyTimes()
+		return NewEnvironmentCredentialAuthorizer(provider)
+	}
+
+	tests := []struct {
+		name      string
+		planeType string
+		credName  string
+		id        string
+		allowed   bool
+	}{
+		{
+			name:      "aws credential of the account of the environment",
+			planeType: "aws",
+			credName:  "account2",
+			id:        "/planes/aws/aws/accounts/1111/regions/us-west-2/providers/AWS.Kinesis/Stream/stream1",
+			allowed:   true,
+		},
+		{
+			name:      "aws credential of another account",
+			planeType: "aws",
+			credName:  "account2",
+			id:        "/planes/aws/aws/accounts/2222/regions/us-west-2/providers/AWS.Kinesis/Stream/stream1",
+		},
+		{
+			name:      "aws credential not selected by an environment",
+			planeType: "aws",
+			credName:  "account3",
+			id:        "/planes/aws/aws/accounts/1111/regions/us-west-2/providers/AWS.Kinesis/Stream/stream1",
+		},
+		{
+			name:      "azure credential of the subscription of the environment",
+			planeType: "azure",
+			credName:  "subscription2",
+			id:        "/planes/azure/azurecloud/subscriptions/0000/providers/Microsoft.Storage/locations/eastus/operations/op1",
+			allowed:   true,
+		},
+		{
+			name:      "azure credential of another subscription",
+			planeType: "azure",
+			credName:  "subscription2",
+			id:        "/planes/azure/azurecloud/subscriptions/9999/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+		},
+		{
+			name:      "credential selected for another plane type",
+			planeType: "azure",
+			credName:  "account2",
+			id:        "/planes/azure/azurecloud/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+		},
+		{
+			name:      "kubernetes credential",
+			planeType: "kubernetes",
+			credName:  "deployer",
+			id:        "/planes/kubernetes/prod",
+		},
+		{
+			name:      "default kubernetes credential",
+			planeType: "kubernetes",
+			credName:  DefaultCredentialName,
+			id:        "/planes/kubernetes/prod",
+			allowed:   true,
+		},
+		{
+			name:      "default credential",
+			planeType: "azure",
+			credName:  DefaultCredentialName,
+			id:        "/planes/azure/azurecloud/subscriptions/9999/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa",
+			allowed:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setup(t).Authorize(context.Background(), tt.planeType, tt.credName, resources.MustParse(tt.id))
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, &ErrCredentialNotAllowed{})
+				require.Equal(t, &ErrCredentialNotAllowed{Name: tt.credName, ID: tt.id}, err)
+			}
+		})
+	}
+
+	t.Run("query failure", func(t *testing.T) {
+		client := store.NewMockStorageClient(gomock.NewController(t))
+		client.EXPECT().Query(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("query failed"))
+		provider := dataprovider.NewMockDataStorageProvider(gomock.NewController(t))
+		provider.EXPECT().GetStorageClient(gomock.Any(), EnvironmentResourceType).Return(client, nil)
+
+		err := NewEnvironmentCredentialAuthorizer(provider).Authorize(context.Background(), "aws", "account2", resources.MustParse("/planes/aws/aws/accounts/1111/regions/us-west-2/providers/AWS.Kinesis/Stream/stream1"))
+		require.EqualError(t, err, "failed to query the environments selecting credential \"account2\": query failed")
+		require.NotErrorIs(t, err, &ErrCredentialNotAllowed{})
+	})
+}
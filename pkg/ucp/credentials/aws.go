@@ -51,10 +51,10 @@ func NewAWSCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.Con
 	}, nil
 }
 
-// Fetch fetches the AWS IAM access keys from UCP and then from an internal storage (e.g.
+// Fetch fetches the AWS IAM access key, assume role or IRSA credentials from UCP and then from an internal storage (e.g.
 // Kubernetes secret store). It returns an AWSCredential struct or an error if the fetch fails.
 func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*AWSCredential, error) {
-	// 1. Fetch the secret name of AWS IAM access keys from UCP.
+	// 1. Fetch the secret name of AWS credentials from UCP.
 	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.AwsCredentialsClientGetOptions{})
 	if err != nil {
 		return nil, err
@@ -71,6 +71,20 @@ func (p *AWSCredentialProvider) Fetch(ctx context.Context, planeName, name strin
 		default:
 			return nil, errors.New("invalid AWSAccessKeyCredentialProperties")
 		}
+	case *ucpapi.AwsAssumeRoleCredentialProperties:
+		switch c := p.Storage.(type) {
+		case *ucpapi.InternalCredentialStorageProperties:
+			storage = c
+		default:
+			return nil, errors.New("invalid AWSAssumeRoleCredentialProperties")
+		}
+	case *ucpapi.AwsIRSACredentialProperties:
+		switch c := p.Storage.(type) {
+		case *ucpapi.InternalCredentialStorageProperties:
+			storage = c
+		default:
+			return nil, errors.New("invalid AWSIRSACredentialProperties")
+		}
 	default:
 		return nil, errors.New("invalid InternalCredentialStorageProperties")
 	}
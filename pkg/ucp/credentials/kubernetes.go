@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	ucpapi "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/secret/provider"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var _ CredentialProvider[KubernetesCredential] = (*KubernetesCredentialProvider)(nil)
+
+// KubernetesCredentialProvider is UCP credential provider for Kubernetes.
+type KubernetesCredentialProvider struct {
+	secretProvider *provider.SecretProvider
+	client         *ucpapi.KubernetesCredentialsClient
+}
+
+// NewKubernetesCredentialProvider creates a new KubernetesCredentialProvider by creating a new KubernetesCredentialClient with the given
+// credential and connection, and returns an error if one occurs.
+func NewKubernetesCredentialProvider(provider *provider.SecretProvider, ucpConn sdk.Connection, credential azcore.TokenCredential) (*KubernetesCredentialProvider, error) {
+	cli, err := ucpapi.NewKubernetesCredentialsClient(credential, sdk.NewClientOptions(ucpConn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesCredentialProvider{
+		secretProvider: provider,
+		client:         cli,
+	}, nil
+}
+
+// Fetch fetches the Kubernetes service account token or kubeconfig credentials from UCP and the internal storage (e.g.
+// Kubernetes secret store) and returns a KubernetesCredential struct. If an error occurs, an error is returned.
+func (p *KubernetesCredentialProvider) Fetch(ctx context.Context, planeName, name string) (*KubernetesCredential, error) {
+	// 1. Fetch the secret name of Kubernetes credentials from UCP.
+	cred, err := p.client.Get(ctx, planeName, name, &ucpapi.KubernetesCredentialsClientGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// We support only kubernetes secret, but we may support multiple secret stores.
+	var storage *ucpapi.InternalCredentialStorageProperties
+
+	switch p := cred.Properties.(type) {
+	case *ucpapi.KubernetesServiceAccountTokenCredentialProperties:
+		switch c := p.Storage.(type) {
+		case *ucpapi.InternalCredentialStorageProperties:
+			storage = c
+		default:
+			return nil, errors.New("invalid KubernetesServiceAccountTokenCredentialProperties")
+		}
+	case *ucpapi.KubernetesKubeconfigCredentialProperties:
+		switch c := p.Storage.(type) {
+		case *ucpapi.InternalCredentialStorageProperties:
+			storage = c
+		default:
+			return nil, errors.New("invalid KubernetesKubeconfigCredentialProperties")
+		}
+	default:
+		return nil, errors.New("invalid InternalCredentialStorageProperties")
+	}
+
+	secretName := to.String(storage.SecretName)
+	if secretName == "" {
+		return nil, errors.New("unspecified SecretName for internal storage")
+	}
+
+	// 2. Fetch the credential from internal storage (e.g. Kubernetes secret store)
+	secretClient, err := p.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := secret.GetSecret[KubernetesCredential](ctx, secretClient, secretName)
+	if err != nil {
+		return nil, errors.New("failed to get credential info: " + err.Error())
+	}
+
+	return &s, nil
+}
+
+// ValidateKubeconfig validates that a kubeconfig credential is self-contained. Kubeconfigs that run commands (exec
+// plugins), use auth provider plugins or reference files are rejected because they would be evaluated inside Radius.
+func ValidateKubeconfig(kubeconfig string) error {
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	for name, user := range config.AuthInfos {
+		if user.Exec != nil {
+			return fmt.Errorf("user %q of the kubeconfig uses an exec plugin, which is not supported", name)
+		}
+		if user.AuthProvider != nil {
+			return fmt.Errorf("user %q of the kubeconfig uses an auth provider, which is not supported", name)
+		}
+		if user.TokenFile != "" || user.ClientCertificate != "" || user.ClientKey != "" {
+			return fmt.Errorf("user %q of the kubeconfig references files, use tokens or embedded certificate data instead", name)
+		}
+	}
+
+	for name, cluster := range config.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %q of the kubeconfig references files, use embedded certificate authority data instead", name)
+		}
+	}
+
+	return nil
+}
+
+// NewKubernetesRESTConfig creates the configuration of a client of the API server at host which authenticates with the
+// given credential. The server of a kubeconfig credential is replaced with host so that the URL registered for the
+// plane is always used. Kubeconfig credentials must be self-contained, see ValidateKubeconfig.
+func NewKubernetesRESTConfig(host string, credential *KubernetesCredential) (*rest.Config, error) {
+	if credential == nil {
+		return nil, errors.New("kubernetes credential is required")
+	}
+
+	if credential.IsKubeconfig() {
+		if err := ValidateKubeconfig(credential.Kubeconfig); err != nil {
+			return nil, err
+		}
+
+		config, err := clientcmd.RESTConfigFromKubeConfig([]byte(credential.Kubeconfig))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+		}
+		config.Host = host
+
+		// Never run plugins or read files on behalf of a credential, even if it was stored before it was validated.
+		config.ExecProvider = nil
+		config.AuthProvider = nil
+		config.BearerTokenFile = ""
+		config.TLSClientConfig.CAFile = ""
+		config.TLSClientConfig.CertFile = ""
+		config.TLSClientConfig.KeyFile = ""
+		return config, nil
+	}
+
+	config := &rest.Config{
+		Host:        host,
+		BearerToken: credential.Token,
+	}
+
+	if credential.CertificateAuthorityData != "" {
+		caData, err := base64.StdEncoding.DecodeString(credential.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode certificate authority data: %w", err)
+		}
+		config.TLSClientConfig.CAData = caData
+	}
+
+	return config, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://10.0.0.1:6443
+users:
+- name: admin
+  user:
+    token: kubeconfig-token
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+current-context: prod
+`
+
+func TestNewKubernetesRESTConfig(t *testing.T) {
+	t.Run("service account token", func(t *testing.T) {
+		credential := &KubernetesCredential{
+			Kind:                     ucp_dm.KubernetesServiceAccountTokenCredentialKind,
+			Token:                    "service-account-token",
+			CertificateAuthorityData: base64.StdEncoding.EncodeToString([]byte("ca-data")),
+		}
+
+		config, err := NewKubernetesRESTConfig("https://prod.example.com:6443", credential)
+		require.NoError(t, err)
+		require.Equal(t, "https://prod.example.com:6443", config.Host)
+		require.Equal(t, "service-account-token", config.BearerToken)
+		require.Equal(t, []byte("ca-data"), config.TLSClientConfig.CAData)
+	})
+
+	t.Run("kubeconfig overrides the server", func(t *testing.T) {
+		credential := &KubernetesCredential{
+			Kind:       ucp_dm.KubernetesKubeconfigCredentialKind,
+			Kubeconfig: testKubeconfig,
+		}
+
+		config, err := NewKubernetesRESTConfig("https://prod.example.com:6443", credential)
+		require.NoError(t, err)
+		require.Equal(t, "https://prod.example.com:6443", config.Host)
+		require.Equal(t, "kubeconfig-token", config.BearerToken)
+	})
+
+	t.Run("invalid certificate authority data", func(t *testing.T) {
+		credential := &KubernetesCredential{
+			Kind:                     ucp_dm.KubernetesServiceAccountTokenCredentialKind,
+			Token:                    "service-account-token",
+			CertificateAuthorityData: "not-base64!",
+		}
+
+		_, err := NewKubernetesRESTConfig("https://prod.example.com:6443", credential)
+		require.ErrorContains(t, err, "failed to decode certificate authority data")
+	})
+
+	t.Run("invalid kubeconfig", func(t *testing.T) {
+		credential := &KubernetesCredential{
+			Kind:       ucp_dm.KubernetesKubeconfigCredentialKind,
+			Kubeconfig: "not a kubeconfig",
+		}
+
+		_, err := NewKubernetesRESTConfig("https://prod.example.com:6443", credential)
+		require.ErrorContains(t, err, "failed to load kubeconfig")
+	})
+
+	t.Run("missing credential", func(t *testing.T) {
+		_, err := NewKubernetesRESTConfig("https://prod.example.com:6443", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestValidateKubeconfig(t *testing.T) {
+	const header = "apiVersion: v1\nkind: Config\n"
+	tests := []struct {
+		name       string
+		kubeconfig string
+		err        string
+	}{
+		{
+			name:       "self-contained",
+			kubeconfig: testKubeconfig,
+		},
+		{
+			name:       "exec plugin",
+			kubeconfig: header + "users:\n- name: admin\n  user:\n    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh\n",
+			err:        "user \"admin\" of the kubeconfig uses an exec plugin, which is not supported",
+		},
+		{
+			name:       "auth provider",
+			kubeconfig: header + "users:\n- name: admin\n  user:\n    auth-provider:\n      name: gcp\n",
+			err:        "user \"admin\" of the kubeconfig uses an auth provider, which is not supported",
+		},
+		{
+			name:       "token file",
+			kubeconfig: header + "users:\n- name: admin\n  user:\n    tokenFile: /var/run/secrets/token\n",
+			err:        "user \"admin\" of the kubeconfig references files, use tokens or embedded certificate data instead",
+		},
+		{
+			name:       "client key file",
+			kubeconfig: header + "users:\n- name: admin\n  user:\n    client-certificate: /etc/cert.pem\n    client-key: /etc/key.pem\n",
+			err:        "user \"admin\" of the kubeconfig references files, use tokens or embedded certificate data instead",
+		},
+		{
+			name:       "certificate authority file",
+			kubeconfig: header + "clusters:\n- name: prod\n  cluster:\n    server: https://10.0.0.1:6443\n    certificate-authority: /etc/ca.pem\n",
+			err:        "cluster \"prod\" of the kubeconfig references files, use embedded certificate authority data instead",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKubeconfig(tt.kubeconfig)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+
+	t.Run("rest config rejects exec plugins", func(t *testing.T) {
+		credential := &KubernetesCredential{
+			Kind:       ucp_dm.KubernetesKubeconfigCredentialKind,
+			Kubeconfig: tests[1].kubeconfig,
+		}
+
+		_, err := NewKubernetesRESTConfig("https://prod.example.com:6443", credential)
+		require.ErrorContains(t, err, "exec plugin")
+	})
+}
@@ -18,6 +18,10 @@ package credentials
 
 import (
 	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 
 	ucp_dm "github.com/radius-project/radius/pkg/ucp/datamodel"
 )
@@ -28,13 +32,71 @@ const (
 
 	// AWSPublic represents the aws public cloud plane name for UCP.
 	AWSPublic = "aws"
+
+	// DefaultCredentialName is the name of the credential used when no credential is selected, for example by the
+	// providers of an environment.
+	DefaultCredentialName = "default"
+
+	// CredentialNameHeader is the header selecting the credential of the plane which UCP uses to authenticate the
+	// proxied request. The default credential is used when the header is not set. UCP rejects the request if the
+	// credential is not allowed by its CredentialAuthorizer, for example if no environment selects it.
+	CredentialNameHeader = "X-Radius-Credential-Name"
 )
 
+// CredentialNameOrDefault returns the given credential name, or the name of the default credential if it is empty.
+func CredentialNameOrDefault(name string) string {
+	if name == "" {
+		return DefaultCredentialName
+	}
+	return name
+}
+
+// WithCredentialName returns a context which selects the named credential for the requests made through UCP by the
+// Azure SDK clients using it. The context is returned unchanged if the name is empty.
+func WithCredentialName(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return runtime.WithHTTPHeader(ctx, http.Header{CredentialNameHeader: []string{name}})
+}
+
+type planeCredentialNamesKey struct{}
+
+// WithPlaneCredentialName returns a context which selects the named credential for the planes of the given type, for
+// example "aws" or "azure". Clients accessing resources of several planes use PlaneCredentialName to look up the
+// credential of the plane of each resource. The context is returned unchanged if the name is empty.
+func WithPlaneCredentialName(ctx context.Context, planeType string, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+
+	names := map[string]string{}
+	if existing, ok := ctx.Value(planeCredentialNamesKey{}).(map[string]string); ok {
+		for k, v := range existing {
+			names[k] = v
+		}
+	}
+	names[strings.ToLower(planeType)] = name
+	return context.WithValue(ctx, planeCredentialNamesKey{}, names)
+}
+
+// PlaneCredentialName returns the name of the credential selected by the context for the planes of the given type, or
+// an empty string if the context does not select a credential.
+func PlaneCredentialName(ctx context.Context, planeType string) string {
+	names, ok := ctx.Value(planeCredentialNamesKey{}).(map[string]string)
+	if !ok {
+		return ""
+	}
+	return names[strings.ToLower(planeType)]
+}
+
 type (
 	// AzureCredential represents a credential for Azure AD.
 	AzureCredential = ucp_dm.AzureCredentialProperties
 	// AWSCredential represents a credential for AWS IAM.
 	AWSCredential = ucp_dm.AWSCredentialProperties
+	// KubernetesCredential represents a credential for the API server of a Kubernetes cluster.
+	KubernetesCredential = ucp_dm.KubernetesCredentialProperties
 )
 
 // CredentialProvider is an UCP credential provider interface.
@@ -42,3 +104,17 @@ type CredentialProvider[T any] interface {
 	// Fetch gets the credentials from secret storage.
 	Fetch(ctx context.Context, planeName, name string) (*T, error)
 }
+
+// CredentialValidator is an UCP credential validator interface.
+type CredentialValidator[T any] interface {
+	// Validate checks that the credentials can authenticate with the cloud provider.
+	Validate(ctx context.Context, credential *T) error
+}
+
+// CredentialValidatorFunc is an adapter to allow the use of an ordinary function as a CredentialValidator.
+type CredentialValidatorFunc[T any] func(ctx context.Context, credential *T) error
+
+// Validate calls f(ctx, credential).
+func (f CredentialValidatorFunc[T]) Validate(ctx context.Context, credential *T) error {
+	return f(ctx, credential)
+}
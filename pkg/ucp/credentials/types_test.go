@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingTransport struct {
+	header http.Header
+}
+
+func (t *recordingTransport) Do(req *http.Request) (*http.Response, error) {
+	t.header = req.Header
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func TestCredentialNameOrDefault(t *testing.T) {
+	require.Equal(t, DefaultCredentialName, CredentialNameOrDefault(""))
+	require.Equal(t, "account2", CredentialNameOrDefault("account2"))
+}
+
+func TestWithCredentialName(t *testing.T) {
+	tests := []struct {
+		desc     string
+		name     string
+		expected string
+	}{
+		{
+			desc:     "named credential",
+			name:     "account2",
+			expected: "account2",
+		},
+		{
+			desc:     "default credential",
+			name:     "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			transport := &recordingTransport{}
+			pipeline := runtime.NewPipeline("test", "v1", runtime.PipelineOptions{}, &policy.ClientOptions{Transport: transport})
+
+			ctx := WithCredentialName(context.Background(), tt.name)
+			req, err := runtime.NewRequest(ctx, http.MethodGet, "http://localhost/test")
+			require.NoError(t, err)
+
+			_, err = pipeline.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, transport.header.Get(CredentialNameHeader))
+		})
+	}
+}
+
+func TestPlaneCredentialName(t *testing.T) {
+	ctx := context.Background()
+	require.Empty(t, PlaneCredentialName(ctx, "aws"))
+
+	ctx = WithPlaneCredentialName(ctx, "aws", "account2")
+	ctx = WithPlaneCredentialName(ctx, "Azure", "subscription2")
+	ctx = WithPlaneCredentialName(ctx, "kubernetes", "")
+
+	require.Equal(t, "account2", PlaneCredentialName(ctx, "aws"))
+	require.Equal(t, "subscription2", PlaneCredentialName(ctx, "azure"))
+	require.Empty(t, PlaneCredentialName(ctx, "kubernetes"))
+
+	// The selection of the parent context is not changed.
+	parent := WithPlaneCredentialName(context.Background(), "aws", "account2")
+	_ = WithPlaneCredentialName(parent, "aws", "account3")
+	require.Equal(t, "account2", PlaneCredentialName(parent, "aws"))
+}
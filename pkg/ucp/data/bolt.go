@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package data
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// BoltDBFileName is the name of the database file created inside the configured bolt directory.
+	BoltDBFileName = "radius.db"
+
+	boltOpenTimeout = time.Second * 10
+)
+
+var (
+	boltDBs   = map[string]*bolt.DB{}
+	boltDBsMu sync.Mutex
+)
+
+// OpenBoltDB opens (or creates) the bolt database stored in the given directory.
+//
+// bolt holds an exclusive file lock on the database for the lifetime of the process, so the database is
+// opened once per directory and shared by every caller in the process (storage, queue and secret clients).
+// The returned database must not be closed by the caller.
+func OpenBoltDB(dir string) (*bolt.DB, error) {
+	if dir == "" {
+		return nil, errors.New("bolt directory is required")
+	}
+
+	path, err := filepath.Abs(filepath.Join(dir, BoltDBFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	boltDBsMu.Lock()
+	defer boltDBsMu.Unlock()
+
+	if db, ok := boltDBs[path]; ok {
+		return db, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	boltDBs[path] = db
+	return db, nil
+}
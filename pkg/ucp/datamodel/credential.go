@@ -16,15 +16,29 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// InternalStorageKind represents ucp credential storage type for internal credential type
 	InternalStorageKind = "Internal"
-	// AzureCredentialKind represents ucp credential kind for azure credentials.
-	AzureCredentialKind = "ServicePrincipal"
-	// AWSCredentialKind represents ucp credential kind for aws credentials.
-	AWSCredentialKind = "AccessKey"
+	// AzureServicePrincipalCredentialKind represents ucp credential kind for azure service principal credentials.
+	AzureServicePrincipalCredentialKind = "ServicePrincipal"
+	// AzureWorkloadIdentityCredentialKind represents ucp credential kind for azure workload identity credentials.
+	AzureWorkloadIdentityCredentialKind = "WorkloadIdentity"
+	// AWSAccessKeyCredentialKind represents ucp credential kind for aws access key credentials.
+	AWSAccessKeyCredentialKind = "AccessKey"
+	// AWSAssumeRoleCredentialKind represents ucp credential kind for aws credentials assuming a role with a base access key.
+	AWSAssumeRoleCredentialKind = "AssumeRole"
+	// AWSIRSACredentialKind represents ucp credential kind for aws IAM roles for service accounts credentials.
+	AWSIRSACredentialKind = "IRSA"
+	// KubernetesServiceAccountTokenCredentialKind represents ucp credential kind for kubernetes service account token credentials.
+	KubernetesServiceAccountTokenCredentialKind = "ServiceAccountToken"
+	// KubernetesKubeconfigCredentialKind represents ucp credential kind for kubernetes kubeconfig credentials.
+	KubernetesKubeconfigCredentialKind = "Kubeconfig"
 )
 
 // Credential represents UCP Credential.
@@ -51,42 +65,118 @@ func (c *AWSCredential) ResourceTypeName() string {
 	return c.Type
 }
 
+// Credential represents UCP Credential.
+type KubernetesCredential struct {
+	v1.BaseResource
+
+	Properties *KubernetesCredentialResourceProperties `json:"properties,omitempty"`
+}
+
+// ResourceTypeName gives the type of ucp resource.
+func (c *KubernetesCredential) ResourceTypeName() string {
+	return c.Type
+}
+
 // Azure Credential Properties represents UCP Credential Properties.
 type AzureCredentialResourceProperties struct {
 	// Kind is the kind of azure credential resource.
 	Kind string `json:"kind,omitempty"`
-	// AzureCredential is the azure service principal credentials.
+	// AzureCredential is the azure service principal or workload identity credentials.
 	AzureCredential *AzureCredentialProperties `json:"azureCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time when the credential expires. It is not set when the credential does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// LastRotatedAt is the time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time `json:"lastRotatedAt,omitempty"`
 }
 
 // AWS Credential Properties represents UCP Credential Properties.
 type AWSCredentialResourceProperties struct {
 	// Kind is the kind of aws credential resource.
 	Kind string `json:"kind,omitempty"`
-	// AWSCredential is the aws iam credentials.
+	// AWSCredential is the aws iam access key, assume role or IRSA credentials.
 	AWSCredential *AWSCredentialProperties `json:"awsCredential,omitempty"`
 	// Storage contains the properties of the storage associated with the kind.
 	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time when the credential expires. It is not set when the credential does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// LastRotatedAt is the time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time `json:"lastRotatedAt,omitempty"`
+}
+
+// Kubernetes Credential Properties represents UCP Credential Properties.
+type KubernetesCredentialResourceProperties struct {
+	// Kind is the kind of kubernetes credential resource.
+	Kind string `json:"kind,omitempty"`
+	// KubernetesCredential is the kubernetes service account token or kubeconfig credentials.
+	KubernetesCredential *KubernetesCredentialProperties `json:"kubernetesCredential,omitempty"`
+	// Storage contains the properties of the storage associated with the kind.
+	Storage *CredentialStorageProperties `json:"storage,omitempty"`
+	// ExpiresAt is the time when the credential expires. It is not set when the credential does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// LastRotatedAt is the time when the secret of the credential was last registered or rotated.
+	LastRotatedAt *time.Time `json:"lastRotatedAt,omitempty"`
 }
 
 // AzureCredentialProperties contains ucp Azure credential properties.
 type AzureCredentialProperties struct {
-	// TenantID represents the tenantId of azure service principal.
+	// Kind is the kind of azure credential. The credential is a service principal credential when it is empty.
+	Kind string `json:"kind,omitempty"`
+	// TenantID represents the tenantId of azure service principal or workload identity.
 	TenantID string `json:"tenantId"`
-	// ClientID represents the clientId of azure service principal.
+	// ClientID represents the clientId of azure service principal or workload identity.
 	ClientID string `json:"clientId"`
-	// ClientSecret represents the client secret of service principal.
+	// ClientSecret represents the client secret of service principal. It is not set for workload identity.
 	ClientSecret string `json:"clientSecret,omitempty"`
 }
 
+// IsWorkloadIdentity returns true if the credential is a workload identity credential, which uses the federated token
+// projected into the pod instead of a client secret.
+func (c *AzureCredentialProperties) IsWorkloadIdentity() bool {
+	return c.Kind == AzureWorkloadIdentityCredentialKind
+}
+
 // AWSCredentialProperties contains ucp AWS credential properties.
 type AWSCredentialProperties struct {
-	// AccessKeyID contains aws access key for iam.
+	// Kind is the kind of aws credential. The credential is an access key credential when it is empty.
+	Kind string `json:"kind,omitempty"`
+	// AccessKeyID contains aws access key for iam. It is the base identity assuming the role for assume role
+	// credentials and is not set for IRSA.
 	AccessKeyID string `json:"accessKeyId"`
-	// SecretAccessKey contains secret access key for iam.
+	// SecretAccessKey contains secret access key for iam. It is not set for IRSA.
 	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	// RoleARN is the ARN of the iam role to assume. It is only set for assume role and IRSA credentials.
+	RoleARN string `json:"roleARN,omitempty"`
+}
+
+// IsIRSA returns true if the credential is an IRSA credential, which assumes the role with the web identity token
+// projected into the pod instead of an access key.
+func (c *AWSCredentialProperties) IsIRSA() bool {
+	return c.Kind == AWSIRSACredentialKind
+}
+
+// IsAssumeRole returns true if the credential assumes a role using the access key as the base identity.
+func (c *AWSCredentialProperties) IsAssumeRole() bool {
+	return c.Kind == AWSAssumeRoleCredentialKind
+}
+
+// KubernetesCredentialProperties contains ucp Kubernetes credential properties.
+type KubernetesCredentialProperties struct {
+	// Kind is the kind of kubernetes credential.
+	Kind string `json:"kind,omitempty"`
+	// Token is the bearer token of the service account. It is only set for service account token credentials.
+	Token string `json:"token,omitempty"`
+	// CertificateAuthorityData is the base64 encoded PEM certificate authority data used to verify the API server.
+	// It is only set for service account token credentials.
+	CertificateAuthorityData string `json:"certificateAuthorityData,omitempty"`
+	// Kubeconfig is the kubeconfig used to authenticate with the cluster. It is only set for kubeconfig credentials.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+}
+
+// IsKubeconfig returns true if the credential authenticates with a kubeconfig instead of a service account token.
+func (c *KubernetesCredentialProperties) IsKubeconfig() bool {
+	return c.Kind == KubernetesKubeconfigCredentialKind
 }
 
 // CredentialStorageProperties contains ucp credential storage properties.
@@ -18,28 +18,45 @@ package dataprovider
 
 import (
 	context "context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/data"
 	store "github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/store/apiserverstore"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
 	"github.com/radius-project/radius/pkg/ucp/store/cosmosdb"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
+	"github.com/radius-project/radius/pkg/ucp/store/postgresstore"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	// Registers the "postgres" driver with database/sql.
+	_ "github.com/lib/pq"
+
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type storageFactoryFunc func(context.Context, StorageProviderOptions, string) (store.StorageClient, error)
 
 var storageClientFactory = map[StorageProviderType]storageFactoryFunc{
-	TypeAPIServer: initAPIServerClient,
-	TypeCosmosDB:  initCosmosDBClient,
-	TypeETCD:      InitETCDClient,
+	TypeAPIServer:  initAPIServerClient,
+	TypeCosmosDB:   initCosmosDBClient,
+	TypeETCD:       InitETCDClient,
+	TypePostgreSQL: initPostgreSQLClient,
+	TypeBolt:       initBoltClient,
 }
 
+var (
+	// postgresDBs caches the connection pool for each PostgreSQL URL. The storage clients of all resource types share
+	// a pool, which also allows them to commit batches that span resource types.
+	postgresDBs   = map[string]*sql.DB{}
+	postgresDBsMu sync.Mutex
+)
+
 func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
 	if opt.APIServer.Namespace == "" {
 		return nil, errors.New("failed to initialize APIServer client: namespace is required")
@@ -69,7 +86,8 @@ func initAPIServerClient(ctx context.Context, opt StorageProviderOptions, _ stri
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The client must support watch so that the store can implement store.Watcher.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -117,3 +135,48 @@ func InitETCDClient(ctx context.Context, opt StorageProviderOptions, _ string) (
 	etcdClient := etcdstore.NewETCDClient(client)
 	return etcdClient, nil
 }
+
+func initPostgreSQLClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	if opt.PostgreSQL.URL == "" {
+		return nil, errors.New("failed to initialize PostgreSQL client: url is required")
+	}
+
+	postgresDBsMu.Lock()
+	defer postgresDBsMu.Unlock()
+
+	if db, ok := postgresDBs[opt.PostgreSQL.URL]; ok {
+		return postgresstore.NewPostgreSQLClient(db), nil
+	}
+
+	db, err := sql.Open("postgres", opt.PostgreSQL.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client - configuration may be invalid: %w", err)
+	}
+
+	if opt.PostgreSQL.MaxOpenConnections > 0 {
+		db.SetMaxOpenConns(opt.PostgreSQL.MaxOpenConnections)
+	}
+
+	client := postgresstore.NewPostgreSQLClient(db)
+	if err = client.Init(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize PostgreSQL client - schema migration failed: %w", err)
+	}
+
+	postgresDBs[opt.PostgreSQL.URL] = db
+	return client, nil
+}
+
+func initBoltClient(ctx context.Context, opt StorageProviderOptions, _ string) (store.StorageClient, error) {
+	db, err := data.OpenBoltDB(opt.Bolt.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	client, err := boltstore.NewBoltClient(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return client, nil
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
 	azure_frontend "github.com/radius-project/radius/pkg/ucp/frontend/azure"
+	kubernetes_frontend "github.com/radius-project/radius/pkg/ucp/frontend/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	radius_frontend "github.com/radius-project/radius/pkg/ucp/frontend/radius"
 	"github.com/radius-project/radius/pkg/ucp/frontend/versions"
@@ -92,6 +93,7 @@ func DefaultModules(options modules.Options) []modules.Initializer {
 	return []modules.Initializer{
 		aws_frontend.NewModule(options),
 		azure_frontend.NewModule(options),
+		kubernetes_frontend.NewModule(options),
 		radius_frontend.NewModule(options),
 	}
 }
@@ -19,6 +19,7 @@ package aws
 import (
 	"github.com/go-chi/chi/v5"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/validator"
 )
@@ -50,6 +51,10 @@ type Module struct {
 
 	// AWSClients provides access to AWS services. This field can be overridden by tests.
 	AWSClients ucp_aws.Clients
+
+	// CredentialValidator validates the AWS credentials when they are registered or rotated. This field can be
+	// overridden by tests.
+	CredentialValidator sdk_cred.CredentialValidator[sdk_cred.AWSCredential]
 }
 
 // PlaneType returns the type of plane this module is for.
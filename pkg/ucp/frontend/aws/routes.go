@@ -35,6 +35,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	awsproxy_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/awsproxy"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	aws_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/aws"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -188,6 +189,11 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
 	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
 
+	// Credentials are validated against AWS when they are registered or rotated unless it is disabled by the config.
+	if m.CredentialValidator == nil && !m.options.Config.Credentials.SkipValidation {
+		m.CredentialValidator = ucp_aws.NewUCPCredentialValidator()
+	}
+
 	handlerOptions = append(handlerOptions, []server.HandlerOptions{
 		{
 			ParentRouter: credentialCollectionRouter,
@@ -220,7 +226,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:       v1.OperationPut,
 			ResourceType: v20231001preview.AWSCredentialType,
 			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
-				return aws_credential_ctrl.NewCreateOrUpdateAWSCredential(o, secretClient)
+				return aws_credential_ctrl.NewCreateOrUpdateAWSCredential(o, secretClient, m.CredentialValidator)
 			},
 		},
 		{
@@ -231,6 +237,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return aws_credential_ctrl.NewDeleteAWSCredential(o, secretClient)
 			},
 		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Path:         "/rotate",
+			Method:       credentials.OperationRotate,
+			ResourceType: v20231001preview.AWSCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return aws_credential_ctrl.NewRotateAWSCredential(o, secretClient, m.CredentialValidator)
+			},
+		},
 	}...)
 
 	ctrlOpts := controller.Options{
@@ -260,6 +275,15 @@ func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 		}
 		p := ucp_aws.NewUCPCredentialProvider(provider, ucp_aws.DefaultExpireDuration)
 		credProviders = append(credProviders, config.WithCredentialsProvider(p))
+
+		// Requests can select another credential of the AWS plane than the default credential, if the providers of an
+		// environment select it.
+		if m.AWSClients.Credentials == nil {
+			m.AWSClients.Credentials = ucp_aws.NewUCPCredentialResolver(provider, ucp_aws.DefaultExpireDuration)
+		}
+		if m.AWSClients.Authorizer == nil {
+			m.AWSClients.Authorizer = sdk_cred.NewEnvironmentCredentialAuthorizer(m.options.DataProvider)
+		}
 		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
 
 	default:
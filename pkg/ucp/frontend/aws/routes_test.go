@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -54,6 +55,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.AWSCredentialType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/aws/aws/providers/System.AWS/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.AWSCredentialType, Method: credentials.OperationRotate},
+			Method:        http.MethodPost,
+			Path:          "/planes/aws/aws/providers/System.AWS/credentials/default/rotate",
 		}, {
 			OperationType: v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationList},
 			Method:        http.MethodGet,
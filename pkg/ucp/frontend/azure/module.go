@@ -18,6 +18,7 @@ package azure
 
 import (
 	"github.com/go-chi/chi/v5"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/validator"
 )
@@ -38,6 +39,10 @@ var _ modules.Initializer = &Module{}
 type Module struct {
 	options modules.Options
 	router  chi.Router
+
+	// CredentialValidator validates the Azure credentials when they are registered or rotated. This field can be
+	// overridden by tests.
+	CredentialValidator sdk_cred.CredentialValidator[sdk_cred.AzureCredential]
 }
 
 // PlaneType returns the type of plane this module is for.
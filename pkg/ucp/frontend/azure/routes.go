@@ -20,15 +20,22 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	azure_credential "github.com/radius-project/radius/pkg/azure/credential"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	azure_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/azure"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	"github.com/radius-project/radius/pkg/ucp/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
@@ -58,6 +65,17 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
 	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
 
+	// Credentials are validated against Azure when they are registered or rotated unless it is disabled by the config.
+	if m.CredentialValidator == nil && !m.options.Config.Credentials.SkipValidation {
+		m.CredentialValidator = azure_credential.NewUCPCredentialValidator(nil)
+	}
+
+	// Proxied requests can select a credential of the Azure plane, which UCP uses to authenticate them.
+	proxyCredential, err := m.newProxyCredential(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			ParentRouter: credentialCollectionRouter,
@@ -90,7 +108,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			Method:       v1.OperationPut,
 			ResourceType: v20231001preview.AzureCredentialType,
 			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
-				return azure_credential_ctrl.NewCreateOrUpdateAzureCredential(opt, secretClient)
+				return azure_credential_ctrl.NewCreateOrUpdateAzureCredential(opt, secretClient, m.CredentialValidator)
 			},
 		},
 		{
@@ -101,6 +119,15 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return azure_credential_ctrl.NewDeleteAzureCredential(opt, secretClient)
 			},
 		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Path:         "/rotate",
+			Method:       credentials.OperationRotate,
+			ResourceType: v20231001preview.AzureCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return azure_credential_ctrl.NewRotateAzureCredential(opt, secretClient, m.CredentialValidator)
+			},
+		},
 
 		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
 		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
@@ -108,10 +135,12 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Method deliberately omitted. This is a catch-all route for proxying.
-			ParentRouter:      baseRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  baseRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opt, proxyCredential, sdk_cred.NewEnvironmentCredentialAuthorizer(m.options.DataProvider))
+			},
 		},
 	}
 
@@ -129,3 +158,19 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 
 	return m.router, nil
 }
+
+// newProxyCredential returns the credential authenticating the proxied requests which select a credential of the Azure
+// plane, or nil if the credentials of the Azure plane are not used by UCP.
+func (m *Module) newProxyCredential(ctx context.Context) (azcore.TokenCredential, error) {
+	if m.options.Config.Identity.AuthMethod != hostoptions.AuthUCPCredential {
+		return nil, nil
+	}
+
+	provider, err := sdk_cred.NewAzureCredentialProvider(m.options.SecretProvider, m.options.UCPConnection, &aztoken.AnonymousCredential{})
+	if err != nil {
+		return nil, err
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info("Configuring 'UCPCredential' authentication of proxied requests selecting a credential")
+	return azure_credential.NewUCPCredential(azure_credential.UCPCredentialOptions{Provider: provider})
+}
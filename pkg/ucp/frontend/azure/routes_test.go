@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/ucp/hostoptions"
 	"github.com/radius-project/radius/pkg/ucp/secret"
@@ -54,6 +55,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: v20231001preview.AzureCredentialType, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.AzureCredentialType, Method: credentials.OperationRotate},
+			Method:        http.MethodPost,
+			Path:          "/planes/azure/azurecloud/providers/System.Azure/credentials/default/rotate",
 		}, {
 			OperationType:               v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
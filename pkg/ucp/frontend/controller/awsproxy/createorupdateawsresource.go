@@ -63,8 +63,13 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 		return errResponse, nil
 	}
 
+	credentials, errResponse, err := credentialsFromRequest(ctx, req, p.awsClients, serviceCtx.ResourceID)
+	if errResponse != nil || err != nil {
+		return errResponse, err
+	}
+
 	body := map[string]any{}
-	err := decoder.Decode(&body)
+	err = decoder.Decode(&body)
 	if err != nil {
 		e := v1.ErrorResponse{
 			Error: v1.ErrorDetails{
@@ -86,8 +91,8 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 		}
 	}
 
-	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region)}
-	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region)}
+	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region), CloudControlCredentialsOption(credentials)}
+	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region), CloudFormationWithCredentialsOption(credentials)}
 
 	// Create and update work differently for AWS - we need to know if the resource
 	// we're working on exists already.
@@ -64,6 +64,11 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 		return errResponse, nil
 	}
 
+	credentials, errResponse, err := credentialsFromRequest(ctx, req, p.awsClients, serviceCtx.ResourceID)
+	if errResponse != nil || err != nil {
+		return errResponse, err
+	}
+
 	properties, err := readPropertiesFromBody(req)
 	if err != nil {
 		e := v1.ErrorResponse{
@@ -75,8 +80,8 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 		return armrpc_rest.NewBadRequestARMResponse(e), nil
 	}
 
-	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region)}
-	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region)}
+	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region), CloudControlCredentialsOption(credentials)}
+	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region), CloudFormationWithCredentialsOption(credentials)}
 
 	describeTypeOutput, err := p.awsClients.CloudFormation.DescribeType(ctx, &cloudformation.DescribeTypeInput{
 		Type:     types.RegistryTypeResource,
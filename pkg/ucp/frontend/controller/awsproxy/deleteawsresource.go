@@ -57,7 +57,12 @@ func (p *DeleteAWSResource) Run(ctx context.Context, w http.ResponseWriter, req
 		return errResponse, nil
 	}
 
-	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region)}
+	credentials, errResponse, err := credentialsFromRequest(ctx, req, p.awsClients, serviceCtx.ResourceID)
+	if errResponse != nil || err != nil {
+		return errResponse, err
+	}
+
+	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region), CloudControlCredentialsOption(credentials)}
 	response, err := p.awsClients.CloudControl.DeleteResource(ctx, &cloudcontrol.DeleteResourceInput{
 		TypeName:   to.Ptr(serviceCtx.ResourceTypeInAWSFormat()),
 		Identifier: aws.String(serviceCtx.ResourceID.Name()),
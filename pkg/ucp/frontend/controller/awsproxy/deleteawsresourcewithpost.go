@@ -66,7 +66,12 @@ func (p *DeleteAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWrit
 		return errResponse, nil
 	}
 
-	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region)}
+	credentials, errResponse, err := credentialsFromRequest(ctx, req, p.awsClients, serviceCtx.ResourceID)
+	if errResponse != nil || err != nil {
+		return errResponse, err
+	}
+
+	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region), CloudControlCredentialsOption(credentials)}
 	properties, err := readPropertiesFromBody(req)
 	if err != nil {
 		e := v1.ErrorResponse{
@@ -78,7 +83,7 @@ func (p *DeleteAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWrit
 		return armrpc_rest.NewBadRequestARMResponse(e), nil
 	}
 
-	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region)}
+	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationWithRegionOption(region), CloudFormationWithCredentialsOption(credentials)}
 	describeTypeOutput, err := p.awsClients.CloudFormation.DescribeType(ctx, &cloudformation.DescribeTypeInput{
 		Type:     types.RegistryTypeResource,
 		TypeName: to.Ptr(serviceCtx.ResourceTypeInAWSFormat()),
@@ -57,7 +57,12 @@ func (p *GetAWSOperationResults) Run(ctx context.Context, w http.ResponseWriter,
 	if errResponse != nil {
 		return errResponse, nil
 	}
-	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region)}
+
+	credentials, errResponse, err := credentialsFromRequest(ctx, req, p.awsClients, serviceCtx.ResourceID)
+	if errResponse != nil || err != nil {
+		return errResponse, err
+	}
+	cloudControlOpts := []func(*cloudcontrol.Options){CloudControlRegionOption(region), CloudControlCredentialsOption(credentials)}
 	response, err := p.awsClients.CloudControl.GetResourceRequestStatus(ctx, &cloudcontrol.GetResourceRequestStatusInput{
 		RequestToken: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
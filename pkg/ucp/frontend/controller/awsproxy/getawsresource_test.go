@@ -16,12 +16,14 @@ limitations under the License.
 package awsproxy
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	"github.com/aws/smithy-go"
@@ -34,6 +36,7 @@ import (
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 )
@@ -84,6 +87,84 @@ func Test_GetAWSResource(t *testing.T) {
 	require.Equal(t, expectedResponse, actualResponse)
 }
 
+type fakeCredentialResolver struct {
+	credentials map[string]aws.CredentialsProvider
+}
+
+func (r *fakeCredentialResolver) Resolve(name string) aws.CredentialsProvider {
+	return r.credentials[name]
+}
+
+func Test_GetAWSResource_SelectedCredential(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+	account2 := credentials.NewStaticCredentialsProvider("account2id", "account2secret", "")
+
+	// The credential account2 may only be used by the requests for the account of the test resource.
+	authorizer := sdk_cred.CredentialAuthorizerFunc(func(ctx context.Context, planeType string, name string, id resources.ID) error {
+		if planeType != "aws" || name != "account2" || id.FindScope("accounts") != "1234567" {
+			return &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: id.String()}
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name       string
+		header     string
+		authorizer sdk_cred.CredentialAuthorizer
+		expected   aws.CredentialsProvider
+		badRequest bool
+	}{
+		{name: "selected credential", header: "account2", authorizer: authorizer, expected: account2},
+		{name: "default credential", header: "", authorizer: authorizer, expected: nil},
+		{name: "credential not allowed", header: "account3", authorizer: authorizer, badRequest: true},
+		{name: "no authorizer", header: "account2", authorizer: nil, badRequest: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testOptions := setupTest(t)
+			if !tt.badRequest {
+				testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+						options := cloudcontrol.Options{}
+						for _, fn := range optFns {
+							fn(&options)
+						}
+						require.Equal(t, tt.expected, options.Credentials)
+
+						return &cloudcontrol.GetResourceOutput{
+							ResourceDescription: &types.ResourceDescription{
+								Identifier: aws.String(testResource.ResourceName),
+							},
+						}, nil
+					})
+			}
+
+			awsClients := ucp_aws.Clients{
+				CloudControl:   testOptions.AWSCloudControlClient,
+				CloudFormation: testOptions.AWSCloudFormationClient,
+				Credentials:    &fakeCredentialResolver{credentials: map[string]aws.CredentialsProvider{"account2": account2}},
+				Authorizer:     tt.authorizer,
+			}
+			awsController, err := NewGetAWSResource(armrpc_controller.Options{StorageClient: testOptions.StorageClient}, awsClients)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodGet, testResource.SingleResourcePath, nil)
+			require.NoError(t, err)
+			if tt.header != "" {
+				request.Header.Set(sdk_cred.CredentialNameHeader, tt.header)
+			}
+
+			ctx := rpctest.NewARMRequestContext(request)
+			response, err := awsController.Run(ctx, nil, request)
+			require.NoError(t, err)
+			if tt.badRequest {
+				require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+			}
+		})
+	}
+}
+
 func Test_GetAWSResource_NotFound(t *testing.T) {
 	testResource := CreateKinesisStreamTestResource(uuid.NewString())
 
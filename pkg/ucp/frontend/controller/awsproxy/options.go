@@ -16,8 +16,18 @@ limitations under the License.
 package awsproxy
 
 import (
+	"context"
+	"errors"
+	http "net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_aws "github.com/radius-project/radius/pkg/ucp/resources/aws"
 )
 
 // CloudControlRegionOption sets the region for the CloudControl client.
@@ -33,3 +43,45 @@ func CloudFormationWithRegionOption(region string) func(*cloudformation.Options)
 		o.Region = region
 	}
 }
+
+// CloudControlCredentialsOption sets the credentials for the CloudControl client. The client keeps the credentials of
+// its configuration if credentials is nil.
+func CloudControlCredentialsOption(credentials aws.CredentialsProvider) func(*cloudcontrol.Options) {
+	return func(o *cloudcontrol.Options) {
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+	}
+}
+
+// CloudFormationWithCredentialsOption sets the credentials for the CloudFormation client. The client keeps the
+// credentials of its configuration if credentials is nil.
+func CloudFormationWithCredentialsOption(credentials aws.CredentialsProvider) func(*cloudformation.Options) {
+	return func(o *cloudformation.Options) {
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+	}
+}
+
+// credentialsFromRequest returns the credentials of the UCP credential selected by the credential name header of the
+// request, or nil if the request does not select a credential. A bad request response is returned if the request may
+// not use the selected credential for the resource id.
+func credentialsFromRequest(ctx context.Context, req *http.Request, awsClients ucp_aws.Clients, id resources.ID) (aws.CredentialsProvider, armrpc_rest.Response, error) {
+	name := req.Header.Get(sdk_cred.CredentialNameHeader)
+	if name == "" || awsClients.Credentials == nil {
+		return nil, nil, nil
+	}
+
+	var err error = &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	if awsClients.Authorizer != nil {
+		err = awsClients.Authorizer.Authorize(ctx, resources_aws.PlaneTypeAWS, name, id)
+	}
+	if errors.Is(err, &sdk_cred.ErrCredentialNotAllowed{}) {
+		return nil, armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return awsClients.Credentials.Resolve(name), nil, nil
+}
@@ -18,10 +18,13 @@ package aws
 import (
 	"context"
 	"net/http"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
@@ -34,11 +37,12 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAWSCredential)(nil)
 type CreateOrUpdateAWSCredential struct {
 	armrpc_controller.Operation[*datamodel.AWSCredential, datamodel.AWSCredential]
 	secretClient secret.Client
+	validator    sdk_cred.CredentialValidator[sdk_cred.AWSCredential]
 }
 
 // NewCreateOrUpdateAWSCredential creates a new CreateOrUpdateAWSCredential controller which is used to create or update
 // AWS credentials in the secret store.
-func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient secret.Client, validator sdk_cred.CredentialValidator[sdk_cred.AWSCredential]) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAWSCredential{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.AWSCredential]{
@@ -47,11 +51,12 @@ func NewCreateOrUpdateAWSCredential(opts armrpc_controller.Options, secretClient
 			},
 		),
 		secretClient: secretClient,
+		validator:    validator,
 	}, nil
 }
 
-// CreateOrUpdateAWSCredential validates the request, saves the AWS credential secret, and saves the resource in the
-// metadata store. If an error occurs, it returns an error response.
+// CreateOrUpdateAWSCredential validates the request and the credential against AWS, saves the AWS credential secret, and
+// saves the resource in the metadata store with the rotation time. If an error occurs, it returns an error response.
 func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
@@ -59,7 +64,9 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AWSCredentialKind {
+	switch newResource.Properties.Kind {
+	case datamodel.AWSAccessKeyCredentialKind, datamodel.AWSAssumeRoleCredentialKind, datamodel.AWSIRSACredentialKind:
+	default:
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -72,6 +79,11 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 		return r, err
 	}
 
+	// Validate the credential against the cloud before it replaces the stored secret.
+	if r := credentials.ValidateCredential(ctx, c.validator, newResource.Properties.AWSCredential); r != nil {
+		return r, nil
+	}
+
 	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
 	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
@@ -85,6 +97,7 @@ func (c *CreateOrUpdateAWSCredential) Run(ctx context.Context, w http.ResponseWr
 
 	// Do not save the secret in metadata store.
 	newResource.Properties.AWSCredential.SecretAccessKey = ""
+	newResource.Properties.LastRotatedAt = to.Ptr(time.Now().UTC())
 
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
+)
+
+func getExistingAWSCredential(kind string) *store.Object {
+	return &store.Object{
+		Metadata: store.Metadata{
+			ID:   "/planes/aws/awscloud/providers/System.AWS/credentials/default",
+			ETag: "existing-etag",
+		},
+		Data: &datamodel.AWSCredential{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:       "/planes/aws/awscloud/providers/System.AWS/credentials/default",
+					Name:     "default",
+					Type:     "System.AWS/credentials",
+					Location: "West US",
+				},
+			},
+			Properties: &datamodel.AWSCredentialResourceProperties{
+				Kind: kind,
+				AWSCredential: &datamodel.AWSCredentialProperties{
+					Kind:        kind,
+					AccessKeyID: "00000000-0000-0000-0000-000000000000",
+				},
+				Storage: &datamodel.CredentialStorageProperties{
+					Kind: datamodel.InternalStorageKind,
+					InternalCredential: &datamodel.InternalCredentialStorageProperties{
+						SecretName: "aws-awscloud-default",
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Rotate_AWS_Credential(t *testing.T) {
+	id, err := resources.Parse("/planes/aws/awscloud/providers/System.AWS/credentials/default")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		existing     *store.Object
+		validatorErr error
+		fn           func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient)
+		expected     armrpc_rest.Response
+		err          error
+	}{
+		{
+			name:     "rotate the secret",
+			existing: getExistingAWSCredential(datamodel.AWSAccessKeyCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				gomock.InOrder(
+					mockSecretClient.EXPECT().Save(gomock.Any(), "aws-awscloud-default", gomock.Any()).Return(nil).Times(1),
+					mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name:     "credential not found",
+			expected: armrpc_rest.NewNotFoundResponse(id),
+		},
+		{
+			name:     "credential kind changed",
+			existing: getExistingAWSCredential(datamodel.AWSIRSACredentialKind),
+			expected: armrpc_rest.NewBadRequestResponse("The kind of the credential cannot be changed by a rotation, expected kind: " + datamodel.AWSIRSACredentialKind),
+		},
+		{
+			name:         "invalid credential",
+			existing:     getExistingAWSCredential(datamodel.AWSAccessKeyCredentialKind),
+			validatorErr: errors.New("the security token included in the request is invalid"),
+			expected:     armrpc_rest.NewBadRequestResponse("Invalid Credential: the security token included in the request is invalid"),
+		},
+		{
+			name:     "secret save failure keeps the existing credential",
+			existing: getExistingAWSCredential(datamodel.AWSAccessKeyCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Secret Save Failure")).Times(1)
+			},
+			err: errors.New("Secret Save Failure"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+			mockSecretClient := secret.NewMockClient(mockCtrl)
+
+			mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+					if tt.existing == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return tt.existing, nil
+				}).Times(1)
+			if tt.fn != nil {
+				tt.fn(mockStorageClient, mockSecretClient)
+			}
+
+			credentialCtrl, err := NewRotateAWSCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient, credentialtest.NewFakeValidator[sdk_cred.AWSCredential](tt.validatorErr))
+			require.NoError(t, err)
+
+			credentialVersionedInput := &v20231001preview.AwsCredentialResource{}
+			err = json.Unmarshal(testutil.ReadFixture("aws-credential.json"), credentialVersionedInput)
+			require.NoError(t, err)
+
+			request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testRotateHeaderFile, credentialVersionedInput)
+			require.NoError(t, err)
+
+			response, err := credentialCtrl.Run(rpctest.NewARMRequestContext(request), nil, request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expected != nil {
+				require.Equal(t, tt.expected, response)
+				return
+			}
+
+			credentialtest.RequireLastRotatedAt(t, response)
+			versioned := response.(*armrpc_rest.OKResponse).Body.(*v20231001preview.AwsCredentialResource)
+			require.Equal(t, "/planes/aws/awscloud/providers/System.AWS/credentials/default", *versioned.ID)
+			storage := versioned.Properties.(*v20231001preview.AwsAccessKeyCredentialProperties).Storage.(*v20231001preview.InternalCredentialStorageProperties)
+			require.Equal(t, "aws-awscloud-default", *storage.SecretName)
+		})
+	}
+}
@@ -18,10 +18,13 @@ package azure
 import (
 	"context"
 	"net/http"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
@@ -34,11 +37,12 @@ var _ armrpc_controller.Controller = (*CreateOrUpdateAzureCredential)(nil)
 type CreateOrUpdateAzureCredential struct {
 	armrpc_controller.Operation[*datamodel.AzureCredential, datamodel.AzureCredential]
 	secretClient secret.Client
+	validator    sdk_cred.CredentialValidator[sdk_cred.AzureCredential]
 }
 
 // NewCreateOrUpdateAzureCredential creates a new CreateOrUpdateAzureCredential controller which is used to create or
 // update Azure credentials and returns it along with a nil error.
-func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClient secret.Client, validator sdk_cred.CredentialValidator[sdk_cred.AzureCredential]) (armrpc_controller.Controller, error) {
 	return &CreateOrUpdateAzureCredential{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.AzureCredential]{
@@ -47,13 +51,14 @@ func NewCreateOrUpdateAzureCredential(opts armrpc_controller.Options, secretClie
 			},
 		),
 		secretClient: secretClient,
+		validator:    validator,
 	}, nil
 }
 
 // CreateOrUpdateAzureCredential Run function saves an Azure credential secret in the secret store and updates the
-// metadata store with the new resource, setting the provisioning state to succeeded. If an invalid credential kind is
-// provided, a bad request response is returned. If an error occurs while saving the secret or the resource, an error is
-// returned.
+// metadata store with the new resource, setting the provisioning state to succeeded and recording the rotation time. If
+// an invalid credential kind is provided or the credential fails validation against Azure, a bad request response is
+// returned. If an error occurs while saving the secret or the resource, an error is returned.
 func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := c.GetResourceFromRequest(ctx, req)
@@ -61,7 +66,7 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return nil, err
 	}
 
-	if newResource.Properties.Kind != datamodel.AzureCredentialKind {
+	if newResource.Properties.Kind != datamodel.AzureServicePrincipalCredentialKind && newResource.Properties.Kind != datamodel.AzureWorkloadIdentityCredentialKind {
 		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
 	}
 
@@ -74,6 +79,11 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 		return r, err
 	}
 
+	// Validate the credential against the cloud before it replaces the stored secret.
+	if r := credentials.ValidateCredential(ctx, c.validator, newResource.Properties.AzureCredential); r != nil {
+		return r, nil
+	}
+
 	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
 	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
 		newResource.Properties.Storage.InternalCredential.SecretName = secretName
@@ -87,6 +97,7 @@ func (c *CreateOrUpdateAzureCredential) Run(ctx context.Context, w http.Response
 
 	// Do not save the secret in metadata store.
 	newResource.Properties.AzureCredential.ClientSecret = ""
+	newResource.Properties.LastRotatedAt = to.Ptr(time.Now().UTC())
 
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
 	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
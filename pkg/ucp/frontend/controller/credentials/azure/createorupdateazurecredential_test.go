@@ -27,9 +27,11 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/secret"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
 
 	"github.com/golang/mock/gomock"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -44,7 +46,7 @@ func Test_Azure_Credential(t *testing.T) {
 
 	credentialCtrl, err := NewCreateOrUpdateAzureCredential(armrpc_controller.Options{
 		StorageClient: mockStorageClient,
-	}, mockSecretClient)
+	}, mockSecretClient, credentialtest.NewFakeValidator[sdk_cred.AzureCredential](nil))
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -138,6 +140,7 @@ func Test_Azure_Credential(t *testing.T) {
 				require.Equal(t, err, tt.err)
 			} else {
 				require.NoError(t, err)
+				credentialtest.RequireLastRotatedAt(t, response)
 				require.Equal(t, tt.expected, response)
 			}
 		})
@@ -206,3 +209,38 @@ func setupCredentialSecretSaveFailMocks(mockStorageClient store.MockStorageClien
 
 func setupEmptyMocks(mockStorageClient store.MockStorageClient, mockSecretClient secret.MockClient) {
 }
+
+func Test_Azure_Credential_Validation(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockStorageClient := store.NewMockStorageClient(mockCtrl)
+	mockSecretClient := secret.NewMockClient(mockCtrl)
+
+	var validated *sdk_cred.AzureCredential
+	validator := sdk_cred.CredentialValidatorFunc[sdk_cred.AzureCredential](func(ctx context.Context, credential *sdk_cred.AzureCredential) error {
+		validated = credential
+		return errors.New("the security token included in the request is invalid")
+	})
+
+	credentialCtrl, err := NewCreateOrUpdateAzureCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient, validator)
+	require.NoError(t, err)
+
+	mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+			return nil, &store.ErrNotFound{ID: id}
+		}).Times(1)
+
+	credentialVersionedInput := &v20231001preview.AzureCredentialResource{}
+	err = json.Unmarshal(testutil.ReadFixture("azure-credential.json"), credentialVersionedInput)
+	require.NoError(t, err)
+
+	request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, testHeaderFile, credentialVersionedInput)
+	require.NoError(t, err)
+
+	// The secret must not be saved when the credential is rejected.
+	response, err := credentialCtrl.Run(rpctest.NewARMRequestContext(request), nil, request)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewBadRequestResponse("Invalid Credential: the security token included in the request is invalid"), response)
+	require.NotNil(t, validated)
+	require.Equal(t, "00000000-0000-0000-0000-000000000000", validated.ClientID)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package azure
+
+import (
+	"context"
+	"time"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+// NewRotateAzureCredential creates a new RotateCredential controller which is used to rotate the secret of Azure
+// credentials in the secret store. The new credential is validated against Azure before the secret is replaced.
+func NewRotateAzureCredential(opts armrpc_controller.Options, secretClient secret.Client, validator sdk_cred.CredentialValidator[sdk_cred.AzureCredential]) (armrpc_controller.Controller, error) {
+	return credentials.NewRotateCredential[*datamodel.AzureCredential](opts, secretClient, credentials.RotateCredentialOptions[datamodel.AzureCredential]{
+		ResourceOptions: armrpc_controller.ResourceOptions[datamodel.AzureCredential]{
+			RequestConverter:  converter.AzureCredentialDataModelFromVersioned,
+			ResponseConverter: converter.AzureCredentialDataModelToVersioned,
+		},
+		Kind: func(credential *datamodel.AzureCredential) string {
+			return credential.Properties.Kind
+		},
+		Validate: func(ctx context.Context, credential *datamodel.AzureCredential) armrpc_rest.Response {
+			return credentials.ValidateCredential(ctx, validator, credential.Properties.AzureCredential)
+		},
+		Secret: func(credential *datamodel.AzureCredential) any {
+			return credential.Properties.AzureCredential
+		},
+		Rotated: func(credential *datamodel.AzureCredential, existing *datamodel.AzureCredential, rotatedAt time.Time) {
+			credential.Properties.Storage = existing.Properties.Storage
+			credential.Properties.LastRotatedAt = to.Ptr(rotatedAt)
+
+			// Do not save the secret in metadata store.
+			credential.Properties.AzureCredential.ClientSecret = ""
+		},
+	})
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
+)
+
+func getExistingAzureCredential(kind string) *store.Object {
+	return &store.Object{
+		Metadata: store.Metadata{
+			ID:   "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+			ETag: "existing-etag",
+		},
+		Data: &datamodel.AzureCredential{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:       "/planes/azure/azurecloud/providers/System.Azure/credentials/default",
+					Name:     "default",
+					Type:     "System.Azure/credentials",
+					Location: "West US",
+				},
+			},
+			Properties: &datamodel.AzureCredentialResourceProperties{
+				Kind: kind,
+				AzureCredential: &datamodel.AzureCredentialProperties{
+					Kind:     kind,
+					ClientID: "00000000-0000-0000-0000-000000000000",
+					TenantID: "00000000-0000-0000-0000-000000000000",
+				},
+				Storage: &datamodel.CredentialStorageProperties{
+					Kind: datamodel.InternalStorageKind,
+					InternalCredential: &datamodel.InternalCredentialStorageProperties{
+						SecretName: "azure-azurecloud-default",
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Rotate_Azure_Credential(t *testing.T) {
+	id, err := resources.Parse("/planes/azure/azurecloud/providers/System.Azure/credentials/default")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		existing     *store.Object
+		validatorErr error
+		fn           func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient)
+		expected     armrpc_rest.Response
+		err          error
+	}{
+		{
+			name:     "rotate the secret",
+			existing: getExistingAzureCredential(datamodel.AzureServicePrincipalCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				gomock.InOrder(
+					mockSecretClient.EXPECT().Save(gomock.Any(), "azure-azurecloud-default", gomock.Any()).Return(nil).Times(1),
+					mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name:     "credential not found",
+			expected: armrpc_rest.NewNotFoundResponse(id),
+		},
+		{
+			name:     "credential kind changed",
+			existing: getExistingAzureCredential(datamodel.AzureWorkloadIdentityCredentialKind),
+			expected: armrpc_rest.NewBadRequestResponse("The kind of the credential cannot be changed by a rotation, expected kind: " + datamodel.AzureWorkloadIdentityCredentialKind),
+		},
+		{
+			name:         "invalid credential",
+			existing:     getExistingAzureCredential(datamodel.AzureServicePrincipalCredentialKind),
+			validatorErr: errors.New("the security token included in the request is invalid"),
+			expected:     armrpc_rest.NewBadRequestResponse("Invalid Credential: the security token included in the request is invalid"),
+		},
+		{
+			name:     "secret save failure keeps the existing credential",
+			existing: getExistingAzureCredential(datamodel.AzureServicePrincipalCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Secret Save Failure")).Times(1)
+			},
+			err: errors.New("Secret Save Failure"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+			mockSecretClient := secret.NewMockClient(mockCtrl)
+
+			mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+					if tt.existing == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return tt.existing, nil
+				}).Times(1)
+			if tt.fn != nil {
+				tt.fn(mockStorageClient, mockSecretClient)
+			}
+
+			credentialCtrl, err := NewRotateAzureCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient, credentialtest.NewFakeValidator[sdk_cred.AzureCredential](tt.validatorErr))
+			require.NoError(t, err)
+
+			credentialVersionedInput := &v20231001preview.AzureCredentialResource{}
+			err = json.Unmarshal(testutil.ReadFixture("azure-credential.json"), credentialVersionedInput)
+			require.NoError(t, err)
+
+			request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testRotateHeaderFile, credentialVersionedInput)
+			require.NoError(t, err)
+
+			response, err := credentialCtrl.Run(rpctest.NewARMRequestContext(request), nil, request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expected != nil {
+				require.Equal(t, tt.expected, response)
+				return
+			}
+
+			credentialtest.RequireLastRotatedAt(t, response)
+			versioned := response.(*armrpc_rest.OKResponse).Body.(*v20231001preview.AzureCredentialResource)
+			require.Equal(t, "/planes/azure/azurecloud/providers/System.Azure/credentials/default", *versioned.ID)
+			storage := versioned.Properties.(*v20231001preview.AzureServicePrincipalProperties).Storage.(*v20231001preview.InternalCredentialStorageProperties)
+			require.Equal(t, "azure-azurecloud-default", *storage.SecretName)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+var _ armrpc_controller.Controller = (*CreateOrUpdateKubernetesCredential)(nil)
+
+// CreateOrUpdateKubernetesCredential is the controller implementation to create/update a UCP Kubernetes credential.
+type CreateOrUpdateKubernetesCredential struct {
+	armrpc_controller.Operation[*datamodel.KubernetesCredential, datamodel.KubernetesCredential]
+	secretClient secret.Client
+}
+
+// NewCreateOrUpdateKubernetesCredential creates a new CreateOrUpdateKubernetesCredential controller which is used to create or
+// update Kubernetes credentials and returns it along with a nil error.
+func NewCreateOrUpdateKubernetesCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+	return &CreateOrUpdateKubernetesCredential{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.KubernetesCredential]{
+				RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+				ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+			},
+		),
+		secretClient: secretClient,
+	}, nil
+}
+
+// CreateOrUpdateKubernetesCredential Run function saves the service account token or kubeconfig of a Kubernetes credential
+// in the secret store and updates the metadata store with the new resource, setting the provisioning state to succeeded
+// and recording the rotation time. If an invalid credential kind is provided, a bad request response is returned. If an
+// error occurs while saving the secret or the resource, an error is returned.
+func (c *CreateOrUpdateKubernetesCredential) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if newResource.Properties.Kind != datamodel.KubernetesServiceAccountTokenCredentialKind && newResource.Properties.Kind != datamodel.KubernetesKubeconfigCredentialKind {
+		return armrpc_rest.NewBadRequestResponse("Invalid Credential Kind"), nil
+	}
+
+	if newResource.Properties.Kind == datamodel.KubernetesKubeconfigCredentialKind {
+		if err := ucp_credentials.ValidateKubeconfig(newResource.Properties.KubernetesCredential.Kubeconfig); err != nil {
+			return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+		}
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	secretName := credentials.GetSecretName(serviceCtx.ResourceID)
+	if newResource.Properties.Storage.Kind == datamodel.InternalStorageKind {
+		newResource.Properties.Storage.InternalCredential.SecretName = secretName
+	}
+
+	// Save the credential secret
+	err = secret.SaveSecret(ctx, c.secretClient, secretName, newResource.Properties.KubernetesCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not save the token or the kubeconfig in metadata store.
+	newResource.Properties.KubernetesCredential.Token = ""
+	newResource.Properties.KubernetesCredential.Kubeconfig = ""
+	newResource.Properties.LastRotatedAt = to.Ptr(time.Now().UTC())
+
+	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
+	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+}
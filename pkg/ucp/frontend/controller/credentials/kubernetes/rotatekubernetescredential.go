@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+// NewRotateKubernetesCredential creates a new RotateCredential controller which is used to rotate the service account
+// token or kubeconfig of Kubernetes credentials in the secret store.
+func NewRotateKubernetesCredential(opts armrpc_controller.Options, secretClient secret.Client) (armrpc_controller.Controller, error) {
+	return credentials.NewRotateCredential[*datamodel.KubernetesCredential](opts, secretClient, credentials.RotateCredentialOptions[datamodel.KubernetesCredential]{
+		ResourceOptions: armrpc_controller.ResourceOptions[datamodel.KubernetesCredential]{
+			RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+			ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+		},
+		Kind: func(credential *datamodel.KubernetesCredential) string {
+			return credential.Properties.Kind
+		},
+		Validate: func(ctx context.Context, credential *datamodel.KubernetesCredential) armrpc_rest.Response {
+			if credential.Properties.Kind != datamodel.KubernetesKubeconfigCredentialKind {
+				return nil
+			}
+			if err := ucp_credentials.ValidateKubeconfig(credential.Properties.KubernetesCredential.Kubeconfig); err != nil {
+				return armrpc_rest.NewBadRequestResponse(err.Error())
+			}
+			return nil
+		},
+		Secret: func(credential *datamodel.KubernetesCredential) any {
+			return credential.Properties.KubernetesCredential
+		},
+		Rotated: func(credential *datamodel.KubernetesCredential, existing *datamodel.KubernetesCredential, rotatedAt time.Time) {
+			credential.Properties.Storage = existing.Properties.Storage
+			credential.Properties.LastRotatedAt = to.Ptr(rotatedAt)
+
+			// Do not save the token or the kubeconfig in metadata store.
+			credential.Properties.KubernetesCredential.Token = ""
+			credential.Properties.KubernetesCredential.Kubeconfig = ""
+		},
+	})
+}
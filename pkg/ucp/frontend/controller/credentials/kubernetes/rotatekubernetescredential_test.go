@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
+)
+
+func getExistingKubernetesCredential(kind string) *store.Object {
+	return &store.Object{
+		Metadata: store.Metadata{
+			ID:   "/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default",
+			ETag: "existing-etag",
+		},
+		Data: &datamodel.KubernetesCredential{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:       "/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default",
+					Name:     "default",
+					Type:     "System.Kubernetes/credentials",
+					Location: "West US",
+				},
+			},
+			Properties: &datamodel.KubernetesCredentialResourceProperties{
+				Kind: kind,
+				KubernetesCredential: &datamodel.KubernetesCredentialProperties{
+					Kind:                     kind,
+					CertificateAuthorityData: "Y2EtZGF0YQ==",
+				},
+				Storage: &datamodel.CredentialStorageProperties{
+					Kind: datamodel.InternalStorageKind,
+					InternalCredential: &datamodel.InternalCredentialStorageProperties{
+						SecretName: "kubernetes-prod-default",
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_Rotate_Kubernetes_Credential(t *testing.T) {
+	id, err := resources.Parse("/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		filename string
+		existing *store.Object
+		fn       func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient)
+		expected armrpc_rest.Response
+		err      error
+	}{
+		{
+			name:     "rotate the secret",
+			existing: getExistingKubernetesCredential(datamodel.KubernetesServiceAccountTokenCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				gomock.InOrder(
+					mockSecretClient.EXPECT().Save(gomock.Any(), "kubernetes-prod-default", gomock.Any()).Return(nil).Times(1),
+					mockStorageClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1),
+				)
+			},
+		},
+		{
+			name:     "credential not found",
+			expected: armrpc_rest.NewNotFoundResponse(id),
+		},
+		{
+			name:     "credential kind changed",
+			existing: getExistingKubernetesCredential(datamodel.KubernetesKubeconfigCredentialKind),
+			expected: armrpc_rest.NewBadRequestResponse("The kind of the credential cannot be changed by a rotation, expected kind: " + datamodel.KubernetesKubeconfigCredentialKind),
+		},
+		{
+			name:     "kubeconfig with exec plugin",
+			filename: "kubernetes-credential-exec-kubeconfig.json",
+			existing: getExistingKubernetesCredential(datamodel.KubernetesKubeconfigCredentialKind),
+			expected: armrpc_rest.NewBadRequestResponse("user \"admin\" of the kubeconfig uses an exec plugin, which is not supported"),
+		},
+		{
+			name:     "kubeconfig with token file",
+			filename: "kubernetes-credential-file-kubeconfig.json",
+			existing: getExistingKubernetesCredential(datamodel.KubernetesKubeconfigCredentialKind),
+			expected: armrpc_rest.NewBadRequestResponse("user \"admin\" of the kubeconfig references files, use tokens or embedded certificate data instead"),
+		},
+		{
+			name:     "secret save failure keeps the existing credential",
+			existing: getExistingKubernetesCredential(datamodel.KubernetesServiceAccountTokenCredentialKind),
+			fn: func(mockStorageClient *store.MockStorageClient, mockSecretClient *secret.MockClient) {
+				mockSecretClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("Secret Save Failure")).Times(1)
+			},
+			err: errors.New("Secret Save Failure"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockStorageClient := store.NewMockStorageClient(mockCtrl)
+			mockSecretClient := secret.NewMockClient(mockCtrl)
+
+			mockStorageClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+					if tt.existing == nil {
+						return nil, &store.ErrNotFound{ID: id}
+					}
+					return tt.existing, nil
+				}).Times(1)
+			if tt.fn != nil {
+				tt.fn(mockStorageClient, mockSecretClient)
+			}
+
+			credentialCtrl, err := NewRotateKubernetesCredential(armrpc_controller.Options{StorageClient: mockStorageClient}, mockSecretClient)
+			require.NoError(t, err)
+
+			filename := tt.filename
+			if filename == "" {
+				filename = "kubernetes-credential.json"
+			}
+			credentialVersionedInput := &v20231001preview.KubernetesCredentialResource{}
+			err = json.Unmarshal(testutil.ReadFixture(filename), credentialVersionedInput)
+			require.NoError(t, err)
+
+			request, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPost, testRotateHeaderFile, credentialVersionedInput)
+			require.NoError(t, err)
+
+			response, err := credentialCtrl.Run(rpctest.NewARMRequestContext(request), nil, request)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expected != nil {
+				require.Equal(t, tt.expected, response)
+				return
+			}
+
+			credentialtest.RequireLastRotatedAt(t, response)
+			versioned := response.(*armrpc_rest.OKResponse).Body.(*v20231001preview.KubernetesCredentialResource)
+			require.Equal(t, "/planes/kubernetes/prod/providers/System.Kubernetes/credentials/default", *versioned.ID)
+			storage := versioned.Properties.(*v20231001preview.KubernetesServiceAccountTokenCredentialProperties).Storage.(*v20231001preview.InternalCredentialStorageProperties)
+			require.Equal(t, "kubernetes-prod-default", *storage.SecretName)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+)
+
+// RotateCredentialOptions describes a type of credential to the RotateCredential controller.
+type RotateCredentialOptions[T any] struct {
+	// ResourceOptions are the options of the credential resource.
+	ResourceOptions armrpc_controller.ResourceOptions[T]
+
+	// Kind returns the kind of the credential.
+	Kind func(credential *T) string
+
+	// Validate validates the credential in the request and returns a bad request response if the credential is
+	// invalid. It is optional.
+	Validate func(ctx context.Context, credential *T) armrpc_rest.Response
+
+	// Secret returns the secret of the credential which is saved in the secret store.
+	Secret func(credential *T) any
+
+	// Rotated is called after the secret of the credential is saved in the secret store. It sets the storage of the
+	// existing credential and the time of the rotation on the credential, and removes the secret from the credential so
+	// that the secret is not saved in the metadata store.
+	Rotated func(credential *T, existing *T, rotatedAt time.Time)
+}
+
+// RotateCredential is the controller implementation to rotate the secret of an existing UCP credential.
+type RotateCredential[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	armrpc_controller.Operation[P, T]
+	secretClient secret.Client
+	options      RotateCredentialOptions[T]
+}
+
+// NewRotateCredential creates a new RotateCredential controller which is used to rotate the secret of the credentials
+// of the type described by options in the secret store.
+func NewRotateCredential[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts armrpc_controller.Options, secretClient secret.Client, options RotateCredentialOptions[T]) (armrpc_controller.Controller, error) {
+	return &RotateCredential[P, T]{
+		Operation:    armrpc_controller.NewOperation[P](opts, options.ResourceOptions),
+		secretClient: secretClient,
+		options:      options,
+	}, nil
+}
+
+// Run replaces the secret of an existing credential with the secret in the request. The new credential is validated
+// before the secret is replaced, and the secret is replaced with a single write to the secret store so that deployments
+// and proxied requests read either the old or the new credential, and never a missing or invalid one. The kind of the
+// credential cannot be changed by a rotation.
+func (c *RotateCredential[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	newResource, err := c.GetResourceFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	old, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if kind := c.options.Kind(old); c.options.Kind(newResource) != kind {
+		return armrpc_rest.NewBadRequestResponse("The kind of the credential cannot be changed by a rotation, expected kind: " + kind), nil
+	}
+
+	if r, err := c.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	if c.options.Validate != nil {
+		if r := c.options.Validate(ctx, newResource); r != nil {
+			return r, nil
+		}
+	}
+
+	// The secret is rotated in place in the storage of the existing credential.
+	err = secret.SaveSecret(ctx, c.secretClient, GetSecretName(serviceCtx.ResourceID), c.options.Secret(newResource))
+	if err != nil {
+		return nil, err
+	}
+
+	c.options.Rotated(newResource, old, time.Now().UTC())
+
+	P(newResource).SetProvisioningState(v1.ProvisioningStateSucceeded)
+	newEtag, err := c.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+}
@@ -16,15 +16,38 @@ limitations under the License.
 package credentials
 
 import (
+	"context"
 	"strings"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/kubernetes"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
+const (
+	// OperationRotate is the operation method of the rotate action on a credential resource.
+	OperationRotate v1.OperationMethod = "ACTIONROTATE"
+)
+
 // GetSecretName takes in a resources.ID and returns a string which is the normalized name of the resource.
 func GetSecretName(id resources.ID) string {
 	planeNamespace := id.PlaneNamespace()
 	planeNamespace = strings.ReplaceAll(planeNamespace, "/", "-")
 	return kubernetes.NormalizeResourceName(planeNamespace + "-" + id.Name())
 }
+
+// ValidateCredential validates the credential against the cloud provider with the given validator and returns a bad
+// request response if the validation fails. The validation is skipped if validator is nil.
+func ValidateCredential[T any](ctx context.Context, validator sdk_cred.CredentialValidator[T], credential *T) armrpc_rest.Response {
+	if validator == nil {
+		return nil
+	}
+
+	if err := validator.Validate(ctx, credential); err != nil {
+		return armrpc_rest.NewBadRequestResponse("Invalid Credential: " + err.Error())
+	}
+
+	return nil
+}
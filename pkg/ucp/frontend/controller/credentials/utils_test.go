@@ -16,8 +16,12 @@ limitations under the License.
 package credentials
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,3 +33,27 @@ func Test_Credential(t *testing.T) {
 	secretName := GetSecretName(id)
 	assert.Equal(t, secretName, "azure-azurecloud-default")
 }
+
+func Test_ValidateCredential(t *testing.T) {
+	credential := &sdk_cred.AzureCredential{ClientID: "fakeid", TenantID: "fakeid", ClientSecret: "fakeSecret"}
+
+	t.Run("validation skipped", func(t *testing.T) {
+		require.Nil(t, ValidateCredential[sdk_cred.AzureCredential](context.Background(), nil, credential))
+	})
+
+	t.Run("valid credential", func(t *testing.T) {
+		validator := sdk_cred.CredentialValidatorFunc[sdk_cred.AzureCredential](func(ctx context.Context, c *sdk_cred.AzureCredential) error {
+			require.Equal(t, credential, c)
+			return nil
+		})
+		require.Nil(t, ValidateCredential[sdk_cred.AzureCredential](context.Background(), validator, credential))
+	})
+
+	t.Run("invalid credential", func(t *testing.T) {
+		validator := sdk_cred.CredentialValidatorFunc[sdk_cred.AzureCredential](func(ctx context.Context, c *sdk_cred.AzureCredential) error {
+			return errors.New("invalid client secret")
+		})
+		resp := ValidateCredential[sdk_cred.AzureCredential](context.Background(), validator, credential)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("Invalid Credential: invalid client secret"), resp)
+	})
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	k8s_rest "k8s.io/client-go/rest"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/rest"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// CredentialResourceType is the resource type of the credentials of a Kubernetes plane.
+	CredentialResourceType = "System.Kubernetes/credentials"
+)
+
+var _ armrpc_controller.Controller = (*ProxyController)(nil)
+
+// ProxyController is the controller implementation to proxy requests to the API server of a Kubernetes cluster
+// registered as a plane.
+type ProxyController struct {
+	armrpc_controller.Operation[*datamodel.Plane, datamodel.Plane]
+	secretClient secret.Client
+	authorizer   sdk_cred.CredentialAuthorizer
+}
+
+// NewProxyController creates a new ProxyController which authenticates the proxied requests with the credentials of the
+// Kubernetes plane stored in the secret store. The credential selected by a request is checked with the authorizer.
+func NewProxyController(opts armrpc_controller.Options, secretClient secret.Client, authorizer sdk_cred.CredentialAuthorizer) (armrpc_controller.Controller, error) {
+	return &ProxyController{
+		Operation:    armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		secretClient: secretClient,
+		authorizer:   authorizer,
+	}, nil
+}
+
+// Run looks up the Kubernetes plane of the request and the credential selected by the credential name header, or the
+// default credential, checks that the request may use the credential, and proxies the request to the API server at the URL of the plane. The planes prefix is removed
+// from the path so that /planes/kubernetes/{planeName}/api/v1/... is sent to {url}/api/v1/....
+func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	relativePath := middleware.GetRelativePath(p.Options().PathBase, req.URL.Path)
+	planeType, planeName, remainder, err := resources.ExtractPlanesPrefixFromURLPath(relativePath)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	planeID, err := resources.ParseScope("/planes/" + planeType + "/" + planeName)
+	if err != nil {
+		return nil, err
+	}
+
+	plane, _, err := p.GetResource(ctx, planeID)
+	if err != nil {
+		return nil, err
+	}
+	if plane == nil {
+		return armrpc_rest.NewNotFoundResponse(planeID), nil
+	}
+
+	if plane.Properties.Kind != rest.PlaneKindKubernetes || plane.Properties.URL == nil || *plane.Properties.URL == "" {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("plane %s is not a Kubernetes cluster with a URL", planeID.String())), nil
+	}
+
+	credentialName := sdk_cred.CredentialNameOrDefault(req.Header.Get(sdk_cred.CredentialNameHeader))
+	credentialID, err := resources.ParseResource(planeID.String() + "/providers/" + CredentialResourceType + "/" + credentialName)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid credential name %q", credentialName)), nil
+	}
+
+	if response, err := p.authorize(ctx, req, planeType, planeID); response != nil || err != nil {
+		return response, err
+	}
+
+	credential, err := secret.GetSecret[sdk_cred.KubernetesCredential](ctx, p.secretClient, credentials.GetSecretName(credentialID))
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("credential %s is not registered", credentialID.String())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	config, err := sdk_cred.NewKubernetesRESTConfig(*plane.Properties.URL, &credential)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := k8s_rest.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	downstream, err := url.Parse(*plane.Properties.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the planes prefix and preserve the query strings of the incoming url.
+	req.URL = &url.URL{Path: remainder, RawQuery: req.URL.RawQuery}
+
+	builder := proxy.ReverseProxyBuilder{
+		Downstream:    downstream,
+		EnableLogging: true,
+		Directors:     []proxy.DirectorFunc{removeUCPHeaders},
+		Transport:     otelhttp.NewTransport(transport),
+	}
+
+	logger.Info(fmt.Sprintf("proxying request to Kubernetes plane %s", planeID.String()))
+	builder.Build().ServeHTTP(w, req.WithContext(ctx))
+
+	// The upstream response has already been sent at this point. Therefore, return nil response here.
+	return nil, nil
+}
+
+// authorize checks that the request may use the credential selected by its credential name header. The header is set
+// by the caller, so the credential must be allowed by the authorizer. Requests without the header use the default
+// credential.
+func (p *ProxyController) authorize(ctx context.Context, req *http.Request, planeType string, planeID resources.ID) (armrpc_rest.Response, error) {
+	name := req.Header.Get(sdk_cred.CredentialNameHeader)
+	if name == "" {
+		return nil, nil
+	}
+
+	var err error = &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: planeID.String()}
+	if p.authorizer != nil {
+		err = p.authorizer.Authorize(ctx, planeType, name, planeID)
+	}
+	if errors.Is(err, &sdk_cred.ErrCredentialNotAllowed{}) {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// removeUCPHeaders removes the headers meant for UCP from the request. The API server is authenticated with the
+// credential of the plane, which is not applied by the transport if the request already has an authorization header.
+func removeUCPHeaders(r *http.Request) {
+	r.Header.Del("Authorization")
+	r.Header.Del(sdk_cred.CredentialNameHeader)
+}
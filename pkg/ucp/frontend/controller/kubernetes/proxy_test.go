@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/to"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/rest"
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func createProxyController(t *testing.T) (*ProxyController, *store.MockStorageClient, *secret.MockClient) {
+	ctrl := gomock.NewController(t)
+	storageClient := store.NewMockStorageClient(ctrl)
+	secretClient := secret.NewMockClient(ctrl)
+
+	p, err := NewProxyController(armrpc_controller.Options{StorageClient: storageClient}, secretClient, allowDeployer)
+	require.NoError(t, err)
+
+	return p.(*ProxyController), storageClient, secretClient
+}
+
+// allowDeployer allows the requests for the plane prod to use the credential deployer.
+var allowDeployer = sdk_cred.CredentialAuthorizerFunc(func(ctx context.Context, planeType string, name string, id resources.ID) error {
+	if planeType != "kubernetes" || name != "deployer" || id.String() != "/planes/kubernetes/prod" {
+		return &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	}
+	return nil
+})
+
+func newKubernetesPlane(url string) *store.Object {
+	return &store.Object{
+		Data: datamodel.Plane{
+			Properties: datamodel.PlaneProperties{
+				Kind: rest.PlaneKindKubernetes,
+				URL:  to.Ptr(url),
+			},
+		},
+	}
+}
+
+func Test_Proxy_Run(t *testing.T) {
+	planeID := "/planes/kubernetes/prod"
+	token, err := json.Marshal(sdk_cred.KubernetesCredential{
+		Kind:  datamodel.KubernetesServiceAccountTokenCredentialKind,
+		Token: "service-account-token",
+	})
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		var downstream *http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			downstream = r
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		p, storageClient, secretClient := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane(server.URL), nil).Times(1)
+		secretClient.EXPECT().Get(gomock.Any(), "kubernetes-prod-default").Return(token, nil).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces/default/pods?limit=10", nil)
+		req.Header.Set("Authorization", "Bearer ucp-token")
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		require.NotNil(t, downstream)
+		require.Equal(t, "/api/v1/namespaces/default/pods", downstream.URL.Path)
+		require.Equal(t, "limit=10", downstream.URL.RawQuery)
+		require.Equal(t, "Bearer service-account-token", downstream.Header.Get("Authorization"))
+	})
+
+	t.Run("selected credential", func(t *testing.T) {
+		var downstream *http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			downstream = r
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		p, storageClient, secretClient := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane(server.URL), nil).Times(1)
+		secretClient.EXPECT().Get(gomock.Any(), "kubernetes-prod-deployer").Return(token, nil).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "deployer")
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Nil(t, response)
+
+		require.NotNil(t, downstream)
+		require.Empty(t, downstream.Header.Get(sdk_cred.CredentialNameHeader))
+	})
+
+	t.Run("credential not selected by an environment", func(t *testing.T) {
+		p, storageClient, _ := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane("https://prod.example.com:6443"), nil).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "admin")
+
+		// The credential is rejected before it is read from the secret store.
+		response, err := p.Run(ctx, httptest.NewRecorder(), req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("credential \"admin\" is not selected by the providers of an environment for \"/planes/kubernetes/prod\""), response)
+	})
+
+	t.Run("no authorizer", func(t *testing.T) {
+		storageClient := store.NewMockStorageClient(gomock.NewController(t))
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane("https://prod.example.com:6443"), nil).Times(1)
+		p, err := NewProxyController(armrpc_controller.Options{StorageClient: storageClient}, secret.NewMockClient(gomock.NewController(t)), nil)
+		require.NoError(t, err)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "deployer")
+
+		response, err := p.Run(ctx, httptest.NewRecorder(), req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("credential \"deployer\" is not selected by the providers of an environment for \"/planes/kubernetes/prod\""), response)
+	})
+
+	t.Run("plane not found", func(t *testing.T) {
+		p, storageClient, _ := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(nil, &store.ErrNotFound{ID: planeID}).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+
+		response, err := p.Run(ctx, httptest.NewRecorder(), req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNotFoundResponse(resources.MustParse(planeID)), response)
+	})
+
+	t.Run("plane without url", func(t *testing.T) {
+		p, storageClient, _ := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane(""), nil).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+
+		response, err := p.Run(ctx, httptest.NewRecorder(), req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("plane /planes/kubernetes/prod is not a Kubernetes cluster with a URL"), response)
+	})
+
+	t.Run("credential not registered", func(t *testing.T) {
+		p, storageClient, secretClient := createProxyController(t)
+		storageClient.EXPECT().Get(gomock.Any(), planeID, gomock.Any()).Return(newKubernetesPlane("https://prod.example.com:6443"), nil).Times(1)
+		secretClient.EXPECT().Get(gomock.Any(), "kubernetes-prod-default").Return(nil, &secret.ErrNotFound{}).Times(1)
+
+		ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{})
+		req := httptest.NewRequest(http.MethodGet, planeID+"/api/v1/namespaces", nil)
+
+		response, err := p.Run(ctx, httptest.NewRecorder(), req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("credential /planes/kubernetes/prod/providers/System.Kubernetes/credentials/default is not registered"), response)
+	})
+}
@@ -17,14 +17,18 @@ package planes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	http "net/http"
 	"net/url"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/middleware"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -42,13 +46,25 @@ var _ armrpc_controller.Controller = (*ProxyController)(nil)
 // ProxyController is the controller implementation to proxy requests to appropriate RP or URL.
 type ProxyController struct {
 	armrpc_controller.Operation[*datamodel.Plane, datamodel.Plane]
+
+	// credential authenticates the requests which select a credential of the plane with the credential name header.
+	// Requests which do not select a credential are proxied with their own authorization. This may be nil when the
+	// plane has no credentials.
+	credential azcore.TokenCredential
+
+	// authorizer checks that the requests may use the credential of the plane they select. Requests selecting a
+	// credential other than the default credential are rejected when it is nil.
+	authorizer sdk_cred.CredentialAuthorizer
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
-// controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// controller cannot be created. The credential authenticates the requests which select a credential of the plane, and
+// may be nil. The authorizer checks that the requests may use the credential they select.
+func NewProxyController(opts armrpc_controller.Options, credential azcore.TokenCredential, authorizer sdk_cred.CredentialAuthorizer) (armrpc_controller.Controller, error) {
 	return &ProxyController{
-		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		Operation:  armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.Plane]{}),
+		credential: credential,
+		authorizer: authorizer,
 	}, nil
 }
 
@@ -146,6 +162,10 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, err
 	}
 
+	if r, err := p.authorize(ctx, req, planeType, resourceID, downstream); r != nil || err != nil {
+		return r, err
+	}
+
 	options := proxy.ReverseProxyOptions{
 		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
 	}
@@ -189,6 +209,37 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	return nil, nil
 }
 
+// authorize authenticates the request with the credential of the plane selected by the credential name header of the
+// request, for example by a recipe deployment using the credential of its environment. A bad request response is
+// returned if the request may not use the credential for the resource id, or the credential fails to authenticate. The
+// token is requested for the downstream URL, so that the selected credential works with sovereign clouds as well.
+func (p *ProxyController) authorize(ctx context.Context, req *http.Request, planeType string, id resources.ID, downstream *url.URL) (armrpc_rest.Response, error) {
+	name := req.Header.Get(sdk_cred.CredentialNameHeader)
+	if name == "" || p.credential == nil {
+		return nil, nil
+	}
+
+	var err error = &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	if p.authorizer != nil {
+		err = p.authorizer.Authorize(ctx, planeType, name, id)
+	}
+	if errors.Is(err, &sdk_cred.ErrCredentialNotAllowed{}) {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	scope := downstream.Scheme + "://" + downstream.Host + "/.default"
+	token, err := p.credential.GetToken(sdk_cred.WithPlaneCredentialName(ctx, planeType, name), policy.TokenRequestOptions{Scopes: []string{scope}})
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("failed to authenticate with credential %q of the plane: %s", name, err.Error())), nil
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	req.Header.Del(sdk_cred.CredentialNameHeader)
+	return nil, nil
+}
+
 // trimPlanesPrefix trims the planes prefix from the request URL path.
 func trimPlanesPrefix(r *http.Request) {
 	_, _, remainder, err := resources.ExtractPlanesPrefixFromURLPath(r.URL.Path)
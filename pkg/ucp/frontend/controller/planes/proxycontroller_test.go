@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+type fakeCredential struct {
+	name   string
+	scopes []string
+	err    error
+}
+
+func (c *fakeCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.name = sdk_cred.PlaneCredentialName(ctx, "azure")
+	c.scopes = opts.Scopes
+	if c.err != nil {
+		return azcore.AccessToken{}, c.err
+	}
+	return azcore.AccessToken{Token: "token-of-" + c.name}, nil
+}
+
+// allowSubscription2 allows the requests for subscription 0000 to use the credential subscription2.
+var allowSubscription2 = sdk_cred.CredentialAuthorizerFunc(func(ctx context.Context, planeType string, name string, id resources.ID) error {
+	if planeType != "azure" || name != "subscription2" || id.FindScope("subscriptions") != "0000" {
+		return &sdk_cred.ErrCredentialNotAllowed{Name: name, ID: id.String()}
+	}
+	return nil
+})
+
+func Test_ProxyController_Authorize(t *testing.T) {
+	downstream, err := url.Parse("https://management.azure.com")
+	require.NoError(t, err)
+
+	id := resources.MustParse("/planes/azure/azurecloud/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa")
+
+	t.Run("selected credential", func(t *testing.T) {
+		credential := &fakeCredential{}
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, credential, allowSubscription2)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set("Authorization", "Bearer caller-token")
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, "subscription2", credential.name)
+		require.Equal(t, []string{"https://management.azure.com/.default"}, credential.scopes)
+		require.Equal(t, "Bearer token-of-subscription2", req.Header.Get("Authorization"))
+		require.Empty(t, req.Header.Get(sdk_cred.CredentialNameHeader))
+	})
+
+	t.Run("no credential selected", func(t *testing.T) {
+		credential := &fakeCredential{}
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, credential, allowSubscription2)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set("Authorization", "Bearer caller-token")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Empty(t, credential.name)
+		require.Equal(t, "Bearer caller-token", req.Header.Get("Authorization"))
+	})
+
+	t.Run("plane without credential", func(t *testing.T) {
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, nil, allowSubscription2)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Empty(t, req.Header.Get("Authorization"))
+	})
+
+	t.Run("credential not allowed", func(t *testing.T) {
+		other := resources.MustParse("/planes/azure/azurecloud/subscriptions/1111/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa")
+		credential := &fakeCredential{}
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, credential, allowSubscription2)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, other.String(), nil)
+		req.Header.Set("Authorization", "Bearer caller-token")
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", other, downstream)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("credential \"subscription2\" is not selected by the providers of an environment for %q", other.String())), response)
+		require.Empty(t, credential.name)
+		require.Equal(t, "Bearer caller-token", req.Header.Get("Authorization"))
+	})
+
+	t.Run("no authorizer", func(t *testing.T) {
+		credential := &fakeCredential{}
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, credential, nil)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+		require.Empty(t, credential.name)
+	})
+
+	t.Run("authorizer fails", func(t *testing.T) {
+		failing := sdk_cred.CredentialAuthorizerFunc(func(ctx context.Context, planeType string, name string, id resources.ID) error {
+			return errors.New("store unavailable")
+		})
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, &fakeCredential{}, failing)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.EqualError(t, err, "store unavailable")
+		require.Nil(t, response)
+	})
+
+	t.Run("credential fails", func(t *testing.T) {
+		credential := &fakeCredential{err: errors.New("credential not found")}
+		ctrl, err := NewProxyController(armrpc_controller.Options{}, credential, allowSubscription2)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, id.String(), nil)
+		req.Header.Set(sdk_cred.CredentialNameHeader, "subscription2")
+
+		response, err := ctrl.(*ProxyController).authorize(context.Background(), req, "azure", id, downstream)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewBadRequestResponse("failed to authenticate with credential \"subscription2\" of the plane: credential not found"), response)
+	})
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
+	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/radius-project/radius/pkg/ucp/trackedresource"
@@ -298,7 +299,12 @@ retry:
 		return nil
 	}
 
-	err := p.StatusManager().QueueAsyncOperation(ctx, &serviceCtx, statusmanager.QueueOperationOptions{OperationTimeout: ProcessOperationTimeout, RetryAfter: ProcessOperationRetryAfter})
+	err := p.StatusManager().QueueAsyncOperation(ctx, &serviceCtx, statusmanager.QueueOperationOptions{
+		OperationTimeout: ProcessOperationTimeout,
+		RetryAfter:       ProcessOperationRetryAfter,
+		// Tracked resource updates happen in the background and should not delay user operations.
+		Priority: queue.PriorityLow,
+	})
 	if err != nil {
 		return err
 	}
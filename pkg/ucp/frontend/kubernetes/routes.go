@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials"
+	kubernetes_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/kubernetes"
+	kubernetes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/kubernetes"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeScope               = "/planes/kubernetes/{planeName}"
+	credentialResourcePath   = "/providers/System.Kubernetes/credentials/{credentialName}"
+	credentialCollectionPath = "/providers/System.Kubernetes/credentials"
+
+	// OperationTypeUCPKubernetesProxy is the operation type for proxying Kubernetes API calls.
+	OperationTypeUCPKubernetesProxy = "UCPKUBERNETESPROXY"
+)
+
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseRouter := server.NewSubrouter(m.router, m.options.PathBase+planeScope)
+
+	// URL for operations on System.Kubernetes provider.
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					armrpc_controller.ResourceOptions[datamodel.KubernetesCredential]{
+						RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+						ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					armrpc_controller.ResourceOptions[datamodel.KubernetesCredential]{
+						RequestConverter:  converter.KubernetesCredentialDataModelFromVersioned,
+						ResponseConverter: converter.KubernetesCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return kubernetes_credential_ctrl.NewCreateOrUpdateKubernetesCredential(opt, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return kubernetes_credential_ctrl.NewDeleteKubernetesCredential(opt, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Path:         "/rotate",
+			Method:       credentials.OperationRotate,
+			ResourceType: v20231001preview.KubernetesCredentialType,
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return kubernetes_credential_ctrl.NewRotateKubernetesCredential(opt, secretClient)
+			},
+		},
+
+		// Chi router uses radix tree so that it doesn't linear search the matched one. So, to catch all requests,
+		// we need to use CatchAllPath(/*) at the above matched routes path in chi router.
+		//
+		// Note that the API validation is not applied for CatchAllPath(/*).
+		{
+			// Method deliberately omitted. This is a catch-all route for proxying.
+			ParentRouter:  baseRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPKubernetesProxy, Method: v1.OperationProxy},
+			ControllerFactory: func(opt armrpc_controller.Options) (armrpc_controller.Controller, error) {
+				return kubernetes_ctrl.NewProxyController(opt, secretClient, sdk_cred.NewEnvironmentCredentialAuthorizer(m.options.DataProvider))
+			},
+		},
+	}
+
+	ctrlOpts := armrpc_controller.Options{
+		Address:      m.options.Address,
+		PathBase:     m.options.PathBase,
+		DataProvider: m.options.DataProvider,
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
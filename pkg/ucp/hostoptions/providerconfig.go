@@ -39,6 +39,7 @@ type UCPConfig struct {
 	TracerProvider   trace.Options                            `yaml:"tracerProvider"`
 	Logging          ucplog.LoggingOptions                    `yaml:"logging"`
 	Identity         Identity                                 `yaml:"identity,omitempty"`
+	Credentials      CredentialOptions                        `yaml:"credentials,omitempty"`
 	UCP              config.UCPOptions                        `yaml:"ucp"`
 	Location         string                                   `yaml:"location"`
 }
@@ -55,3 +56,15 @@ type Identity struct {
 	// AuthMethod represents the method of authentication for authenticating with external systems like Azure and AWS.
 	AuthMethod string `yaml:"authMethod"`
 }
+
+// CredentialOptions represents configuration options for the credentials registered with UCP.
+type CredentialOptions struct {
+	// SkipValidation disables the validation of credentials against the cloud provider when they are registered or rotated.
+	SkipValidation bool `yaml:"skipValidation,omitempty"`
+
+	// ExpiryCheckInterval is the interval between the checks for expiring credentials. Defaults to 1h.
+	ExpiryCheckInterval string `yaml:"expiryCheckInterval,omitempty"`
+
+	// ExpiryWarningThreshold is the remaining lifetime under which warnings are emitted for an expiring credential. Defaults to 168h.
+	ExpiryWarningThreshold string `yaml:"expiryWarningThreshold,omitempty"`
+}
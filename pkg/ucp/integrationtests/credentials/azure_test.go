@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	azureCredentialResourceURL   = "/planes/azure/azuretest/providers/System.Azure/credentials/default?api-version=2023-10-01-preview"
+	azureCredentialCollectionURL = "/planes/azure/azuretest/providers/System.Azure/credentials?api-version=2023-10-01-preview"
+	azureCredentialRotateURL     = "/planes/azure/azuretest/providers/System.Azure/credentials/default/rotate?api-version=2023-10-01-preview"
+)
+
+func Test_AzureCredential_Operations(t *testing.T) {
+	server := startWithFakeValidators(t, nil)
+	defer server.Close()
+
+	// Create the credential, twice.
+	response := server.MakeTypedRequest(http.MethodPut, azureCredentialResourceURL, getAzureTestCredentialObject("secret"))
+	response.EqualsStatusCode(http.StatusOK)
+	require.Equal(t, getExpectedAzureTestCredentialObject(), readAzureTestCredential(t, response.Body.Bytes()))
+
+	response = server.MakeTypedRequest(http.MethodPut, azureCredentialResourceURL, getAzureTestCredentialObject("secret"))
+	response.EqualsStatusCode(http.StatusOK)
+	require.Equal(t, getExpectedAzureTestCredentialObject(), readAzureTestCredential(t, response.Body.Bytes()))
+
+	// List the credentials.
+	response = server.MakeRequest(http.MethodGet, azureCredentialCollectionURL, nil)
+	response.EqualsStatusCode(http.StatusOK)
+	list := struct {
+		Value []json.RawMessage `json:"value"`
+	}{}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &list))
+	require.Len(t, list.Value, 1)
+	require.Equal(t, getExpectedAzureTestCredentialObject(), readAzureTestCredential(t, list.Value[0]))
+
+	// Get the credential.
+	response = server.MakeRequest(http.MethodGet, azureCredentialResourceURL, nil)
+	response.EqualsStatusCode(http.StatusOK)
+	require.Equal(t, getExpectedAzureTestCredentialObject(), readAzureTestCredential(t, response.Body.Bytes()))
+
+	// Rotate the credential.
+	response = server.MakeTypedRequest(http.MethodPost, azureCredentialRotateURL, getAzureTestCredentialObject("rotated"))
+	response.EqualsStatusCode(http.StatusOK)
+	require.Equal(t, getExpectedAzureTestCredentialObject(), readAzureTestCredential(t, response.Body.Bytes()))
+
+	// Delete the credential, and the credential which no longer exists.
+	response = server.MakeRequest(http.MethodDelete, azureCredentialResourceURL, nil)
+	response.EqualsStatusCode(http.StatusOK)
+
+	response = server.MakeRequest(http.MethodDelete, azureCredentialResourceURL, nil)
+	response.EqualsStatusCode(http.StatusNoContent)
+
+	response = server.MakeRequest(http.MethodGet, azureCredentialResourceURL, nil)
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+}
+
+func Test_AzureCredential_InvalidCredential(t *testing.T) {
+	server := startWithFakeValidators(t, errors.New("invalid client secret provided"))
+	defer server.Close()
+
+	response := server.MakeTypedRequest(http.MethodPut, azureCredentialResourceURL, getAzureTestCredentialObject("secret"))
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+
+	response = server.MakeRequest(http.MethodGet, azureCredentialResourceURL, nil)
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+}
+
+// readAzureTestCredential reads the credential in the body of a response and checks that its rotation time is recorded.
+func readAzureTestCredential(t *testing.T, body []byte) ucp.AzureCredentialResource {
+	credential := ucp.AzureCredentialResource{}
+	require.NoError(t, json.Unmarshal(body, &credential))
+	credentialtest.RequireLastRotatedAt(t, &credential)
+	return credential
+}
+
+func getAzureTestCredentialObject(clientSecret string) ucp.AzureCredentialResource {
+	return ucp.AzureCredentialResource{
+		Location: to.Ptr("global"),
+		ID:       to.Ptr("/planes/azure/azuretest/providers/System.Azure/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Azure/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &ucp.AzureServicePrincipalProperties{
+			ClientID:     to.Ptr("00000000-0000-0000-0000-000000000000"),
+			TenantID:     to.Ptr("00000000-0000-0000-0000-000000000000"),
+			ClientSecret: to.Ptr(clientSecret),
+			Kind:         to.Ptr(ucp.AzureCredentialKindServicePrincipal),
+			Storage: &ucp.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(ucp.CredentialStorageKindInternal),
+				SecretName: to.Ptr("azure-azuretest-default"),
+			},
+		},
+	}
+}
+
+func getExpectedAzureTestCredentialObject() ucp.AzureCredentialResource {
+	return ucp.AzureCredentialResource{
+		Location: to.Ptr("global"),
+		ID:       to.Ptr("/planes/azure/azuretest/providers/System.Azure/credentials/default"),
+		Name:     to.Ptr("default"),
+		Type:     to.Ptr("System.Azure/credentials"),
+		Tags: map[string]*string{
+			"env": to.Ptr("dev"),
+		},
+		Properties: &ucp.AzureServicePrincipalProperties{
+			ClientID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			TenantID: to.Ptr("00000000-0000-0000-0000-000000000000"),
+			Kind:     to.Ptr(ucp.AzureCredentialKindServicePrincipal),
+			Storage: &ucp.InternalCredentialStorageProperties{
+				Kind:       to.Ptr(ucp.CredentialStorageKindInternal),
+				SecretName: to.Ptr("azure-azuretest-default"),
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/frontend/api"
+	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
+	azure_frontend "github.com/radius-project/radius/pkg/ucp/frontend/azure"
+	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
+	"github.com/radius-project/radius/pkg/ucp/integrationtests/testserver"
+	"github.com/radius-project/radius/test/ucp/credentialtest"
+)
+
+// startWithFakeValidators starts a test server whose AWS and Azure credentials are validated by local fakes, which
+// fail with err, instead of AWS and Azure. The tests register credentials with fake keys.
+func startWithFakeValidators(t *testing.T, err error) *testserver.TestServer {
+	return testserver.StartWithETCD(t, func(options modules.Options) []modules.Initializer {
+		initializers := api.DefaultModules(options)
+		for _, initializer := range initializers {
+			switch module := initializer.(type) {
+			case *aws_frontend.Module:
+				module.CredentialValidator = credentialtest.NewFakeValidator[sdk_cred.AWSCredential](err)
+			case *azure_frontend.Module:
+				module.CredentialValidator = credentialtest.NewFakeValidator[sdk_cred.AzureCredential](err)
+			}
+		}
+		return initializers
+	})
+}
@@ -257,7 +257,9 @@ func Test_RadiusPlane_ResourceAsync(t *testing.T) {
 		err := json.Unmarshal(response.Body.Bytes(), resource)
 		require.NoError(t, err)
 		require.Equal(t, message, *resource.Properties.Message)
-		require.Equal(t, string(v1.ProvisioningStateAccepted), *resource.Properties.ProvisioningState)
+
+		// The worker is notified of the operation and may have started it, so the resource is Accepted or Updating.
+		require.False(t, v1.ProvisioningState(*resource.Properties.ProvisioningState).IsTerminal())
 	})
 
 	t.Run("Complete PUT", func(t *testing.T) {
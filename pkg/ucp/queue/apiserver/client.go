@@ -58,6 +58,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/queue/client"
@@ -76,6 +77,14 @@ const (
 	LabelQueueName = "ucp.dev/queuename"
 	// LabelNextVisibleAt is the label representing the time when message is visible in the queue or requeued.
 	LabelNextVisibleAt = "ucp.dev/nextvisibleat"
+	// LabelPriority is the label representing the priority class of the message. Messages with the normal
+	// priority don't have this label.
+	LabelPriority = "ucp.dev/priority"
+	// LabelFairnessKey is the label representing the hash of the fairness key of the message. Messages without a
+	// fairness key don't have this label.
+	LabelFairnessKey = "ucp.dev/fairnesskey"
+	// AnnotationFairnessKey is the annotation storing the fairness key of the message, which can't be a label value.
+	AnnotationFairnessKey = "ucp.dev/fairnesskey"
 
 	defaultMessageLockDuration = time.Duration(5) * time.Minute
 	defaultExpiryDuration      = time.Duration(10) * time.Hour
@@ -88,6 +97,9 @@ type Client struct {
 	client runtimeclient.Client
 
 	opts Options
+
+	// round is the fairness round of the messages dequeued by this client.
+	round client.FairnessRound
 }
 
 // Options is the options to create apiserver queue client.
@@ -117,6 +129,25 @@ func getTimeFromString(s string) time.Time {
 	return time.Unix(0, nsec)
 }
 
+// priorityLabelValue returns the value of LabelPriority for the given priority, or an empty string for the normal priority.
+func priorityLabelValue(p client.Priority) string {
+	if p.Class() == client.PriorityNormal {
+		return ""
+	}
+	return strings.ToLower(p.Class().String())
+}
+
+func getPriorityFromLabel(s string) client.Priority {
+	switch s {
+	case priorityLabelValue(client.PriorityHigh):
+		return client.PriorityHigh
+	case priorityLabelValue(client.PriorityLow):
+		return client.PriorityLow
+	default:
+		return client.PriorityNormal
+	}
+}
+
 func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 	msg.Metadata = client.Metadata{
 		ID:            queueMessage.Name,
@@ -125,6 +156,8 @@ func copyMessage(msg *client.Message, queueMessage *v1alpha1.QueueMessage) {
 		ExpireAt:      queueMessage.Spec.ExpireAt.Time,
 		NextVisibleAt: getTimeFromString(queueMessage.Labels[LabelNextVisibleAt]),
 	}
+	msg.Priority = getPriorityFromLabel(queueMessage.Labels[LabelPriority])
+	msg.FairnessKey = queueMessage.Annotations[AnnotationFairnessKey]
 	msg.ContentType = client.JSONContentType
 	msg.Data = make([]byte, len(queueMessage.Spec.Data.Raw))
 	copy(msg.Data, queueMessage.Spec.Data.Raw)
@@ -188,6 +221,15 @@ func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...cl
 		},
 	}
 
+	if v := priorityLabelValue(msg.Priority); v != "" {
+		resource.Labels[LabelPriority] = v
+	}
+
+	if msg.FairnessKey != "" {
+		resource.Labels[LabelFairnessKey] = client.HashFairnessKey(msg.FairnessKey)
+		resource.Annotations = map[string]string{AnnotationFairnessKey: msg.FairnessKey}
+	}
+
 	return c.client.Create(ctx, resource)
 }
 
@@ -212,18 +254,99 @@ func newMessageLabelSelector(now time.Time, name string) (labels.Selector, error
 	return selector.Add(*nameLabel), nil
 }
 
-// getQueueMessage fetches the first item which is the message in the current queue. We can
-// determine whether the message is leased by another client by checking if `NextVisibleAt“
-// value is less than `now`.
+// getQueueMessage fetches the first item of the highest priority class whose fairness key was not dequeued in the
+// current round, which is the message in the current queue. We can determine whether the message is leased by another
+// client by checking if `NextVisibleAt“ value is less than `now`.
 func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.QueueMessage, error) {
-	ql := &v1alpha1.QueueMessageList{}
-
 	selector, err := newMessageLabelSelector(now, c.opts.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	err = c.client.List(
+	// Normal priority messages don't have the priority label.
+	high, err := labels.NewRequirement(LabelPriority, selection.Equals, []string{priorityLabelValue(client.PriorityHigh)})
+	if err != nil {
+		return nil, err
+	}
+	normal, err := labels.NewRequirement(LabelPriority, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	low, err := labels.NewRequirement(LabelPriority, selection.Equals, []string{priorityLabelValue(client.PriorityLow)})
+	if err != nil {
+		return nil, err
+	}
+
+	classes := []struct {
+		priority    client.Priority
+		requirement *labels.Requirement
+	}{
+		{client.PriorityHigh, high},
+		{client.PriorityNormal, normal},
+		{client.PriorityLow, low},
+	}
+
+	for _, class := range classes {
+		classSelector := selector.Add(*class.requirement)
+
+		fairSelector, err := c.unservedSelector(classSelector, class.priority)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := c.listFirst(ctx, fairSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every fairness key with a visible message was dequeued in this round.
+		if item == nil && c.round.Reset(class.priority) {
+			item, err = c.listFirst(ctx, classSelector)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if item != nil {
+			return item, nil
+		}
+	}
+
+	return nil, client.ErrMessageNotFound
+}
+
+// unservedSelector returns the selector excluding the messages whose fairness key was dequeued in the current round of
+// the priority class.
+func (c *Client) unservedSelector(selector labels.Selector, p client.Priority) (labels.Selector, error) {
+	hashes := []string{}
+	for _, hash := range c.round.ServedKeys(p) {
+		// Messages without a fairness key don't have the label.
+		if hash == "" {
+			exists, err := labels.NewRequirement(LabelFairnessKey, selection.Exists, nil)
+			if err != nil {
+				return nil, err
+			}
+			selector = selector.Add(*exists)
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+
+	if len(hashes) == 0 {
+		return selector, nil
+	}
+
+	notIn, err := labels.NewRequirement(LabelFairnessKey, selection.NotIn, hashes)
+	if err != nil {
+		return nil, err
+	}
+	return selector.Add(*notIn), nil
+}
+
+// listFirst returns the first message matching the selector, or nil if there is none.
+func (c *Client) listFirst(ctx context.Context, selector labels.Selector) (*v1alpha1.QueueMessage, error) {
+	ql := &v1alpha1.QueueMessageList{}
+	err := c.client.List(
 		ctx, ql,
 		runtimeclient.InNamespace(c.opts.Namespace),
 		runtimeclient.MatchingLabelsSelector{Selector: selector},
@@ -232,11 +355,10 @@ func (c *Client) getQueueMessage(ctx context.Context, now time.Time) (*v1alpha1.
 		return nil, err
 	}
 
-	if len(ql.Items) > 0 {
-		return &ql.Items[0], nil
+	if len(ql.Items) == 0 {
+		return nil, nil
 	}
-
-	return nil, client.ErrMessageNotFound
+	return &ql.Items[0], nil
 }
 
 // extendItem udpates LabelNextVisibleAt to extend the lease time of message. Dequeue and ExtendMessage
@@ -305,6 +427,8 @@ func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*c
 		if err != nil {
 			return err
 		}
+
+		c.round.Serve(getPriorityFromLabel(item.Labels[LabelPriority]), item.Labels[LabelFairnessKey])
 		return nil
 	})
 
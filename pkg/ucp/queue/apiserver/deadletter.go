@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+
+	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LabelDeadLetterQueueName is the label representing the name of the queue a dead-lettered message belongs to.
+	// Dead-lettered messages don't have LabelQueueName so they are never dequeued.
+	LabelDeadLetterQueueName = "ucp.dev/deadletterqueuename"
+
+	// AnnotationDeadLetterReason is the annotation representing the reason the message was dead-lettered.
+	AnnotationDeadLetterReason = "ucp.dev/deadletterreason"
+	// AnnotationDeadLetterLastError is the annotation representing the last error observed while processing the message.
+	AnnotationDeadLetterLastError = "ucp.dev/deadletterlasterror"
+	// AnnotationDeadLetteredAt is the annotation representing the time when the message was dead-lettered.
+	AnnotationDeadLetteredAt = "ucp.dev/deadletteredat"
+)
+
+var _ client.DeadLetterQueue = (*Client)(nil)
+
+func copyDeadLetterMessage(queueMessage *v1alpha1.QueueMessage) *client.DeadLetterMessage {
+	msg := &client.DeadLetterMessage{
+		DeadLetterInfo: client.DeadLetterInfo{
+			Reason:    queueMessage.Annotations[AnnotationDeadLetterReason],
+			LastError: queueMessage.Annotations[AnnotationDeadLetterLastError],
+		},
+	}
+	copyMessage(&msg.Message, queueMessage)
+
+	if at, err := time.Parse(time.RFC3339Nano, queueMessage.Annotations[AnnotationDeadLetteredAt]); err == nil {
+		msg.DeadLetteredAt = at
+	}
+
+	return msg
+}
+
+// getDeadLetter gets the dead-lettered QueueMessage CR. It returns ErrDeadLetterNotFound if the CR doesn't exist
+// or is not dead-lettered in this queue.
+func (c *Client) getDeadLetter(ctx context.Context, id string) (*v1alpha1.QueueMessage, error) {
+	result := &v1alpha1.QueueMessage{}
+	err := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: id}, result)
+	if apierrors.IsNotFound(err) {
+		return nil, client.ErrDeadLetterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if result.Labels[LabelDeadLetterQueueName] != c.opts.Name {
+		return nil, client.ErrDeadLetterNotFound
+	}
+
+	return result, nil
+}
+
+// DeadLetter moves the leased message to the dead-letter queue by replacing its queue name label with
+// LabelDeadLetterQueueName. The reason and the last error are stored in annotations.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, info client.DeadLetterInfo) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	result := &v1alpha1.QueueMessage{}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		getErr := c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: c.opts.Namespace, Name: msg.ID}, result)
+		if apierrors.IsNotFound(getErr) {
+			return client.ErrInvalidMessage
+		} else if getErr != nil {
+			return getErr
+		}
+
+		if result.Labels[LabelQueueName] != c.opts.Name {
+			return client.ErrInvalidMessage
+		}
+
+		// Ensure that it doesn't dead-letter the message that another client leased.
+		if result.Spec.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		delete(result.Labels, LabelQueueName)
+		result.Labels[LabelDeadLetterQueueName] = c.opts.Name
+
+		if result.Annotations == nil {
+			result.Annotations = map[string]string{}
+		}
+		result.Annotations[AnnotationDeadLetterReason] = info.Reason
+		result.Annotations[AnnotationDeadLetterLastError] = info.LastError
+		result.Annotations[AnnotationDeadLetteredAt] = time.Now().UTC().Format(time.RFC3339Nano)
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.DeadLetterMessage, error) {
+	ql := &v1alpha1.QueueMessageList{}
+	err := c.client.List(
+		ctx, ql,
+		runtimeclient.InNamespace(c.opts.Namespace),
+		runtimeclient.MatchingLabels{LabelDeadLetterQueueName: c.opts.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*client.DeadLetterMessage{}
+	for i := range ql.Items {
+		result = append(result, copyDeadLetterMessage(&ql.Items[i]))
+	}
+
+	return result, nil
+}
+
+// GetDeadLetter gets the message from the dead-letter queue.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.DeadLetterMessage, error) {
+	result, err := c.getDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyDeadLetterMessage(result), nil
+}
+
+// RequeueDeadLetter moves the message from the dead-letter queue back to the queue. The message is visible
+// immediately and its dequeue count is reset.
+func (c *Client) RequeueDeadLetter(ctx context.Context, id string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		result, err := c.getDeadLetter(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		delete(result.Labels, LabelDeadLetterQueueName)
+		result.Labels[LabelQueueName] = c.opts.Name
+		result.Labels[LabelNextVisibleAt] = int64toa(now.UnixNano())
+
+		delete(result.Annotations, AnnotationDeadLetterReason)
+		delete(result.Annotations, AnnotationDeadLetterLastError)
+		delete(result.Annotations, AnnotationDeadLetteredAt)
+
+		result.Spec.DequeueCount = 0
+		result.Spec.ExpireAt.Time = now.Add(c.opts.ExpiryDuration).UTC()
+
+		return c.client.Update(ctx, result)
+	})
+}
+
+// DeleteDeadLetter deletes the message from the dead-letter queue.
+func (c *Client) DeleteDeadLetter(ctx context.Context, id string) error {
+	result, err := c.getDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.client.Delete(ctx, result)
+	if apierrors.IsNotFound(err) {
+		return client.ErrDeadLetterNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	v1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// watchRetryInterval is the duration to wait before watching the queue messages again when the watch fails.
+	watchRetryInterval = time.Duration(5) * time.Second
+)
+
+var _ client.Notifier = (*Client)(nil)
+
+// Notify returns a channel that receives a value when a message is enqueued or requeued from the dead-letter queue.
+// It watches the QueueMessage resources of the queue, so the Kubernetes client must support watch. The watch is
+// restarted when it is closed by the server.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("the Kubernetes client does not support watch")
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{LabelQueueName: c.opts.Name})
+
+	ch := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+
+	go func() {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		defer close(ch)
+
+		for {
+			watcher, err := wc.Watch(ctx, &v1alpha1.QueueMessageList{}, &runtimeclient.ListOptions{Namespace: c.opts.Namespace, LabelSelector: selector})
+			if err != nil {
+				logger.Error(err, "failed to watch queue messages", "queue", c.opts.Name)
+			} else {
+				c.watchMessages(ctx, watcher, notify)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchRetryInterval):
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// watchMessages calls notify for the messages added to the queue until the watch is closed or ctx is done.
+func (c *Client) watchMessages(ctx context.Context, watcher watch.Interface, notify func()) {
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+
+			// Messages requeued from the dead-letter queue start matching the selector so they are added as well.
+			// Modified events are ignored since they are sent when a message is leased.
+			if event.Type == watch.Added {
+				notify()
+			}
+		}
+	}
+}
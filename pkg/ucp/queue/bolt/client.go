@@ -0,0 +1,308 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bolt is a file-backed queue implementation using a bolt database. It is intended for single-binary
+// development and CI installations where the queue must survive restarts. It is not suitable for production use.
+//
+// Each named queue is stored in its own bucket. Messages are keyed by the bucket sequence number encoded in big-endian
+// order so that a cursor visits them in FIFO order. Since bolt serializes write transactions, Dequeue can safely
+// find and lease the first visible message in a single transaction.
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	bucketPrefix = "queue|"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+)
+
+var _ client.Client = (*Client)(nil)
+var _ client.Notifier = (*Client)(nil)
+
+// Client is the file-backed queue client used for dev and test purpose.
+type Client struct {
+	db   *bolt.DB
+	opts Options
+
+	// notifications notifies the consumers when a message is enqueued or a message lock is released. Only the
+	// messages enqueued by this client are notified, which is enough since the database is used by a single process.
+	notifications client.Broadcaster
+
+	// round is the fairness round of the dequeued messages.
+	round client.FairnessRound
+}
+
+// Options is the options to create bolt queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+}
+
+// New creates the queue backed by the given bolt database. name is unique name for each service which will consume the queue.
+func New(db *bolt.DB, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	c := &Client{db: db, opts: options}
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(c.bucketName())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Client) bucketName() []byte {
+	return []byte(bucketPrefix + c.opts.Name)
+}
+
+func idToKey(id string) ([]byte, error) {
+	seq, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, client.ErrInvalidMessage
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key, nil
+}
+
+func keyToID(key []byte) string {
+	return strconv.FormatUint(binary.BigEndian.Uint64(key), 10)
+}
+
+// Enqueue enqueues message to the queue.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, seq)
+
+		now := time.Now().UTC()
+		stored := client.Message{
+			Metadata: client.Metadata{
+				ID:            keyToID(key),
+				DequeueCount:  0,
+				EnqueueAt:     now,
+				ExpireAt:      now.Add(c.opts.ExpiryDuration),
+				NextVisibleAt: now,
+			},
+			Priority:    msg.Priority,
+			FairnessKey: msg.FairnessKey,
+			ContentType: msg.ContentType,
+			Data:        msg.Data,
+		}
+
+		b, err := json.Marshal(&stored)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, b)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.notifications.Broadcast()
+	return nil
+}
+
+// Notify returns a channel that receives a value when a message is enqueued or a message lock is released.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.notifications.Notify(ctx)
+}
+
+// Dequeue leases the first visible message of the highest priority class whose fairness key is next in turn.
+// Expired messages are removed while scanning.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	var found *client.Message
+
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		cursor := bucket.Cursor()
+		now := time.Now()
+
+		// Deleting with the cursor while iterating skips the next key, so the expired messages are deleted after
+		// the scan.
+		var visibleKeys, expiredKeys [][]byte
+		var visible []*client.Message
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			msg := &client.Message{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			if msg.ExpireAt.Before(now) {
+				expiredKeys = append(expiredKeys, append([]byte{}, k...))
+				continue
+			}
+
+			if msg.NextVisibleAt.After(now) {
+				continue
+			}
+
+			visibleKeys = append(visibleKeys, append([]byte{}, k...))
+			visible = append(visible, msg)
+		}
+
+		for _, k := range expiredKeys {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		// Returning an error would roll back the deletion of the expired messages, so not finding a message is
+		// reported after the transaction is committed.
+		i := c.round.Select(visible)
+		if i < 0 {
+			return nil
+		}
+		found = visible[i]
+
+		found.DequeueCount++
+		found.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+
+		b, err := json.Marshal(found)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(visibleKeys[i], b)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, client.ErrMessageNotFound
+	}
+
+	// Wake up the consumers when the lock is released in case the message is not finished.
+	time.AfterFunc(c.opts.MessageLockDuration, c.notifications.Broadcast)
+
+	return found, nil
+}
+
+// FinishMessage deletes the message from the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := idToKey(msg.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		if bucket.Get(key) == nil {
+			return client.ErrInvalidMessage
+		}
+		return bucket.Delete(key)
+	})
+}
+
+// ExtendMessage extends the message lock. The message must still be leased by the caller.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := idToKey(msg.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.Message{}
+		if err := json.Unmarshal(v, stored); err != nil {
+			return err
+		}
+
+		// DequeueCount is used as the revision of the lease, see the apiserver queue for details.
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		now := time.Now()
+		if stored.NextVisibleAt.Before(now) {
+			return client.ErrInvalidMessage
+		}
+
+		stored.NextVisibleAt = now.Add(c.opts.MessageLockDuration)
+		b, err := json.Marshal(stored)
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(key, b); err != nil {
+			return err
+		}
+
+		msg.Metadata = stored.Metadata
+		return nil
+	})
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestClient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.db")
+	db, err := bolt.Open(path, 0o600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	cli, err := New(db, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := db.Update(func(tx *bolt.Tx) error {
+			if err := tx.DeleteBucket(cli.deadLetterBucketName()); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+				return err
+			}
+			if err := tx.DeleteBucket(cli.bucketName()); err != nil {
+				return err
+			}
+			_, err := tx.CreateBucket(cli.bucketName())
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	sharedtest.RunTest(t, cli, clear)
+
+	t.Run("expired messages are removed when the queue is empty", func(t *testing.T) {
+		ctx := testcontext.New(t)
+
+		expiring, err := New(db, Options{Name: "expiring", ExpiryDuration: time.Millisecond})
+		require.NoError(t, err)
+
+		// Two adjacent expired messages make sure that deleting the first one does not skip the second one.
+		require.NoError(t, expiring.Enqueue(ctx, client.NewMessage("{}")))
+		require.NoError(t, expiring.Enqueue(ctx, client.NewMessage("{}")))
+		time.Sleep(10 * time.Millisecond)
+
+		_, err = expiring.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		err = db.View(func(tx *bolt.Tx) error {
+			require.Equal(t, 0, tx.Bucket(expiring.bucketName()).Stats().KeyN)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("messages survive reopening the database", func(t *testing.T) {
+		clear(t)
+		ctx := testcontext.New(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		require.NoError(t, db.Close())
+		db, err = bolt.Open(path, 0o600, nil)
+		require.NoError(t, err)
+
+		reopened, err := New(db, Options{Name: "applications.core"})
+		require.NoError(t, err)
+
+		msg, err := reopened.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []byte("{}"), msg.Data)
+		require.Equal(t, 1, msg.DequeueCount)
+	})
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	deadLetterBucketPrefix = "deadletter|"
+)
+
+var _ client.DeadLetterQueue = (*Client)(nil)
+
+func (c *Client) deadLetterBucketName() []byte {
+	return []byte(deadLetterBucketPrefix + c.opts.Name)
+}
+
+// DeadLetter moves the leased message to the dead-letter bucket of the queue. The message keeps its key so
+// that it can be requeued with the same ID.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, info client.DeadLetterInfo) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	key, err := idToKey(msg.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.bucketName())
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrInvalidMessage
+		}
+
+		stored := &client.DeadLetterMessage{}
+		if err := json.Unmarshal(v, &stored.Message); err != nil {
+			return err
+		}
+
+		// DequeueCount is used as the revision of the lease, see the apiserver queue for details.
+		if stored.DequeueCount != msg.DequeueCount {
+			return client.ErrDequeuedMessage
+		}
+
+		stored.DeadLetterInfo = info
+		stored.DeadLetteredAt = time.Now().UTC()
+
+		b, err := json.Marshal(stored)
+		if err != nil {
+			return err
+		}
+
+		deadLetters, err := tx.CreateBucketIfNotExists(c.deadLetterBucketName())
+		if err != nil {
+			return err
+		}
+
+		if err := deadLetters.Put(key, b); err != nil {
+			return err
+		}
+
+		return bucket.Delete(key)
+	})
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.DeadLetterMessage, error) {
+	result := []*client.DeadLetterMessage{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.deadLetterBucketName())
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			msg := &client.DeadLetterMessage{}
+			if err := json.Unmarshal(v, msg); err != nil {
+				return err
+			}
+
+			result = append(result, msg)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetDeadLetter gets the message from the dead-letter queue.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.DeadLetterMessage, error) {
+	key, err := idToKey(id)
+	if err != nil {
+		return nil, client.ErrDeadLetterNotFound
+	}
+
+	msg := &client.DeadLetterMessage{}
+	err = c.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.deadLetterBucketName())
+		if bucket == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		v := bucket.Get(key)
+		if v == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		return json.Unmarshal(v, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// RequeueDeadLetter moves the message from the dead-letter queue back to the queue. The message is visible
+// immediately and its dequeue count is reset.
+func (c *Client) RequeueDeadLetter(ctx context.Context, id string) error {
+	key, err := idToKey(id)
+	if err != nil {
+		return client.ErrDeadLetterNotFound
+	}
+
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		deadLetters := tx.Bucket(c.deadLetterBucketName())
+		if deadLetters == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		v := deadLetters.Get(key)
+		if v == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		dl := &client.DeadLetterMessage{}
+		if err := json.Unmarshal(v, dl); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		msg := dl.Message
+		msg.DequeueCount = 0
+		msg.NextVisibleAt = now
+		msg.ExpireAt = now.Add(c.opts.ExpiryDuration)
+
+		b, err := json.Marshal(&msg)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(c.bucketName()).Put(key, b); err != nil {
+			return err
+		}
+
+		return deadLetters.Delete(key)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.notifications.Broadcast()
+	return nil
+}
+
+// DeleteDeadLetter deletes the message from the dead-letter queue.
+func (c *Client) DeleteDeadLetter(ctx context.Context, id string) error {
+	key, err := idToKey(id)
+	if err != nil {
+		return client.ErrDeadLetterNotFound
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(c.deadLetterBucketName())
+		if bucket == nil || bucket.Get(key) == nil {
+			return client.ErrDeadLetterNotFound
+		}
+
+		return bucket.Delete(key)
+	})
+}
@@ -59,30 +59,61 @@ type Client interface {
 }
 
 // StartDequeuer starts a dequeuer to consume the message from the queue and return the output channel.
+//
+// When the client implements Notifier, the dequeuer drains the queue as soon as it is notified and otherwise polls the
+// queue every IdleDequeueIntervalDuration, to pick up messages whose lock was released. Other clients are polled every
+// DequeueIntervalDuration.
 func StartDequeuer(ctx context.Context, cli Client, opts ...DequeueOptions) (<-chan *Message, error) {
 	log := ucplog.FromContextOrDiscard(ctx)
 	out := make(chan *Message, 1)
 
+	queueconfig := NewDequeueConfig(opts...)
+	if queueconfig.IdleDequeueIntervalDuration == time.Duration(0) {
+		queueconfig.IdleDequeueIntervalDuration = defaultIdleDequeueInterval
+	}
+
+	var notifyCh <-chan struct{}
+	if notifier, ok := cli.(Notifier); ok {
+		var err error
+		notifyCh, err = notifier.Notify(ctx)
+		if err != nil {
+			log.Error(err, "fails to subscribe to queue notifications, falling back to polling")
+			notifyCh = nil
+		}
+	}
+
 	go func() {
 		for {
-			var queueconfig QueueClientConfig
-			if opts != nil {
-				queueconfig = NewDequeueConfig(opts...)
-			}
 			msg, err := cli.Dequeue(ctx, queueconfig)
 			if err == nil {
 				out <- msg
+
+				// Keep draining the queue while messages are available since no notification is sent for them.
+				if notifyCh != nil {
+					continue
+				}
 			} else if !errors.Is(err, ErrMessageNotFound) {
 				log.Error(err, "fails to dequeue the message")
 			}
 
+			interval := queueconfig.DequeueIntervalDuration
+			if notifyCh != nil {
+				interval = queueconfig.IdleDequeueIntervalDuration
+			}
+
 			select {
 			case <-ctx.Done():
 				close(out)
 				return
-			case <-time.After(queueconfig.DequeueIntervalDuration):
+			case _, ok := <-notifyCh:
+				if !ok {
+					if ctx.Err() == nil {
+						log.Info("queue notifications stopped, falling back to polling")
+					}
+					notifyCh = nil
+				}
+			case <-time.After(interval):
 			}
-
 		}
 	}()
 
@@ -79,3 +79,46 @@ func TestStartDequeuer(t *testing.T) {
 
 	require.Equal(t, 1, recvCnt)
 }
+
+// notifierClient is the client which notifies the consumers through notifyCh.
+type notifierClient struct {
+	*MockClient
+	notifyCh chan struct{}
+}
+
+func (c *notifierClient) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.notifyCh, nil
+}
+
+func TestStartDequeuer_Notifier(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	cli := &notifierClient{MockClient: NewMockClient(mctrl), notifyCh: make(chan struct{}, 1)}
+
+	testMessage := &Message{
+		Metadata:    Metadata{ID: "testID", DequeueCount: 1},
+		ContentType: JSONContentType,
+		Data:        []byte("{}"),
+	}
+
+	firstCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound)
+	secondCall := cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(testMessage, nil).After(firstCall)
+	cli.EXPECT().Dequeue(gomock.Any(), gomock.Any()).Return(nil, ErrMessageNotFound).AnyTimes().After(secondCall)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The queue is not polled, so the message is dequeued only after the notification.
+	msgCh, err := StartDequeuer(ctx, cli, WithDequeueInterval(time.Hour), WithIdleDequeueInterval(time.Hour))
+	require.NoError(t, err)
+
+	cli.notifyCh <- struct{}{}
+
+	select {
+	case msg := <-msgCh:
+		require.Equal(t, "testID", msg.ID)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "message is not dequeued after the notification")
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DeadLetterReasonMaxRetryCountExceeded is the reason used when a message was dequeued more times than allowed.
+	DeadLetterReasonMaxRetryCountExceeded = "MaxRetryCountExceeded"
+
+	// DeadLetterReasonInvalidMessage is the reason used when a message cannot be decoded.
+	DeadLetterReasonInvalidMessage = "InvalidMessage"
+
+	// DeadLetterReasonUnknownOperation is the reason used when no controller is registered for the operation of a message.
+	DeadLetterReasonUnknownOperation = "UnknownOperation"
+)
+
+var (
+	// ErrDeadLetterNotFound represents the error when the dead-lettered message does not exist.
+	ErrDeadLetterNotFound = errors.New("dead-lettered message not found")
+)
+
+// DeadLetterInfo describes why a message was moved to the dead-letter queue.
+type DeadLetterInfo struct {
+	// Reason is the reason the message was dead-lettered, for example DeadLetterReasonMaxRetryCountExceeded.
+	Reason string
+	// LastError is the last error observed while processing the message, if any.
+	LastError string
+	// DeadLetteredAt is the time when the message was dead-lettered. It is set by the queue.
+	DeadLetteredAt time.Time
+}
+
+// DeadLetterMessage represents a message in the dead-letter queue.
+type DeadLetterMessage struct {
+	Message
+
+	DeadLetterInfo
+}
+
+// DeadLetterQueue is an optional interface implemented by queue clients that keep messages that could not be processed.
+// Use DeadLetter() to dead-letter a message with any Client, including ones that don't implement DeadLetterQueue.
+//
+// Dead-lettered messages keep their ID and payload, and are never dequeued until they are requeued.
+type DeadLetterQueue interface {
+	// DeadLetter moves a dequeued message to the dead-letter queue. The message must still be leased by the caller.
+	DeadLetter(ctx context.Context, msg *Message, info DeadLetterInfo) error
+
+	// ListDeadLetters lists the messages in the dead-letter queue.
+	ListDeadLetters(ctx context.Context) ([]*DeadLetterMessage, error)
+
+	// GetDeadLetter gets the message with the given ID from the dead-letter queue, or returns ErrDeadLetterNotFound.
+	GetDeadLetter(ctx context.Context, id string) (*DeadLetterMessage, error)
+
+	// RequeueDeadLetter moves the message with the given ID back to the queue and resets its dequeue count.
+	RequeueDeadLetter(ctx context.Context, id string) error
+
+	// DeleteDeadLetter deletes the message with the given ID from the dead-letter queue.
+	DeleteDeadLetter(ctx context.Context, id string) error
+}
+
+// DeadLetter moves the message to the dead-letter queue when the client implements DeadLetterQueue, otherwise the
+// message is finished and its payload is discarded.
+func DeadLetter(ctx context.Context, cli Client, msg *Message, info DeadLetterInfo) error {
+	if msg == nil {
+		return ErrEmptyMessage
+	}
+
+	if dlq, ok := cli.(DeadLetterQueue); ok {
+		return dlq.DeadLetter(ctx, msg, info)
+	}
+
+	return cli.FinishMessage(ctx, msg)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+)
+
+// FairnessRound tracks the fairness keys dequeued in the current round of each priority class. Queue clients use it
+// to dequeue the messages of a priority class in round-robin order of their FairnessKey: the next message is the
+// oldest visible message whose key was not dequeued in the current round, and a new round starts once every key with
+// a visible message was dequeued. This keeps a key with a large backlog, such as the resource group of a large
+// deployment, from delaying the messages of the other keys.
+//
+// The zero value is ready to use.
+type FairnessRound struct {
+	mu     sync.Mutex
+	served map[Priority]map[string]struct{}
+}
+
+// IsServed returns true if a message with the key was dequeued in the current round of the priority class.
+func (r *FairnessRound) IsServed(p Priority, key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.served[p.Class()][key]
+	return ok
+}
+
+// ServedKeys returns the sorted keys dequeued in the current round of the priority class.
+func (r *FairnessRound) ServedKeys(p Priority) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := []string{}
+	for key := range r.served[p.Class()] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Serve records that a message with the key was dequeued in the current round of the priority class.
+func (r *FairnessRound) Serve(p Priority, key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.served == nil {
+		r.served = map[Priority]map[string]struct{}{}
+	}
+	if r.served[p.Class()] == nil {
+		r.served[p.Class()] = map[string]struct{}{}
+	}
+	r.served[p.Class()][key] = struct{}{}
+}
+
+// Reset starts a new round of the priority class. It returns false if no message was dequeued in the current round.
+func (r *FairnessRound) Reset(p Priority) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.served[p.Class()]) == 0 {
+		return false
+	}
+	delete(r.served, p.Class())
+	return true
+}
+
+// Select returns the index of the next message to dequeue among the visible messages of the queue in FIFO order, and
+// records its key as dequeued. It returns -1 if there is no visible message.
+func (r *FairnessRound) Select(visible []*Message) int {
+	for _, class := range []Priority{PriorityHigh, PriorityNormal, PriorityLow} {
+		first := -1
+		for i, msg := range visible {
+			if msg.Priority.Class() != class {
+				continue
+			}
+			if first < 0 {
+				first = i
+			}
+			if !r.IsServed(class, msg.FairnessKey) {
+				r.Serve(class, msg.FairnessKey)
+				return i
+			}
+		}
+
+		// Every key with a visible message was dequeued in this round.
+		if first >= 0 {
+			r.Reset(class)
+			r.Serve(class, visible[first].FairnessKey)
+			return first
+		}
+	}
+
+	return -1
+}
+
+// HashFairnessKey returns a fixed length hexadecimal hash of the fairness key which can be used where the key itself
+// is not allowed, such as Kubernetes label values and NATS subjects. It returns an empty string for an empty key.
+func HashFairnessKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:16])
+}
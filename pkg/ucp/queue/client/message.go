@@ -26,10 +26,53 @@ const (
 	JSONContentType = "application/json"
 )
 
+// Priority represents the priority class of a queue message. Messages with a higher priority are dequeued
+// before messages with a lower priority. Messages in the same priority class are dequeued in FIFO order, taking turns
+// between the fairness keys of the messages.
+type Priority int
+
+const (
+	// PriorityLow is the priority of background work which can wait for other messages, such as tracked resource updates.
+	PriorityLow Priority = -1
+	// PriorityNormal is the default priority.
+	PriorityNormal Priority = 0
+	// PriorityHigh is the priority of messages which should not wait behind other messages, such as deletes.
+	PriorityHigh Priority = 1
+)
+
+// String returns the name of the priority class.
+func (p Priority) String() string {
+	switch {
+	case p > PriorityNormal:
+		return "High"
+	case p < PriorityNormal:
+		return "Low"
+	default:
+		return "Normal"
+	}
+}
+
+// Class returns the priority class of p, clamping unknown values to the closest class.
+func (p Priority) Class() Priority {
+	switch {
+	case p > PriorityNormal:
+		return PriorityHigh
+	case p < PriorityNormal:
+		return PriorityLow
+	default:
+		return PriorityNormal
+	}
+}
+
 // Message represents message managed by queue.
 type Message struct {
 	Metadata
 
+	// Priority is the priority class of the message. It is set by the producer and kept by the queue.
+	Priority Priority
+	// FairnessKey groups the messages which take turns with the other groups in their priority class, such as the
+	// messages of a resource group. Messages without a key form a group. It is set by the producer and kept by the queue.
+	FairnessKey string
 	ContentType string
 	Data        []byte
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// Notifier is an optional interface implemented by queue clients that can notify consumers when a message may be
+// available. StartDequeuer waits for notifications instead of polling the queue when the client implements Notifier.
+type Notifier interface {
+	// Notify returns a channel that receives a value when a message may be available to dequeue, for example when
+	// a message is enqueued. Notifications are coalesced, so a single notification can stand for many messages, and
+	// they can be spurious. The channel is closed when ctx is done or when the client can no longer deliver
+	// notifications, in which case the consumer should fall back to polling.
+	Notify(ctx context.Context) (<-chan struct{}, error)
+}
+
+// Broadcaster delivers notifications to the channels returned by Notify. The zero value is ready to use.
+// Queue clients can use it to implement Notifier.
+type Broadcaster struct {
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+// Notify returns a channel that receives a value after each call to Broadcast. The channel is closed when ctx is done.
+func (b *Broadcaster) Notify(ctx context.Context) (<-chan struct{}, error) {
+	ch := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	if b.subs == nil {
+		b.subs = map[chan struct{}]struct{}{}
+	}
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs, ch)
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// Broadcast notifies all subscribers without blocking. A subscriber with a pending notification is not notified again.
+func (b *Broadcaster) Broadcast() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
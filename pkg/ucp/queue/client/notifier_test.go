@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcaster(t *testing.T) {
+	b := &Broadcaster{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch1, err := b.Notify(ctx)
+	require.NoError(t, err)
+	ch2, err := b.Notify(context.Background())
+	require.NoError(t, err)
+
+	// Notifications are coalesced.
+	b.Broadcast()
+	b.Broadcast()
+
+	for _, ch := range []<-chan struct{}{ch1, ch2} {
+		_, ok := <-ch
+		require.True(t, ok)
+		select {
+		case <-ch:
+			require.Fail(t, "notifications must be coalesced")
+		default:
+		}
+	}
+
+	// The channel is closed when ctx is done.
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-ch1
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	b.Broadcast()
+	_, ok := <-ch2
+	require.True(t, ok)
+}
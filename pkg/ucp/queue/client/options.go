@@ -18,6 +18,12 @@ package client
 
 import "time"
 
+const (
+	// defaultIdleDequeueInterval is the default duration between 2 successive dequeue attempts on an idle queue when
+	// the client implements Notifier.
+	defaultIdleDequeueInterval = time.Duration(5) * time.Second
+)
+
 type (
 	// EnqueueOptions applies an option to Enqueue().
 	EnqueueOptions interface {
@@ -41,6 +47,10 @@ type (
 type QueueClientConfig struct {
 	// DequeueIntervalDuration is the time duration between 2 successive dequeue attempts on the queue
 	DequeueIntervalDuration time.Duration
+
+	// IdleDequeueIntervalDuration is the time duration between 2 successive dequeue attempts on an idle queue when the
+	// client implements Notifier. Messages whose lock is released are dequeued within this duration.
+	IdleDequeueIntervalDuration time.Duration
 }
 
 type dequeueOptions struct {
@@ -62,6 +72,16 @@ func WithDequeueInterval(t time.Duration) DequeueOptions {
 	}
 }
 
+// WithIdleDequeueInterval sets dequeueing interval of an idle queue when the client implements Notifier.
+func WithIdleDequeueInterval(t time.Duration) DequeueOptions {
+	return &dequeueOptions{
+		fn: func(cfg QueueClientConfig) QueueClientConfig {
+			cfg.IdleDequeueIntervalDuration = t
+			return cfg
+		},
+	}
+}
+
 func (q dequeueOptions) private() {}
 
 // NewDequeueConfig returns new queue config for StartDequeuer().
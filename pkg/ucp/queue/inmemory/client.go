@@ -25,6 +25,8 @@ import (
 
 var namedQueue = &sync.Map{}
 var _ client.Client = (*Client)(nil)
+var _ client.DeadLetterQueue = (*Client)(nil)
+var _ client.Notifier = (*Client)(nil)
 
 // Client is the queue client used for dev and test purpose.
 type Client struct {
@@ -89,3 +91,43 @@ func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
 	}
 	return err
 }
+
+// DeadLetter moves the message to the dead-letter queue.
+func (c *Client) DeadLetter(ctx context.Context, msg *client.Message, info client.DeadLetterInfo) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	return c.queue.DeadLetter(msg, info)
+}
+
+// ListDeadLetters lists the messages in the dead-letter queue.
+func (c *Client) ListDeadLetters(ctx context.Context) ([]*client.DeadLetterMessage, error) {
+	return c.queue.DeadLetters(), nil
+}
+
+// GetDeadLetter gets the message from the dead-letter queue.
+func (c *Client) GetDeadLetter(ctx context.Context, id string) (*client.DeadLetterMessage, error) {
+	for _, dl := range c.queue.DeadLetters() {
+		if dl.ID == id {
+			return dl, nil
+		}
+	}
+
+	return nil, client.ErrDeadLetterNotFound
+}
+
+// RequeueDeadLetter moves the message from the dead-letter queue back to the queue.
+func (c *Client) RequeueDeadLetter(ctx context.Context, id string) error {
+	return c.queue.RequeueDeadLetter(id)
+}
+
+// DeleteDeadLetter deletes the message from the dead-letter queue.
+func (c *Client) DeleteDeadLetter(ctx context.Context, id string) error {
+	return c.queue.DeleteDeadLetter(id)
+}
+
+// Notify returns a channel that receives a value when a message is enqueued or a message lock is released.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.queue.Notify(ctx)
+}
@@ -18,6 +18,7 @@ package inmemory
 
 import (
 	"container/list"
+	"context"
 	"sync"
 	"time"
 
@@ -43,7 +44,16 @@ type InmemQueue struct {
 	v   *list.List
 	vMu sync.Mutex
 
+	// deadLetters is the dead-letter queue. It is guarded by vMu.
+	deadLetters []*client.DeadLetterMessage
+
 	lockDuration time.Duration
+
+	// notifications notifies the consumers when a message is enqueued or a message lock is released.
+	notifications client.Broadcaster
+
+	// round is the fairness round of the dequeued messages.
+	round client.FairnessRound
 }
 
 func NewInMemQueue(lockDuration time.Duration) *InmemQueue {
@@ -63,6 +73,7 @@ func (q *InmemQueue) DeleteAll() {
 	q.vMu.Lock()
 	defer q.vMu.Unlock()
 	_ = q.v.Init()
+	q.deadLetters = nil
 }
 
 func (q *InmemQueue) Enqueue(msg *client.Message) {
@@ -77,25 +88,46 @@ func (q *InmemQueue) Enqueue(msg *client.Message) {
 	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
 
 	q.v.PushBack(&element{val: msg, visible: true})
+	q.notifications.Broadcast()
+}
+
+// Notify returns a channel that receives a value when a message may be available to dequeue.
+func (q *InmemQueue) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return q.notifications.Notify(ctx)
 }
 
 func (q *InmemQueue) Dequeue() *client.Message {
 	q.updateQueue()
 
-	var found *client.Message
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
 
-	q.elementRange(func(e *list.Element, elem *element) bool {
+	// Lease the first visible message of the highest priority class whose fairness key is next in turn.
+	elems := []*element{}
+	visible := []*client.Message{}
+	for e := q.v.Front(); e != nil; e = e.Next() {
+		elem := e.Value.(*element)
 		if elem.visible {
-			elem.val.DequeueCount++
-			elem.val.NextVisibleAt = time.Now().Add(q.lockDuration)
-			elem.visible = false
-			found = elem.val
-			return true
+			elems = append(elems, elem)
+			visible = append(visible, elem.val)
 		}
-		return false
-	})
+	}
 
-	return found
+	i := q.round.Select(visible)
+	if i < 0 {
+		return nil
+	}
+	found := elems[i]
+
+	found.val.DequeueCount++
+	found.val.NextVisibleAt = time.Now().Add(q.lockDuration)
+	found.visible = false
+
+	// Wake up the consumers when the lock is released in case the message is not finished. The notification is
+	// spurious if the message is finished or its lock is extended.
+	time.AfterFunc(q.lockDuration, q.notifications.Broadcast)
+
+	return found.val
 }
 
 func (q *InmemQueue) Complete(msg *client.Message) error {
@@ -141,6 +173,89 @@ func (q *InmemQueue) Extend(msg *client.Message) error {
 	return nil
 }
 
+// DeadLetter moves the leased message to the dead-letter queue.
+func (q *InmemQueue) DeadLetter(msg *client.Message, info client.DeadLetterInfo) error {
+	var found *client.Message
+	q.elementRange(func(e *list.Element, elem *element) bool {
+		if elem.val.ID == msg.ID {
+			if elem.val.DequeueCount == msg.DequeueCount {
+				found = elem.val
+				q.v.Remove(e)
+			}
+			return true
+		}
+		return false
+	})
+
+	if found == nil {
+		return client.ErrInvalidMessage
+	}
+
+	info.DeadLetteredAt = time.Now().UTC()
+
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+	q.deadLetters = append(q.deadLetters, &client.DeadLetterMessage{Message: copyMessage(found), DeadLetterInfo: info})
+	return nil
+}
+
+// DeadLetters returns a copy of the messages in the dead-letter queue.
+func (q *InmemQueue) DeadLetters() []*client.DeadLetterMessage {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	result := []*client.DeadLetterMessage{}
+	for _, dl := range q.deadLetters {
+		result = append(result, &client.DeadLetterMessage{Message: copyMessage(&dl.Message), DeadLetterInfo: dl.DeadLetterInfo})
+	}
+	return result
+}
+
+// RequeueDeadLetter moves the dead-lettered message back to the queue.
+func (q *InmemQueue) RequeueDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	for i, dl := range q.deadLetters {
+		if dl.ID == id {
+			q.deadLetters = append(q.deadLetters[:i], q.deadLetters[i+1:]...)
+
+			msg := copyMessage(&dl.Message)
+			msg.DequeueCount = 0
+			msg.EnqueueAt = time.Now().UTC()
+			msg.ExpireAt = msg.EnqueueAt.Add(messageExpireDuration)
+			msg.NextVisibleAt = time.Time{}
+			q.v.PushBack(&element{val: &msg, visible: true})
+			q.notifications.Broadcast()
+			return nil
+		}
+	}
+
+	return client.ErrDeadLetterNotFound
+}
+
+// DeleteDeadLetter deletes the message from the dead-letter queue.
+func (q *InmemQueue) DeleteDeadLetter(id string) error {
+	q.vMu.Lock()
+	defer q.vMu.Unlock()
+
+	for i, dl := range q.deadLetters {
+		if dl.ID == id {
+			q.deadLetters = append(q.deadLetters[:i], q.deadLetters[i+1:]...)
+			return nil
+		}
+	}
+
+	return client.ErrDeadLetterNotFound
+}
+
+func copyMessage(msg *client.Message) client.Message {
+	result := *msg
+	result.Data = make([]byte, len(msg.Data))
+	copy(result.Data, msg.Data)
+	return result
+}
+
 func (q *InmemQueue) updateQueue() {
 	q.elementRange(func(e *list.Element, elem *element) bool {
 		now := time.Now().UTC()
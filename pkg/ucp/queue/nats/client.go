@@ -0,0 +1,459 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nats is a queue implementation backed by NATS JetStream. It is intended for production installations where
+// the APIServer queue can't keep up with the load.
+//
+// Each named queue is stored in its own work-queue stream with one subject and one durable pull consumer per
+// priority class and fairness key. Messages without a fairness key use the subject of their priority class, and the
+// other messages use a subject suffixed with the hash of their key. Dequeue takes turns between the subjects of a
+// priority class, and the consumers of fairness keys are deleted by the broker after they are inactive for the expiry
+// duration of the queue. The consumer AckWait is the message lock duration, so the broker redelivers messages whose lock
+// was not extended or finished in time. Leases are tracked by the client that dequeued the message, since the broker
+// does not identify the holder of a lease. Consumers are notified of new messages by a core NATS subscription on the
+// subjects of the queue, which also receives the messages enqueued by other processes.
+package nats
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+)
+
+const (
+	subjectPrefix = "radius.queue."
+
+	// headerFairnessKey is the message header storing the fairness key of the message.
+	headerFairnessKey = "Radius-Fairness-Key"
+
+	defaultMessageLockDuration = time.Duration(5) * time.Minute
+	defaultExpiryDuration      = time.Duration(10) * time.Hour
+	defaultDuplicateWindow     = time.Duration(2) * time.Minute
+)
+
+// priorityClasses is the list of priority classes in the order they are dequeued.
+var priorityClasses = []client.Priority{client.PriorityHigh, client.PriorityNormal, client.PriorityLow}
+
+var _ client.Client = (*Client)(nil)
+var _ client.Notifier = (*Client)(nil)
+
+// Client is the queue client backed by a NATS JetStream stream.
+type Client struct {
+	js     jetstream.JetStream
+	stream jetstream.Stream
+	opts   Options
+
+	// consumers is the pull consumer of the messages without a fairness key of each priority class.
+	consumers map[client.Priority]jetstream.Consumer
+
+	// keyConsumers is the pull consumer of each subject of the messages with a fairness key.
+	keyConsumers   map[string]jetstream.Consumer
+	keyConsumersMu sync.Mutex
+
+	// round is the fairness round of the messages dequeued by this client.
+	round client.FairnessRound
+
+	// leases is the messages leased by this client keyed by message ID.
+	leases   map[string]*lease
+	leasesMu sync.Mutex
+
+	// notifications notifies the consumers when a message is enqueued or a message lock is released.
+	notifications client.Broadcaster
+}
+
+// lease represents a message dequeued by this client which is not finished yet.
+type lease struct {
+	msg          jetstream.Msg
+	dequeueCount int
+	lockedUntil  time.Time
+}
+
+// Options is the options to create NATS JetStream queue client.
+type Options struct {
+	// Name represents the name of queue.
+	Name string
+
+	// Replicas is the number of replicas of the stream in a clustered JetStream deployment. Defaults to 1.
+	Replicas int
+
+	// MessageLockDuration represents the duration of message lock.
+	MessageLockDuration time.Duration
+	// ExpiryDuration represents the duration of the expiry.
+	ExpiryDuration time.Duration
+	// DuplicateWindow represents the duration in which a message enqueued twice is stored only once.
+	DuplicateWindow time.Duration
+}
+
+// New creates the queue backed by a JetStream stream using the given connection. The stream and its consumers are
+// created or updated to match the options. name is unique name for each service which will consume the queue.
+func New(ctx context.Context, nc *nats.Conn, options Options) (*Client, error) {
+	if options.Name == "" {
+		return nil, errors.New("Name is required")
+	}
+
+	if options.Replicas == 0 {
+		options.Replicas = 1
+	}
+
+	if options.MessageLockDuration == time.Duration(0) {
+		options.MessageLockDuration = defaultMessageLockDuration
+	}
+
+	if options.ExpiryDuration == time.Duration(0) {
+		options.ExpiryDuration = defaultExpiryDuration
+	}
+
+	if options.DuplicateWindow == time.Duration(0) {
+		options.DuplicateWindow = defaultDuplicateWindow
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JetStream: %w", err)
+	}
+
+	c := &Client{js: js, opts: options, leases: map[string]*lease{}}
+	if err := c.init(ctx); err != nil {
+		return nil, err
+	}
+
+	// The subscription lives as long as the connection and is restored by the connection when it reconnects.
+	if _, err := nc.Subscribe(c.subjectPrefix()+">", func(*nats.Msg) { c.notifications.Broadcast() }); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to queue %s: %w", c.opts.Name, err)
+	}
+
+	return c, nil
+}
+
+// init creates or updates the stream and the consumers of the queue.
+func (c *Client) init(ctx context.Context) error {
+	stream, err := c.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       c.streamName(),
+		Subjects:   []string{c.subjectPrefix() + ">"},
+		Retention:  jetstream.WorkQueuePolicy,
+		Storage:    jetstream.FileStorage,
+		Replicas:   c.opts.Replicas,
+		MaxAge:     c.opts.ExpiryDuration,
+		Duplicates: c.opts.DuplicateWindow,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", c.streamName(), err)
+	}
+	c.stream = stream
+
+	c.consumers = map[client.Priority]jetstream.Consumer{}
+	for _, p := range priorityClasses {
+		consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+			Durable:       p.String(),
+			FilterSubject: c.subject(p),
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			AckWait:       c.opts.MessageLockDuration,
+			MaxDeliver:    -1,
+			DeliverPolicy: jetstream.DeliverAllPolicy,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create consumer %s of stream %s: %w", p.String(), c.streamName(), err)
+		}
+		c.consumers[p] = consumer
+	}
+
+	c.keyConsumersMu.Lock()
+	defer c.keyConsumersMu.Unlock()
+	c.keyConsumers = map[string]jetstream.Consumer{}
+
+	return nil
+}
+
+// streamName returns the name of the stream. Stream names can't contain '.', which is common in queue names.
+func (c *Client) streamName() string {
+	return "radius-queue-" + strings.ReplaceAll(strings.ToLower(c.opts.Name), ".", "_")
+}
+
+func (c *Client) subjectPrefix() string {
+	return subjectPrefix + strings.ReplaceAll(strings.ToLower(c.opts.Name), ".", "_") + "."
+}
+
+func (c *Client) subject(p client.Priority) string {
+	return c.subjectPrefix() + p.String()
+}
+
+// keySubject returns the subject of the messages with the given fairness key hash in the priority class.
+func (c *Client) keySubject(p client.Priority, hash string) string {
+	if hash == "" {
+		return c.subject(p)
+	}
+	return c.subject(p) + "." + hash
+}
+
+// Enqueue enqueues message to the queue. Messages with the same ID or, when the ID is not set, the same data are
+// stored only once within the duplicate window.
+func (c *Client) Enqueue(ctx context.Context, msg *client.Message, options ...client.EnqueueOptions) error {
+	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
+		return client.ErrEmptyMessage
+	}
+
+	if msg.ContentType != client.JSONContentType {
+		return client.ErrUnsupportedContentType
+	}
+
+	dedupID := msg.ID
+	if dedupID == "" {
+		h := sha256.Sum256(msg.Data)
+		dedupID = hex.EncodeToString(h[:])
+	}
+
+	m := nats.NewMsg(c.keySubject(msg.Priority.Class(), client.HashFairnessKey(msg.FairnessKey)))
+	m.Data = msg.Data
+	if msg.FairnessKey != "" {
+		m.Header.Set(headerFairnessKey, msg.FairnessKey)
+	}
+
+	_, err := c.js.PublishMsg(ctx, m, jetstream.WithMsgID(dedupID))
+	return err
+}
+
+// Dequeue leases the first available message of the highest priority class in the queue, taking turns between the
+// fairness keys of the priority class.
+func (c *Client) Dequeue(ctx context.Context, opts client.QueueClientConfig) (*client.Message, error) {
+	for _, p := range priorityClasses {
+		hashes, err := c.fairnessKeyHashes(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			for _, hash := range hashes {
+				if c.round.IsServed(p, hash) {
+					continue
+				}
+
+				m, err := c.fetch(ctx, p, hash)
+				if err != nil {
+					return nil, err
+				}
+
+				if m != nil {
+					c.round.Serve(p, hash)
+					return c.leaseMessage(p, m)
+				}
+			}
+
+			// Every fairness key with an available message was dequeued in this round.
+			if !c.round.Reset(p) {
+				break
+			}
+		}
+	}
+
+	return nil, client.ErrMessageNotFound
+}
+
+// fairnessKeyHashes returns the sorted hashes of the fairness keys with messages in the priority class. The empty
+// hash of the messages without a fairness key is always returned.
+func (c *Client) fairnessKeyHashes(ctx context.Context, p client.Priority) ([]string, error) {
+	prefix := c.subject(p) + "."
+	info, err := c.stream.Info(ctx, jetstream.WithSubjectFilter(prefix+"*"))
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := []string{""}
+	for subject := range info.State.Subjects {
+		hashes = append(hashes, strings.TrimPrefix(subject, prefix))
+	}
+	sort.Strings(hashes)
+	return hashes, nil
+}
+
+// fetch fetches the next available message of the subject of the fairness key hash in the priority class, or returns
+// nil if there is none.
+func (c *Client) fetch(ctx context.Context, p client.Priority, hash string) (jetstream.Msg, error) {
+	consumer, err := c.consumer(ctx, p, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := consumer.FetchNoWait(1)
+	if err == nil {
+		for m := range batch.Messages() {
+			return m, nil
+		}
+		err = batch.Error()
+	}
+
+	// The consumer of a fairness key may have been deleted by the broker after it was inactive, it is created again
+	// by the next Dequeue.
+	if err != nil && hash != "" {
+		c.keyConsumersMu.Lock()
+		delete(c.keyConsumers, c.keySubject(p, hash))
+		c.keyConsumersMu.Unlock()
+	}
+
+	return nil, err
+}
+
+// consumer returns the consumer of the subject of the fairness key hash in the priority class, creating it if needed.
+func (c *Client) consumer(ctx context.Context, p client.Priority, hash string) (jetstream.Consumer, error) {
+	if hash == "" {
+		return c.consumers[p], nil
+	}
+
+	c.keyConsumersMu.Lock()
+	defer c.keyConsumersMu.Unlock()
+
+	subject := c.keySubject(p, hash)
+	if consumer, ok := c.keyConsumers[subject]; ok {
+		return consumer, nil
+	}
+
+	name := p.String() + "-" + hash
+	consumer, err := c.stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:           name,
+		FilterSubject:     subject,
+		AckPolicy:         jetstream.AckExplicitPolicy,
+		AckWait:           c.opts.MessageLockDuration,
+		MaxDeliver:        -1,
+		DeliverPolicy:     jetstream.DeliverAllPolicy,
+		InactiveThreshold: c.opts.ExpiryDuration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer %s of stream %s: %w", name, c.streamName(), err)
+	}
+
+	c.keyConsumers[subject] = consumer
+	return consumer, nil
+}
+
+func (c *Client) leaseMessage(p client.Priority, m jetstream.Msg) (*client.Message, error) {
+	meta, err := m.Metadata()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msg := &client.Message{
+		Metadata: client.Metadata{
+			ID:            strconv.FormatUint(meta.Sequence.Stream, 10),
+			DequeueCount:  int(meta.NumDelivered),
+			EnqueueAt:     meta.Timestamp,
+			ExpireAt:      meta.Timestamp.Add(c.opts.ExpiryDuration),
+			NextVisibleAt: now.Add(c.opts.MessageLockDuration),
+		},
+		Priority:    p,
+		FairnessKey: m.Headers().Get(headerFairnessKey),
+		ContentType: client.JSONContentType,
+		Data:        m.Data(),
+	}
+
+	c.leasesMu.Lock()
+	defer c.leasesMu.Unlock()
+
+	// Forget the leases which expired without being finished, the broker redelivers their messages.
+	for id, l := range c.leases {
+		if l.lockedUntil.Before(now) {
+			delete(c.leases, id)
+		}
+	}
+	c.leases[msg.ID] = &lease{msg: m, dequeueCount: msg.DequeueCount, lockedUntil: msg.NextVisibleAt}
+
+	// Wake up the consumers when the lock is released in case the message is not finished.
+	time.AfterFunc(c.opts.MessageLockDuration, c.notifications.Broadcast)
+
+	return msg, nil
+}
+
+// Notify returns a channel that receives a value when a message is enqueued or a message lock is released. Messages
+// whose lock is released by other processes are not notified.
+func (c *Client) Notify(ctx context.Context) (<-chan struct{}, error) {
+	return c.notifications.Notify(ctx)
+}
+
+// getLease returns the lease of the message if the message is still leased by the caller. The caller must hold leasesMu.
+func (c *Client) getLease(msg *client.Message) (*lease, error) {
+	l, ok := c.leases[msg.ID]
+	if !ok {
+		return nil, client.ErrInvalidMessage
+	}
+
+	// The message was redelivered to this client after the lease of the caller expired.
+	if l.dequeueCount != msg.DequeueCount {
+		return nil, client.ErrDequeuedMessage
+	}
+
+	if l.lockedUntil.Before(time.Now()) {
+		delete(c.leases, msg.ID)
+		return nil, client.ErrInvalidMessage
+	}
+
+	return l, nil
+}
+
+// FinishMessage deletes the message from the queue.
+func (c *Client) FinishMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	c.leasesMu.Lock()
+	defer c.leasesMu.Unlock()
+
+	l, err := c.getLease(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := l.msg.DoubleAck(ctx); err != nil {
+		return err
+	}
+
+	delete(c.leases, msg.ID)
+	return nil
+}
+
+// ExtendMessage extends the message lock. The message must still be leased by the caller.
+func (c *Client) ExtendMessage(ctx context.Context, msg *client.Message) error {
+	if msg == nil {
+		return client.ErrEmptyMessage
+	}
+
+	c.leasesMu.Lock()
+	defer c.leasesMu.Unlock()
+
+	l, err := c.getLease(msg)
+	if err != nil {
+		return err
+	}
+
+	// InProgress resets the AckWait timer of the message in the broker.
+	if err := l.msg.InProgress(); err != nil {
+		return err
+	}
+
+	l.lockedUntil = time.Now().Add(c.opts.MessageLockDuration)
+	msg.NextVisibleAt = l.lockedUntil
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/radius-project/radius/pkg/ucp/queue/client"
+	"github.com/radius-project/radius/test/testcontext"
+	sharedtest "github.com/radius-project/radius/test/ucp/queuetest"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer starts a NATS server with JetStream enabled in the test process.
+func startServer(t *testing.T) *server.Server {
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+	require.True(t, srv.ReadyForConnections(10*time.Second), "NATS server is not ready")
+
+	return srv
+}
+
+func TestClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	srv := startServer(t)
+
+	nc, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+
+	cli, err := New(ctx, nc, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+	require.NoError(t, err)
+
+	clear := func(t *testing.T) {
+		err := cli.js.DeleteStream(context.Background(), cli.streamName())
+		require.NoError(t, err)
+		err = cli.init(context.Background())
+		require.NoError(t, err)
+
+		cli.leasesMu.Lock()
+		defer cli.leasesMu.Unlock()
+		cli.leases = map[string]*lease{}
+	}
+
+	t.Run("duplicated message is enqueued once", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+		err = cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []byte("{}"), msg.Data)
+
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+	})
+
+	t.Run("finish message after the lock is expired", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		time.Sleep(sharedtest.TestMessageLockTime * 2)
+		err = cli.FinishMessage(ctx, msg)
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("messages survive reconnecting", func(t *testing.T) {
+		clear(t)
+
+		err := cli.Enqueue(ctx, client.NewMessage("{}"))
+		require.NoError(t, err)
+
+		other, err := nats.Connect(srv.ClientURL())
+		require.NoError(t, err)
+		defer other.Close()
+
+		reconnected, err := New(ctx, other, Options{Name: "applications.core", MessageLockDuration: sharedtest.TestMessageLockTime})
+		require.NoError(t, err)
+
+		msg, err := reconnected.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, []byte("{}"), msg.Data)
+		require.Equal(t, 1, msg.DequeueCount)
+
+		err = reconnected.FinishMessage(ctx, msg)
+		require.NoError(t, err)
+	})
+
+	sharedtest.RunTest(t, cli, clear)
+}
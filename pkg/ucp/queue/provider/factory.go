@@ -21,10 +21,14 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/nats-io/nats.go"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/queue/apiserver"
+	qbolt "github.com/radius-project/radius/pkg/ucp/queue/bolt"
 	queue "github.com/radius-project/radius/pkg/ucp/queue/client"
 	qinmem "github.com/radius-project/radius/pkg/ucp/queue/inmemory"
+	qnats "github.com/radius-project/radius/pkg/ucp/queue/nats"
 	ucpv1alpha1 "github.com/radius-project/radius/pkg/ucp/store/apiserverstore/api/ucp.dev/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +39,8 @@ type factoryFunc func(context.Context, QueueProviderOptions) (queue.Client, erro
 var clientFactory = map[QueueProviderType]factoryFunc{
 	TypeInmemory:  initInMemory,
 	TypeAPIServer: initAPIServer,
+	TypeBolt:      initBolt,
+	TypeNATS:      initNATS,
 }
 
 func initInMemory(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
@@ -70,7 +76,8 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		},
 	}
 
-	rc, err := runtimeclient.New(cfg, options)
+	// The queue client watches the queue messages to notify the consumers.
+	rc, err := runtimeclient.NewWithWatch(cfg, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize APIServer client: %w", err)
 	}
@@ -80,3 +87,36 @@ func initAPIServer(ctx context.Context, opt QueueProviderOptions) (queue.Client,
 		Namespace: opt.APIServer.Namespace,
 	})
 }
+
+func initBolt(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	db, err := data.OpenBoltDB(opt.Bolt.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bolt client: %w", err)
+	}
+
+	return qbolt.New(db, qbolt.Options{Name: opt.Name})
+}
+
+func initNATS(ctx context.Context, opt QueueProviderOptions) (queue.Client, error) {
+	if opt.NATS.URL == "" {
+		return nil, errors.New("failed to initialize NATS client: url is required")
+	}
+
+	natsOpts := []nats.Option{nats.Name(opt.Name), nats.MaxReconnects(-1)}
+	if opt.NATS.CredentialsFile != "" {
+		natsOpts = append(natsOpts, nats.UserCredentials(opt.NATS.CredentialsFile))
+	}
+
+	nc, err := nats.Connect(opt.NATS.URL, natsOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize NATS client: %w", err)
+	}
+
+	cli, err := qnats.New(ctx, nc, qnats.Options{Name: opt.Name, Replicas: opt.NATS.Replicas})
+	if err != nil {
+		nc.Close()
+		return nil, fmt.Errorf("failed to initialize NATS client: %w", err)
+	}
+
+	return cli, nil
+}
@@ -29,6 +29,12 @@ type QueueProviderOptions struct {
 
 	// APIServer configures options for the Kubernetes APIServer store. (Optional)
 	APIServer APIServerOptions `yaml:"apiserver,omitempty"`
+
+	// Bolt configures options for the file-backed bolt queue. (Optional)
+	Bolt BoltOptions `yaml:"bolt,omitempty"`
+
+	// NATS configures options for the NATS JetStream queue. (Optional)
+	NATS NATSOptions `yaml:"nats,omitempty"`
 }
 
 // InMemoryQueueOptions represents the inmemory queue options.
@@ -44,3 +50,21 @@ type APIServerOptions struct {
 	// Namespace configures the Kubernetes namespace used for data-storage. The namespace must already exist.
 	Namespace string `yaml:"namespace"`
 }
+
+type BoltOptions struct {
+	// Directory configures the directory where the database file is stored. The same directory can be shared with
+	// the bolt storage and secret providers of the same process.
+	Directory string `yaml:"directory"`
+}
+
+// NATSOptions represents the options of the NATS JetStream queue.
+type NATSOptions struct {
+	// URL is the URL of the NATS server, for example 'nats://nats.radius-system:4222'.
+	URL string `yaml:"url"`
+
+	// CredentialsFile is the path to the NATS user credentials file. (Optional)
+	CredentialsFile string `yaml:"credentialsFile,omitempty"`
+
+	// Replicas is the number of replicas of the stream in a clustered JetStream deployment. Defaults to 1.
+	Replicas int `yaml:"replicas,omitempty"`
+}
@@ -25,4 +25,10 @@ const (
 
 	// TypeAPIServer represents the Kubernetes APIServer provider.
 	TypeAPIServer QueueProviderType = "apiserver"
+
+	// TypeBolt represents the file-backed bolt queue provider.
+	TypeBolt QueueProviderType = "bolt"
+
+	// TypeNATS represents the NATS JetStream queue provider.
+	TypeNATS QueueProviderType = "nats"
 )
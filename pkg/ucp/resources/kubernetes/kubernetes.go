@@ -35,6 +35,10 @@ const (
 	// clusters in a single Radius instance, we will need to remove this and replace all occurrences.
 	PlaneNameTODO = "local"
 
+	// PlaneNameLocal is the name of the Kubernetes plane of the cluster Radius runs in, which is used when an
+	// environment does not target another cluster.
+	PlaneNameLocal = "local"
+
 	// ScopeTypeNamespaces defines the type name of the Kubernetes namespace scope.
 	ScopeNamespaces = "namespaces"
 )
@@ -63,6 +67,32 @@ func ToParts(id resources.ID) (group, kind, namespace, name string) {
 	return group, kind, namespace, name
 }
 
+// PlaneNameOrDefault returns the name of the Kubernetes plane of the given cluster, or the name of the plane of the
+// cluster Radius runs in if cluster is empty.
+func PlaneNameOrDefault(cluster string) string {
+	if cluster == "" {
+		return PlaneNameLocal
+	}
+	return cluster
+}
+
+// IsLocalPlane returns true if the given plane name is the name of the plane of the cluster Radius runs in.
+func IsLocalPlane(planeName string) bool {
+	return planeName == "" || strings.EqualFold(planeName, PlaneNameLocal)
+}
+
+// PlaneName returns the name of the Kubernetes plane of the given UCP resource ID, or the name of the plane of the
+// cluster Radius runs in if the resource ID does not belong to a Kubernetes plane.
+func PlaneName(id resources.ID) string {
+	return PlaneNameOrDefault(id.FindScope(PlaneTypeKubernetes))
+}
+
+// WithPlaneName returns the UCP resource ID of the given Kubernetes resource in the Kubernetes plane with the given name.
+func WithPlaneName(id resources.ID, planeName string) resources.ID {
+	group, kind, namespace, name := ToParts(id)
+	return IDFromParts(planeName, group, kind, namespace, name)
+}
+
 // IDFromMeta returns the UCP resource ID for the given Kubernetes object specified by its GroupVersionKind
 // and ObjectMeta.
 func IDFromMeta(planeName string, gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta) resources.ID {
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 func Test_ToUCPResourceID(t *testing.T) {
@@ -60,3 +62,32 @@ func Test_ToUCPResourceID(t *testing.T) {
 		require.EqualError(t, err, "resourceType or resourceName is empty")
 	})
 }
+
+func Test_PlaneName(t *testing.T) {
+	require.Equal(t, "local", PlaneNameOrDefault(""))
+	require.Equal(t, "prod", PlaneNameOrDefault("prod"))
+
+	require.True(t, IsLocalPlane(""))
+	require.True(t, IsLocalPlane("local"))
+	require.False(t, IsLocalPlane("prod"))
+
+	require.Equal(t, "prod", PlaneName(resources.MustParse("/planes/kubernetes/prod/namespaces/default/providers/apps/Deployment/test-deployment")))
+	require.Equal(t, "local", PlaneName(resources.MustParse("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container")))
+}
+
+func Test_WithPlaneName(t *testing.T) {
+	t.Run("namespaced resource", func(t *testing.T) {
+		id := resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/test-deployment")
+		require.Equal(t, "/planes/kubernetes/prod/namespaces/default/providers/apps/Deployment/test-deployment", WithPlaneName(id, "prod").String())
+	})
+
+	t.Run("core resource", func(t *testing.T) {
+		id := resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/core/Service/test-service")
+		require.Equal(t, "/planes/kubernetes/prod/namespaces/default/providers/core/Service/test-service", WithPlaneName(id, "prod").String())
+	})
+
+	t.Run("cluster scoped resource", func(t *testing.T) {
+		id := resources.MustParse("/planes/kubernetes/local/providers/rbac.authorization.k8s.io/ClusterRole/test-role")
+		require.Equal(t, "/planes/kubernetes/prod/providers/rbac.authorization.k8s.io/ClusterRole/test-role", WithPlaneName(id, "prod").String())
+	})
+}
@@ -26,9 +26,10 @@ type PlaneProperties struct {
 
 // Plane kinds
 const (
-	PlaneKindUCPNative = "UCPNative"
-	PlaneKindAzure     = "Azure"
-	PlaneKindAWS       = "AWS"
+	PlaneKindUCPNative  = "UCPNative"
+	PlaneKindAzure      = "Azure"
+	PlaneKindAWS        = "AWS"
+	PlaneKindKubernetes = "Kubernetes"
 )
 
 type Plane struct {
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/secret"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_Bolt(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "secrets.db"), 0o600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client := &Client{DB: db}
+
+	t.Run("get_not_found", func(t *testing.T) {
+		_, err := client.Get(ctx, "azure-azurecloud-default")
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("delete_not_found", func(t *testing.T) {
+		err := client.Delete(ctx, "azure-azurecloud-default")
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+
+	t.Run("invalid_arguments", func(t *testing.T) {
+		err := client.Save(ctx, "", []byte("value"))
+		require.ErrorIs(t, err, &secret.ErrInvalid{})
+
+		err = client.Save(ctx, "name", nil)
+		require.ErrorIs(t, err, &secret.ErrInvalid{})
+
+		_, err = client.Get(ctx, "")
+		require.ErrorIs(t, err, &secret.ErrInvalid{})
+	})
+
+	t.Run("save_get_delete", func(t *testing.T) {
+		err := secret.SaveSecret(ctx, client, "Azure-AzureCloud-Default", "test_secret")
+		require.NoError(t, err)
+
+		value, err := secret.GetSecret[string](ctx, client, "azure-azurecloud-default")
+		require.NoError(t, err)
+		require.Equal(t, "test_secret", value)
+
+		err = client.Delete(ctx, "azure-azurecloud-default")
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, "azure-azurecloud-default")
+		require.ErrorIs(t, err, &secret.ErrNotFound{})
+	})
+}
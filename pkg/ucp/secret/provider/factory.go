@@ -21,8 +21,10 @@ import (
 	"errors"
 
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
 	"github.com/radius-project/radius/pkg/ucp/secret"
+	secretbolt "github.com/radius-project/radius/pkg/ucp/secret/bolt"
 	"github.com/radius-project/radius/pkg/ucp/secret/etcd"
 	kubernetes_client "github.com/radius-project/radius/pkg/ucp/secret/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/store/etcdstore"
@@ -35,6 +37,7 @@ type secretFactoryFunc func(context.Context, SecretProviderOptions) (secret.Clie
 var secretClientFactory = map[SecretProviderType]secretFactoryFunc{
 	TypeETCDSecret:       initETCDSecretClient,
 	TypeKubernetesSecret: initKubernetesSecretClient,
+	TypeBoltSecret:       initBoltSecretClient,
 }
 
 func initETCDSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
@@ -70,3 +73,12 @@ func initKubernetesSecretClient(ctx context.Context, opt SecretProviderOptions)
 	}
 	return &kubernetes_client.Client{K8sClient: client}, nil
 }
+
+func initBoltSecretClient(ctx context.Context, opts SecretProviderOptions) (secret.Client, error) {
+	db, err := data.OpenBoltDB(opts.Bolt.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretbolt.Client{DB: db}, nil
+}
@@ -25,4 +25,7 @@ const (
 
 	// TypeKubernetesSecret represents the Kubernetes secret provider.
 	TypeKubernetesSecret SecretProviderType = "kubernetes"
+
+	// TypeBoltSecret represents the file-backed bolt secret provider.
+	TypeBoltSecret SecretProviderType = "bolt"
 )
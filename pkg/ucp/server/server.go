@@ -32,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/trace"
 	"github.com/radius-project/radius/pkg/ucp/backend"
+	"github.com/radius-project/radius/pkg/ucp/backend/credentialexpiry"
 	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/dataprovider"
@@ -147,8 +148,8 @@ func NewServerOptionsFromEnvironment() (Options, error) {
 	}, nil
 }
 
-// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler and Backend (if
-// enabled) based on the given Options.
+// NewServer creates a new hosting.Host instance with services for API, EmbeddedETCD, Metrics, Profiler, Backend and
+// credential expiry checks (if enabled) based on the given Options.
 func NewServer(options *Options) (*hosting.Host, error) {
 	hostingServices := []hosting.Service{
 		api.NewService(api.ServiceOptions{
@@ -203,6 +204,11 @@ func NewServer(options *Options) (*hosting.Host, error) {
 	}
 	hostingServices = append(hostingServices, backend.NewService(backendServiceOptions))
 
+	hostingServices = append(hostingServices, credentialexpiry.NewService(credentialexpiry.ServiceOptions{
+		StorageProviderOptions: options.StorageProviderOptions,
+		Config:                 options.Config.Credentials,
+	}))
+
 	options.TracerProviderOptions.ServiceName = "ucp"
 	hostingServices = append(hostingServices, &trace.Service{Options: options.TracerProviderOptions})
 
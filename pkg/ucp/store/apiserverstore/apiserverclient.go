@@ -44,6 +44,7 @@ import (
 	"context"
 	"crypto/sha1"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"unicode"
@@ -59,6 +60,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -88,6 +90,8 @@ func NewAPIServerClient(client runtimeclient.Client, namespace string) *APIServe
 }
 
 var _ store.StorageClient = (*APIServerClient)(nil)
+var _ store.Watcher = (*APIServerClient)(nil)
+var _ store.Transactional = (*APIServerClient)(nil)
 
 type APIServerClient struct {
 	client    runtimeclient.Client
@@ -111,6 +115,9 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
 
 	selector, err := createLabelSelector(query)
 	if err != nil {
@@ -141,7 +148,7 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 					return nil, err
 				}
 
-				match, err := converted.MatchesFilters(query.Filters)
+				match, err := converted.Matches(query)
 				if err != nil {
 					return nil, err
 				} else if !match {
@@ -153,6 +160,10 @@ func (c *APIServerClient) Query(ctx context.Context, query store.Query, options
 		}
 	}
 
+	if err := store.SortObjects(results.Items, query.OrderBy); err != nil {
+		return nil, err
+	}
+
 	return &results, nil
 }
 
@@ -317,6 +328,8 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 			// The ETag is only meaning for a replace/update operation not a create. We treat
 			// the absence of the resource as a match failure.
 			return false, &store.ErrConcurrency{}
+		} else if index != nil && config.CreateOnly {
+			return false, &store.ErrConcurrency{}
 		} else if index == nil {
 			resource.Entries = append(resource.Entries, *converted)
 		} else {
@@ -355,6 +368,184 @@ func (c *APIServerClient) Save(ctx context.Context, obj *store.Object, options .
 	return err
 }
 
+// CommitBatch applies all operations in the batch. The API Server has no multi-object transactions, so the batch is
+// applied with store.CommitBatchWithRollback: operations that were already applied are reverted when a later
+// operation fails. This is best-effort and a crash during the batch can leave it partially applied.
+func (c *APIServerClient) CommitBatch(ctx context.Context, batch *store.Batch) error {
+	return store.CommitBatchWithRollback(ctx, c, batch)
+}
+
+// SharesStore returns true if the other client is an APIServerClient that stores resources in the same namespace.
+func (c *APIServerClient) SharesStore(other store.StorageClient) bool {
+	o, ok := other.(*APIServerClient)
+	return ok && o.namespace == c.namespace
+}
+
+// Watch streams changes to objects matching the query by listing and then watching the Kubernetes objects that
+// hold them, like an informer. Since each Kubernetes object can hold multiple entries, changes are computed by
+// comparing the entries of each object with the last observed state.
+//
+// The resume token of each event is the Kubernetes resource version of the change. Resuming requires the API
+// Server to still have the resource version available, otherwise an error is returned.
+//
+// Watch requires the Kubernetes client to implement runtimeclient.WithWatch.
+func (c *APIServerClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
+
+	wc, ok := c.client.(runtimeclient.WithWatch)
+	if !ok {
+		return nil, errors.New("the Kubernetes client does not support watch")
+	}
+
+	selector, err := createLabelSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	config := store.NewWatchConfig(options...)
+	listOptions := &runtimeclient.ListOptions{Namespace: c.namespace, LabelSelector: selector}
+	if config.ResumeToken != "" {
+		// Build the initial state as of the resume token so that we report the changes that happened since.
+		listOptions.Raw = &v1.ListOptions{ResourceVersion: config.ResumeToken, ResourceVersionMatch: v1.ResourceVersionMatchExact}
+	}
+
+	rs := ucpv1alpha1.ResourceList{}
+	err = wc.List(ctx, &rs, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]map[string]store.Object{}
+	for i := range rs.Items {
+		known[rs.Items[i].Name], err = matchingEntries(ctx, &rs.Items[i], query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	watchOptions := &runtimeclient.ListOptions{
+		Namespace:     c.namespace,
+		LabelSelector: selector,
+		Raw:           &v1.ListOptions{ResourceVersion: rs.ResourceVersion},
+	}
+	watcher, err := wc.Watch(ctx, &ucpv1alpha1.ResourceList{}, watchOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan store.WatchEvent, 1)
+	go func() {
+		defer close(out)
+		defer watcher.Stop()
+
+		send := func(event store.WatchEvent) bool {
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			var event watch.Event
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok = <-watcher.ResultChan():
+				if !ok {
+					if ctx.Err() == nil {
+						send(store.WatchEvent{Type: store.WatchEventError, Err: errors.New("the watch was closed by the server")})
+					}
+					return
+				}
+			}
+
+			if event.Type == watch.Error {
+				send(store.WatchEvent{Type: store.WatchEventError, Err: apierrors.FromObject(event.Object)})
+				return
+			}
+
+			resource, ok := event.Object.(*ucpv1alpha1.Resource)
+			if !ok {
+				// Bookmarks and other events that don't carry a resource.
+				continue
+			}
+
+			after := map[string]store.Object{}
+			if event.Type != watch.Deleted {
+				after, err = matchingEntries(ctx, resource, query)
+				if err != nil {
+					send(store.WatchEvent{Type: store.WatchEventError, Err: err})
+					return
+				}
+			}
+
+			for _, change := range store.DiffObjects(known[resource.Name], after) {
+				change.ResumeToken = resource.ResourceVersion
+				if !send(change) {
+					return
+				}
+			}
+
+			if len(after) == 0 {
+				delete(known, resource.Name)
+			} else {
+				known[resource.Name] = after
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// matchingEntries returns the entries of the resource that match the query keyed by their normalized ID.
+func matchingEntries(ctx context.Context, resource *ucpv1alpha1.Resource, query store.Query) (map[string]store.Object, error) {
+	results := map[string]store.Object{}
+	for _, entry := range resource.Entries {
+		id, err := resources.Parse(entry.ID)
+		if err != nil {
+			// Ignore invalid IDs when watching, we don't want a single piece of bad data to
+			// break all watches.
+			logger := ucplog.FromContextOrDiscard(ctx)
+			logger.Error(err, "found an invalid resource id as part of a watch", "name", resource.Name, "namespace", resource.Namespace)
+			continue
+		}
+
+		if !storeutil.IDMatchesQuery(id, query) {
+			continue
+		}
+
+		converted, err := readEntry(&entry)
+		if err != nil {
+			return nil, err
+		}
+
+		match, err := converted.Matches(query)
+		if err != nil {
+			return nil, err
+		} else if !match {
+			continue
+		}
+
+		results[strings.ToLower(entry.ID)] = *converted
+	}
+
+	return results, nil
+}
+
 func (c *APIServerClient) doWithRetry(ctx context.Context, action func() (bool, error)) error {
 	for i := 0; i < RetryCount; i++ {
 		retryable, err := action()
@@ -17,6 +17,7 @@ limitations under the License.
 package apiserverstore
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -134,6 +135,36 @@ func Test_APIServer_Client(t *testing.T) {
 	// The APIServer implementation is complex enough that we have some of our tests in addition
 	// to the standard suite.
 
+	t.Run("watch", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+
+		query := store.Query{RootScope: shared.ResourceGroup1Scope}
+		events, err := client.Watch(watchCtx, query)
+		require.NoError(t, err)
+
+		obj := store.Object{Metadata: store.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data1}
+		require.NoError(t, client.Save(ctx, &obj))
+
+		obj.Data = shared.Data2
+		require.NoError(t, client.Save(ctx, &obj))
+		require.NoError(t, client.Delete(ctx, shared.Resource1ID.String()))
+
+		created := <-events
+		require.Equal(t, store.WatchEventCreated, created.Type)
+		require.Equal(t, shared.Resource1ID.String(), created.Object.ID)
+
+		updated := <-events
+		require.Equal(t, store.WatchEventUpdated, updated.Type)
+		require.Equal(t, obj.ETag, updated.Object.ETag)
+
+		deleted := <-events
+		require.Equal(t, store.WatchEventDeleted, deleted.Type)
+		require.Equal(t, shared.Resource1ID.String(), deleted.Object.ID)
+	})
+
 	t.Run("save_resource_and_validate_kubernetes_object", func(t *testing.T) {
 		clear(t)
 
@@ -641,6 +672,42 @@ func Test_APIServer_Client(t *testing.T) {
 	})
 }
 
+func Test_MatchingEntries(t *testing.T) {
+	resource := ucpv1alpha1.Resource{
+		Entries: []ucpv1alpha1.ResourceEntry{
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/cool-app",
+				ETag: "1",
+				Data: &runtime.RawExtension{Raw: []byte(`{"value":"1"}`)},
+			},
+			{
+				ID:   "/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/cool-app",
+				ETag: "2",
+				Data: &runtime.RawExtension{Raw: []byte(`{"value":"1"}`)},
+			},
+			{
+				ID:   "/planes/radius/local/resourceGroups/cool-group/providers/Applications.Core/applications/another-app",
+				ETag: "3",
+				Data: &runtime.RawExtension{Raw: []byte(`{"value":"2"}`)},
+			},
+		},
+	}
+
+	query := store.Query{
+		RootScope: "/planes/radius/local/resourceGroups/cool-group",
+		Filters:   []store.QueryFilter{{Field: "value", Value: "1"}},
+	}
+
+	entries, err := matchingEntries(testcontext.New(t), &resource, query)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	entry, ok := entries["/planes/radius/local/resourcegroups/cool-group/providers/applications.core/applications/cool-app"]
+	require.True(t, ok)
+	require.Equal(t, "1", entry.ETag)
+	require.Equal(t, map[string]any{"value": "1"}, entry.Data)
+}
+
 func Test_AssignLabels_Resource_NoConflicts(t *testing.T) {
 	resource := ucpv1alpha1.Resource{
 		Entries: []ucpv1alpha1.ResourceEntry{
@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BatchOperationType is the type of an operation in a Batch.
+type BatchOperationType string
+
+const (
+	// BatchOperationSave creates or updates an object.
+	BatchOperationSave BatchOperationType = "Save"
+
+	// BatchOperationDelete deletes an object.
+	BatchOperationDelete BatchOperationType = "Delete"
+)
+
+// BatchOperation is a single operation in a Batch.
+type BatchOperation struct {
+	// Type is the type of the operation.
+	Type BatchOperationType
+
+	// ID is the resource id of the object. For save operations this is the ID of Object.
+	ID string
+
+	// Object is the object to save. Object is only set for save operations. The ETag of Object is updated
+	// when the batch is committed.
+	Object *Object
+
+	// ETag is the optional ETag precondition of the operation. The batch fails with ErrConcurrency when the
+	// stored object does not match.
+	ETag ETag
+}
+
+// Batch is a set of save and delete operations that are committed together using CommitBatch().
+//
+// Operations in a batch are applied in order and either all succeed or none are applied. Each object can only
+// appear once in a batch. Unlike Delete(), deleting an object that does not exist is not an error unless an
+// ETag precondition is specified.
+type Batch struct {
+	// Operations is the list of operations in the batch.
+	Operations []BatchOperation
+}
+
+// Save adds an operation that creates or updates the object. store.WithETag can be used to specify a precondition.
+func (b *Batch) Save(obj *Object, options ...SaveOptions) *Batch {
+	op := BatchOperation{Type: BatchOperationSave, Object: obj, ETag: NewSaveConfig(options...).ETag}
+	if obj != nil {
+		op.ID = obj.ID
+	}
+
+	b.Operations = append(b.Operations, op)
+	return b
+}
+
+// Delete adds an operation that deletes the object. store.WithETag can be used to specify a precondition.
+func (b *Batch) Delete(id string, options ...DeleteOptions) *Batch {
+	b.Operations = append(b.Operations, BatchOperation{Type: BatchOperationDelete, ID: id, ETag: NewDeleteConfig(options...).ETag})
+	return b
+}
+
+// Validate validates the batch and returns ErrInvalid if it is malformed.
+func (b *Batch) Validate() error {
+	if b == nil {
+		return &ErrInvalid{Message: "invalid argument. 'batch' is required"}
+	}
+
+	ids := map[string]bool{}
+	for _, op := range b.Operations {
+		switch op.Type {
+		case BatchOperationSave:
+			if op.Object == nil {
+				return &ErrInvalid{Message: "invalid argument. save operations require an object"}
+			}
+		case BatchOperationDelete:
+		default:
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. unsupported batch operation %q", op.Type)}
+		}
+
+		if op.ID == "" {
+			return &ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+		}
+
+		key := strings.ToLower(op.ID)
+		if ids[key] {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. %s appears more than once in the batch", op.ID)}
+		}
+		ids[key] = true
+	}
+
+	return nil
+}
+
+// Transactional is an optional interface implemented by StorageClient implementations that can commit a Batch
+// atomically. Use CommitBatch() to commit a batch with any StorageClient, including ones that don't implement
+// Transactional.
+type Transactional interface {
+	// CommitBatch atomically applies all operations in the batch.
+	CommitBatch(ctx context.Context, batch *Batch) error
+
+	// SharesStore reports whether the other client writes to the same underlying store, in which case objects
+	// owned by either client can be committed in a single batch.
+	SharesStore(other StorageClient) bool
+}
+
+// CommitBatch applies all operations in the batch. CommitBatch uses the native transaction support of the
+// StorageClient when it implements Transactional, otherwise it falls back to CommitBatchWithRollback.
+func CommitBatch(ctx context.Context, client StorageClient, batch *Batch) error {
+	if tx, ok := client.(Transactional); ok {
+		return tx.CommitBatch(ctx, batch)
+	}
+
+	return CommitBatchWithRollback(ctx, client, batch)
+}
+
+// CommitBatchWithRollback implements CommitBatch() for storage clients that cannot apply several writes atomically.
+//
+// Each operation is applied in order after taking a snapshot of the object. When an operation fails the operations
+// that were already applied are reverted in reverse order. This is best-effort: concurrent writers can observe the
+// intermediate state, and a crash before the rollback completes leaves the batch partially applied.
+func CommitBatchWithRollback(ctx context.Context, client StorageClient, batch *Batch) error {
+	if ctx == nil {
+		return &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	applied := []appliedOperation{}
+	for _, op := range batch.Operations {
+		previous, err := client.Get(ctx, op.ID)
+		if errors.Is(err, &ErrNotFound{}) {
+			previous = nil
+		} else if err != nil {
+			return rollback(ctx, client, applied, err)
+		}
+
+		switch op.Type {
+		case BatchOperationSave:
+			options := []SaveOptions{}
+			if op.ETag != "" {
+				options = append(options, WithETag(op.ETag))
+			}
+
+			original := op.Object.ETag
+			err = client.Save(ctx, op.Object, options...)
+			if err == nil {
+				applied = append(applied, appliedOperation{op: op, previous: previous, originalETag: original})
+			}
+		case BatchOperationDelete:
+			if previous == nil && op.ETag == "" {
+				continue
+			}
+
+			options := []DeleteOptions{}
+			if op.ETag != "" {
+				options = append(options, WithETag(op.ETag))
+			}
+
+			err = client.Delete(ctx, op.ID, options...)
+			if err == nil {
+				applied = append(applied, appliedOperation{op: op, previous: previous})
+			}
+		}
+
+		if errors.Is(err, &ErrNotFound{}) && op.ETag != "" {
+			// The object was removed between the snapshot and the write, so the precondition can't match.
+			err = &ErrConcurrency{}
+		}
+		if err != nil {
+			return rollback(ctx, client, applied, err)
+		}
+	}
+
+	return nil
+}
+
+type appliedOperation struct {
+	op           BatchOperation
+	previous     *Object
+	originalETag ETag
+}
+
+// rollback reverts the applied operations in reverse order and returns the original error combined with any
+// errors encountered while reverting.
+func rollback(ctx context.Context, client StorageClient, applied []appliedOperation, cause error) error {
+	errs := []error{cause}
+	for i := len(applied) - 1; i >= 0; i-- {
+		a := applied[i]
+
+		var err error
+		switch {
+		case a.op.Type == BatchOperationSave && a.previous == nil:
+			err = client.Delete(ctx, a.op.ID, WithETag(a.op.Object.ETag))
+		case a.op.Type == BatchOperationSave:
+			err = client.Save(ctx, a.previous, WithETag(a.op.Object.ETag))
+		default:
+			err = client.Save(ctx, a.previous)
+		}
+
+		if a.op.Type == BatchOperationSave {
+			// The caller's object was not saved as far as the batch is concerned.
+			a.op.Object.ETag = a.originalETag
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back %s of %s: %w", strings.ToLower(string(a.op.Type)), a.op.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
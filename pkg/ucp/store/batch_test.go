@@ -0,0 +1,191 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// memoryClient is a non-transactional StorageClient that keeps objects in memory.
+type memoryClient struct {
+	items    map[string]Object
+	revision int
+
+	// failSave causes Save to fail for the given ID.
+	failSave string
+}
+
+func (c *memoryClient) Query(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error) {
+	return &ObjectQueryResult{}, nil
+}
+
+func (c *memoryClient) Get(ctx context.Context, id string, options ...GetOptions) (*Object, error) {
+	obj, ok := c.items[id]
+	if !ok {
+		return nil, &ErrNotFound{ID: id}
+	}
+	return &obj, nil
+}
+
+func (c *memoryClient) Delete(ctx context.Context, id string, options ...DeleteOptions) error {
+	obj, ok := c.items[id]
+	cfg := NewDeleteConfig(options...)
+	if cfg.ETag != "" && (!ok || obj.ETag != cfg.ETag) {
+		return &ErrConcurrency{}
+	} else if !ok {
+		return &ErrNotFound{ID: id}
+	}
+
+	delete(c.items, id)
+	return nil
+}
+
+func (c *memoryClient) Save(ctx context.Context, obj *Object, options ...SaveOptions) error {
+	if obj.ID == c.failSave {
+		return errors.New("save failed")
+	}
+
+	existing, ok := c.items[obj.ID]
+	cfg := NewSaveConfig(options...)
+	if cfg.ETag != "" && (!ok || existing.ETag != cfg.ETag) {
+		return &ErrConcurrency{}
+	}
+
+	c.revision++
+	obj.ETag = strconv.Itoa(c.revision)
+	c.items[obj.ID] = *obj
+	return nil
+}
+
+func Test_Batch_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		batch *Batch
+		err   error
+	}{
+		{
+			name:  "valid",
+			batch: (&Batch{}).Save(&Object{Metadata: Metadata{ID: "/planes/radius/local/resourceGroups/a"}}).Delete("/planes/radius/local/resourceGroups/b"),
+		},
+		{
+			name:  "nil batch",
+			batch: nil,
+			err:   &ErrInvalid{Message: "invalid argument. 'batch' is required"},
+		},
+		{
+			name:  "nil object",
+			batch: (&Batch{}).Save(nil),
+			err:   &ErrInvalid{Message: "invalid argument. save operations require an object"},
+		},
+		{
+			name:  "empty id",
+			batch: (&Batch{}).Delete(""),
+			err:   &ErrInvalid{Message: "invalid argument. 'id' must not be empty"},
+		},
+		{
+			name:  "duplicate id",
+			batch: (&Batch{}).Save(&Object{Metadata: Metadata{ID: "/planes/radius/local/resourceGroups/a"}}).Delete("/planes/radius/local/resourceGroups/A"),
+			err:   &ErrInvalid{Message: "invalid argument. /planes/radius/local/resourceGroups/A appears more than once in the batch"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.batch.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func Test_CommitBatchWithRollback(t *testing.T) {
+	existingID := "/planes/radius/local/resourceGroups/existing"
+	newID := "/planes/radius/local/resourceGroups/new"
+	deletedID := "/planes/radius/local/resourceGroups/deleted"
+
+	setup := func() *memoryClient {
+		client := &memoryClient{items: map[string]Object{}}
+		for _, id := range []string{existingID, deletedID} {
+			require.NoError(t, client.Save(context.Background(), &Object{Metadata: Metadata{ID: id}, Data: "original"}))
+		}
+		return client
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client := setup()
+		existing := &Object{Metadata: Metadata{ID: existingID}, Data: "updated"}
+		created := &Object{Metadata: Metadata{ID: newID}, Data: "created"}
+
+		batch := (&Batch{}).
+			Save(existing, WithETag(client.items[existingID].ETag)).
+			Save(created).
+			Delete(deletedID).
+			Delete("/planes/radius/local/resourceGroups/missing")
+
+		err := CommitBatch(context.Background(), client, batch)
+		require.NoError(t, err)
+
+		require.Equal(t, "updated", client.items[existingID].Data)
+		require.Equal(t, client.items[existingID].ETag, existing.ETag)
+		require.Equal(t, "created", client.items[newID].Data)
+		require.Equal(t, client.items[newID].ETag, created.ETag)
+		require.NotContains(t, client.items, deletedID)
+	})
+
+	t.Run("concurrency failure is rolled back", func(t *testing.T) {
+		client := setup()
+		existing := &Object{Metadata: Metadata{ID: existingID}, Data: "updated"}
+		created := &Object{Metadata: Metadata{ID: newID}, Data: "created"}
+
+		batch := (&Batch{}).
+			Save(existing).
+			Save(created).
+			Delete(deletedID, WithETag("not-a-match"))
+
+		err := CommitBatch(context.Background(), client, batch)
+		require.ErrorIs(t, err, &ErrConcurrency{})
+
+		require.Equal(t, "original", client.items[existingID].Data)
+		require.Empty(t, existing.ETag)
+		require.NotContains(t, client.items, newID)
+		require.Empty(t, created.ETag)
+		require.Contains(t, client.items, deletedID)
+	})
+
+	t.Run("delete is rolled back", func(t *testing.T) {
+		client := setup()
+		client.failSave = newID
+
+		batch := (&Batch{}).
+			Delete(deletedID).
+			Save(&Object{Metadata: Metadata{ID: newID}, Data: "created"})
+
+		err := CommitBatch(context.Background(), client, batch)
+		require.Error(t, err)
+
+		require.Equal(t, "original", client.items[deletedID].Data)
+		require.NotContains(t, client.items, newID)
+	})
+}
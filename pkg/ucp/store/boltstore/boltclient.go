@@ -0,0 +1,425 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package boltstore stores resources in a local bolt database file. It is intended for single-binary development
+// and CI installations where neither Kubernetes nor etcd are available. It is not suitable for production use.
+//
+// The key scheme matches etcdstore, which gives us ordered prefix scans for queries:
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// ETags are generated from the bucket sequence, which is incremented on every write.
+package boltstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a storage key.
+	SectionSeparator = "|"
+
+	// BucketName is the name of the bolt bucket used to store resources.
+	BucketName = "resources"
+)
+
+var _ store.StorageClient = (*BoltClient)(nil)
+var _ store.Transactional = (*BoltClient)(nil)
+
+// BoltClient implements store.StorageClient using a bolt database.
+type BoltClient struct {
+	db *bolt.DB
+}
+
+// NewBoltClient creates a new BoltClient using the given database, creating the resources bucket if needed.
+func NewBoltClient(db *bolt.DB) (*BoltClient, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte(BucketName))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltClient{db: db}, nil
+}
+
+// Query retrieves objects from the store that match the given query and filters. Results are ordered by key, and
+// the query is paginated when store.WithMaxQueryItemCount is specified. Queries with query.OrderBy are sorted and
+// paginated after reading all matching objects.
+func (c *BoltClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
+
+	cfg := store.NewQueryConfig(options...)
+	if len(query.OrderBy) > 0 {
+		return c.querySorted(query, cfg)
+	}
+
+	var after []byte
+	if cfg.PaginationToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cfg.PaginationToken)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. pagination token is invalid"}
+		}
+		after = b
+	}
+
+	prefix := []byte(keyFromQuery(query))
+	result := &store.ObjectQueryResult{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket([]byte(BucketName)).Cursor()
+
+		start := prefix
+		if after != nil {
+			start = after
+		}
+
+		for k, v := cursor.Seek(start); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			if after != nil && bytes.Equal(k, after) {
+				continue
+			}
+
+			obj := store.Object{}
+			if err := json.Unmarshal(v, &obj); err != nil {
+				return err
+			}
+
+			id, err := resources.Parse(obj.ID)
+			if err != nil || !storeutil.IDMatchesQuery(id, query) {
+				continue
+			}
+
+			match, err := obj.Matches(query)
+			if err != nil {
+				return err
+			} else if !match {
+				continue
+			}
+
+			result.Items = append(result.Items, obj)
+			if cfg.MaxQueryItemCount > 0 && len(result.Items) == cfg.MaxQueryItemCount {
+				result.PaginationToken = base64.RawURLEncoding.EncodeToString(k)
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// querySorted runs a query with query.OrderBy. The matching objects are sorted and paginated with the helpers of
+// the store package.
+func (c *BoltClient) querySorted(query store.Query, cfg store.StoreConfig) (*store.ObjectQueryResult, error) {
+	prefix := []byte(keyFromQuery(query))
+	items := []store.Object{}
+	err := c.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket([]byte(BucketName)).Cursor()
+		for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			obj := store.Object{}
+			if err := json.Unmarshal(v, &obj); err != nil {
+				return err
+			}
+
+			id, err := resources.Parse(obj.ID)
+			if err != nil || !storeutil.IDMatchesQuery(id, query) {
+				continue
+			}
+
+			match, err := obj.Matches(query)
+			if err != nil {
+				return err
+			} else if match {
+				items = append(items, obj)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.SortObjects(items, query.OrderBy); err != nil {
+		return nil, err
+	}
+
+	return store.PageObjects(items, cfg.PaginationToken, cfg.MaxQueryItemCount)
+}
+
+// Get retrieves the object with the given id from the store, or returns store.ErrNotFound.
+func (c *BoltClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj *store.Object
+	err = c.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket([]byte(BucketName)).Get([]byte(keyFromID(parsed)))
+		if v == nil {
+			return &store.ErrNotFound{ID: id}
+		}
+
+		obj = &store.Object{}
+		return json.Unmarshal(v, obj)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete deletes the object with the given id from the store. If an ETag is provided it must match the
+// stored ETag, otherwise store.ErrConcurrency is returned.
+func (c *BoltClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewDeleteConfig(options...)
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return deleteKey(tx.Bucket([]byte(BucketName)), key, id, config.ETag, false)
+	})
+}
+
+// Save creates or updates the object in the store and sets the object's ETag. If an ETag is provided the object
+// must already exist with a matching ETag, and with store.WithCreateOnly the object must not exist, otherwise
+// store.ErrConcurrency is returned.
+func (c *BoltClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	key := []byte(keyFromID(parsed))
+	config := store.NewSaveConfig(options...)
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		if config.CreateOnly && bucket.Get(key) != nil {
+			return &store.ErrConcurrency{}
+		}
+
+		saved, err := putKey(bucket, key, obj, config.ETag)
+		if err != nil {
+			return err
+		}
+
+		obj.ETag = saved
+		return nil
+	})
+}
+
+// CommitBatch applies all operations in the batch in a single bolt transaction.
+func (c *BoltClient) CommitBatch(ctx context.Context, batch *store.Batch) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	keys := [][]byte{}
+	for _, op := range batch.Operations {
+		parsed, err := parseID(op.ID)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, []byte(keyFromID(parsed)))
+	}
+
+	etags := make([]store.ETag, len(batch.Operations))
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(BucketName))
+		for i, op := range batch.Operations {
+			var err error
+			switch op.Type {
+			case store.BatchOperationSave:
+				etags[i], err = putKey(bucket, keys[i], op.Object, op.ETag)
+			case store.BatchOperationDelete:
+				err = deleteKey(bucket, keys[i], op.ID, op.ETag, true)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only update the caller's objects once the transaction has been committed.
+	for i, op := range batch.Operations {
+		if op.Type == store.BatchOperationSave {
+			op.Object.ETag = etags[i]
+		}
+	}
+
+	return nil
+}
+
+// SharesStore returns true if the other client is a BoltClient using the same database.
+func (c *BoltClient) SharesStore(other store.StorageClient) bool {
+	o, ok := other.(*BoltClient)
+	return ok && o.db == c.db
+}
+
+// putKey writes the object to the bucket and returns the new ETag. If an ETag is provided the object must already
+// exist with a matching ETag. The caller's object is not modified.
+func putKey(bucket *bolt.Bucket, key []byte, obj *store.Object, expected store.ETag) (store.ETag, error) {
+	if err := checkETag(bucket, key, expected); err != nil {
+		return "", err
+	}
+
+	revision, err := bucket.NextSequence()
+	if err != nil {
+		return "", err
+	}
+
+	saved := *obj
+	saved.ETag = etag.NewFromRevision(int64(revision))
+
+	b, err := json.Marshal(&saved)
+	if err != nil {
+		return "", err
+	}
+
+	if err := bucket.Put(key, b); err != nil {
+		return "", err
+	}
+
+	return saved.ETag, nil
+}
+
+// deleteKey deletes the key from the bucket. If an ETag is provided the object must exist with a matching ETag.
+// Deleting a missing key returns store.ErrNotFound unless missingOK is set.
+func deleteKey(bucket *bolt.Bucket, key []byte, id string, expected store.ETag, missingOK bool) error {
+	if err := checkETag(bucket, key, expected); err != nil {
+		return err
+	}
+
+	if bucket.Get(key) == nil {
+		if missingOK {
+			return nil
+		}
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return bucket.Delete(key)
+}
+
+// checkETag returns store.ErrConcurrency if an ETag is provided and the stored object does not exist or has a
+// different ETag.
+func checkETag(bucket *bolt.Bucket, key []byte, expected store.ETag) error {
+	if expected == "" {
+		return nil
+	}
+
+	v := bucket.Get(key)
+	if v == nil {
+		return &store.ErrConcurrency{}
+	}
+
+	existing := store.Object{}
+	if err := json.Unmarshal(v, &existing); err != nil {
+		return err
+	}
+
+	if existing.ETag != expected {
+		return &store.ErrConcurrency{}
+	}
+
+	return nil
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the key to use for an ID. They key should be used as an exact match.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
+
+// keyFromQuery returns the key to use for an for executing a query. The key should be used as a prefix.
+func keyFromQuery(query store.Query) string {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	if query.ScopeRecursive {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope)
+	} else {
+		return prefix + SectionSeparator + storeutil.NormalizePart(query.RootScope) + SectionSeparator + storeutil.NormalizePart(query.RoutingScopePrefix)
+	}
+}
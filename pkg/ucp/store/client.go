@@ -18,6 +18,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 )
 
 //go:generate mockgen -destination=./mock_storageClient.go -package=store -self_package github.com/radius-project/radius/pkg/ucp/store github.com/radius-project/radius/pkg/ucp/store StorageClient
@@ -71,10 +72,42 @@ type Query struct {
 	// 	set RootScope to /planes/radius/local and ScopeRecursive = True and IsScopeQuery to False.
 	IsScopeQuery bool
 
-	// TODO: Revisit filter design
-
-	// Filters is an query filter to filter the specific property value.
+	// Filters is an query filter to filter the specific property value. Filters is equivalent to a list of
+	// FilterOperatorEqual conditions and is kept for compatibility, new code should use Conditions.
 	Filters []QueryFilter
+
+	// Conditions is the optional list of conditions applied to the data of each object. An object matches the
+	// query when it matches all of the conditions. Storage clients push the conditions down to the database when
+	// it supports them, otherwise they are evaluated client-side with Object.MatchesConditions.
+	//
+	// Example: to find the resources of an application that were created after a point in time
+	//
+	//	[]FilterCondition{
+	//		{Field: "properties.application", Operator: FilterOperatorEqual, Values: []string{appID}},
+	//		{Field: "systemData.createdAt", Operator: FilterOperatorGreaterThan, Values: []string{"2023-10-01T00:00:00Z"}},
+	//	}
+	Conditions []FilterCondition
+
+	// OrderBy is the optional list of fields used to sort the results. Results are sorted by the first field, then
+	// by the second field, and so on. The order of results is unspecified when OrderBy is empty.
+	OrderBy []SortField
+}
+
+// ValidateConditions returns ErrInvalid if any of the conditions or sort fields of the query are malformed.
+func (q Query) ValidateConditions() error {
+	for _, condition := range q.Conditions {
+		if err := condition.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range q.OrderBy {
+		if field.Field == "" {
+			return &ErrInvalid{Message: "invalid argument. sort field is required"}
+		}
+	}
+
+	return nil
 }
 
 // QueryFilter is the filter which filters property in resource entity.
@@ -82,3 +115,80 @@ type QueryFilter struct {
 	Field string
 	Value string
 }
+
+// FilterOperator is the comparison operator of a FilterCondition.
+type FilterOperator string
+
+const (
+	// FilterOperatorEqual matches when the field is equal to the value.
+	FilterOperatorEqual FilterOperator = "eq"
+
+	// FilterOperatorNotEqual matches when the field is not equal to the value, including when the field is missing.
+	FilterOperatorNotEqual FilterOperator = "ne"
+
+	// FilterOperatorIn matches when the field is equal to any of the values.
+	FilterOperatorIn FilterOperator = "in"
+
+	// FilterOperatorPrefix matches when the field starts with the value.
+	FilterOperatorPrefix FilterOperator = "prefix"
+
+	// FilterOperatorExists matches when the field is present and not null. It does not take a value.
+	FilterOperatorExists FilterOperator = "exists"
+
+	// FilterOperatorGreaterThan matches when the field is greater than the value. See CompareValues for the ordering.
+	FilterOperatorGreaterThan FilterOperator = "gt"
+
+	// FilterOperatorLessThan matches when the field is less than the value. See CompareValues for the ordering.
+	FilterOperatorLessThan FilterOperator = "lt"
+)
+
+// FilterCondition is a condition on a field of the object's data.
+type FilterCondition struct {
+	// Field is the '.' separated path of the field in the object's data.
+	//
+	// Example:
+	//	properties.application
+	Field string
+
+	// Operator is the comparison operator.
+	Operator FilterOperator
+
+	// Values are the operands of the comparison. FilterOperatorIn takes one or more values, FilterOperatorExists
+	// takes none, and the other operators take exactly one.
+	Values []string
+}
+
+// Validate returns ErrInvalid if the condition is malformed.
+func (c FilterCondition) Validate() error {
+	if c.Field == "" {
+		return &ErrInvalid{Message: "invalid argument. filter condition requires a field"}
+	}
+
+	switch c.Operator {
+	case FilterOperatorExists:
+		if len(c.Values) != 0 {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter operator %q does not take a value", c.Operator)}
+		}
+	case FilterOperatorIn:
+		if len(c.Values) == 0 {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter operator %q requires at least one value", c.Operator)}
+		}
+	case FilterOperatorEqual, FilterOperatorNotEqual, FilterOperatorPrefix, FilterOperatorGreaterThan, FilterOperatorLessThan:
+		if len(c.Values) != 1 {
+			return &ErrInvalid{Message: fmt.Sprintf("invalid argument. filter operator %q requires exactly one value", c.Operator)}
+		}
+	default:
+		return &ErrInvalid{Message: fmt.Sprintf("invalid argument. unsupported filter operator %q", c.Operator)}
+	}
+
+	return nil
+}
+
+// SortField is a field used to sort query results.
+type SortField struct {
+	// Field is the '.' separated path of the field in the object's data.
+	Field string
+
+	// Descending sorts the results in descending order when true.
+	Descending bool
+}
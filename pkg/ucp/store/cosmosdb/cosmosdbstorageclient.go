@@ -39,9 +39,98 @@ const (
 	errResourceNotFoundMsg       = "Resource that no longer exists"
 	errIDConflictMsg             = "The ID provided has been taken by an existing resource"
 	errEtagPreconditionMsgPrefix = "The operation specified an eTag"
+
+	// batchStoredProcedureName is the name of the stored procedure used by CommitBatch. The name is versioned so that
+	// changes to the stored procedure do not affect running instances using the previous version.
+	batchStoredProcedureName = "radiusCommitBatchV1"
 )
 
+// batchStoredProcedure commits a batch of operations within a single partition. Stored procedures run in a
+// transaction scoped to the partition, so throwing an error reverts every write made by the procedure.
+//
+// ETag preconditions are checked before any writes are made so that a conflict can be reported to the caller
+// without throwing, since the error returned for a failed stored procedure does not include the cause.
+const batchStoredProcedure = `function commitBatch(operations) {
+    var collection = getContext().getCollection();
+    var response = getContext().getResponse();
+    var etags = [];
+
+    function check(i) {
+        if (i >= operations.length) {
+            write(0);
+            return;
+        }
+
+        var op = operations[i];
+        if (!op.etag) {
+            check(i + 1);
+            return;
+        }
+
+        var accepted = collection.readDocument(collection.getAltLink() + "/docs/" + op.id, {}, function (err, doc) {
+            if (err && err.number !== 404) {
+                throw err;
+            }
+            if (err || doc._etag !== op.etag) {
+                response.setBody({ conflict: true });
+                return;
+            }
+            check(i + 1);
+        });
+        if (!accepted) {
+            throw new Error("the batch was not completed within the allotted time");
+        }
+    }
+
+    function write(i) {
+        if (i >= operations.length) {
+            response.setBody({ etags: etags });
+            return;
+        }
+
+        var op = operations[i];
+        var accepted;
+        if (op.type === "Save") {
+            accepted = collection.upsertDocument(collection.getAltLink(), op.document, {}, function (err, doc) {
+                if (err) {
+                    throw err;
+                }
+                etags.push(doc._etag);
+                write(i + 1);
+            });
+        } else {
+            accepted = collection.deleteDocument(collection.getAltLink() + "/docs/" + op.id, {}, function (err) {
+                if (err && err.number !== 404) {
+                    throw err;
+                }
+                write(i + 1);
+            });
+        }
+        if (!accepted) {
+            throw new Error("the batch was not completed within the allotted time");
+        }
+    }
+
+    check(0);
+}`
+
+// batchOperation is the argument passed to the batch stored procedure for each operation.
+type batchOperation struct {
+	Type     store.BatchOperationType `json:"type"`
+	ID       string                   `json:"id"`
+	ETag     string                   `json:"etag,omitempty"`
+	Document *ResourceEntity          `json:"document,omitempty"`
+}
+
+// batchResult is the result of the batch stored procedure.
+type batchResult struct {
+	Conflict bool     `json:"conflict"`
+	ETags    []string `json:"etags"`
+}
+
 var _ store.StorageClient = (*CosmosDBStorageClient)(nil)
+var _ store.Watcher = (*CosmosDBStorageClient)(nil)
+var _ store.Transactional = (*CosmosDBStorageClient)(nil)
 
 // ResourceEntity represents the default envelope model to store resource metadata.
 type ResourceEntity struct {
@@ -93,8 +182,8 @@ func NewCosmosDBStorageClient(options *ConnectionOptions) (*CosmosDBStorageClien
 	}, nil
 }
 
-// Init checks if the database and collection exist, and if not, creates them. It returns an error if
-// either of the checks or creations fail.
+// Init checks if the database, collection and stored procedures exist, and if not, creates them. It returns an error
+// if any of the checks or creations fail.
 func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createDatabaseIfNotExists(ctx); err != nil {
 		return err
@@ -102,6 +191,9 @@ func (c *CosmosDBStorageClient) Init(ctx context.Context) error {
 	if err := c.createCollectionIfNotExists(ctx); err != nil {
 		return err
 	}
+	if err := c.createStoredProcedureIfNotExists(ctx, batchStoredProcedureName, batchStoredProcedure); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -173,6 +265,21 @@ func (c *CosmosDBStorageClient) createCollectionIfNotExists(ctx context.Context)
 	return err
 }
 
+func (c *CosmosDBStorageClient) createStoredProcedureIfNotExists(ctx context.Context, name string, body string) error {
+	_, err := c.client.GetStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, name)
+	if err == nil {
+		return nil
+	}
+	if err != nil && !strings.EqualFold(err.Error(), errResourceNotFoundMsg) {
+		return err
+	}
+	_, err = c.client.CreateStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, name, body)
+	if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+		return nil
+	}
+	return err
+}
+
 func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	if query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "RoutingScopePrefix is not supported."}
@@ -223,6 +330,55 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 		})
 	}
 
+	for i, condition := range query.Conditions {
+		if err := condition.Validate(); err != nil {
+			return nil, err
+		}
+
+		path, err := entityPath(condition.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		// Values are compared as strings like store.Object.MatchesConditions does, so that only scalar values can
+		// match a value and eg: the number 6379 is equal to '6379'.
+		scalar := fmt.Sprintf("(IS_PRIMITIVE(%s) AND NOT IS_NULL(%s))", path, path)
+		param := fmt.Sprintf("@condition%d", i)
+		var expr string
+		var value any = nil
+		switch condition.Operator {
+		case store.FilterOperatorEqual:
+			expr, value = fmt.Sprintf("(%s AND ToString(%s) = %s)", scalar, path, param), condition.Values[0]
+		case store.FilterOperatorNotEqual:
+			// Missing fields and non-scalar values are not equal to any value.
+			expr, value = fmt.Sprintf("NOT (%s AND ToString(%s) = %s)", scalar, path, param), condition.Values[0]
+		case store.FilterOperatorIn:
+			expr, value = fmt.Sprintf("(%s AND ARRAY_CONTAINS(%s, ToString(%s)))", scalar, param, path), condition.Values
+		case store.FilterOperatorPrefix:
+			expr, value = fmt.Sprintf("(%s AND STARTSWITH(ToString(%s), %s))", scalar, path, param), condition.Values[0]
+		case store.FilterOperatorExists:
+			expr = fmt.Sprintf("(IS_DEFINED(%s) AND NOT IS_NULL(%s))", path, path)
+		case store.FilterOperatorGreaterThan, store.FilterOperatorLessThan:
+			// Range comparisons use the ordering of store.CompareValues and are evaluated client-side.
+			continue
+		}
+
+		if whereParam != "" {
+			whereParam += " and "
+		}
+		whereParam += expr
+		if value != nil {
+			queryParams = append(queryParams, cosmosapi.QueryParam{Name: param, Value: value})
+		}
+	}
+
+	for _, field := range query.OrderBy {
+		// Sort fields are evaluated client-side, but they are still validated here.
+		if _, err := entityPath(field.Field); err != nil {
+			return nil, err
+		}
+	}
+
 	if whereParam == "" {
 		return nil, &store.ErrInvalid{Message: "invalid Query parameters"}
 	}
@@ -230,6 +386,24 @@ func constructCosmosDBQuery(query store.Query) (*cosmosapi.Query, error) {
 	return &cosmosapi.Query{Query: queryString + whereParam, Params: queryParams}, nil
 }
 
+// entityPath converts a '.' separated field path to a property path of the entity. Bracket notation is used so that
+// fields with names that are not valid identifiers (eg: tag names) can be used.
+func entityPath(field string) (string, error) {
+	if field == "" {
+		return "", &store.ErrInvalid{Message: "invalid argument. field is required"}
+	}
+
+	path := "c.entity"
+	for _, segment := range strings.Split(field, ".") {
+		if segment == "" || strings.ContainsAny(segment, "\"\\") {
+			return "", &store.ErrInvalid{Message: fmt.Sprintf("invalid argument. field %q is not supported", field)}
+		}
+		path += "[\"" + segment + "\"]"
+	}
+
+	return path, nil
+}
+
 // Query builds and executes a CosmosDB query based on the provided store.Query and returns the results.
 func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, opts ...store.QueryOptions) (*store.ObjectQueryResult, error) {
 	if ctx == nil {
@@ -254,8 +428,6 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 		return nil, err
 	}
 
-	entities := []ResourceEntity{}
-
 	maxItemCount := c.options.DefaultQueryItemCount
 	if cfg.MaxQueryItemCount > 0 {
 		maxItemCount = cfg.MaxQueryItemCount
@@ -279,15 +451,65 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 		qops.EnableCrossPartition = false
 	}
 
+	if store.RequiresClientSideEvaluation(query) {
+		return c.queryClientSide(ctx, query, *qry, qops, cfg)
+	}
+
 	if cfg.PaginationToken != "" {
 		qops.Continuation = cfg.PaginationToken
 	}
 
-	resp, err := c.client.QueryDocuments(ctx, c.options.DatabaseName, c.options.CollectionName, *qry, &entities, qops)
+	output, continuation, err := c.queryDocuments(ctx, *qry, qops)
 	if err != nil {
 		return nil, err
 	}
 
+	return &store.ObjectQueryResult{
+		PaginationToken: continuation,
+		Items:           output,
+	}, nil
+}
+
+// queryClientSide runs a query that needs client-side evaluation. All the documents that match the conditions the
+// database can evaluate are read, then filtered, sorted and paginated with the helpers of the store package.
+func (c *CosmosDBStorageClient) queryClientSide(ctx context.Context, query store.Query, qry cosmosapi.Query, qops cosmosapi.QueryDocumentsOptions, cfg store.StoreConfig) (*store.ObjectQueryResult, error) {
+	items := []store.Object{}
+	for {
+		output, continuation, err := c.queryDocuments(ctx, qry, qops)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range output {
+			match, err := obj.MatchesConditions(query.Conditions)
+			if err != nil {
+				return nil, err
+			} else if match {
+				items = append(items, obj)
+			}
+		}
+
+		if continuation == "" {
+			break
+		}
+		qops.Continuation = continuation
+	}
+
+	if err := store.SortObjects(items, query.OrderBy); err != nil {
+		return nil, err
+	}
+
+	return store.PageObjects(items, cfg.PaginationToken, cfg.MaxQueryItemCount)
+}
+
+// queryDocuments runs a page of the query and returns the objects and the continuation token of the next page.
+func (c *CosmosDBStorageClient) queryDocuments(ctx context.Context, qry cosmosapi.Query, qops cosmosapi.QueryDocumentsOptions) ([]store.Object, string, error) {
+	entities := []ResourceEntity{}
+	resp, err := c.client.QueryDocuments(ctx, c.options.DatabaseName, c.options.CollectionName, qry, &entities, qops)
+	if err != nil {
+		return nil, "", err
+	}
+
 	output := []store.Object{}
 	for _, entity := range entities {
 		output = append(output, store.Object{
@@ -299,10 +521,7 @@ func (c *CosmosDBStorageClient) Query(ctx context.Context, query store.Query, op
 		})
 	}
 
-	return &store.ObjectQueryResult{
-		PaginationToken: resp.Continuation,
-		Items:           output,
-	}, nil
+	return output, resp.Continuation, nil
 }
 
 // Get retrieves an object using CosmosDBStorageClient using the provided ID and optional GetOptions. It returns an error
@@ -376,7 +595,8 @@ func (c *CosmosDBStorageClient) Delete(ctx context.Context, id string, opts ...s
 }
 
 // Save saves an object to the CosmosDB storage, returning an error if one occurs. If an ETag is provided, an error is
-// returned if the ETag does not match the existing ETag.
+// returned if the ETag does not match the existing ETag, and with store.WithCreateOnly an error is returned if the
+// object exists.
 func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opts ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -416,7 +636,15 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	}
 
 	var resp *cosmosapi.Resource
-	if ifMatch == "" {
+	if cfg.CreateOnly {
+		op := cosmosapi.CreateDocumentOptions{
+			PartitionKeyValue: partitionKey,
+		}
+		resp, _, err = c.client.CreateDocument(ctx, c.options.DatabaseName, c.options.CollectionName, entity, op)
+		if err != nil && strings.EqualFold(err.Error(), errIDConflictMsg) {
+			return &store.ErrConcurrency{}
+		}
+	} else if ifMatch == "" {
 		op := cosmosapi.CreateDocumentOptions{
 			PartitionKeyValue: partitionKey,
 			IsUpsert:          true,
@@ -444,6 +672,85 @@ func (c *CosmosDBStorageClient) Save(ctx context.Context, obj *store.Object, opt
 	return nil
 }
 
+// CommitBatch applies all operations in the batch atomically using a stored procedure. CosmosDB transactions are
+// scoped to a single partition, so all objects in the batch must belong to the same partition, otherwise
+// store.ErrInvalid is returned.
+func (c *CosmosDBStorageClient) CommitBatch(ctx context.Context, batch *store.Batch) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+	if len(batch.Operations) == 0 {
+		return nil
+	}
+
+	partitionKey := ""
+	operations := []batchOperation{}
+	for i, op := range batch.Operations {
+		parsed, err := resources.Parse(op.ID)
+		if err != nil {
+			return err
+		}
+
+		pk, err := GetPartitionKey(parsed)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			partitionKey = pk
+		} else if pk != partitionKey {
+			return &store.ErrInvalid{Message: "invalid argument. all objects in a batch must belong to the same partition"}
+		}
+
+		docID, err := GenerateCosmosDBKey(parsed)
+		if err != nil {
+			return err
+		}
+
+		operation := batchOperation{Type: op.Type, ID: docID, ETag: op.ETag}
+		if op.Type == store.BatchOperationSave {
+			operation.Document = &ResourceEntity{
+				ID:           docID,
+				ResourceID:   strings.ToLower(parsed.String()),
+				RootScope:    strings.ToLower(parsed.RootScope()),
+				PartitionKey: partitionKey,
+				Entity:       op.Object.Data,
+			}
+		}
+		operations = append(operations, operation)
+	}
+
+	result := batchResult{}
+	opts := cosmosapi.ExecuteStoredProcedureOptions{PartitionKeyValue: partitionKey}
+	err := c.client.ExecuteStoredProcedure(ctx, c.options.DatabaseName, c.options.CollectionName, batchStoredProcedureName, opts, &result, operations)
+	if err != nil {
+		return err
+	}
+
+	if result.Conflict {
+		return &store.ErrConcurrency{}
+	}
+
+	i := 0
+	for _, op := range batch.Operations {
+		if op.Type == store.BatchOperationSave && i < len(result.ETags) {
+			op.Object.ETag = result.ETags[i]
+			i++
+		}
+	}
+
+	return nil
+}
+
+// SharesStore returns true if the other client is a CosmosDBStorageClient for the same collection. CosmosDB
+// transactions can't span collections.
+func (c *CosmosDBStorageClient) SharesStore(other store.StorageClient) bool {
+	o, ok := other.(*CosmosDBStorageClient)
+	return ok && o.options.Url == c.options.Url && o.options.DatabaseName == c.options.DatabaseName && o.options.CollectionName == c.options.CollectionName
+}
+
 // GetPartitionKey returns a partition key based on the given ID, normalizing the subscription ID and normalizing the
 // plane namespace if the ID is UCP-qualified.
 // Examples:
@@ -458,3 +765,9 @@ func GetPartitionKey(id resources.ID) (string, error) {
 
 	return partitionKey, nil
 }
+
+// Watch streams changes to objects matching the query. CosmosDB change feed is not supported by our SDK, so
+// Watch polls using store.PollingWatch.
+func (c *CosmosDBStorageClient) Watch(ctx context.Context, query store.Query, opts ...store.WatchOptions) (<-chan store.WatchEvent, error) {
+	return store.PollingWatch(ctx, c, query, opts...)
+}
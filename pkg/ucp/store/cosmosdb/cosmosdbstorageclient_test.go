@@ -30,6 +30,7 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/store"
+	shared "github.com/radius-project/radius/test/ucp/storetest"
 	"github.com/stretchr/testify/require"
 	"github.com/vippsas/go-cosmosdb/cosmosapi"
 )
@@ -227,6 +228,56 @@ func TestConstructCosmosDBQuery(t *testing.T) {
 			}},
 			err: nil,
 		},
+		{
+			desc: "conditions-and-order-by",
+			storeQuery: store.Query{
+				RootScope:    "/planes/radius/local/resourcegroups/testgroup",
+				ResourceType: "applications.core/containers",
+				Conditions: []store.FilterCondition{
+					{Field: "properties.provisioningState", Operator: store.FilterOperatorIn, Values: []string{"Failed", "Canceled"}},
+					{Field: "tags.team", Operator: store.FilterOperatorExists},
+					{Field: "name", Operator: store.FilterOperatorPrefix, Values: []string{"front"}},
+					{Field: "location", Operator: store.FilterOperatorNotEqual, Values: []string{"global"}},
+					{Field: "properties.port", Operator: store.FilterOperatorEqual, Values: []string{"6379"}},
+					{Field: "systemData.createdAt", Operator: store.FilterOperatorGreaterThan, Values: []string{"2023-10-01T00:00:00Z"}},
+				},
+				OrderBy: []store.SortField{{Field: "systemData.createdAt", Descending: true}, {Field: "name"}},
+			},
+			// Range comparisons and sorting are evaluated client-side.
+			queryString: "SELECT * FROM c WHERE c.rootScope = @rootScope and STRINGEQUALS(c.entity.type, @rtype, true) and " +
+				"((IS_PRIMITIVE(c.entity[\"properties\"][\"provisioningState\"]) AND NOT IS_NULL(c.entity[\"properties\"][\"provisioningState\"])) AND ARRAY_CONTAINS(@condition0, ToString(c.entity[\"properties\"][\"provisioningState\"]))) and " +
+				"(IS_DEFINED(c.entity[\"tags\"][\"team\"]) AND NOT IS_NULL(c.entity[\"tags\"][\"team\"])) and " +
+				"((IS_PRIMITIVE(c.entity[\"name\"]) AND NOT IS_NULL(c.entity[\"name\"])) AND STARTSWITH(ToString(c.entity[\"name\"]), @condition2)) and " +
+				"NOT ((IS_PRIMITIVE(c.entity[\"location\"]) AND NOT IS_NULL(c.entity[\"location\"])) AND ToString(c.entity[\"location\"]) = @condition3) and " +
+				"((IS_PRIMITIVE(c.entity[\"properties\"][\"port\"]) AND NOT IS_NULL(c.entity[\"properties\"][\"port\"])) AND ToString(c.entity[\"properties\"][\"port\"]) = @condition4)",
+			params: []cosmosapi.QueryParam{{
+				Name:  "@rootScope",
+				Value: "/planes/radius/local/resourcegroups/testgroup",
+			}, {
+				Name:  "@rtype",
+				Value: "applications.core/containers",
+			}, {
+				Name:  "@condition0",
+				Value: []string{"Failed", "Canceled"},
+			}, {
+				Name:  "@condition2",
+				Value: "front",
+			}, {
+				Name:  "@condition3",
+				Value: "global",
+			}, {
+				Name:  "@condition4",
+				Value: "6379",
+			}},
+		},
+		{
+			desc: "invalid-sort-field",
+			storeQuery: store.Query{
+				RootScope: "/planes/radius/local/resourcegroups/testgroup",
+				OrderBy:   []store.SortField{{Field: "name"}, {Field: "tags..team"}},
+			},
+			err: &store.ErrInvalid{Message: "invalid argument. field \"tags..team\" is not supported"},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -735,6 +786,22 @@ func TestPaginationTokenAndQueryItemCount(t *testing.T) {
 	}
 }
 
+// TestQueryConditions runs the query conditions tests shared by all the storage clients.
+func TestQueryConditions(t *testing.T) {
+	ctx := context.Background()
+	client := mustGetTestClient(t)
+
+	clear := func(t *testing.T) {
+		result, err := client.Query(ctx, store.Query{RootScope: shared.RadiusScope, ScopeRecursive: true})
+		require.NoError(t, err)
+		for _, item := range result.Items {
+			require.NoError(t, client.Delete(ctx, item.ID))
+		}
+	}
+
+	shared.RunConditionsTest(t, client, clear)
+}
+
 func TestGetPartitionKey(t *testing.T) {
 	cases := []struct {
 		desc   string
@@ -49,6 +49,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
@@ -68,6 +69,8 @@ func NewETCDClient(c *etcdclient.Client) *ETCDClient {
 }
 
 var _ store.StorageClient = (*ETCDClient)(nil)
+var _ store.Watcher = (*ETCDClient)(nil)
+var _ store.Transactional = (*ETCDClient)(nil)
 
 type ETCDClient struct {
 	client *etcdclient.Client
@@ -84,6 +87,9 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
 		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
 	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
 
 	key := keyFromQuery(query)
 
@@ -105,7 +111,7 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 				return nil, err
 			}
 
-			match, err := value.MatchesFilters(query.Filters)
+			match, err := value.Matches(query)
 			if err != nil {
 				return nil, err
 			} else if !match {
@@ -117,6 +123,10 @@ func (c *ETCDClient) Query(ctx context.Context, query store.Query, options ...st
 		}
 	}
 
+	if err := store.SortObjects(results.Items, query.OrderBy); err != nil {
+		return nil, err
+	}
+
 	return &results, nil
 }
 
@@ -220,7 +230,8 @@ func (c *ETCDClient) Delete(ctx context.Context, id string, options ...store.Del
 }
 
 // Save checks the context and object parameters, parses the object ID, marshals the object into JSON, saves the object to
-// the store, and sets the object's ETag. If an ETag is provided, a transaction is executed to ensure concurrency.
+// the store, and sets the object's ETag. If an ETag is provided, or the object must be created with store.WithCreateOnly,
+// a transaction is executed to ensure concurrency.
 func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
 	if ctx == nil {
 		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
@@ -243,6 +254,25 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	key := keyFromID(parsed)
 	config := store.NewSaveConfig(options...)
 
+	// A key which doesn't exist has a create revision of 0.
+	if config.CreateOnly {
+		txn, err := c.client.Txn(ctx).
+			If(etcdclient.Compare(etcdclient.CreateRevision(key), "=", 0)).
+			Then(etcdclient.OpPut(key, string(b))).
+			Commit()
+		if err != nil {
+			return err
+		}
+
+		if !txn.Succeeded {
+			return &store.ErrConcurrency{}
+		}
+
+		response := txn.Responses[0].GetResponsePut()
+		obj.ETag = etag.NewFromRevision(response.Header.Revision)
+		return nil
+	}
+
 	// If we have an ETag then we do to execute a transaction.
 	if config.ETag != "" {
 		revision, err := etag.ParseRevision(config.ETag)
@@ -279,6 +309,188 @@ func (c *ETCDClient) Save(ctx context.Context, obj *store.Object, options ...sto
 	return nil
 }
 
+// CommitBatch applies all operations in the batch using a single etcd transaction. The ETag preconditions of the
+// operations are evaluated as comparisons of the transaction, so the batch fails with store.ErrConcurrency without
+// applying any changes when one of them does not match.
+func (c *ETCDClient) CommitBatch(ctx context.Context, batch *store.Batch) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	comparisons := []etcdclient.Cmp{}
+	ops := []etcdclient.Op{}
+	for _, op := range batch.Operations {
+		parsed, err := resources.Parse(op.ID)
+		if err != nil {
+			return &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+		}
+
+		key := keyFromID(parsed)
+		if op.ETag != "" {
+			revision, err := etag.ParseRevision(op.ETag)
+			if err != nil {
+				// Treat an invalid ETag as a concurrency failure, since it will never match.
+				return &store.ErrConcurrency{}
+			}
+
+			comparisons = append(comparisons, etcdclient.Compare(etcdclient.ModRevision(key), "=", revision))
+		}
+
+		switch op.Type {
+		case store.BatchOperationSave:
+			b, err := json.Marshal(op.Object)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, etcdclient.OpPut(key, string(b)))
+		case store.BatchOperationDelete:
+			ops = append(ops, etcdclient.OpDelete(key))
+		}
+	}
+
+	txn, err := c.client.Txn(ctx).If(comparisons...).Then(ops...).Commit()
+	if err != nil {
+		return err
+	}
+
+	if !txn.Succeeded {
+		return &store.ErrConcurrency{}
+	}
+
+	// Every key written by the transaction has the revision of the transaction as its mod revision.
+	for _, op := range batch.Operations {
+		if op.Type == store.BatchOperationSave {
+			op.Object.ETag = etag.NewFromRevision(txn.Header.Revision)
+		}
+	}
+
+	return nil
+}
+
+// SharesStore returns true if the other client is an ETCDClient using the same etcd client.
+func (c *ETCDClient) SharesStore(other store.StorageClient) bool {
+	o, ok := other.(*ETCDClient)
+	return ok && o.client == c.client
+}
+
+// Watch streams changes to objects matching the query using etcd's native watch. The resume token of each event is
+// the etcd revision of the change, so a watch can be resumed as long as the revision has not been compacted.
+func (c *ETCDClient) Watch(ctx context.Context, query store.Query, options ...store.WatchOptions) (<-chan store.WatchEvent, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
+
+	config := store.NewWatchConfig(options...)
+	opts := []etcdclient.OpOption{etcdclient.WithPrefix(), etcdclient.WithPrevKV()}
+	if config.ResumeToken != "" {
+		revision, err := strconv.ParseInt(config.ResumeToken, 10, 64)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. resume token is invalid"}
+		}
+
+		// The resume token is the revision of the last event that was observed, so start from the next one.
+		opts = append(opts, etcdclient.WithRev(revision+1))
+	}
+
+	watch := c.client.Watch(ctx, keyFromQuery(query), opts...)
+
+	out := make(chan store.WatchEvent, 1)
+	go func() {
+		defer close(out)
+
+		send := func(event store.WatchEvent) bool {
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for response := range watch {
+			if err := response.Err(); err != nil {
+				if ctx.Err() == nil {
+					send(store.WatchEvent{Type: store.WatchEventError, Err: err})
+				}
+				return
+			}
+
+			for _, event := range response.Events {
+				converted, err := convertEvent(event, query)
+				if err != nil {
+					send(store.WatchEvent{Type: store.WatchEventError, Err: err})
+					return
+				} else if converted == nil {
+					continue
+				}
+
+				if !send(*converted) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// convertEvent converts an etcd watch event to a store.WatchEvent. Returns nil if the event does not match the query.
+func convertEvent(event *etcdclient.Event, query store.Query) (*store.WatchEvent, error) {
+	if !keyMatchesQuery(event.Kv.Key, query) {
+		return nil, nil
+	}
+
+	result := store.WatchEvent{ResumeToken: strconv.FormatInt(event.Kv.ModRevision, 10)}
+	switch {
+	case event.Type == etcdclient.EventTypeDelete:
+		result.Type = store.WatchEventDeleted
+		if event.PrevKv != nil {
+			if err := json.Unmarshal(event.PrevKv.Value, &result.Object); err != nil {
+				return nil, err
+			}
+			result.Object.ETag = etag.NewFromRevision(event.PrevKv.ModRevision)
+		} else {
+			id, err := idFromKey(event.Kv.Key)
+			if err != nil {
+				return nil, err
+			}
+			result.Object.ID = id.String()
+		}
+	case event.IsCreate():
+		result.Type = store.WatchEventCreated
+	default:
+		result.Type = store.WatchEventUpdated
+	}
+
+	if result.Type != store.WatchEventDeleted {
+		if err := json.Unmarshal(event.Kv.Value, &result.Object); err != nil {
+			return nil, err
+		}
+		result.Object.ETag = etag.NewFromRevision(event.Kv.ModRevision)
+	}
+
+	match, err := result.Object.Matches(query)
+	if err != nil {
+		return nil, err
+	} else if !match {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
 // Client returns the etcdclient.Client instance stored in the ETCDClient struct.
 func (c *ETCDClient) Client() *etcdclient.Client {
 	return c.client
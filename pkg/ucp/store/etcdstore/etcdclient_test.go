@@ -22,6 +22,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/ucp/data"
 	"github.com/radius-project/radius/pkg/ucp/hosting"
+	"github.com/radius-project/radius/pkg/ucp/store"
 	"github.com/stretchr/testify/require"
 	etcdclient "go.etcd.io/etcd/client/v3"
 
@@ -67,4 +68,45 @@ func Test_ETCDClient(t *testing.T) {
 
 	// The actual test logic lives in a shared package, we're just doing the setup here.
 	shared.RunTest(t, client, clear)
+
+	t.Run("watch", func(t *testing.T) {
+		clear(t)
+
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		defer cancelWatch()
+
+		query := store.Query{RootScope: shared.ResourceGroup1Scope}
+		events, err := client.Watch(watchCtx, query)
+		require.NoError(t, err)
+
+		obj := store.Object{Metadata: store.Metadata{ID: shared.Resource1ID.String()}, Data: shared.Data1}
+		require.NoError(t, client.Save(ctx, &obj))
+
+		// Not part of the query, should not produce an event.
+		other := store.Object{Metadata: store.Metadata{ID: shared.Resource2ID.String()}, Data: shared.Data2}
+		require.NoError(t, client.Save(ctx, &other))
+
+		obj.Data = shared.Data2
+		require.NoError(t, client.Save(ctx, &obj))
+		require.NoError(t, client.Delete(ctx, shared.Resource1ID.String()))
+
+		created := <-events
+		require.Equal(t, store.WatchEventCreated, created.Type)
+		require.Equal(t, shared.Resource1ID.String(), created.Object.ID)
+
+		updated := <-events
+		require.Equal(t, store.WatchEventUpdated, updated.Type)
+		require.Equal(t, obj.ETag, updated.Object.ETag)
+
+		deleted := <-events
+		require.Equal(t, store.WatchEventDeleted, deleted.Type)
+		require.Equal(t, shared.Resource1ID.String(), deleted.Object.ID)
+
+		// Resuming from the created event should replay the update and delete.
+		cancelWatch()
+		resumed, err := client.Watch(ctx, query, store.WithResumeToken(created.ResumeToken))
+		require.NoError(t, err)
+		require.Equal(t, store.WatchEventUpdated, (<-resumed).Type)
+		require.Equal(t, store.WatchEventDeleted, (<-resumed).Type)
+	})
 }
@@ -17,8 +17,13 @@ limitations under the License.
 package store
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // MatchesFilters checks if the object's data matches the given filters and returns a boolean and an error.
@@ -73,3 +78,267 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 
 	return true, nil
 }
+
+// MatchesConditions checks if the object's data matches all of the given conditions and returns a boolean and
+// an error.
+func (o Object) MatchesConditions(conditions []FilterCondition) (bool, error) {
+	if len(conditions) == 0 {
+		// Skip expensive work if there is nothing to filter-by.
+		return true, nil
+	}
+
+	data, err := o.dataMap()
+	if err != nil {
+		return false, err
+	}
+
+	for _, condition := range conditions {
+		if err := condition.Validate(); err != nil {
+			return false, err
+		}
+
+		value, found := lookupField(data, condition.Field)
+		if !condition.matches(value, found) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Matches checks if the object matches both the legacy equality filters and the conditions of the query.
+func (o Object) Matches(query Query) (bool, error) {
+	match, err := o.MatchesFilters(query.Filters)
+	if err != nil || !match {
+		return match, err
+	}
+
+	return o.MatchesConditions(query.Conditions)
+}
+
+func (c FilterCondition) matches(value any, found bool) bool {
+	if c.Operator == FilterOperatorExists {
+		return found && value != nil
+	}
+
+	s, ok := scalarString(value)
+	if !found || !ok {
+		// Missing fields and non-scalar values never equal a value, so they only match 'ne'.
+		return c.Operator == FilterOperatorNotEqual
+	}
+
+	switch c.Operator {
+	case FilterOperatorEqual:
+		return s == c.Values[0]
+	case FilterOperatorNotEqual:
+		return s != c.Values[0]
+	case FilterOperatorIn:
+		for _, v := range c.Values {
+			if s == v {
+				return true
+			}
+		}
+		return false
+	case FilterOperatorPrefix:
+		return strings.HasPrefix(s, c.Values[0])
+	case FilterOperatorGreaterThan:
+		return CompareValues(s, c.Values[0]) > 0
+	case FilterOperatorLessThan:
+		return CompareValues(s, c.Values[0]) < 0
+	}
+
+	return false
+}
+
+// RequiresClientSideEvaluation returns true if the query has range conditions or sort fields. Their ordering is
+// defined by CompareValues, which databases cannot evaluate, so storage clients read all the objects matching the
+// rest of the query, then filter, sort and paginate them with Object.MatchesConditions, SortObjects and PageObjects.
+func RequiresClientSideEvaluation(query Query) bool {
+	if len(query.OrderBy) > 0 {
+		return true
+	}
+
+	for _, condition := range query.Conditions {
+		if condition.Operator == FilterOperatorGreaterThan || condition.Operator == FilterOperatorLessThan {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PageObjects returns the page of items starting at the offset encoded in the pagination token, with at most
+// maxItemCount items when maxItemCount is greater than zero. The pagination token of the result is the offset of the
+// next page.
+func PageObjects(items []Object, paginationToken string, maxItemCount int) (*ObjectQueryResult, error) {
+	offset := 0
+	if paginationToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(paginationToken)
+		if err == nil {
+			offset, err = strconv.Atoi(string(b))
+		}
+		if err != nil || offset < 0 {
+			return nil, &ErrInvalid{Message: "invalid argument. pagination token is invalid"}
+		}
+	}
+
+	result := &ObjectQueryResult{}
+	if offset >= len(items) {
+		return result, nil
+	}
+
+	items = items[offset:]
+	if maxItemCount > 0 && len(items) > maxItemCount {
+		items = items[:maxItemCount]
+		result.PaginationToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset + maxItemCount)))
+	}
+
+	result.Items = items
+	return result, nil
+}
+
+// SortObjects sorts the objects in place using the given sort fields. The sort is stable, so objects with equal
+// sort values retain their order. Objects missing a field sort before objects that have it.
+func SortObjects(items []Object, orderBy []SortField) error {
+	if len(orderBy) == 0 {
+		return nil
+	}
+
+	data := make([]map[string]any, len(items))
+	for i := range items {
+		m, err := items[i].dataMap()
+		if err != nil {
+			return err
+		}
+		data[i] = m
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(a, b int) bool {
+		for _, field := range orderBy {
+			left, lok := lookupField(data[indexes[a]], field.Field)
+			right, rok := lookupField(data[indexes[b]], field.Field)
+			ls, lok := scalarIfFound(left, lok)
+			rs, rok := scalarIfFound(right, rok)
+
+			result := 0
+			switch {
+			case !lok && !rok:
+				result = 0
+			case !lok:
+				result = -1
+			case !rok:
+				result = 1
+			default:
+				result = CompareValues(ls, rs)
+			}
+
+			if field.Descending {
+				result = -result
+			}
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+
+	sorted := make([]Object, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+	return nil
+}
+
+// CompareValues compares two values for ordering. Values that are both RFC3339 timestamps or both numbers are
+// compared by their parsed value, otherwise they are compared as strings.
+func CompareValues(a string, b string) int {
+	if at, err := time.Parse(time.RFC3339Nano, a); err == nil {
+		if bt, err := time.Parse(time.RFC3339Nano, b); err == nil {
+			return at.Compare(bt)
+		}
+	}
+
+	if af, err := strconv.ParseFloat(a, 64); err == nil {
+		if bf, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case af < bf:
+				return -1
+			case af > bf:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// dataMap returns the data of the object as a map[string]any, converting it through JSON if needed.
+func (o Object) dataMap() (map[string]any, error) {
+	switch data := o.Data.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		return data, nil
+	}
+
+	b, err := json.Marshal(o.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]any{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// lookupField returns the value of the dot-separated field path in data.
+func lookupField(data map[string]any, field string) (any, bool) {
+	var current any = data
+	for _, segment := range strings.Split(field, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func scalarIfFound(value any, found bool) (string, bool) {
+	if !found {
+		return "", false
+	}
+	return scalarString(value)
+}
+
+// scalarString returns the string representation of a scalar JSON value.
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	}
+
+	return "", false
+}
@@ -123,3 +123,119 @@ func Test_MatchesFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_MatchesConditions(t *testing.T) {
+	obj := &Object{Data: map[string]any{
+		"name": "frontend",
+		"properties": map[string]any{
+			"provisioningState": "Succeeded",
+			"replicas":          float64(3),
+			"empty":             nil,
+			"nested":            map[string]any{"value": "cool"},
+		},
+	}}
+
+	cases := []struct {
+		Description   string
+		Conditions    []FilterCondition
+		ExpectedMatch bool
+	}{
+		{"empty", []FilterCondition{}, true},
+		{"eq_match", []FilterCondition{{Field: "name", Operator: FilterOperatorEqual, Values: []string{"frontend"}}}, true},
+		{"eq_not_match", []FilterCondition{{Field: "name", Operator: FilterOperatorEqual, Values: []string{"backend"}}}, false},
+		{"eq_number", []FilterCondition{{Field: "properties.replicas", Operator: FilterOperatorEqual, Values: []string{"3"}}}, true},
+		{"eq_non_scalar", []FilterCondition{{Field: "properties.nested", Operator: FilterOperatorEqual, Values: []string{"cool"}}}, false},
+		{"ne_match", []FilterCondition{{Field: "name", Operator: FilterOperatorNotEqual, Values: []string{"backend"}}}, true},
+		{"ne_missing", []FilterCondition{{Field: "properties.missing", Operator: FilterOperatorNotEqual, Values: []string{"backend"}}}, true},
+		{"in_match", []FilterCondition{{Field: "properties.provisioningState", Operator: FilterOperatorIn, Values: []string{"Failed", "Succeeded"}}}, true},
+		{"in_not_match", []FilterCondition{{Field: "properties.provisioningState", Operator: FilterOperatorIn, Values: []string{"Failed", "Canceled"}}}, false},
+		{"prefix_match", []FilterCondition{{Field: "name", Operator: FilterOperatorPrefix, Values: []string{"front"}}}, true},
+		{"prefix_missing", []FilterCondition{{Field: "properties.missing", Operator: FilterOperatorPrefix, Values: []string{"front"}}}, false},
+		{"exists_match", []FilterCondition{{Field: "properties.nested.value", Operator: FilterOperatorExists}}, true},
+		{"exists_null", []FilterCondition{{Field: "properties.empty", Operator: FilterOperatorExists}}, false},
+		{"exists_missing", []FilterCondition{{Field: "properties.missing", Operator: FilterOperatorExists}}, false},
+		{"gt_number", []FilterCondition{{Field: "properties.replicas", Operator: FilterOperatorGreaterThan, Values: []string{"10"}}}, false},
+		{"lt_number", []FilterCondition{{Field: "properties.replicas", Operator: FilterOperatorLessThan, Values: []string{"10"}}}, true},
+		{
+			"multiple_conditions",
+			[]FilterCondition{
+				{Field: "name", Operator: FilterOperatorEqual, Values: []string{"frontend"}},
+				{Field: "properties.provisioningState", Operator: FilterOperatorEqual, Values: []string{"Failed"}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Description, func(t *testing.T) {
+			match, err := obj.MatchesConditions(tc.Conditions)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedMatch, match)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := obj.MatchesConditions([]FilterCondition{{Field: "name", Operator: FilterOperatorExists, Values: []string{"a"}}})
+		require.ErrorIs(t, err, &ErrInvalid{})
+	})
+}
+
+func Test_SortObjects(t *testing.T) {
+	items := []Object{
+		{Metadata: Metadata{ID: "a"}, Data: map[string]any{"createdAt": "2023-10-02T00:00:00Z", "group": "x"}},
+		{Metadata: Metadata{ID: "b"}, Data: map[string]any{"createdAt": "2023-10-01T00:00:00Z", "group": "y"}},
+		{Metadata: Metadata{ID: "c"}, Data: map[string]any{"group": "x"}},
+		{Metadata: Metadata{ID: "d"}, Data: map[string]any{"createdAt": "2023-10-03T00:00:00Z", "group": "x"}},
+	}
+
+	ids := func() []string {
+		result := []string{}
+		for _, item := range items {
+			result = append(result, item.ID)
+		}
+		return result
+	}
+
+	err := SortObjects(items, []SortField{{Field: "createdAt"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "b", "a", "d"}, ids())
+
+	err = SortObjects(items, []SortField{{Field: "group"}, {Field: "createdAt", Descending: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"d", "a", "c", "b"}, ids())
+}
+
+func Test_CompareValues(t *testing.T) {
+	require.Equal(t, -1, CompareValues("2", "10"))
+	require.Equal(t, 1, CompareValues("2023-10-01T10:00:00+02:00", "2023-10-01T07:00:00Z"))
+	require.Equal(t, 0, CompareValues("1.0", "1"))
+	require.Equal(t, -1, CompareValues("abc", "abd"))
+	require.Equal(t, 1, CompareValues("b", "10"))
+}
+
+func Test_RequiresClientSideEvaluation(t *testing.T) {
+	require.False(t, RequiresClientSideEvaluation(Query{Conditions: []FilterCondition{{Field: "name", Operator: FilterOperatorEqual, Values: []string{"a"}}}}))
+	require.True(t, RequiresClientSideEvaluation(Query{Conditions: []FilterCondition{{Field: "port", Operator: FilterOperatorGreaterThan, Values: []string{"1"}}}}))
+	require.True(t, RequiresClientSideEvaluation(Query{OrderBy: []SortField{{Field: "name"}}}))
+}
+
+func Test_PageObjects(t *testing.T) {
+	items := []Object{{Metadata: Metadata{ID: "a"}}, {Metadata: Metadata{ID: "b"}}, {Metadata: Metadata{ID: "c"}}}
+
+	result, err := PageObjects(items, "", 2)
+	require.NoError(t, err)
+	require.Equal(t, items[:2], result.Items)
+	require.NotEmpty(t, result.PaginationToken)
+
+	result, err = PageObjects(items, result.PaginationToken, 2)
+	require.NoError(t, err)
+	require.Equal(t, items[2:], result.Items)
+	require.Empty(t, result.PaginationToken)
+
+	result, err = PageObjects(items, "", 0)
+	require.NoError(t, err)
+	require.Equal(t, items, result.Items)
+
+	_, err = PageObjects(items, "not-a-token", 2)
+	require.ErrorIs(t, err, &ErrInvalid{})
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Lease is a lease stored as an object of the storage client, which lets one of the replicas of a service run a
+// periodic task. The lease is held by one holder at a time until it expires or is renewed by its holder. Concurrent
+// updates of the lease are detected with the ETag of the object, and concurrent creations of the lease with a create-only
+// save.
+type Lease struct {
+	client   StorageClient
+	id       string
+	holder   string
+	duration time.Duration
+}
+
+// leaseData is the data of the object storing the lease.
+type leaseData struct {
+	Holder    string    `json:"holder"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NewLease creates the lease stored as the object with the given ID. holder identifies the caller, and duration is how
+// long the lease is held after it is acquired or renewed.
+func NewLease(client StorageClient, id string, holder string, duration time.Duration) *Lease {
+	return &Lease{
+		client:   client,
+		id:       id,
+		holder:   holder,
+		duration: duration,
+	}
+}
+
+// TryAcquire acquires the lease if it is not held by another holder, or renews it if it is held by the caller. It
+// returns true if the caller holds the lease.
+func (l *Lease) TryAcquire(ctx context.Context) (bool, error) {
+	now := time.Now().UTC()
+
+	etag := ""
+	obj, err := l.client.Get(ctx, l.id)
+	if err != nil && !errors.Is(err, &ErrNotFound{}) {
+		return false, err
+	} else if err == nil {
+		current := leaseData{}
+		if err := obj.As(&current); err != nil {
+			return false, err
+		}
+
+		if current.Holder != l.holder && now.Before(current.ExpiresAt) {
+			return false, nil
+		}
+		etag = obj.ETag
+	}
+
+	update := &Object{
+		Metadata: Metadata{ID: l.id},
+		Data:     &leaseData{Holder: l.holder, ExpiresAt: now.Add(l.duration)},
+	}
+
+	// The lease is created only if it doesn't exist yet, so that one of the holders creating it at the same time
+	// holds it.
+	option := WithCreateOnly()
+	if etag != "" {
+		option = WithETag(etag)
+	}
+
+	err = l.client.Save(ctx, update, option)
+	if errors.Is(err, &ErrConcurrency{}) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
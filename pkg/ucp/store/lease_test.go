@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/boltstore"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const testLeaseID = "/planes/radius/local/providers/applications.core/locations/global/leases/test"
+
+func newTestStorageClient(t *testing.T) store.StorageClient {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0o600, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	client, err := boltstore.NewBoltClient(db)
+	require.NoError(t, err)
+	return client
+}
+
+func Test_Lease_HeldByOneHolder(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestStorageClient(t)
+
+	first := store.NewLease(client, testLeaseID, "first", time.Hour)
+	second := store.NewLease(client, testLeaseID, "second", time.Hour)
+
+	acquired, err := first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	acquired, err = second.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	// The holder renews the lease.
+	acquired, err = first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, acquired)
+}
+
+func Test_Lease_AcquiredAfterExpiry(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := newTestStorageClient(t)
+
+	first := store.NewLease(client, testLeaseID, "first", time.Millisecond)
+	second := store.NewLease(client, testLeaseID, "second", time.Hour)
+
+	acquired, err := first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	time.Sleep(10 * time.Millisecond)
+
+	acquired, err = second.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	acquired, err = first.TryAcquire(ctx)
+	require.NoError(t, err)
+	require.False(t, acquired)
+}
+
+// racingGetClient is a storage client whose first Get calls return once all the racing callers have read the object,
+// so that they all find the lease missing before any of them creates it.
+type racingGetClient struct {
+	store.StorageClient
+	racing int32
+	reads  atomic.Int32
+	read   *sync.WaitGroup
+}
+
+func (c *racingGetClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	obj, err := c.StorageClient.Get(ctx, id, options...)
+	if c.reads.Add(1) <= c.racing {
+		c.read.Done()
+		c.read.Wait()
+	}
+	return obj, err
+}
+
+func Test_Lease_CreatedByOneHolder(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	read := &sync.WaitGroup{}
+	read.Add(2)
+	client := &racingGetClient{StorageClient: newTestStorageClient(t), racing: 2, read: read}
+
+	holders := []*store.Lease{
+		store.NewLease(client, testLeaseID, "first", time.Hour),
+		store.NewLease(client, testLeaseID, "second", time.Hour),
+	}
+
+	acquired := make([]bool, len(holders))
+	errs := make([]error, len(holders))
+	done := &sync.WaitGroup{}
+	for i, holder := range holders {
+		done.Add(1)
+		go func(i int, holder *store.Lease) {
+			defer done.Done()
+			acquired[i], errs[i] = holder.TryAcquire(ctx)
+		}(i, holder)
+	}
+	done.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.True(t, acquired[0] != acquired[1], "exactly one holder must acquire the lease")
+}
@@ -16,6 +16,8 @@ limitations under the License.
 
 package store
 
+import "time"
+
 type (
 	// QueryOptions applies an option to Query().
 	QueryOptions interface {
@@ -60,6 +62,16 @@ type (
 		SaveOptions
 		DeleteOptions
 	}
+
+	// WatchOptions applies an option to Watch().
+	WatchOptions interface {
+		ApplyWatchOption(StoreConfig) StoreConfig
+
+		// A private method to prevent users implementing the
+		// interface and so future additions to it will not
+		// violate compatibility.
+		private()
+	}
 )
 
 // Store Config represents the configurations of storageclient APIs.
@@ -72,6 +84,15 @@ type StoreConfig struct {
 
 	// ETag represents the entity tag for optimistic consistency control.
 	ETag ETag
+
+	// CreateOnly represents that Save() only creates the object. Save() returns ErrConcurrency if the object exists.
+	CreateOnly bool
+
+	// ResumeToken represents the token returned by a previous watch event. The watch resumes after the event.
+	ResumeToken string
+
+	// PollingInterval represents the interval between queries when a watch falls back to polling.
+	PollingInterval time.Duration
 }
 
 // Query Options
@@ -150,6 +171,50 @@ func WithETag(etag ETag) MutatingOptions {
 	}
 }
 
+// WithCreateOnly sets the CreateOnly field in the StoreConfig struct.
+func WithCreateOnly() SaveOptions {
+	return &saveOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.CreateOnly = true
+			return cfg
+		},
+	}
+}
+
+// WatchOptions
+type watchOptions struct {
+	fn func(StoreConfig) StoreConfig
+}
+
+var _ WatchOptions = (*watchOptions)(nil)
+
+// ApplyWatchOption applies a watch option to a StoreConfig.
+func (w *watchOptions) ApplyWatchOption(cfg StoreConfig) StoreConfig {
+	return w.fn(cfg)
+}
+
+func (w watchOptions) private() {}
+
+// WithResumeToken sets the resume token for Watch().
+func WithResumeToken(token string) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.ResumeToken = token
+			return cfg
+		},
+	}
+}
+
+// WithPollingInterval sets the polling interval for Watch() when the storage client does not support native watches.
+func WithPollingInterval(interval time.Duration) WatchOptions {
+	return &watchOptions{
+		fn: func(cfg StoreConfig) StoreConfig {
+			cfg.PollingInterval = interval
+			return cfg
+		},
+	}
+}
+
 // NewQueryConfig applies a set of QueryOptions to a StoreConfig and returns the modified StoreConfig for Query().
 func NewQueryConfig(opts ...QueryOptions) StoreConfig {
 	cfg := StoreConfig{}
@@ -176,3 +241,12 @@ func NewSaveConfig(opts ...SaveOptions) StoreConfig {
 	}
 	return cfg
 }
+
+// NewWatchConfig applies a set of WatchOptions to a StoreConfig and returns the modified StoreConfig for Watch().
+func NewWatchConfig(opts ...WatchOptions) StoreConfig {
+	cfg := StoreConfig{}
+	for _, opt := range opts {
+		cfg = opt.ApplyWatchOption(cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+const (
+	// migrationLockID is the key of the advisory lock that serializes schema migrations across replicas.
+	migrationLockID = 0x72616469757301
+)
+
+// migrations is the ordered list of schema migrations. The version of a migration is its index in the list + 1.
+//
+// Migrations are append-only. Never modify or reorder an existing entry once it has shipped, add a new
+// migration instead.
+var migrations = []string{
+	// Version 1: initial schema.
+	`
+CREATE SEQUENCE IF NOT EXISTS resource_revision;
+
+CREATE TABLE IF NOT EXISTS resources (
+	storage_key   TEXT PRIMARY KEY,
+	resource_id   TEXT NOT NULL,
+	kind          TEXT NOT NULL,
+	root_scope    TEXT NOT NULL,
+	routing_scope TEXT NOT NULL,
+	resource_type TEXT NOT NULL,
+	api_version   TEXT NOT NULL DEFAULT '',
+	content_type  TEXT NOT NULL DEFAULT '',
+	revision      BIGINT NOT NULL,
+	data          JSONB
+);
+
+CREATE INDEX IF NOT EXISTS resources_scope_idx ON resources (kind, root_scope, routing_scope);
+CREATE INDEX IF NOT EXISTS resources_type_idx ON resources (resource_type);
+`,
+}
+
+// migrate applies all pending schema migrations inside a single transaction.
+func migrate(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Multiple replicas can start at the same time. The advisory lock is released when the transaction ends.
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	current := 0
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&current); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if current > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than the latest known version %d", current, len(migrations))
+	}
+
+	for i := current; i < len(migrations); i++ {
+		version := i + 1
+		if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+			return fmt.Errorf("failed to apply schema migration %d: %w", version, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version); err != nil {
+			return fmt.Errorf("failed to record schema migration %d: %w", version, err)
+		}
+	}
+
+	return tx.Commit()
+}
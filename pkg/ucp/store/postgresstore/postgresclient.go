@@ -0,0 +1,537 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package postgresstore stores resources in a PostgreSQL database.
+//
+// All resources and scopes are stored in a single 'resources' table. Each row is keyed by the same
+// storage key used by etcdstore:
+//
+//	scope|/planes/radius/local/|/resourceGroups/cool-group/
+//	resource|/planes/radius/local/resourceGroups/cool-group/|/Applications.Core/applications/cool-app/
+//
+// The components of the key (kind, root scope, routing scope and resource type) are also stored as
+// separate columns so that queries can be executed by the database rather than with client-side filtering.
+//
+// ETags are derived from a database sequence that is incremented on every write, which gives us the
+// same optimistic-concurrency semantics as etcd's mod revision.
+package postgresstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/pkg/ucp/store/storeutil"
+	"github.com/radius-project/radius/pkg/ucp/util/etag"
+)
+
+const (
+	// SectionSeparator is the separator used between the sections of a storage key.
+	SectionSeparator = "|"
+)
+
+var _ store.StorageClient = (*PostgreSQLClient)(nil)
+var _ store.Transactional = (*PostgreSQLClient)(nil)
+
+// PostgreSQLClient implements store.StorageClient using PostgreSQL.
+type PostgreSQLClient struct {
+	db *sql.DB
+}
+
+// NewPostgreSQLClient creates a new PostgreSQLClient instance with the given database handle. Call Init before
+// using the client to ensure that the schema is up to date.
+func NewPostgreSQLClient(db *sql.DB) *PostgreSQLClient {
+	return &PostgreSQLClient{db: db}
+}
+
+// Init applies any pending schema migrations. Init is safe to call concurrently from multiple processes.
+func (c *PostgreSQLClient) Init(ctx context.Context) error {
+	return migrate(ctx, c.db)
+}
+
+// DB returns the sql.DB instance used by the client.
+func (c *PostgreSQLClient) DB() *sql.DB {
+	return c.db
+}
+
+// Query retrieves objects from the store that match the given query and filters. Results are ordered by key, and
+// the query is paginated when store.WithMaxQueryItemCount is specified.
+//
+// Conditions are evaluated by the database except for range comparisons, which use the ordering of
+// store.CompareValues. Queries with range comparisons or with query.OrderBy are sorted and paginated client-side.
+func (c *PostgreSQLClient) Query(ctx context.Context, query store.Query, options ...store.QueryOptions) (*store.ObjectQueryResult, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+	if query.IsScopeQuery && query.RoutingScopePrefix != "" {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'query.RoutingScopePrefix' is not supported for scope queries"}
+	}
+	if err := query.ValidateConditions(); err != nil {
+		return nil, err
+	}
+
+	cfg := store.NewQueryConfig(options...)
+	if store.RequiresClientSideEvaluation(query) {
+		return c.queryClientSide(ctx, query, cfg)
+	}
+
+	after := ""
+	if cfg.PaginationToken != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cfg.PaginationToken)
+		if err != nil {
+			return nil, &store.ErrInvalid{Message: "invalid argument. pagination token is invalid"}
+		}
+		after = string(b)
+	}
+
+	statement, args := buildQuery(query, after, cfg.MaxQueryItemCount)
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &store.ObjectQueryResult{}
+	lastKey := ""
+	for rows.Next() {
+		key := ""
+		obj, err := scanObject(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+
+		lastKey = key
+		result.Items = append(result.Items, *obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if cfg.MaxQueryItemCount > 0 && len(result.Items) == cfg.MaxQueryItemCount {
+		result.PaginationToken = base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+	}
+
+	return result, nil
+}
+
+// queryClientSide runs a query that needs client-side evaluation. All rows that match the conditions the database
+// can evaluate are read, then filtered, sorted and paginated with the helpers of the store package.
+func (c *PostgreSQLClient) queryClientSide(ctx context.Context, query store.Query, cfg store.StoreConfig) (*store.ObjectQueryResult, error) {
+	statement, args := buildQuery(query, "", 0)
+	rows, err := c.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []store.Object{}
+	for rows.Next() {
+		key := ""
+		obj, err := scanObject(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+
+		match, err := obj.MatchesConditions(query.Conditions)
+		if err != nil {
+			return nil, err
+		} else if match {
+			items = append(items, *obj)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := store.SortObjects(items, query.OrderBy); err != nil {
+		return nil, err
+	}
+
+	return store.PageObjects(items, cfg.PaginationToken, cfg.MaxQueryItemCount)
+}
+
+// Get retrieves the object with the given id from the store, or returns store.ErrNotFound.
+func (c *PostgreSQLClient) Get(ctx context.Context, id string, options ...store.GetOptions) (*store.Object, error) {
+	if ctx == nil {
+		return nil, &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	row := c.db.QueryRowContext(ctx, selectColumns+" FROM resources WHERE storage_key = $1", keyFromID(parsed))
+
+	key := ""
+	obj, err := scanObject(row, &key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &store.ErrNotFound{ID: id}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// Delete deletes the object with the given id from the store. If an ETag is provided it must match the
+// stored revision, otherwise store.ErrConcurrency is returned.
+func (c *PostgreSQLClient) Delete(ctx context.Context, id string, options ...store.DeleteOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	parsed, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	return deleteKey(ctx, c.db, keyFromID(parsed), id, store.NewDeleteConfig(options...).ETag, false)
+}
+
+// Save creates or updates the object in the store and sets the object's ETag. If an ETag is provided the object
+// must already exist with a matching revision, and with store.WithCreateOnly the object must not exist, otherwise
+// store.ErrConcurrency is returned.
+func (c *PostgreSQLClient) Save(ctx context.Context, obj *store.Object, options ...store.SaveOptions) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if obj == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'obj' is required"}
+	}
+
+	parsed, err := resources.Parse(obj.Metadata.ID)
+	if err != nil {
+		return err
+	}
+
+	config := store.NewSaveConfig(options...)
+
+	var revision int64
+	if config.CreateOnly {
+		revision, err = createObject(ctx, c.db, parsed, obj)
+	} else {
+		revision, err = saveObject(ctx, c.db, parsed, obj, config.ETag)
+	}
+	if err != nil {
+		return err
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return nil
+}
+
+// CommitBatch applies all operations in the batch in a single database transaction.
+func (c *PostgreSQLClient) CommitBatch(ctx context.Context, batch *store.Batch) error {
+	if ctx == nil {
+		return &store.ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if err := batch.Validate(); err != nil {
+		return err
+	}
+
+	ids := []resources.ID{}
+	for _, op := range batch.Operations {
+		parsed, err := parseID(op.ID)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, parsed)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	revisions := make([]int64, len(batch.Operations))
+	for i, op := range batch.Operations {
+		switch op.Type {
+		case store.BatchOperationSave:
+			revisions[i], err = saveObject(ctx, tx, ids[i], op.Object, op.ETag)
+		case store.BatchOperationDelete:
+			err = deleteKey(ctx, tx, keyFromID(ids[i]), op.ID, op.ETag, true)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// Only update the caller's objects once the transaction has been committed.
+	for i, op := range batch.Operations {
+		if op.Type == store.BatchOperationSave {
+			op.Object.ETag = etag.NewFromRevision(revisions[i])
+		}
+	}
+
+	return nil
+}
+
+// SharesStore returns true if the other client is a PostgreSQLClient using the same database handle.
+func (c *PostgreSQLClient) SharesStore(other store.StorageClient) bool {
+	o, ok := other.(*PostgreSQLClient)
+	return ok && o.db == c.db
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// saveObject writes the object and returns the new revision. If an ETag is provided the object must already exist
+// with a matching revision. The caller's object is not modified.
+func saveObject(ctx context.Context, q querier, parsed resources.ID, obj *store.Object, expected store.ETag) (int64, error) {
+	data, err := json.Marshal(obj.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+	key := keyFromID(parsed)
+
+	var revision int64
+	if expected != "" {
+		expectedRevision, err := etag.ParseRevision(expected)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return 0, &store.ErrConcurrency{}
+		}
+
+		err = q.QueryRowContext(ctx, `
+UPDATE resources
+SET resource_id = $2, api_version = $3, content_type = $4, data = $5, revision = nextval('resource_revision')
+WHERE storage_key = $1 AND revision = $6
+RETURNING revision`,
+			key, obj.Metadata.ID, obj.Metadata.APIVersion, obj.Metadata.ContentType, string(data), expectedRevision).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, &store.ErrConcurrency{}
+		} else if err != nil {
+			return 0, err
+		}
+
+		return revision, nil
+	}
+
+	err = q.QueryRowContext(ctx, `
+INSERT INTO resources (storage_key, resource_id, kind, root_scope, routing_scope, resource_type, api_version, content_type, data, revision)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, nextval('resource_revision'))
+ON CONFLICT (storage_key) DO UPDATE
+SET resource_id = EXCLUDED.resource_id, api_version = EXCLUDED.api_version, content_type = EXCLUDED.content_type,
+	data = EXCLUDED.data, revision = EXCLUDED.revision
+RETURNING revision`,
+		key, obj.Metadata.ID, prefix, rootScope, routingScope, resourceType, obj.Metadata.APIVersion, obj.Metadata.ContentType, string(data)).Scan(&revision)
+	if err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+// createObject inserts the object and returns its revision. The object must not exist, otherwise
+// store.ErrConcurrency is returned. The caller's object is not modified.
+func createObject(ctx context.Context, q querier, parsed resources.ID, obj *store.Object) (int64, error) {
+	data, err := json.Marshal(obj.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	prefix, rootScope, routingScope, resourceType := storeutil.ExtractStorageParts(parsed)
+
+	var revision int64
+	err = q.QueryRowContext(ctx, `
+INSERT INTO resources (storage_key, resource_id, kind, root_scope, routing_scope, resource_type, api_version, content_type, data, revision)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, nextval('resource_revision'))
+ON CONFLICT (storage_key) DO NOTHING
+RETURNING revision`,
+		keyFromID(parsed), obj.Metadata.ID, prefix, rootScope, routingScope, resourceType, obj.Metadata.APIVersion, obj.Metadata.ContentType, string(data)).Scan(&revision)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, &store.ErrConcurrency{}
+	} else if err != nil {
+		return 0, err
+	}
+
+	return revision, nil
+}
+
+// deleteKey deletes the row with the given key. If an ETag is provided the row must exist with a matching revision.
+// Deleting a missing row returns store.ErrNotFound unless missingOK is set.
+func deleteKey(ctx context.Context, q querier, key string, id string, expected store.ETag, missingOK bool) error {
+	if expected != "" {
+		revision, err := etag.ParseRevision(expected)
+		if err != nil {
+			// Treat an invalid ETag as a concurrency failure, since it will never match.
+			return &store.ErrConcurrency{}
+		}
+
+		result, err := q.ExecContext(ctx, "DELETE FROM resources WHERE storage_key = $1 AND revision = $2", key, revision)
+		if err != nil {
+			return err
+		}
+
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return &store.ErrConcurrency{}
+		}
+
+		return nil
+	}
+
+	result, err := q.ExecContext(ctx, "DELETE FROM resources WHERE storage_key = $1", key)
+	if err != nil {
+		return err
+	}
+
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 && !missingOK {
+		return &store.ErrNotFound{ID: id}
+	}
+
+	return nil
+}
+
+const selectColumns = "SELECT storage_key, resource_id, api_version, content_type, revision, data"
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanObject(row scanner, key *string) (*store.Object, error) {
+	obj := &store.Object{}
+	var revision int64
+	var data []byte
+	if err := row.Scan(key, &obj.ID, &obj.APIVersion, &obj.ContentType, &revision, &data); err != nil {
+		return nil, err
+	}
+
+	if data != nil {
+		if err := json.Unmarshal(data, &obj.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	obj.ETag = etag.NewFromRevision(revision)
+	return obj, nil
+}
+
+// buildQuery builds the SQL statement and arguments for a store.Query. Range comparisons in query.Conditions are
+// not included in the statement.
+func buildQuery(query store.Query, after string, limit int) (string, []any) {
+	prefix := storeutil.ResourcePrefix
+	if query.IsScopeQuery {
+		prefix = storeutil.ScopePrefix
+	}
+
+	args := []any{prefix, storeutil.NormalizePart(query.RootScope)}
+	conditions := []string{"kind = $1"}
+	if query.ScopeRecursive {
+		conditions = append(conditions, "starts_with(root_scope, $2)")
+	} else {
+		conditions = append(conditions, "root_scope = $2")
+	}
+
+	next := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if query.RoutingScopePrefix != "" {
+		conditions = append(conditions, "starts_with(routing_scope, "+next(storeutil.NormalizePart(query.RoutingScopePrefix))+")")
+	}
+
+	if query.ResourceType != "" {
+		conditions = append(conditions, "resource_type = "+next(strings.ToLower(query.ResourceType)))
+	}
+
+	for _, filter := range query.Filters {
+		// Matches the behavior of store.Object.MatchesFilters: only string values can match a filter.
+		path := next(pq.Array(strings.Split(filter.Field, ".")))
+		conditions = append(conditions, fmt.Sprintf("jsonb_typeof(data #> %s) = 'string' AND data #>> %s = %s", path, path, next(filter.Value)))
+	}
+
+	for _, condition := range query.Conditions {
+		if condition.Operator == store.FilterOperatorGreaterThan || condition.Operator == store.FilterOperatorLessThan {
+			// Range comparisons are evaluated client-side.
+			continue
+		}
+
+		path := next(pq.Array(strings.Split(condition.Field, ".")))
+
+		// Matches the behavior of store.Object.MatchesConditions: only scalar values can be compared to a value.
+		scalar := fmt.Sprintf("(CASE WHEN jsonb_typeof(data #> %s) IN ('string', 'number', 'boolean') THEN data #>> %s END)", path, path)
+		switch condition.Operator {
+		case store.FilterOperatorEqual:
+			conditions = append(conditions, scalar+" = "+next(condition.Values[0]))
+		case store.FilterOperatorNotEqual:
+			conditions = append(conditions, scalar+" IS DISTINCT FROM "+next(condition.Values[0]))
+		case store.FilterOperatorIn:
+			conditions = append(conditions, scalar+" = ANY("+next(pq.Array(condition.Values))+")")
+		case store.FilterOperatorPrefix:
+			conditions = append(conditions, "starts_with("+scalar+", "+next(condition.Values[0])+")")
+		case store.FilterOperatorExists:
+			conditions = append(conditions, fmt.Sprintf("jsonb_typeof(data #> %s) <> 'null'", path))
+		}
+	}
+
+	if after != "" {
+		conditions = append(conditions, "storage_key > "+next(after))
+	}
+
+	statement := selectColumns + " FROM resources WHERE " + strings.Join(conditions, " AND ") + " ORDER BY storage_key"
+	if limit > 0 {
+		statement += " LIMIT " + next(limit)
+	}
+
+	return statement, args
+}
+
+func parseID(id string) (resources.ID, error) {
+	parsed, err := resources.Parse(id)
+	if err != nil {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must be a valid resource id"}
+	}
+	if parsed.IsEmpty() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must not be empty"}
+	}
+	if parsed.IsResourceCollection() || parsed.IsScopeCollection() {
+		return resources.ID{}, &store.ErrInvalid{Message: "invalid argument. 'id' must refer to a named resource, not a collection"}
+	}
+
+	return parsed, nil
+}
+
+// keyFromID returns the storage key to use for an ID.
+func keyFromID(id resources.ID) string {
+	prefix, rootScope, routingScope, _ := storeutil.ExtractStorageParts(id)
+	return prefix + SectionSeparator + rootScope + SectionSeparator + routingScope
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+)
+
+// QueryEach runs the query to completion, following the pagination tokens, and calls fn for each result in order. It
+// stops and returns the error if the query or fn fails.
+func QueryEach(ctx context.Context, client StorageClient, query Query, fn func(obj *Object) error) error {
+	token := ""
+	for {
+		options := []QueryOptions{}
+		if token != "" {
+			options = append(options, WithPaginationToken(token))
+		}
+
+		result, err := client.Query(ctx, query, options...)
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Items {
+			if err := fn(&result.Items[i]); err != nil {
+				return err
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		token = result.PaginationToken
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QueryEach(t *testing.T) {
+	query := Query{RootScope: "/planes", ScopeRecursive: true, ResourceType: "Applications.Core/containers"}
+
+	setup := func(t *testing.T) *MockStorageClient {
+		client := NewMockStorageClient(gomock.NewController(t))
+		client.EXPECT().
+			Query(gomock.Any(), query).
+			Return(&ObjectQueryResult{Items: []Object{{Metadata: Metadata{ID: "a"}}, {Metadata: Metadata{ID: "b"}}}, PaginationToken: "next"}, nil)
+		client.EXPECT().
+			Query(gomock.Any(), query, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error) {
+				require.Equal(t, "next", NewQueryConfig(options...).PaginationToken)
+				return &ObjectQueryResult{Items: []Object{{Metadata: Metadata{ID: "c"}}}}, nil
+			}).
+			MaxTimes(1)
+		return client
+	}
+
+	t.Run("follows pagination tokens", func(t *testing.T) {
+		ids := []string{}
+		err := QueryEach(context.Background(), setup(t), query, func(obj *Object) error {
+			ids = append(ids, obj.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, ids)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		expected := errors.New("stop")
+		ids := []string{}
+		err := QueryEach(context.Background(), setup(t), query, func(obj *Object) error {
+			ids = append(ids, obj.ID)
+			return expected
+		})
+		require.ErrorIs(t, err, expected)
+		require.Equal(t, []string{"a"}, ids)
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		client := NewMockStorageClient(gomock.NewController(t))
+		client.EXPECT().Query(gomock.Any(), query).Return(nil, errors.New("query failed"))
+
+		err := QueryEach(context.Background(), client, query, func(obj *Object) error {
+			return nil
+		})
+		require.EqualError(t, err, "query failed")
+	})
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultPollingInterval is the interval between queries when a watch falls back to polling.
+	DefaultPollingInterval = 10 * time.Second
+)
+
+// WatchEventType is the type of a change reported by Watch().
+type WatchEventType string
+
+const (
+	// WatchEventCreated indicates that an object was created.
+	WatchEventCreated WatchEventType = "Created"
+
+	// WatchEventUpdated indicates that an object was updated. Watches that cannot distinguish a create
+	// from an update (eg: when resuming a polling watch) report Updated.
+	WatchEventUpdated WatchEventType = "Updated"
+
+	// WatchEventDeleted indicates that an object was deleted. The object contains the last known state when
+	// the storage client can provide it, otherwise only the ID is set.
+	WatchEventDeleted WatchEventType = "Deleted"
+
+	// WatchEventError indicates that the watch failed. The channel is closed after an error event.
+	WatchEventError WatchEventType = "Error"
+)
+
+// WatchEvent represents a change to an object that matches the query of a watch.
+type WatchEvent struct {
+	// Type is the type of the change.
+	Type WatchEventType
+
+	// Object is the object that was changed. Object is empty for error events.
+	Object Object
+
+	// ResumeToken can be passed to Watch() using WithResumeToken to resume watching after this event.
+	ResumeToken string
+
+	// Err is the error that terminated the watch. Err is only set for error events.
+	Err error
+}
+
+// Watcher is an optional interface implemented by StorageClient implementations that support streaming
+// changes. Use Watch() to watch any StorageClient, including ones that don't implement Watcher.
+type Watcher interface {
+	// Watch streams changes to objects matching the query until the context is cancelled. The returned
+	// channel is closed when the watch ends.
+	Watch(ctx context.Context, query Query, options ...WatchOptions) (<-chan WatchEvent, error)
+}
+
+// Watch streams changes to objects matching the query. Watch uses the native watch support of the
+// StorageClient when it implements Watcher, otherwise it falls back to polling with PollingWatch.
+func Watch(ctx context.Context, client StorageClient, query Query, options ...WatchOptions) (<-chan WatchEvent, error) {
+	if watcher, ok := client.(Watcher); ok {
+		return watcher.Watch(ctx, query, options...)
+	}
+
+	return PollingWatch(ctx, client, query, options...)
+}
+
+// PollingWatch implements Watch() by periodically querying the StorageClient and comparing ETags.
+//
+// The first query establishes the baseline and does not produce events. The resume token of a polling
+// watch is the count of completed polls. Since no history is available, resuming a polling watch reports
+// every existing object as Updated so that consumers can reconcile anything they may have missed.
+func PollingWatch(ctx context.Context, client StorageClient, query Query, options ...WatchOptions) (<-chan WatchEvent, error) {
+	if ctx == nil {
+		return nil, &ErrInvalid{Message: "invalid argument. 'ctx' is required"}
+	}
+	if query.RootScope == "" {
+		return nil, &ErrInvalid{Message: "invalid argument. 'query.RootScope' is required"}
+	}
+
+	cfg := NewWatchConfig(options...)
+	if cfg.PollingInterval <= 0 {
+		cfg.PollingInterval = DefaultPollingInterval
+	}
+
+	var poll int64
+	if cfg.ResumeToken != "" {
+		var err error
+		poll, err = strconv.ParseInt(cfg.ResumeToken, 10, 64)
+		if err != nil {
+			return nil, &ErrInvalid{Message: "invalid argument. resume token is invalid"}
+		}
+	}
+
+	// Run the first query synchronously so that errors (eg: an invalid query) are returned to the caller.
+	known, err := queryAll(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan WatchEvent, 1)
+	go func() {
+		defer close(out)
+
+		send := func(event WatchEvent) bool {
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		poll++
+		if cfg.ResumeToken != "" {
+			for _, obj := range known {
+				if !send(WatchEvent{Type: WatchEventUpdated, Object: obj, ResumeToken: strconv.FormatInt(poll, 10)}) {
+					return
+				}
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(cfg.PollingInterval):
+			}
+
+			current, err := queryAll(ctx, client, query)
+			if err != nil {
+				if ctx.Err() == nil {
+					send(WatchEvent{Type: WatchEventError, Err: err})
+				}
+				return
+			}
+
+			poll++
+			token := strconv.FormatInt(poll, 10)
+			for _, event := range DiffObjects(known, current) {
+				event.ResumeToken = token
+				if !send(event) {
+					return
+				}
+			}
+
+			known = current
+		}
+	}()
+
+	return out, nil
+}
+
+// queryAll runs the query to completion and returns the results keyed by ID.
+func queryAll(ctx context.Context, client StorageClient, query Query) (map[string]Object, error) {
+	results := map[string]Object{}
+	err := QueryEach(ctx, client, query, func(obj *Object) error {
+		results[obj.ID] = *obj
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DiffObjects compares two snapshots keyed by ID and returns the events that transform before into after. The
+// ResumeToken of the returned events is not set.
+func DiffObjects(before map[string]Object, after map[string]Object) []WatchEvent {
+	events := []WatchEvent{}
+	for id, obj := range after {
+		previous, ok := before[id]
+		if !ok {
+			events = append(events, WatchEvent{Type: WatchEventCreated, Object: obj})
+		} else if previous.ETag != obj.ETag {
+			events = append(events, WatchEvent{Type: WatchEventUpdated, Object: obj})
+		}
+	}
+
+	for id, obj := range before {
+		if _, ok := after[id]; !ok {
+			events = append(events, WatchEvent{Type: WatchEventDeleted, Object: obj})
+		}
+	}
+
+	return events
+}
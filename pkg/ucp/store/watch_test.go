@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient is a minimal StorageClient that returns a fixed set of objects from Query.
+type fakeClient struct {
+	mu    sync.Mutex
+	items map[string]Object
+}
+
+func (c *fakeClient) set(obj Object) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[obj.ID] = obj
+}
+
+func (c *fakeClient) remove(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, id)
+}
+
+func (c *fakeClient) Query(ctx context.Context, query Query, options ...QueryOptions) (*ObjectQueryResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := &ObjectQueryResult{}
+	for _, item := range c.items {
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+func (c *fakeClient) Get(ctx context.Context, id string, options ...GetOptions) (*Object, error) {
+	return nil, &ErrNotFound{ID: id}
+}
+
+func (c *fakeClient) Delete(ctx context.Context, id string, options ...DeleteOptions) error {
+	return nil
+}
+
+func (c *fakeClient) Save(ctx context.Context, obj *Object, options ...SaveOptions) error {
+	return nil
+}
+
+func object(id string, etag string) Object {
+	return Object{Metadata: Metadata{ID: id, ETag: etag}}
+}
+
+func receive(t *testing.T, events <-chan WatchEvent) WatchEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for watch event")
+		return WatchEvent{}
+	}
+}
+
+func Test_PollingWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := &fakeClient{items: map[string]Object{}}
+	client.set(object("/planes/radius/local/resourceGroups/a", "1"))
+
+	events, err := Watch(ctx, client, Query{RootScope: "/planes"}, WithPollingInterval(time.Millisecond))
+	require.NoError(t, err)
+
+	client.set(object("/planes/radius/local/resourceGroups/b", "1"))
+	event := receive(t, events)
+	require.Equal(t, WatchEventCreated, event.Type)
+	require.Equal(t, "/planes/radius/local/resourceGroups/b", event.Object.ID)
+	require.NotEmpty(t, event.ResumeToken)
+
+	client.set(object("/planes/radius/local/resourceGroups/a", "2"))
+	event = receive(t, events)
+	require.Equal(t, WatchEventUpdated, event.Type)
+	require.Equal(t, "/planes/radius/local/resourceGroups/a", event.Object.ID)
+
+	client.remove("/planes/radius/local/resourceGroups/b")
+	event = receive(t, events)
+	require.Equal(t, WatchEventDeleted, event.Type)
+	require.Equal(t, "/planes/radius/local/resourceGroups/b", event.Object.ID)
+
+	cancel()
+	for range events {
+		// Drain until the channel is closed.
+	}
+}
+
+func Test_PollingWatch_Resume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := &fakeClient{items: map[string]Object{}}
+	client.set(object("/planes/radius/local/resourceGroups/a", "1"))
+
+	events, err := Watch(ctx, client, Query{RootScope: "/planes"}, WithPollingInterval(time.Hour), WithResumeToken("5"))
+	require.NoError(t, err)
+
+	event := receive(t, events)
+	require.Equal(t, WatchEventUpdated, event.Type)
+	require.Equal(t, "/planes/radius/local/resourceGroups/a", event.Object.ID)
+	require.Equal(t, "6", event.ResumeToken)
+}
+
+func Test_PollingWatch_Invalid(t *testing.T) {
+	client := &fakeClient{items: map[string]Object{}}
+
+	_, err := Watch(context.Background(), client, Query{})
+	require.ErrorIs(t, err, &ErrInvalid{})
+
+	_, err = Watch(context.Background(), client, Query{RootScope: "/planes"}, WithResumeToken("not-a-number"))
+	require.ErrorIs(t, err, &ErrInvalid{})
+}
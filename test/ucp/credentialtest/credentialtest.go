@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentialtest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+// RequireLastRotatedAt verifies that the rotation time of the credential resource is recorded and clears it so that the
+// resource can be compared with the expected resource. credential is either an OK response whose body is the resource,
+// or a pointer to the resource. It works for the versioned resources of all credential types, whose properties have a
+// LastRotatedAt field.
+func RequireLastRotatedAt(t *testing.T, credential any) {
+	t.Helper()
+
+	if response, isResponse := credential.(armrpc_rest.Response); isResponse {
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.Truef(t, isOK, "expected an OK response, got %T", response)
+		credential = ok.Body
+	}
+
+	properties := reflect.ValueOf(credential).Elem().FieldByName("Properties")
+	require.Truef(t, properties.IsValid(), "the credential %T has no properties", credential)
+	require.False(t, properties.IsNil(), "the properties of the credential are missing")
+
+	field := properties.Elem().Elem().FieldByName("LastRotatedAt")
+	require.Truef(t, field.IsValid(), "the properties %s have no LastRotatedAt field", properties.Elem().Type())
+
+	lastRotatedAt, _ := field.Interface().(*time.Time)
+	require.NotNil(t, lastRotatedAt)
+	require.WithinDuration(t, time.Now(), *lastRotatedAt, time.Minute)
+	field.Set(reflect.Zero(field.Type()))
+}
+
+// NewFakeValidator creates a local fake of the validation of credentials against the cloud provider, which fails with
+// err. It accepts all the credentials when err is nil.
+func NewFakeValidator[T any](err error) sdk_cred.CredentialValidator[T] {
+	return sdk_cred.CredentialValidatorFunc[T](func(ctx context.Context, credential *T) error {
+		return err
+	})
+}
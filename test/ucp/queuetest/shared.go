@@ -104,6 +104,68 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		}
 	})
 
+	t.Run("dequeue messages by priority", func(t *testing.T) {
+		clear(t)
+
+		priorities := []client.Priority{client.PriorityLow, client.PriorityNormal, client.PriorityHigh, client.PriorityNormal, client.PriorityHigh}
+		for i, p := range priorities {
+			msg := client.NewMessage(&testQueueMessage{ID: fmt.Sprintf("%d", i), Message: p.String()})
+			msg.Priority = p
+			err := cli.Enqueue(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		// Messages of a higher priority class are dequeued first, and messages in the same class are dequeued in order.
+		for _, i := range []int{2, 4, 1, 3, 0} {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+			result := &testQueueMessage{}
+			err = json.Unmarshal(msg.Data, result)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("%d", i), result.ID)
+			require.Equal(t, priorities[i], msg.Priority)
+
+			err = cli.FinishMessage(ctx, msg)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("dequeue messages in turns of fairness keys", func(t *testing.T) {
+		clear(t)
+
+		// The first key has a backlog of messages enqueued before the message of the second key.
+		for i := 0; i < 20; i++ {
+			msg := client.NewMessage(&testQueueMessage{ID: fmt.Sprintf("busy-%d", i), Message: "busy"})
+			msg.FairnessKey = "/planes/radius/local/resourcegroups/busy"
+			err := cli.Enqueue(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		msg := client.NewMessage(&testQueueMessage{ID: "quiet", Message: "quiet"})
+		msg.FairnessKey = "/planes/radius/local/resourcegroups/quiet"
+		err := cli.Enqueue(ctx, msg)
+		require.NoError(t, err)
+
+		// The message of the second key is dequeued in the first round, ahead of the backlog of the first key.
+		keys := []string{}
+		for i := 0; i < 2; i++ {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+			keys = append(keys, msg.FairnessKey)
+		}
+		require.ElementsMatch(t, []string{"/planes/radius/local/resourcegroups/busy", "/planes/radius/local/resourcegroups/quiet"}, keys)
+
+		// The remaining messages of the first key are dequeued in order.
+		for i := 1; i < 20; i++ {
+			msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+			require.NoError(t, err)
+			result := &testQueueMessage{}
+			err = json.Unmarshal(msg.Data, result)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprintf("busy-%d", i), result.ID)
+		}
+	})
+
 	t.Run("message lock is expired", func(t *testing.T) {
 		clear(t)
 
@@ -204,6 +266,37 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.ErrorIs(t, err, client.ErrInvalidMessage)
 	})
 
+	if dlq, ok := cli.(client.DeadLetterQueue); ok {
+		runDeadLetterTest(ctx, t, cli, dlq, clear)
+	}
+
+	if _, ok := cli.(client.Notifier); ok {
+		t.Run("StartDequeuer is notified of enqueued messages", func(t *testing.T) {
+			clear(t)
+
+			// The dequeuer must not poll the queue, so the message can only be received after a notification.
+			notifyCtx, cancelNotify := context.WithCancel(ctx)
+			defer cancelNotify()
+			msgCh, err := client.StartDequeuer(notifyCtx, cli, client.WithDequeueInterval(time.Hour), client.WithIdleDequeueInterval(time.Hour))
+			require.NoError(t, err)
+
+			// Wait for the first dequeue attempt of the empty queue.
+			time.Sleep(pollingInterval)
+
+			err = queueTestMessage(cli, 1)
+			require.NoError(t, err)
+
+			select {
+			case msg := <-msgCh:
+				require.Equal(t, 1, msg.DequeueCount)
+				err = cli.FinishMessage(ctx, msg)
+				require.NoError(t, err)
+			case <-time.After(10 * time.Second):
+				require.Fail(t, "message is not dequeued after the notification")
+			}
+		})
+	}
+
 	t.Run("StartDequeuer dequeues message via channel", func(t *testing.T) {
 		clear(t)
 		msgCh, err := client.StartDequeuer(ctx, cli, client.WithDequeueInterval(defaultTestDequeueInterval))
@@ -240,3 +333,92 @@ func RunTest(t *testing.T, cli client.Client, clear func(t *testing.T)) {
 		require.Equal(t, msgCount, recvCnt)
 	})
 }
+
+// runDeadLetterTest tests the DeadLetterQueue methods of the client.
+func runDeadLetterTest(ctx context.Context, t *testing.T, cli client.Client, dlq client.DeadLetterQueue, clear func(t *testing.T)) {
+	t.Run("dead-lettered message is not dequeued", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = client.DeadLetter(ctx, cli, msg, client.DeadLetterInfo{Reason: client.DeadLetterReasonMaxRetryCountExceeded, LastError: "boom"})
+		require.NoError(t, err)
+
+		// The message must not be requeued after the lock is released.
+		time.Sleep(TestMessageLockTime * 2)
+		_, err = cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.ErrorIs(t, err, client.ErrMessageNotFound)
+
+		deadLetters, err := dlq.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		require.Equal(t, msg.ID, deadLetters[0].ID)
+		require.Equal(t, msg.Data, deadLetters[0].Data)
+		require.Equal(t, client.DeadLetterReasonMaxRetryCountExceeded, deadLetters[0].Reason)
+		require.Equal(t, "boom", deadLetters[0].LastError)
+		require.False(t, deadLetters[0].DeadLetteredAt.IsZero())
+
+		deadLetter, err := dlq.GetDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+		require.Equal(t, deadLetters[0].Data, deadLetter.Data)
+
+		// The message is no longer leased, so it can't be finished or dead-lettered again.
+		err = dlq.DeadLetter(ctx, msg, client.DeadLetterInfo{})
+		require.ErrorIs(t, err, client.ErrInvalidMessage)
+	})
+
+	t.Run("requeue dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dlq.DeadLetter(ctx, msg, client.DeadLetterInfo{Reason: client.DeadLetterReasonInvalidMessage})
+		require.NoError(t, err)
+
+		err = dlq.RequeueDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		deadLetters, err := dlq.ListDeadLetters(ctx)
+		require.NoError(t, err)
+		require.Empty(t, deadLetters)
+
+		requeued, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+		require.Equal(t, msg.ID, requeued.ID)
+		require.Equal(t, msg.Data, requeued.Data)
+		require.Equal(t, 1, requeued.DequeueCount)
+
+		err = dlq.RequeueDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+	})
+
+	t.Run("delete dead-lettered message", func(t *testing.T) {
+		clear(t)
+
+		err := queueTestMessage(cli, 1)
+		require.NoError(t, err)
+
+		msg, err := cli.Dequeue(ctx, client.QueueClientConfig{})
+		require.NoError(t, err)
+
+		err = dlq.DeadLetter(ctx, msg, client.DeadLetterInfo{Reason: client.DeadLetterReasonUnknownOperation})
+		require.NoError(t, err)
+
+		err = dlq.DeleteDeadLetter(ctx, msg.ID)
+		require.NoError(t, err)
+
+		_, err = dlq.GetDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+
+		err = dlq.DeleteDeadLetter(ctx, msg.ID)
+		require.ErrorIs(t, err, client.ErrDeadLetterNotFound)
+	})
+}
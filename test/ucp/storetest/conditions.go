@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/store"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// ConditionData1, ConditionData2 and ConditionData3 are the data of the objects used by RunConditionsTest. They use
+// numbers, booleans, nulls, timestamps and nested objects so that the semantics of the conditions can be compared
+// across storage clients.
+var ConditionData1 = map[string]any{
+	"name": "frontend",
+	"tags": map[string]any{
+		"team": "web",
+	},
+	"properties": map[string]any{
+		"port":    6379,
+		"enabled": true,
+		"tier":    "web",
+		"config": map[string]any{
+			"key": "value",
+		},
+	},
+	"systemData": map[string]any{
+		"createdAt": "2023-10-01T10:00:00Z",
+	},
+}
+
+var ConditionData2 = map[string]any{
+	"name": "backend",
+	"tags": map[string]any{
+		"team": nil,
+	},
+	"properties": map[string]any{
+		"port":    80,
+		"enabled": false,
+		"tier":    "db",
+	},
+	"systemData": map[string]any{
+		"createdAt": "2023-09-01T00:00:00Z",
+	},
+}
+
+var ConditionData3 = map[string]any{
+	"name": "frontdoor",
+	"properties": map[string]any{
+		"port": 10000,
+		"tier": "web",
+	},
+	"systemData": map[string]any{
+		"createdAt": "2023-10-02T00:00:00Z",
+	},
+}
+
+// RunConditionsTest checks that the StorageClient's Query method evaluates query.Conditions and query.OrderBy with
+// the semantics of store.Object.MatchesConditions and store.SortObjects. Values are compared as strings, range
+// comparisons and sorting use store.CompareValues, and missing fields and non-scalar values only match 'ne'. It is
+// run by RunTest, and separately for storage clients that do not support all the queries of RunTest.
+func RunConditionsTest(t *testing.T, client store.StorageClient, clear func(t *testing.T)) {
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	clear(t)
+
+	obj1 := createObject(Resource1ID, ConditionData1)
+	require.NoError(t, client.Save(ctx, &obj1))
+
+	obj2 := createObject(Resource2ID, ConditionData2)
+	require.NoError(t, client.Save(ctx, &obj2))
+
+	obj3 := createObject(Resource3ID, ConditionData3)
+	require.NoError(t, client.Save(ctx, &obj3))
+
+	conditionTests := []struct {
+		desc       string
+		conditions []store.FilterCondition
+		expected   []string
+	}{
+		{
+			desc:       "eq_number",
+			conditions: []store.FilterCondition{{Field: "properties.port", Operator: store.FilterOperatorEqual, Values: []string{"6379"}}},
+			expected:   []string{obj1.ID},
+		},
+		{
+			desc:       "eq_boolean",
+			conditions: []store.FilterCondition{{Field: "properties.enabled", Operator: store.FilterOperatorEqual, Values: []string{"true"}}},
+			expected:   []string{obj1.ID},
+		},
+		{
+			desc:       "eq_object",
+			conditions: []store.FilterCondition{{Field: "properties.config", Operator: store.FilterOperatorEqual, Values: []string{"value"}}},
+			expected:   []string{},
+		},
+		{
+			desc:       "ne_missing",
+			conditions: []store.FilterCondition{{Field: "properties.enabled", Operator: store.FilterOperatorNotEqual, Values: []string{"true"}}},
+			expected:   []string{obj2.ID, obj3.ID},
+		},
+		{
+			desc:       "ne_object",
+			conditions: []store.FilterCondition{{Field: "properties.config", Operator: store.FilterOperatorNotEqual, Values: []string{"value"}}},
+			expected:   []string{obj1.ID, obj2.ID, obj3.ID},
+		},
+		{
+			desc:       "in_numbers",
+			conditions: []store.FilterCondition{{Field: "properties.port", Operator: store.FilterOperatorIn, Values: []string{"80", "10000"}}},
+			expected:   []string{obj2.ID, obj3.ID},
+		},
+		{
+			desc:       "prefix_string",
+			conditions: []store.FilterCondition{{Field: "name", Operator: store.FilterOperatorPrefix, Values: []string{"front"}}},
+			expected:   []string{obj1.ID, obj3.ID},
+		},
+		{
+			desc:       "prefix_number",
+			conditions: []store.FilterCondition{{Field: "properties.port", Operator: store.FilterOperatorPrefix, Values: []string{"63"}}},
+			expected:   []string{obj1.ID},
+		},
+		{
+			desc:       "exists_ignores_null",
+			conditions: []store.FilterCondition{{Field: "tags.team", Operator: store.FilterOperatorExists}},
+			expected:   []string{obj1.ID},
+		},
+		{
+			// Numbers are compared by value, as strings "80" would be greater than "1000".
+			desc:       "gt_number",
+			conditions: []store.FilterCondition{{Field: "properties.port", Operator: store.FilterOperatorGreaterThan, Values: []string{"1000"}}},
+			expected:   []string{obj1.ID, obj3.ID},
+		},
+		{
+			desc:       "lt_timestamp",
+			conditions: []store.FilterCondition{{Field: "systemData.createdAt", Operator: store.FilterOperatorLessThan, Values: []string{"2023-10-01T12:00:00Z"}}},
+			expected:   []string{obj1.ID, obj2.ID},
+		},
+		{
+			desc: "range_and_equality",
+			conditions: []store.FilterCondition{
+				{Field: "properties.port", Operator: store.FilterOperatorGreaterThan, Values: []string{"100"}},
+				{Field: "properties.tier", Operator: store.FilterOperatorEqual, Values: []string{"web"}},
+				{Field: "name", Operator: store.FilterOperatorNotEqual, Values: []string{"frontend"}},
+			},
+			expected: []string{obj3.ID},
+		},
+	}
+
+	for _, tc := range conditionTests {
+		t.Run("conditions_"+tc.desc, func(t *testing.T) {
+			ids := queryIDs(ctx, t, client, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Conditions: tc.conditions}, 0)
+			require.ElementsMatch(t, tc.expected, ids)
+		})
+	}
+
+	orderByTests := []struct {
+		desc         string
+		orderBy      []store.SortField
+		maxItemCount int
+		expected     []string
+	}{
+		{
+			// Numbers are sorted by value, as strings "10000" would sort first.
+			desc:     "number",
+			orderBy:  []store.SortField{{Field: "properties.port"}},
+			expected: []string{obj2.ID, obj1.ID, obj3.ID},
+		},
+		{
+			desc:     "multiple_fields",
+			orderBy:  []store.SortField{{Field: "properties.tier", Descending: true}, {Field: "properties.port", Descending: true}},
+			expected: []string{obj3.ID, obj1.ID, obj2.ID},
+		},
+		{
+			desc:     "missing_field_first",
+			orderBy:  []store.SortField{{Field: "properties.enabled"}},
+			expected: []string{obj3.ID, obj2.ID, obj1.ID},
+		},
+		{
+			desc:         "paginated",
+			orderBy:      []store.SortField{{Field: "systemData.createdAt", Descending: true}},
+			maxItemCount: 2,
+			expected:     []string{obj3.ID, obj1.ID, obj2.ID},
+		},
+	}
+
+	for _, tc := range orderByTests {
+		t.Run("order_by_"+tc.desc, func(t *testing.T) {
+			ids := queryIDs(ctx, t, client, store.Query{RootScope: RadiusScope, ScopeRecursive: true, OrderBy: tc.orderBy}, tc.maxItemCount)
+			require.Equal(t, tc.expected, ids)
+		})
+	}
+
+	t.Run("invalid_condition", func(t *testing.T) {
+		conditions := []store.FilterCondition{{Field: "name", Operator: "bogus", Values: []string{"frontend"}}}
+		_, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Conditions: conditions})
+		require.ErrorIs(t, err, &store.ErrInvalid{})
+	})
+}
+
+// queryIDs runs the query, following the pagination tokens, and returns the IDs of the results in order.
+func queryIDs(ctx context.Context, t *testing.T, client store.StorageClient, query store.Query, maxItemCount int) []string {
+	ids := []string{}
+	token := ""
+	for {
+		options := []store.QueryOptions{store.WithPaginationToken(token)}
+		if maxItemCount > 0 {
+			options = append(options, store.WithMaxQueryItemCount(maxItemCount))
+		}
+
+		result, err := client.Query(ctx, query, options...)
+		require.NoError(t, err)
+
+		for _, item := range result.Items {
+			ids = append(ids, item.ID)
+		}
+
+		if result.PaginationToken == "" {
+			return ids
+		}
+		token = result.PaginationToken
+	}
+}
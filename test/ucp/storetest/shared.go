@@ -287,6 +287,35 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		require.Nil(t, obj1Get)
 	})
 
+	t.Run("save_create_only_creates_missing_resource", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1, store.WithCreateOnly())
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
+	t.Run("save_create_only_cannot_update", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource1ID, Data2)
+		err = client.Save(ctx, &obj2, store.WithCreateOnly())
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
 	t.Run("save_and_get_scope_only", func(t *testing.T) {
 		clear(t)
 
@@ -351,6 +380,71 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 		require.ErrorIs(t, err, &store.ErrConcurrency{})
 	})
 
+	t.Run("batch_save_and_delete", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj1Updated := createObject(Resource1ID, Data2)
+		obj2 := createObject(NestedResource1ID, NestedData1)
+		batch := (&store.Batch{}).
+			Save(&obj1Updated, store.WithETag(obj1.ETag)).
+			Save(&obj2).
+			Delete(Resource2ID.String())
+
+		err = store.CommitBatch(ctx, client, batch)
+		require.NoError(t, err)
+		require.NotEmpty(t, obj1Updated.ETag)
+		require.NotEmpty(t, obj2.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1Updated, obj1Get)
+
+		obj2Get, err := client.Get(ctx, NestedResource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj2, obj2Get)
+
+		batch = (&store.Batch{}).
+			Delete(Resource1ID.String(), store.WithETag(obj1Updated.ETag)).
+			Delete(NestedResource1ID.String())
+
+		err = store.CommitBatch(ctx, client, batch)
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, Resource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: Resource1ID.String()})
+		_, err = client.Get(ctx, NestedResource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: NestedResource1ID.String()})
+	})
+
+	t.Run("batch_is_not_applied_with_non_matching_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj1Updated := createObject(Resource1ID, Data2)
+		obj2 := createObject(NestedResource1ID, NestedData1)
+		batch := (&store.Batch{}).
+			Save(&obj2).
+			Save(&obj1Updated, store.WithETag(etag.New(MarshalOrPanic(Data2))))
+
+		err = store.CommitBatch(ctx, client, batch)
+		require.ErrorIs(t, err, &store.ErrConcurrency{})
+		require.Empty(t, obj2.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+
+		_, err = client.Get(ctx, NestedResource1ID.String())
+		require.ErrorIs(t, err, &store.ErrNotFound{ID: NestedResource1ID.String()})
+	})
+
 	t.Run("list_can_be_empty", func(t *testing.T) {
 		clear(t)
 
@@ -485,6 +579,46 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 
+		t.Run("query_resources_at_plane_scope_recursive_with_conditions", func(t *testing.T) {
+			conditions := []store.FilterCondition{
+				{Field: "value", Operator: store.FilterOperatorIn, Values: []string{"1", "3"}},
+				{Field: "properties.resource", Operator: store.FilterOperatorExists},
+				{Field: "properties.missing", Operator: store.FilterOperatorNotEqual, Values: []string{"1"}},
+			}
+			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Conditions: conditions})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj1,
+				nested1,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("query_resources_at_plane_scope_recursive_with_range_conditions", func(t *testing.T) {
+			conditions := []store.FilterCondition{
+				{Field: "value", Operator: store.FilterOperatorGreaterThan, Values: []string{"1"}},
+				{Field: "properties.resource", Operator: store.FilterOperatorPrefix, Values: []string{"2"}},
+			}
+			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, Conditions: conditions})
+			require.NoError(t, err)
+			expected := []store.Object{
+				obj2,
+			}
+			CompareObjectLists(t, expected, objs.Items)
+		})
+
+		t.Run("query_resources_at_plane_scope_recursive_with_order_by", func(t *testing.T) {
+			orderBy := []store.SortField{{Field: "value", Descending: true}}
+			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, OrderBy: orderBy})
+			require.NoError(t, err)
+
+			ids := []string{}
+			for _, item := range objs.Items {
+				ids = append(ids, item.ID)
+			}
+			require.Equal(t, []string{nested1.ID, obj2.ID, obj1.ID}, ids)
+		})
+
 		t.Run("query_resources_at_plane_scope_recursive_with_prefix", func(t *testing.T) {
 			objs, err := client.Query(ctx, store.Query{RootScope: RadiusScope, ScopeRecursive: true, RoutingScopePrefix: ResourcePath1})
 			require.NoError(t, err)
@@ -588,4 +722,8 @@ func RunTest(t *testing.T, client store.StorageClient, clear func(t *testing.T))
 			CompareObjectLists(t, expected, objs.Items)
 		})
 	})
+
+	t.Run("query_conditions", func(t *testing.T) {
+		RunConditionsTest(t, client, clear)
+	})
 }